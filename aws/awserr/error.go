@@ -86,3 +86,38 @@ type RequestFailure interface {
 	// to a connection error.
 	RequestID() string
 }
+
+// An ExtendedRequestFailure is an interface to extract the extended details
+// a service may return with a request failure, in addition to those of a
+// RequestFailure. S3 errors satisfy this interface. Details the service did
+// not return are empty.
+//
+// Example:
+//
+//     output, err := svc.HeadObject(input)
+//     if err != nil {
+//         if reqErr, ok := err.(awserr.ExtendedRequestFailure); ok {
+//             // Report the IDs AWS support needs to trace the request
+//             fmt.Println(reqErr.RequestID(), reqErr.HostID())
+//         }
+//     }
+//
+type ExtendedRequestFailure interface {
+	RequestFailure
+
+	// The host ID returned by the service, which identifies the host that
+	// served the request together with the request ID.
+	HostID() string
+
+	// The resource the request failed on.
+	Resource() string
+
+	// The bucket the request failed on, for storage services.
+	BucketName() string
+
+	// The key of the object the request failed on, for storage services.
+	Key() string
+
+	// The raw body of the error response. Nil if the response had no body.
+	Body() []byte
+}
@@ -21,6 +21,19 @@ var DefaultChainCredentials = credentials.NewChainCredentials(
 		&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
 	})
 
+// The addressing styles of S3 requests. See Config.S3AddressingStyle.
+const (
+	// S3AddressingStyleAuto puts the bucket in the host if the bucket name
+	// allows it, and in the path otherwise.
+	S3AddressingStyleAuto = ""
+
+	// S3AddressingStylePath always puts the bucket in the path.
+	S3AddressingStylePath = "path"
+
+	// S3AddressingStyleVirtual always puts the bucket in the host.
+	S3AddressingStyleVirtual = "virtual"
+)
+
 // The default number of retries for a service. The value of -1 indicates that
 // the service specific retry default will be used.
 const DefaultRetries = -1
@@ -40,6 +53,13 @@ var DefaultConfig = &Config{
 	DisableParamValidation:  false,
 	DisableComputeChecksums: false,
 	S3ForcePathStyle:        false,
+	S3AddressingStyle:       S3AddressingStyleAuto,
+	S3BucketEndpoint:        false,
+	S3UseAccelerate:         false,
+	UseDualStack:            false,
+	UseFIPSEndpoint:         false,
+	ValidateParamEnums:      false,
+	EndpointResolver:        nil,
 }
 
 // A Config provides service configuration
@@ -57,6 +77,37 @@ type Config struct {
 	DisableParamValidation  bool
 	DisableComputeChecksums bool
 	S3ForcePathStyle        bool
+
+	// The addressing style of S3 requests to Endpoint, one of the
+	// S3AddressingStyle constants. S3ForcePathStyle takes precedence.
+	S3AddressingStyle string
+
+	// Set if Endpoint already addresses the bucket, so S3 requests add the
+	// bucket to neither the host nor the path.
+	S3BucketEndpoint bool
+
+	// Send S3 requests through S3 Transfer Acceleration. The bucket must
+	// have acceleration enabled, and its name must be DNS compatible and
+	// contain no dots.
+	S3UseAccelerate bool
+
+	// Send requests to the dualstack endpoint of the region, which supports
+	// both IPv4 and IPv6. Ignored if Endpoint is set.
+	UseDualStack bool
+
+	// Send requests to the FIPS 140-2 validated endpoint of the region.
+	// Ignored if Endpoint is set.
+	UseFIPSEndpoint bool
+
+	// The resolver of the endpoints services send requests to when Endpoint
+	// is not set. Leave this as nil to use DefaultEndpointResolver.
+	EndpointResolver EndpointResolver
+
+	// Also reject input parameters whose value is not one of the values of
+	// their enum. Off by default, since services add enum values, such as new
+	// regions of a bucket's LocationConstraint, which the SDK does not know
+	// of yet.
+	ValidateParamEnums bool
 }
 
 // Copy will return a shallow copy of the Config object.
@@ -75,6 +126,13 @@ func (c Config) Copy() Config {
 	dst.DisableParamValidation = c.DisableParamValidation
 	dst.DisableComputeChecksums = c.DisableComputeChecksums
 	dst.S3ForcePathStyle = c.S3ForcePathStyle
+	dst.S3AddressingStyle = c.S3AddressingStyle
+	dst.S3BucketEndpoint = c.S3BucketEndpoint
+	dst.S3UseAccelerate = c.S3UseAccelerate
+	dst.UseDualStack = c.UseDualStack
+	dst.UseFIPSEndpoint = c.UseFIPSEndpoint
+	dst.ValidateParamEnums = c.ValidateParamEnums
+	dst.EndpointResolver = c.EndpointResolver
 
 	return dst
 }
@@ -169,5 +227,47 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.S3ForcePathStyle = c.S3ForcePathStyle
 	}
 
+	if newcfg.S3AddressingStyle != "" {
+		cfg.S3AddressingStyle = newcfg.S3AddressingStyle
+	} else {
+		cfg.S3AddressingStyle = c.S3AddressingStyle
+	}
+
+	if newcfg.S3BucketEndpoint {
+		cfg.S3BucketEndpoint = newcfg.S3BucketEndpoint
+	} else {
+		cfg.S3BucketEndpoint = c.S3BucketEndpoint
+	}
+
+	if newcfg.S3UseAccelerate {
+		cfg.S3UseAccelerate = newcfg.S3UseAccelerate
+	} else {
+		cfg.S3UseAccelerate = c.S3UseAccelerate
+	}
+
+	if newcfg.UseDualStack {
+		cfg.UseDualStack = newcfg.UseDualStack
+	} else {
+		cfg.UseDualStack = c.UseDualStack
+	}
+
+	if newcfg.UseFIPSEndpoint {
+		cfg.UseFIPSEndpoint = newcfg.UseFIPSEndpoint
+	} else {
+		cfg.UseFIPSEndpoint = c.UseFIPSEndpoint
+	}
+
+	if newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	} else {
+		cfg.EndpointResolver = c.EndpointResolver
+	}
+
+	if newcfg.ValidateParamEnums {
+		cfg.ValidateParamEnums = newcfg.ValidateParamEnums
+	} else {
+		cfg.ValidateParamEnums = c.ValidateParamEnums
+	}
+
 	return &cfg
 }
@@ -18,6 +18,13 @@ var testCredentials = credentials.NewChainCredentials([]credentials.Provider{
 	&credentials.EC2RoleProvider{ExpiryWindow: 5 * time.Minute},
 })
 
+// staticResolver is a comparable EndpointResolver for the config tests.
+type staticResolver struct{}
+
+func (staticResolver) EndpointFor(service, region string, opts EndpointOptions) (ResolvedEndpoint, error) {
+	return ResolvedEndpoint{URL: "https://static.example.com"}, nil
+}
+
 var copyTestConfig = Config{
 	Credentials:             testCredentials,
 	Endpoint:                "CopyTestEndpoint",
@@ -32,6 +39,13 @@ var copyTestConfig = Config{
 	DisableParamValidation:  true,
 	DisableComputeChecksums: true,
 	S3ForcePathStyle:        true,
+	S3AddressingStyle:       S3AddressingStyleVirtual,
+	S3BucketEndpoint:        true,
+	S3UseAccelerate:         true,
+	UseDualStack:            true,
+	UseFIPSEndpoint:         true,
+	EndpointResolver:        staticResolver{},
+	ValidateParamEnums:      true,
 }
 
 func TestCopy(t *testing.T) {
@@ -67,6 +81,13 @@ var mergeTestConfig = Config{
 	DisableParamValidation:  true,
 	DisableComputeChecksums: true,
 	S3ForcePathStyle:        true,
+	S3AddressingStyle:       S3AddressingStyleVirtual,
+	S3BucketEndpoint:        true,
+	S3UseAccelerate:         true,
+	UseDualStack:            true,
+	UseFIPSEndpoint:         true,
+	EndpointResolver:        staticResolver{},
+	ValidateParamEnums:      true,
 }
 
 var mergeTests = []struct {
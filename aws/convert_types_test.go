@@ -0,0 +1,881 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueConversions(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, "", StringValue(nil))
+	assert.Equal(t, "foo", StringValue(String("foo")))
+	assert.Equal(t, false, BoolValue(nil))
+	assert.Equal(t, true, BoolValue(Bool(true)))
+	assert.Equal(t, 0, IntValue(nil))
+	assert.Equal(t, 1, IntValue(Int(1)))
+	assert.Equal(t, int32(0), Int32Value(nil))
+	assert.Equal(t, int32(1), Int32Value(Int32(1)))
+	assert.Equal(t, int64(0), Int64Value(nil))
+	assert.Equal(t, int64(1), Int64Value(Int64(1)))
+	assert.Equal(t, uint(0), UintValue(nil))
+	assert.Equal(t, uint(1), UintValue(Uint(1)))
+	assert.Equal(t, uint64(1), Uint64Value(Uint64(1)))
+	assert.Equal(t, float32(0), Float32Value(nil))
+	assert.Equal(t, float32(1.5), Float32Value(Float32(1.5)))
+	assert.Equal(t, float64(0), Float64Value(nil))
+	assert.Equal(t, 1.5, Float64Value(Float64(1.5)))
+	assert.Equal(t, time.Time{}, TimeValue(nil))
+	assert.Equal(t, now, TimeValue(Time(now)))
+}
+
+func TestValueConversionsOfExistingConstructors(t *testing.T) {
+	assert.Equal(t, true, BoolValue(Boolean(true)))
+	assert.Equal(t, int64(1), Int64Value(Long(1)))
+	assert.Equal(t, 1.5, Float64Value(Double(1.5)))
+}
+
+func TestSliceConversions(t *testing.T) {
+	in := []string{"a", "", "b"}
+	out := StringSlice(in)
+	assert.Len(t, out, 3)
+	for i := range in {
+		assert.Equal(t, in[i], *out[i])
+	}
+	assert.Equal(t, in, StringValueSlice(out))
+
+	assert.Equal(t, []string{"a", ""}, StringValueSlice([]*string{String("a"), nil}))
+	assert.Equal(t, []int64{1, 0}, Int64ValueSlice([]*int64{Int64(1), nil}))
+	assert.Equal(t, []bool{true, false}, BoolValueSlice(BoolSlice([]bool{true, false})))
+	assert.Equal(t, []float64{1.5}, Float64ValueSlice(Float64Slice([]float64{1.5})))
+	assert.Empty(t, StringSlice(nil))
+	assert.Empty(t, StringValueSlice(nil))
+}
+
+func TestMapConversions(t *testing.T) {
+	in := map[string]string{"a": "1", "b": ""}
+	out := StringMap(in)
+	assert.Len(t, out, 2)
+	for k, v := range in {
+		assert.Equal(t, v, *out[k])
+	}
+	assert.Equal(t, in, StringValueMap(out))
+
+	assert.Equal(t, map[string]string{"a": "1"}, StringValueMap(map[string]*string{"a": String("1"), "b": nil}))
+	assert.Equal(t, map[string]int64{"a": 1}, Int64ValueMap(Int64Map(map[string]int64{"a": 1})))
+	assert.Empty(t, StringValueMap(nil))
+}
+
+func TestTimeConversions(t *testing.T) {
+	tm := time.Date(2015, 6, 30, 12, 0, 0, int(250*time.Millisecond), time.UTC)
+
+	assert.Equal(t, int64(1435665600250), TimeUnixMilli(tm))
+	assert.True(t, tm.Equal(MillisecondsTimeValue(Int64(1435665600250))))
+	assert.True(t, tm.Truncate(time.Second).Equal(SecondsTimeValue(Int64(1435665600))))
+	assert.Equal(t, time.Time{}, MillisecondsTimeValue(nil))
+	assert.Equal(t, time.Time{}, SecondsTimeValue(nil))
+}
+
+// conversionTests covers the value, slice and map conversions of every
+// type. Nil pointers convert to the zero value in slices and are dropped
+// from maps.
+var conversionTests = []struct {
+	name       string
+	value      func(interface{}) interface{}
+	nilValue   func() interface{}
+	slice      func(interface{}) interface{}
+	valueSlice func() interface{}
+	m          func(interface{}) interface{}
+	valueMap   func() interface{}
+
+	in, zero          interface{}
+	inSlice, outSlice interface{}
+	inMap, outMap     interface{}
+}{
+	{
+		name:       "String",
+		value:      func(v interface{}) interface{} { return StringValue(String(v.(string))) },
+		nilValue:   func() interface{} { return StringValue(nil) },
+		slice:      func(v interface{}) interface{} { return StringValueSlice(StringSlice(v.([]string))) },
+		valueSlice: func() interface{} { return StringValueSlice([]*string{String("a"), nil, String("c")}) },
+		m:          func(v interface{}) interface{} { return StringValueMap(StringMap(v.(map[string]string))) },
+		valueMap:   func() interface{} { return StringValueMap(map[string]*string{"a": String("a"), "b": nil}) },
+		in:         "a",
+		zero:       "",
+		inSlice:    []string{"a", "", "c"},
+		outSlice:   []string{"a", "", "c"},
+		inMap:      map[string]string{"a": "a", "b": "b", "c": ""},
+		outMap:     map[string]string{"a": "a"},
+	},
+	{
+		name:       "Bool",
+		value:      func(v interface{}) interface{} { return BoolValue(Bool(v.(bool))) },
+		nilValue:   func() interface{} { return BoolValue(nil) },
+		slice:      func(v interface{}) interface{} { return BoolValueSlice(BoolSlice(v.([]bool))) },
+		valueSlice: func() interface{} { return BoolValueSlice([]*bool{Bool(true), nil, Bool(true)}) },
+		m:          func(v interface{}) interface{} { return BoolValueMap(BoolMap(v.(map[string]bool))) },
+		valueMap:   func() interface{} { return BoolValueMap(map[string]*bool{"a": Bool(true), "b": nil}) },
+		in:         true,
+		zero:       false,
+		inSlice:    []bool{true, false, true},
+		outSlice:   []bool{true, false, true},
+		inMap:      map[string]bool{"a": true, "b": true, "c": false},
+		outMap:     map[string]bool{"a": true},
+	},
+	{
+		name:       "Int",
+		value:      func(v interface{}) interface{} { return IntValue(Int(v.(int))) },
+		nilValue:   func() interface{} { return IntValue(nil) },
+		slice:      func(v interface{}) interface{} { return IntValueSlice(IntSlice(v.([]int))) },
+		valueSlice: func() interface{} { return IntValueSlice([]*int{Int(1), nil, Int(3)}) },
+		m:          func(v interface{}) interface{} { return IntValueMap(IntMap(v.(map[string]int))) },
+		valueMap:   func() interface{} { return IntValueMap(map[string]*int{"a": Int(1), "b": nil}) },
+		in:         int(1),
+		zero:       int(0),
+		inSlice:    []int{1, 0, 3},
+		outSlice:   []int{1, 0, 3},
+		inMap:      map[string]int{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]int{"a": 1},
+	},
+	{
+		name:       "Int8",
+		value:      func(v interface{}) interface{} { return Int8Value(Int8(v.(int8))) },
+		nilValue:   func() interface{} { return Int8Value(nil) },
+		slice:      func(v interface{}) interface{} { return Int8ValueSlice(Int8Slice(v.([]int8))) },
+		valueSlice: func() interface{} { return Int8ValueSlice([]*int8{Int8(1), nil, Int8(3)}) },
+		m:          func(v interface{}) interface{} { return Int8ValueMap(Int8Map(v.(map[string]int8))) },
+		valueMap:   func() interface{} { return Int8ValueMap(map[string]*int8{"a": Int8(1), "b": nil}) },
+		in:         int8(1),
+		zero:       int8(0),
+		inSlice:    []int8{1, 0, 3},
+		outSlice:   []int8{1, 0, 3},
+		inMap:      map[string]int8{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]int8{"a": 1},
+	},
+	{
+		name:       "Int16",
+		value:      func(v interface{}) interface{} { return Int16Value(Int16(v.(int16))) },
+		nilValue:   func() interface{} { return Int16Value(nil) },
+		slice:      func(v interface{}) interface{} { return Int16ValueSlice(Int16Slice(v.([]int16))) },
+		valueSlice: func() interface{} { return Int16ValueSlice([]*int16{Int16(1), nil, Int16(3)}) },
+		m:          func(v interface{}) interface{} { return Int16ValueMap(Int16Map(v.(map[string]int16))) },
+		valueMap:   func() interface{} { return Int16ValueMap(map[string]*int16{"a": Int16(1), "b": nil}) },
+		in:         int16(1),
+		zero:       int16(0),
+		inSlice:    []int16{1, 0, 3},
+		outSlice:   []int16{1, 0, 3},
+		inMap:      map[string]int16{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]int16{"a": 1},
+	},
+	{
+		name:       "Int32",
+		value:      func(v interface{}) interface{} { return Int32Value(Int32(v.(int32))) },
+		nilValue:   func() interface{} { return Int32Value(nil) },
+		slice:      func(v interface{}) interface{} { return Int32ValueSlice(Int32Slice(v.([]int32))) },
+		valueSlice: func() interface{} { return Int32ValueSlice([]*int32{Int32(1), nil, Int32(3)}) },
+		m:          func(v interface{}) interface{} { return Int32ValueMap(Int32Map(v.(map[string]int32))) },
+		valueMap:   func() interface{} { return Int32ValueMap(map[string]*int32{"a": Int32(1), "b": nil}) },
+		in:         int32(1),
+		zero:       int32(0),
+		inSlice:    []int32{1, 0, 3},
+		outSlice:   []int32{1, 0, 3},
+		inMap:      map[string]int32{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]int32{"a": 1},
+	},
+	{
+		name:       "Int64",
+		value:      func(v interface{}) interface{} { return Int64Value(Int64(v.(int64))) },
+		nilValue:   func() interface{} { return Int64Value(nil) },
+		slice:      func(v interface{}) interface{} { return Int64ValueSlice(Int64Slice(v.([]int64))) },
+		valueSlice: func() interface{} { return Int64ValueSlice([]*int64{Int64(1), nil, Int64(3)}) },
+		m:          func(v interface{}) interface{} { return Int64ValueMap(Int64Map(v.(map[string]int64))) },
+		valueMap:   func() interface{} { return Int64ValueMap(map[string]*int64{"a": Int64(1), "b": nil}) },
+		in:         int64(1),
+		zero:       int64(0),
+		inSlice:    []int64{1, 0, 3},
+		outSlice:   []int64{1, 0, 3},
+		inMap:      map[string]int64{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]int64{"a": 1},
+	},
+	{
+		name:       "Uint",
+		value:      func(v interface{}) interface{} { return UintValue(Uint(v.(uint))) },
+		nilValue:   func() interface{} { return UintValue(nil) },
+		slice:      func(v interface{}) interface{} { return UintValueSlice(UintSlice(v.([]uint))) },
+		valueSlice: func() interface{} { return UintValueSlice([]*uint{Uint(1), nil, Uint(3)}) },
+		m:          func(v interface{}) interface{} { return UintValueMap(UintMap(v.(map[string]uint))) },
+		valueMap:   func() interface{} { return UintValueMap(map[string]*uint{"a": Uint(1), "b": nil}) },
+		in:         uint(1),
+		zero:       uint(0),
+		inSlice:    []uint{1, 0, 3},
+		outSlice:   []uint{1, 0, 3},
+		inMap:      map[string]uint{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]uint{"a": 1},
+	},
+	{
+		name:       "Uint8",
+		value:      func(v interface{}) interface{} { return Uint8Value(Uint8(v.(uint8))) },
+		nilValue:   func() interface{} { return Uint8Value(nil) },
+		slice:      func(v interface{}) interface{} { return Uint8ValueSlice(Uint8Slice(v.([]uint8))) },
+		valueSlice: func() interface{} { return Uint8ValueSlice([]*uint8{Uint8(1), nil, Uint8(3)}) },
+		m:          func(v interface{}) interface{} { return Uint8ValueMap(Uint8Map(v.(map[string]uint8))) },
+		valueMap:   func() interface{} { return Uint8ValueMap(map[string]*uint8{"a": Uint8(1), "b": nil}) },
+		in:         uint8(1),
+		zero:       uint8(0),
+		inSlice:    []uint8{1, 0, 3},
+		outSlice:   []uint8{1, 0, 3},
+		inMap:      map[string]uint8{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]uint8{"a": 1},
+	},
+	{
+		name:       "Uint16",
+		value:      func(v interface{}) interface{} { return Uint16Value(Uint16(v.(uint16))) },
+		nilValue:   func() interface{} { return Uint16Value(nil) },
+		slice:      func(v interface{}) interface{} { return Uint16ValueSlice(Uint16Slice(v.([]uint16))) },
+		valueSlice: func() interface{} { return Uint16ValueSlice([]*uint16{Uint16(1), nil, Uint16(3)}) },
+		m:          func(v interface{}) interface{} { return Uint16ValueMap(Uint16Map(v.(map[string]uint16))) },
+		valueMap:   func() interface{} { return Uint16ValueMap(map[string]*uint16{"a": Uint16(1), "b": nil}) },
+		in:         uint16(1),
+		zero:       uint16(0),
+		inSlice:    []uint16{1, 0, 3},
+		outSlice:   []uint16{1, 0, 3},
+		inMap:      map[string]uint16{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]uint16{"a": 1},
+	},
+	{
+		name:       "Uint32",
+		value:      func(v interface{}) interface{} { return Uint32Value(Uint32(v.(uint32))) },
+		nilValue:   func() interface{} { return Uint32Value(nil) },
+		slice:      func(v interface{}) interface{} { return Uint32ValueSlice(Uint32Slice(v.([]uint32))) },
+		valueSlice: func() interface{} { return Uint32ValueSlice([]*uint32{Uint32(1), nil, Uint32(3)}) },
+		m:          func(v interface{}) interface{} { return Uint32ValueMap(Uint32Map(v.(map[string]uint32))) },
+		valueMap:   func() interface{} { return Uint32ValueMap(map[string]*uint32{"a": Uint32(1), "b": nil}) },
+		in:         uint32(1),
+		zero:       uint32(0),
+		inSlice:    []uint32{1, 0, 3},
+		outSlice:   []uint32{1, 0, 3},
+		inMap:      map[string]uint32{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]uint32{"a": 1},
+	},
+	{
+		name:       "Uint64",
+		value:      func(v interface{}) interface{} { return Uint64Value(Uint64(v.(uint64))) },
+		nilValue:   func() interface{} { return Uint64Value(nil) },
+		slice:      func(v interface{}) interface{} { return Uint64ValueSlice(Uint64Slice(v.([]uint64))) },
+		valueSlice: func() interface{} { return Uint64ValueSlice([]*uint64{Uint64(1), nil, Uint64(3)}) },
+		m:          func(v interface{}) interface{} { return Uint64ValueMap(Uint64Map(v.(map[string]uint64))) },
+		valueMap:   func() interface{} { return Uint64ValueMap(map[string]*uint64{"a": Uint64(1), "b": nil}) },
+		in:         uint64(1),
+		zero:       uint64(0),
+		inSlice:    []uint64{1, 0, 3},
+		outSlice:   []uint64{1, 0, 3},
+		inMap:      map[string]uint64{"a": 1, "b": 2, "c": 0},
+		outMap:     map[string]uint64{"a": 1},
+	},
+	{
+		name:       "Float32",
+		value:      func(v interface{}) interface{} { return Float32Value(Float32(v.(float32))) },
+		nilValue:   func() interface{} { return Float32Value(nil) },
+		slice:      func(v interface{}) interface{} { return Float32ValueSlice(Float32Slice(v.([]float32))) },
+		valueSlice: func() interface{} { return Float32ValueSlice([]*float32{Float32(1.5), nil, Float32(3.5)}) },
+		m:          func(v interface{}) interface{} { return Float32ValueMap(Float32Map(v.(map[string]float32))) },
+		valueMap:   func() interface{} { return Float32ValueMap(map[string]*float32{"a": Float32(1.5), "b": nil}) },
+		in:         float32(1.5),
+		zero:       float32(0),
+		inSlice:    []float32{1.5, 0, 3.5},
+		outSlice:   []float32{1.5, 0, 3.5},
+		inMap:      map[string]float32{"a": 1.5, "b": 2.5, "c": 0},
+		outMap:     map[string]float32{"a": 1.5},
+	},
+	{
+		name:       "Float64",
+		value:      func(v interface{}) interface{} { return Float64Value(Float64(v.(float64))) },
+		nilValue:   func() interface{} { return Float64Value(nil) },
+		slice:      func(v interface{}) interface{} { return Float64ValueSlice(Float64Slice(v.([]float64))) },
+		valueSlice: func() interface{} { return Float64ValueSlice([]*float64{Float64(1.5), nil, Float64(3.5)}) },
+		m:          func(v interface{}) interface{} { return Float64ValueMap(Float64Map(v.(map[string]float64))) },
+		valueMap:   func() interface{} { return Float64ValueMap(map[string]*float64{"a": Float64(1.5), "b": nil}) },
+		in:         float64(1.5),
+		zero:       float64(0),
+		inSlice:    []float64{1.5, 0, 3.5},
+		outSlice:   []float64{1.5, 0, 3.5},
+		inMap:      map[string]float64{"a": 1.5, "b": 2.5, "c": 0},
+		outMap:     map[string]float64{"a": 1.5},
+	},
+	{
+		name:     "Time",
+		value:    func(v interface{}) interface{} { return TimeValue(Time(v.(time.Time))) },
+		nilValue: func() interface{} { return TimeValue(nil) },
+		slice:    func(v interface{}) interface{} { return TimeValueSlice(TimeSlice(v.([]time.Time))) },
+		valueSlice: func() interface{} {
+			return TimeValueSlice([]*time.Time{Time(time.Unix(1, 0)), nil, Time(time.Unix(3, 0))})
+		},
+		m:        func(v interface{}) interface{} { return TimeValueMap(TimeMap(v.(map[string]time.Time))) },
+		valueMap: func() interface{} { return TimeValueMap(map[string]*time.Time{"a": Time(time.Unix(1, 0)), "b": nil}) },
+		in:       time.Unix(1, 0),
+		zero:     time.Time{},
+		inSlice:  []time.Time{time.Unix(1, 0), time.Time{}, time.Unix(3, 0)},
+		outSlice: []time.Time{time.Unix(1, 0), time.Time{}, time.Unix(3, 0)},
+		inMap:    map[string]time.Time{"a": time.Unix(1, 0), "b": time.Unix(2, 0), "c": time.Time{}},
+		outMap:   map[string]time.Time{"a": time.Unix(1, 0)},
+	},
+}
+
+func TestConversions(t *testing.T) {
+	for _, c := range conversionTests {
+		assert.Equal(t, c.in, c.value(c.in), c.name)
+		assert.Equal(t, c.zero, c.nilValue(), c.name)
+		assert.Equal(t, c.inSlice, c.slice(c.inSlice), c.name)
+		assert.Equal(t, c.outSlice, c.valueSlice(), c.name)
+		assert.Equal(t, c.inMap, c.m(c.inMap), c.name)
+		assert.Equal(t, c.outMap, c.valueMap(), c.name)
+	}
+}
+
+func BenchmarkStringValue(b *testing.B) {
+	v := String("a")
+	for i := 0; i < b.N; i++ {
+		StringValue(v)
+	}
+}
+
+func BenchmarkStringSlice(b *testing.B) {
+	in := []string{"a", "b", "c", "a", "b", "c", "a", "b"}
+	for i := 0; i < b.N; i++ {
+		StringSlice(in)
+	}
+}
+
+func BenchmarkStringValueSlice(b *testing.B) {
+	in := StringSlice([]string{"a", "b", "c", "a", "b", "c", "a", "b"})
+	for i := 0; i < b.N; i++ {
+		StringValueSlice(in)
+	}
+}
+
+func BenchmarkStringMap(b *testing.B) {
+	in := map[string]string{"a": "a", "b": "b", "c": "c", "d": "a"}
+	for i := 0; i < b.N; i++ {
+		StringMap(in)
+	}
+}
+
+func BenchmarkStringValueMap(b *testing.B) {
+	in := StringMap(map[string]string{"a": "a", "b": "b", "c": "c", "d": "a"})
+	for i := 0; i < b.N; i++ {
+		StringValueMap(in)
+	}
+}
+
+func BenchmarkBoolValue(b *testing.B) {
+	v := Bool(true)
+	for i := 0; i < b.N; i++ {
+		BoolValue(v)
+	}
+}
+
+func BenchmarkBoolSlice(b *testing.B) {
+	in := []bool{true, true, true, true, true, true, true, true}
+	for i := 0; i < b.N; i++ {
+		BoolSlice(in)
+	}
+}
+
+func BenchmarkBoolValueSlice(b *testing.B) {
+	in := BoolSlice([]bool{true, true, true, true, true, true, true, true})
+	for i := 0; i < b.N; i++ {
+		BoolValueSlice(in)
+	}
+}
+
+func BenchmarkBoolMap(b *testing.B) {
+	in := map[string]bool{"a": true, "b": true, "c": true, "d": true}
+	for i := 0; i < b.N; i++ {
+		BoolMap(in)
+	}
+}
+
+func BenchmarkBoolValueMap(b *testing.B) {
+	in := BoolMap(map[string]bool{"a": true, "b": true, "c": true, "d": true})
+	for i := 0; i < b.N; i++ {
+		BoolValueMap(in)
+	}
+}
+
+func BenchmarkIntValue(b *testing.B) {
+	v := Int(1)
+	for i := 0; i < b.N; i++ {
+		IntValue(v)
+	}
+}
+
+func BenchmarkIntSlice(b *testing.B) {
+	in := []int{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		IntSlice(in)
+	}
+}
+
+func BenchmarkIntValueSlice(b *testing.B) {
+	in := IntSlice([]int{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		IntValueSlice(in)
+	}
+}
+
+func BenchmarkIntMap(b *testing.B) {
+	in := map[string]int{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		IntMap(in)
+	}
+}
+
+func BenchmarkIntValueMap(b *testing.B) {
+	in := IntMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		IntValueMap(in)
+	}
+}
+
+func BenchmarkInt8Value(b *testing.B) {
+	v := Int8(1)
+	for i := 0; i < b.N; i++ {
+		Int8Value(v)
+	}
+}
+
+func BenchmarkInt8Slice(b *testing.B) {
+	in := []int8{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Int8Slice(in)
+	}
+}
+
+func BenchmarkInt8ValueSlice(b *testing.B) {
+	in := Int8Slice([]int8{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Int8ValueSlice(in)
+	}
+}
+
+func BenchmarkInt8Map(b *testing.B) {
+	in := map[string]int8{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Int8Map(in)
+	}
+}
+
+func BenchmarkInt8ValueMap(b *testing.B) {
+	in := Int8Map(map[string]int8{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Int8ValueMap(in)
+	}
+}
+
+func BenchmarkInt16Value(b *testing.B) {
+	v := Int16(1)
+	for i := 0; i < b.N; i++ {
+		Int16Value(v)
+	}
+}
+
+func BenchmarkInt16Slice(b *testing.B) {
+	in := []int16{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Int16Slice(in)
+	}
+}
+
+func BenchmarkInt16ValueSlice(b *testing.B) {
+	in := Int16Slice([]int16{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Int16ValueSlice(in)
+	}
+}
+
+func BenchmarkInt16Map(b *testing.B) {
+	in := map[string]int16{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Int16Map(in)
+	}
+}
+
+func BenchmarkInt16ValueMap(b *testing.B) {
+	in := Int16Map(map[string]int16{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Int16ValueMap(in)
+	}
+}
+
+func BenchmarkInt32Value(b *testing.B) {
+	v := Int32(1)
+	for i := 0; i < b.N; i++ {
+		Int32Value(v)
+	}
+}
+
+func BenchmarkInt32Slice(b *testing.B) {
+	in := []int32{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Int32Slice(in)
+	}
+}
+
+func BenchmarkInt32ValueSlice(b *testing.B) {
+	in := Int32Slice([]int32{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Int32ValueSlice(in)
+	}
+}
+
+func BenchmarkInt32Map(b *testing.B) {
+	in := map[string]int32{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Int32Map(in)
+	}
+}
+
+func BenchmarkInt32ValueMap(b *testing.B) {
+	in := Int32Map(map[string]int32{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Int32ValueMap(in)
+	}
+}
+
+func BenchmarkInt64Value(b *testing.B) {
+	v := Int64(1)
+	for i := 0; i < b.N; i++ {
+		Int64Value(v)
+	}
+}
+
+func BenchmarkInt64Slice(b *testing.B) {
+	in := []int64{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Int64Slice(in)
+	}
+}
+
+func BenchmarkInt64ValueSlice(b *testing.B) {
+	in := Int64Slice([]int64{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Int64ValueSlice(in)
+	}
+}
+
+func BenchmarkInt64Map(b *testing.B) {
+	in := map[string]int64{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Int64Map(in)
+	}
+}
+
+func BenchmarkInt64ValueMap(b *testing.B) {
+	in := Int64Map(map[string]int64{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Int64ValueMap(in)
+	}
+}
+
+func BenchmarkUintValue(b *testing.B) {
+	v := Uint(1)
+	for i := 0; i < b.N; i++ {
+		UintValue(v)
+	}
+}
+
+func BenchmarkUintSlice(b *testing.B) {
+	in := []uint{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		UintSlice(in)
+	}
+}
+
+func BenchmarkUintValueSlice(b *testing.B) {
+	in := UintSlice([]uint{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		UintValueSlice(in)
+	}
+}
+
+func BenchmarkUintMap(b *testing.B) {
+	in := map[string]uint{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		UintMap(in)
+	}
+}
+
+func BenchmarkUintValueMap(b *testing.B) {
+	in := UintMap(map[string]uint{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		UintValueMap(in)
+	}
+}
+
+func BenchmarkUint8Value(b *testing.B) {
+	v := Uint8(1)
+	for i := 0; i < b.N; i++ {
+		Uint8Value(v)
+	}
+}
+
+func BenchmarkUint8Slice(b *testing.B) {
+	in := []uint8{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Uint8Slice(in)
+	}
+}
+
+func BenchmarkUint8ValueSlice(b *testing.B) {
+	in := Uint8Slice([]uint8{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Uint8ValueSlice(in)
+	}
+}
+
+func BenchmarkUint8Map(b *testing.B) {
+	in := map[string]uint8{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Uint8Map(in)
+	}
+}
+
+func BenchmarkUint8ValueMap(b *testing.B) {
+	in := Uint8Map(map[string]uint8{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Uint8ValueMap(in)
+	}
+}
+
+func BenchmarkUint16Value(b *testing.B) {
+	v := Uint16(1)
+	for i := 0; i < b.N; i++ {
+		Uint16Value(v)
+	}
+}
+
+func BenchmarkUint16Slice(b *testing.B) {
+	in := []uint16{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Uint16Slice(in)
+	}
+}
+
+func BenchmarkUint16ValueSlice(b *testing.B) {
+	in := Uint16Slice([]uint16{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Uint16ValueSlice(in)
+	}
+}
+
+func BenchmarkUint16Map(b *testing.B) {
+	in := map[string]uint16{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Uint16Map(in)
+	}
+}
+
+func BenchmarkUint16ValueMap(b *testing.B) {
+	in := Uint16Map(map[string]uint16{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Uint16ValueMap(in)
+	}
+}
+
+func BenchmarkUint32Value(b *testing.B) {
+	v := Uint32(1)
+	for i := 0; i < b.N; i++ {
+		Uint32Value(v)
+	}
+}
+
+func BenchmarkUint32Slice(b *testing.B) {
+	in := []uint32{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Uint32Slice(in)
+	}
+}
+
+func BenchmarkUint32ValueSlice(b *testing.B) {
+	in := Uint32Slice([]uint32{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Uint32ValueSlice(in)
+	}
+}
+
+func BenchmarkUint32Map(b *testing.B) {
+	in := map[string]uint32{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Uint32Map(in)
+	}
+}
+
+func BenchmarkUint32ValueMap(b *testing.B) {
+	in := Uint32Map(map[string]uint32{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Uint32ValueMap(in)
+	}
+}
+
+func BenchmarkUint64Value(b *testing.B) {
+	v := Uint64(1)
+	for i := 0; i < b.N; i++ {
+		Uint64Value(v)
+	}
+}
+
+func BenchmarkUint64Slice(b *testing.B) {
+	in := []uint64{1, 2, 3, 1, 2, 3, 1, 2}
+	for i := 0; i < b.N; i++ {
+		Uint64Slice(in)
+	}
+}
+
+func BenchmarkUint64ValueSlice(b *testing.B) {
+	in := Uint64Slice([]uint64{1, 2, 3, 1, 2, 3, 1, 2})
+	for i := 0; i < b.N; i++ {
+		Uint64ValueSlice(in)
+	}
+}
+
+func BenchmarkUint64Map(b *testing.B) {
+	in := map[string]uint64{"a": 1, "b": 2, "c": 3, "d": 1}
+	for i := 0; i < b.N; i++ {
+		Uint64Map(in)
+	}
+}
+
+func BenchmarkUint64ValueMap(b *testing.B) {
+	in := Uint64Map(map[string]uint64{"a": 1, "b": 2, "c": 3, "d": 1})
+	for i := 0; i < b.N; i++ {
+		Uint64ValueMap(in)
+	}
+}
+
+func BenchmarkFloat32Value(b *testing.B) {
+	v := Float32(1.5)
+	for i := 0; i < b.N; i++ {
+		Float32Value(v)
+	}
+}
+
+func BenchmarkFloat32Slice(b *testing.B) {
+	in := []float32{1.5, 2.5, 3.5, 1.5, 2.5, 3.5, 1.5, 2.5}
+	for i := 0; i < b.N; i++ {
+		Float32Slice(in)
+	}
+}
+
+func BenchmarkFloat32ValueSlice(b *testing.B) {
+	in := Float32Slice([]float32{1.5, 2.5, 3.5, 1.5, 2.5, 3.5, 1.5, 2.5})
+	for i := 0; i < b.N; i++ {
+		Float32ValueSlice(in)
+	}
+}
+
+func BenchmarkFloat32Map(b *testing.B) {
+	in := map[string]float32{"a": 1.5, "b": 2.5, "c": 3.5, "d": 1.5}
+	for i := 0; i < b.N; i++ {
+		Float32Map(in)
+	}
+}
+
+func BenchmarkFloat32ValueMap(b *testing.B) {
+	in := Float32Map(map[string]float32{"a": 1.5, "b": 2.5, "c": 3.5, "d": 1.5})
+	for i := 0; i < b.N; i++ {
+		Float32ValueMap(in)
+	}
+}
+
+func BenchmarkFloat64Value(b *testing.B) {
+	v := Float64(1.5)
+	for i := 0; i < b.N; i++ {
+		Float64Value(v)
+	}
+}
+
+func BenchmarkFloat64Slice(b *testing.B) {
+	in := []float64{1.5, 2.5, 3.5, 1.5, 2.5, 3.5, 1.5, 2.5}
+	for i := 0; i < b.N; i++ {
+		Float64Slice(in)
+	}
+}
+
+func BenchmarkFloat64ValueSlice(b *testing.B) {
+	in := Float64Slice([]float64{1.5, 2.5, 3.5, 1.5, 2.5, 3.5, 1.5, 2.5})
+	for i := 0; i < b.N; i++ {
+		Float64ValueSlice(in)
+	}
+}
+
+func BenchmarkFloat64Map(b *testing.B) {
+	in := map[string]float64{"a": 1.5, "b": 2.5, "c": 3.5, "d": 1.5}
+	for i := 0; i < b.N; i++ {
+		Float64Map(in)
+	}
+}
+
+func BenchmarkFloat64ValueMap(b *testing.B) {
+	in := Float64Map(map[string]float64{"a": 1.5, "b": 2.5, "c": 3.5, "d": 1.5})
+	for i := 0; i < b.N; i++ {
+		Float64ValueMap(in)
+	}
+}
+
+func BenchmarkTimeValue(b *testing.B) {
+	v := Time(time.Unix(1, 0))
+	for i := 0; i < b.N; i++ {
+		TimeValue(v)
+	}
+}
+
+func BenchmarkTimeSlice(b *testing.B) {
+	in := []time.Time{time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0), time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0), time.Unix(1, 0), time.Unix(2, 0)}
+	for i := 0; i < b.N; i++ {
+		TimeSlice(in)
+	}
+}
+
+func BenchmarkTimeValueSlice(b *testing.B) {
+	in := TimeSlice([]time.Time{time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0), time.Unix(1, 0), time.Unix(2, 0), time.Unix(3, 0), time.Unix(1, 0), time.Unix(2, 0)})
+	for i := 0; i < b.N; i++ {
+		TimeValueSlice(in)
+	}
+}
+
+func BenchmarkTimeMap(b *testing.B) {
+	in := map[string]time.Time{"a": time.Unix(1, 0), "b": time.Unix(2, 0), "c": time.Unix(3, 0), "d": time.Unix(1, 0)}
+	for i := 0; i < b.N; i++ {
+		TimeMap(in)
+	}
+}
+
+func BenchmarkTimeValueMap(b *testing.B) {
+	in := TimeMap(map[string]time.Time{"a": time.Unix(1, 0), "b": time.Unix(2, 0), "c": time.Unix(3, 0), "d": time.Unix(1, 0)})
+	for i := 0; i < b.N; i++ {
+		TimeValueMap(in)
+	}
+}
+
+func BenchmarkSecondsTimeValue(b *testing.B) {
+	v := Int64(1435665600)
+	for i := 0; i < b.N; i++ {
+		SecondsTimeValue(v)
+	}
+}
+
+func BenchmarkMillisecondsTimeValue(b *testing.B) {
+	v := Int64(1435665600250)
+	for i := 0; i < b.N; i++ {
+		MillisecondsTimeValue(v)
+	}
+}
+
+func BenchmarkTimeUnixMilli(b *testing.B) {
+	tm := time.Unix(1435665600, 250*int64(time.Millisecond))
+	for i := 0; i < b.N; i++ {
+		TimeUnixMilli(tm)
+	}
+}
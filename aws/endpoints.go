@@ -0,0 +1,90 @@
+package aws
+
+import "github.com/dongfangx/aws-sdk-go/internal/endpoints"
+
+// EndpointOptions are the options a service endpoint is resolved with. They
+// are taken from the Config of the service.
+type EndpointOptions struct {
+	// Resolve an endpoint using plain HTTP instead of HTTPS.
+	DisableSSL bool
+
+	// Resolve the dualstack endpoint, which supports both IPv4 and IPv6.
+	UseDualStack bool
+
+	// Resolve the FIPS 140-2 validated endpoint.
+	UseFIPS bool
+}
+
+// A ResolvedEndpoint is the endpoint of a service in a region, and how
+// requests sent to it are signed.
+type ResolvedEndpoint struct {
+	// The URL of the endpoint. The scheme is optional, and added according
+	// to Config.DisableSSL if missing.
+	URL string
+
+	// The region requests are signed for. Leave this empty to sign for the
+	// region of the service's Config.
+	SigningRegion string
+
+	// The service name requests are signed for. Leave this empty to sign
+	// for the service's own signing name.
+	SigningName string
+
+	// The signature version the endpoint requires, such as "v4". Leave this
+	// empty to sign with the service's default signer.
+	SignatureVersion string
+}
+
+// An EndpointResolver resolves the endpoint of a service in a region.
+// Set Config.EndpointResolver to override the endpoints services send
+// requests to without setting Config.Endpoint for each service.
+type EndpointResolver interface {
+	EndpointFor(service, region string, opts EndpointOptions) (ResolvedEndpoint, error)
+}
+
+// The EndpointResolverFunc type is an adapter to allow the use of ordinary
+// functions as an EndpointResolver.
+type EndpointResolverFunc func(service, region string, opts EndpointOptions) (ResolvedEndpoint, error)
+
+// EndpointFor calls fn(service, region, opts).
+func (fn EndpointResolverFunc) EndpointFor(service, region string, opts EndpointOptions) (ResolvedEndpoint, error) {
+	return fn(service, region, opts)
+}
+
+// DefaultEndpointResolver resolves endpoints from the partitions model of
+// the SDK, which covers the aws, aws-cn and aws-us-gov partitions. Regions
+// outside of the known partitions are resolved with the aws partition's
+// default endpoints.
+var DefaultEndpointResolver EndpointResolver = EndpointResolverFunc(defaultEndpointFor)
+
+func defaultEndpointFor(service, region string, opts EndpointOptions) (ResolvedEndpoint, error) {
+	e, err := endpoints.Resolve(service, region, endpoints.Options{
+		DisableSSL:   opts.DisableSSL,
+		UseDualStack: opts.UseDualStack,
+		UseFIPS:      opts.UseFIPS,
+	})
+	if err != nil {
+		return ResolvedEndpoint{}, err
+	}
+	return ResolvedEndpoint{
+		URL:              e.URL,
+		SigningRegion:    e.SigningRegion,
+		SigningName:      e.SigningName,
+		SignatureVersion: e.SignatureVersion,
+	}, nil
+}
+
+// ResolveEndpoint returns the endpoint of service in region, resolved by
+// the EndpointResolver of the config, or by DefaultEndpointResolver if it
+// is not set.
+func (c *Config) ResolveEndpoint(service, region string) (ResolvedEndpoint, error) {
+	resolver := c.EndpointResolver
+	if resolver == nil {
+		resolver = DefaultEndpointResolver
+	}
+	return resolver.EndpointFor(service, region, EndpointOptions{
+		DisableSSL:   c.DisableSSL,
+		UseDualStack: c.UseDualStack,
+		UseFIPS:      c.UseFIPSEndpoint,
+	})
+}
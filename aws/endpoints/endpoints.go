@@ -0,0 +1,200 @@
+// Package endpoints enumerates the partitions, regions and services of the
+// SDK's endpoints model.
+//
+// A partition is a group of regions sharing a DNS suffix, such as the aws,
+// aws-cn and aws-us-gov partitions. Tooling can use this package to list the
+// regions a service is available in, and the endpoints of the service in
+// those regions. For example, to print the hostnames of S3 in the aws
+// partition:
+//
+//     p, _ := endpoints.PartitionForRegion(endpoints.UsEast1RegionID)
+//     for id := range p.Services()[endpoints.S3ServiceID].Regions() {
+//         e, _ := p.EndpointFor(endpoints.S3ServiceID, id, aws.EndpointOptions{})
+//         fmt.Println(id, e.URL)
+//     }
+//
+// The partition, region and service identifiers are generated from the
+// endpoints model.
+package endpoints
+
+import (
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/endpoints"
+)
+
+// DefaultPartitions returns the partitions of the SDK's endpoints model.
+func DefaultPartitions() []Partition {
+	ps := endpoints.DefaultPartitions()
+	partitions := make([]Partition, len(ps))
+	for i, p := range ps {
+		partitions[i] = Partition{p: p}
+	}
+	return partitions
+}
+
+// PartitionForRegion returns the partition of the SDK's endpoints model the
+// region is part of. It returns false if no partition has the region.
+func PartitionForRegion(region string) (Partition, bool) {
+	for _, p := range DefaultPartitions() {
+		if _, ok := p.p.Regions[region]; ok {
+			return p, true
+		}
+	}
+	return Partition{}, false
+}
+
+// A Partition is a group of regions sharing a DNS suffix and the endpoints
+// of their services.
+type Partition struct {
+	p endpoints.Partition
+}
+
+// ID returns the identifier of the partition, such as "aws".
+func (p Partition) ID() string { return p.p.ID }
+
+// Name returns the name of the partition, such as "AWS Standard".
+func (p Partition) Name() string { return p.p.Name }
+
+// DNSSuffix returns the DNS suffix of the endpoints in the partition.
+func (p Partition) DNSSuffix() string { return p.p.DNSSuffix }
+
+// Regions returns the regions of the partition, keyed by their ID.
+func (p Partition) Regions() map[string]Region {
+	regions := map[string]Region{}
+	for id, r := range p.p.Regions {
+		regions[id] = Region{id: id, desc: r.Description, p: p.p}
+	}
+	return regions
+}
+
+// Services returns the services of the partition, keyed by their ID.
+func (p Partition) Services() map[string]Service {
+	services := map[string]Service{}
+	for id := range p.p.Services {
+		services[id] = Service{id: id, p: p.p}
+	}
+	return services
+}
+
+// EndpointFor returns the endpoint of a service in a region of the
+// partition. Partition implements aws.EndpointResolver, so it can be set as
+// Config.EndpointResolver to resolve every region in the partition.
+func (p Partition) EndpointFor(service, region string, opts aws.EndpointOptions) (aws.ResolvedEndpoint, error) {
+	return resolve(p.p, service, region, opts)
+}
+
+// A Region is a region of a partition.
+type Region struct {
+	id, desc string
+	p        endpoints.Partition
+}
+
+// ID returns the identifier of the region, such as "us-east-1".
+func (r Region) ID() string { return r.id }
+
+// Description returns the description of the region, such as
+// "US East (N. Virginia)".
+func (r Region) Description() string { return r.desc }
+
+// Services returns the services available in the region, keyed by their
+// ID. Global services, and services served by the partition defaults, are
+// available in every region of their partition.
+func (r Region) Services() map[string]Service {
+	services := map[string]Service{}
+	for id, s := range r.p.Services {
+		if available(s, r.id) {
+			services[id] = Service{id: id, p: r.p}
+		}
+	}
+	return services
+}
+
+// ResolveEndpoint returns the endpoint of a service in the region.
+func (r Region) ResolveEndpoint(service string, opts aws.EndpointOptions) (aws.ResolvedEndpoint, error) {
+	return resolve(r.p, service, r.id, opts)
+}
+
+// A Service is a service of a partition.
+type Service struct {
+	id string
+	p  endpoints.Partition
+}
+
+// ID returns the identifier of the service, such as "s3".
+func (s Service) ID() string { return s.id }
+
+// Global returns whether the service is served by a single endpoint for
+// every region of its partition, such as "iam".
+func (s Service) Global() bool { return s.p.Services[s.id].PartitionEndpoint != "" }
+
+// Regions returns the regions the service is available in, keyed by their
+// ID. Global services, and services served by the partition defaults, are
+// available in every region of their partition.
+func (s Service) Regions() map[string]Region {
+	regions := map[string]Region{}
+	svc := s.p.Services[s.id]
+	for id, r := range s.p.Regions {
+		if available(svc, id) {
+			regions[id] = Region{id: id, desc: r.Description, p: s.p}
+		}
+	}
+	return regions
+}
+
+// Endpoints returns the endpoints of the service, keyed by their ID. The ID
+// of an endpoint is the region it serves, or the ID of the partition
+// endpoint of a global service, such as "aws-global". A service served by
+// the partition defaults has an endpoint in every region of its partition.
+func (s Service) Endpoints() map[string]Endpoint {
+	eps := map[string]Endpoint{}
+	svc := s.p.Services[s.id]
+	for id := range svc.Endpoints {
+		eps[id] = Endpoint{id: id, service: s.id, p: s.p}
+	}
+	if len(svc.Endpoints) == 0 {
+		for id := range s.p.Regions {
+			eps[id] = Endpoint{id: id, service: s.id, p: s.p}
+		}
+	}
+	return eps
+}
+
+// An Endpoint is an endpoint of a service.
+type Endpoint struct {
+	id, service string
+	p           endpoints.Partition
+}
+
+// ID returns the identifier of the endpoint.
+func (e Endpoint) ID() string { return e.id }
+
+// ServiceID returns the identifier of the service of the endpoint.
+func (e Endpoint) ServiceID() string { return e.service }
+
+// ResolveEndpoint returns the URL and signing values of the endpoint.
+func (e Endpoint) ResolveEndpoint(opts aws.EndpointOptions) (aws.ResolvedEndpoint, error) {
+	return resolve(e.p, e.service, e.id, opts)
+}
+
+// available returns whether s is available in the region of its partition.
+func available(s endpoints.Service, region string) bool {
+	_, ok := s.Endpoints[region]
+	return ok || s.PartitionEndpoint != "" || len(s.Endpoints) == 0
+}
+
+func resolve(p endpoints.Partition, service, region string, opts aws.EndpointOptions) (aws.ResolvedEndpoint, error) {
+	e, err := p.Resolve(service, region, endpoints.Options{
+		DisableSSL:   opts.DisableSSL,
+		UseDualStack: opts.UseDualStack,
+		UseFIPS:      opts.UseFIPS,
+	})
+	if err != nil {
+		return aws.ResolvedEndpoint{}, err
+	}
+	return aws.ResolvedEndpoint{
+		URL:              e.URL,
+		SigningRegion:    e.SigningRegion,
+		SigningName:      e.SigningName,
+		SignatureVersion: e.SignatureVersion,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package endpoints_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/endpoints"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ aws.EndpointResolver = endpoints.Partition{}
+
+func keys(m interface{}) []string {
+	var ks []string
+	switch m := m.(type) {
+	case map[string]endpoints.Region:
+		for k := range m {
+			ks = append(ks, k)
+		}
+	case map[string]endpoints.Service:
+		for k := range m {
+			ks = append(ks, k)
+		}
+	case map[string]endpoints.Endpoint:
+		for k := range m {
+			ks = append(ks, k)
+		}
+	}
+	sort.Strings(ks)
+	return ks
+}
+
+func TestDefaultPartitions(t *testing.T) {
+	ids := []string{}
+	for _, p := range endpoints.DefaultPartitions() {
+		ids = append(ids, p.ID())
+	}
+	assert.Equal(t, []string{
+		endpoints.AwsPartitionID,
+		endpoints.AwsCnPartitionID,
+		endpoints.AwsUsGovPartitionID,
+	}, ids)
+}
+
+func TestPartitionForRegion(t *testing.T) {
+	p, ok := endpoints.PartitionForRegion(endpoints.CnNorth1RegionID)
+	assert.True(t, ok)
+	assert.Equal(t, "aws-cn", p.ID())
+	assert.Equal(t, "AWS China", p.Name())
+	assert.Equal(t, "amazonaws.com.cn", p.DNSSuffix())
+
+	r := p.Regions()[endpoints.CnNorth1RegionID]
+	assert.Equal(t, "cn-north-1", r.ID())
+	assert.Equal(t, "China (Beijing)", r.Description())
+
+	_, ok = endpoints.PartitionForRegion("mock-region")
+	assert.False(t, ok)
+}
+
+func TestServiceRegions(t *testing.T) {
+	p, _ := endpoints.PartitionForRegion(endpoints.UsEast1RegionID)
+
+	assert.Equal(t, keys(p.Regions()), keys(p.Services()[endpoints.S3ServiceID].Regions()))
+	assert.Equal(t, []string{
+		"ap-northeast-1", "ap-southeast-1", "ap-southeast-2", "eu-west-1",
+		"sa-east-1", "us-east-1", "us-west-1", "us-west-2",
+	}, keys(p.Services()[endpoints.SdbServiceID].Regions()))
+}
+
+func TestRegionServices(t *testing.T) {
+	p, _ := endpoints.PartitionForRegion(endpoints.EuCentral1RegionID)
+	r := p.Regions()[endpoints.EuCentral1RegionID]
+
+	// sdb is not available in eu-central-1, global services and services
+	// served by the partition defaults are
+	services := r.Services()
+	assert.NotContains(t, services, endpoints.SdbServiceID)
+	for _, id := range []string{"cloudfront", "dynamodb", "ec2", "iam", "importexport", "route53", "s3", "sqs", "sts"} {
+		assert.Contains(t, services, id)
+	}
+
+	e, err := r.ResolveEndpoint(endpoints.S3ServiceID, aws.EndpointOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.eu-central-1.amazonaws.com", e.URL)
+	assert.Equal(t, "v4", e.SignatureVersion)
+}
+
+func TestDefaultServiceEndpoints(t *testing.T) {
+	for _, region := range []string{endpoints.UsEast1RegionID, endpoints.CnNorth1RegionID, endpoints.UsGovWest1RegionID} {
+		p, _ := endpoints.PartitionForRegion(region)
+		s, ok := p.Services()[endpoints.Ec2ServiceID]
+		assert.True(t, ok, p.ID())
+		assert.False(t, s.Global())
+		assert.Equal(t, keys(p.Regions()), keys(s.Regions()), p.ID())
+		assert.Equal(t, keys(p.Regions()), keys(s.Endpoints()), p.ID())
+		assert.Contains(t, p.Regions()[region].Services(), endpoints.Ec2ServiceID)
+	}
+
+	p, _ := endpoints.PartitionForRegion(endpoints.CnNorth1RegionID)
+	e, err := p.Services()[endpoints.Ec2ServiceID].Endpoints()[endpoints.CnNorth1RegionID].ResolveEndpoint(aws.EndpointOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://ec2.cn-north-1.amazonaws.com.cn", e.URL)
+	assert.Equal(t, "cn-north-1", e.SigningRegion)
+}
+
+func TestGlobalServiceEndpoints(t *testing.T) {
+	p, _ := endpoints.PartitionForRegion(endpoints.UsGovWest1RegionID)
+	s := p.Services()[endpoints.IamServiceID]
+
+	assert.True(t, s.Global())
+	assert.False(t, p.Services()[endpoints.S3ServiceID].Global())
+	assert.Equal(t, []string{"us-gov-west-1"}, keys(s.Regions()))
+	assert.Equal(t, []string{"aws-us-gov-global"}, keys(s.Endpoints()))
+
+	ep := s.Endpoints()["aws-us-gov-global"]
+	assert.Equal(t, "iam", ep.ServiceID())
+
+	e, err := ep.ResolveEndpoint(aws.EndpointOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://iam.us-gov.amazonaws.com", e.URL)
+	assert.Equal(t, "us-gov-west-1", e.SigningRegion)
+
+	// global endpoints only have the variants they define
+	_, err = ep.ResolveEndpoint(aws.EndpointOptions{UseDualStack: true})
+	assert.Error(t, err)
+}
+
+func TestPartitionEndpointResolver(t *testing.T) {
+	p, _ := endpoints.PartitionForRegion(endpoints.UsWest2RegionID)
+	cfg := &aws.Config{EndpointResolver: p, UseDualStack: true}
+
+	e, err := cfg.ResolveEndpoint(endpoints.S3ServiceID, endpoints.UsWest2RegionID)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://s3.dualstack.us-west-2.amazonaws.com", e.URL)
+	assert.Equal(t, "us-west-2", e.SigningRegion)
+}
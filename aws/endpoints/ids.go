@@ -0,0 +1,74 @@
+package endpoints
+
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Partition identifiers.
+const (
+	AwsPartitionID      = "aws"        // AWS Standard.
+	AwsCnPartitionID    = "aws-cn"     // AWS China.
+	AwsUsGovPartitionID = "aws-us-gov" // AWS GovCloud (US).
+)
+
+// Region identifiers.
+const (
+	ApNortheast1RegionID = "ap-northeast-1" // Asia Pacific (Tokyo).
+	ApSoutheast1RegionID = "ap-southeast-1" // Asia Pacific (Singapore).
+	ApSoutheast2RegionID = "ap-southeast-2" // Asia Pacific (Sydney).
+	CnNorth1RegionID     = "cn-north-1"     // China (Beijing).
+	EuCentral1RegionID   = "eu-central-1"   // EU (Frankfurt).
+	EuWest1RegionID      = "eu-west-1"      // EU (Ireland).
+	SaEast1RegionID      = "sa-east-1"      // South America (Sao Paulo).
+	UsEast1RegionID      = "us-east-1"      // US East (N. Virginia).
+	UsGovWest1RegionID   = "us-gov-west-1"  // AWS GovCloud (US).
+	UsWest1RegionID      = "us-west-1"      // US West (N. California).
+	UsWest2RegionID      = "us-west-2"      // US West (Oregon).
+)
+
+// Service identifiers.
+const (
+	AutoscalingServiceID          = "autoscaling"
+	CloudformationServiceID       = "cloudformation"
+	CloudfrontServiceID           = "cloudfront"
+	CloudhsmServiceID             = "cloudhsm"
+	CloudsearchServiceID          = "cloudsearch"
+	CloudtrailServiceID           = "cloudtrail"
+	CodedeployServiceID           = "codedeploy"
+	CognitoIdentityServiceID      = "cognito-identity"
+	CognitoSyncServiceID          = "cognito-sync"
+	ConfigServiceID               = "config"
+	DatapipelineServiceID         = "datapipeline"
+	DirectconnectServiceID        = "directconnect"
+	DsServiceID                   = "ds"
+	DynamodbServiceID             = "dynamodb"
+	Ec2ServiceID                  = "ec2"
+	ElasticacheServiceID          = "elasticache"
+	ElasticbeanstalkServiceID     = "elasticbeanstalk"
+	ElasticfilesystemServiceID    = "elasticfilesystem"
+	ElasticloadbalancingServiceID = "elasticloadbalancing"
+	ElasticmapreduceServiceID     = "elasticmapreduce"
+	ElastictranscoderServiceID    = "elastictranscoder"
+	EmailServiceID                = "email"
+	GlacierServiceID              = "glacier"
+	IamServiceID                  = "iam"
+	ImportexportServiceID         = "importexport"
+	KinesisServiceID              = "kinesis"
+	KmsServiceID                  = "kms"
+	LambdaServiceID               = "lambda"
+	LogsServiceID                 = "logs"
+	MachinelearningServiceID      = "machinelearning"
+	MonitoringServiceID           = "monitoring"
+	OpsworksServiceID             = "opsworks"
+	RdsServiceID                  = "rds"
+	RedshiftServiceID             = "redshift"
+	Route53ServiceID              = "route53"
+	Route53domainsServiceID       = "route53domains"
+	S3ServiceID                   = "s3"
+	SdbServiceID                  = "sdb"
+	SnsServiceID                  = "sns"
+	SqsServiceID                  = "sqs"
+	SsmServiceID                  = "ssm"
+	StoragegatewayServiceID       = "storagegateway"
+	StsServiceID                  = "sts"
+	SwfServiceID                  = "swf"
+	WorkspacesServiceID           = "workspaces"
+)
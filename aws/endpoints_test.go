@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"os"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/stretchr/testify/assert"
+)
+
+func newEndpointService(cfg *Config) *Service {
+	svc := &Service{Config: cfg, ServiceName: "mock", SigningName: "mock"}
+	svc.Initialize()
+	return svc
+}
+
+func TestDefaultEndpointResolver(t *testing.T) {
+	svc := newEndpointService(&Config{Region: "us-west-2", UseFIPSEndpoint: true})
+
+	assert.Equal(t, "https://mock-fips.us-west-2.amazonaws.com", svc.Endpoint)
+	assert.Equal(t, "us-west-2", svc.SigningRegion)
+	assert.Equal(t, "mock", svc.SigningName)
+}
+
+func TestCustomEndpointResolver(t *testing.T) {
+	var opts EndpointOptions
+	resolver := EndpointResolverFunc(func(service, region string, o EndpointOptions) (ResolvedEndpoint, error) {
+		opts = o
+		return ResolvedEndpoint{
+			URL:              service + "." + region + ".example.com",
+			SigningRegion:    "signing-region",
+			SigningName:      "signing-name",
+			SignatureVersion: "v4",
+		}, nil
+	})
+	svc := newEndpointService(&Config{
+		Region:           "mock-region",
+		DisableSSL:       true,
+		UseDualStack:     true,
+		EndpointResolver: resolver,
+	})
+
+	assert.Equal(t, EndpointOptions{DisableSSL: true, UseDualStack: true}, opts)
+	assert.Equal(t, "http://mock.mock-region.example.com", svc.Endpoint)
+	assert.Equal(t, "signing-region", svc.SigningRegion)
+	assert.Equal(t, "signing-name", svc.SigningName)
+	assert.Equal(t, "v4", svc.SignatureVersion)
+}
+
+func TestEndpointResolverNotUsedWithEndpoint(t *testing.T) {
+	resolver := EndpointResolverFunc(func(service, region string, o EndpointOptions) (ResolvedEndpoint, error) {
+		t.Error("resolver should not be called when the endpoint is set")
+		return ResolvedEndpoint{}, nil
+	})
+	svc := newEndpointService(&Config{
+		Region:           "mock-region",
+		Endpoint:         "https://endpoint.example.com",
+		EndpointResolver: resolver,
+	})
+
+	assert.Equal(t, "https://endpoint.example.com", svc.Endpoint)
+}
+
+func TestValidateEndpointHandlerResolverError(t *testing.T) {
+	os.Clearenv()
+	resolveErr := apierr.New("UnknownEndpoint", "no endpoint", nil)
+	svc := newEndpointService(&Config{
+		Region: "mock-region",
+		EndpointResolver: EndpointResolverFunc(func(string, string, EndpointOptions) (ResolvedEndpoint, error) {
+			return ResolvedEndpoint{}, resolveErr
+		}),
+	})
+	svc.Handlers.Clear()
+	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Build()
+
+	assert.Equal(t, resolveErr, err)
+}
+
+func TestValidateEndpointHandlerUnknownVariant(t *testing.T) {
+	os.Clearenv()
+	svc := newEndpointService(&Config{Region: "cn-north-1", UseFIPSEndpoint: true})
+	svc.Handlers.Clear()
+	svc.Handlers.Validate.PushBack(ValidateEndpointHandler)
+
+	req := NewRequest(svc, &Operation{Name: "Operation"}, nil, nil)
+	err := req.Build()
+
+	assert.Error(t, err)
+	assert.Equal(t, "UnknownEndpoint", err.(awserr.Error).Code())
+}
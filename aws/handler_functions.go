@@ -148,6 +148,8 @@ var (
 func ValidateEndpointHandler(r *Request) {
 	if r.Service.SigningRegion == "" && r.Service.Config.Region == "" {
 		r.Error = ErrMissingRegion
+	} else if r.Service.endpointErr != nil {
+		r.Error = r.Service.endpointErr
 	} else if r.Service.Endpoint == "" {
 		r.Error = ErrMissingEndpoint
 	}
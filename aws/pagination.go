@@ -0,0 +1,209 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// A Pagination iterates over the pages of a paginated operation. Each call
+// to Next sends the request of the next page:
+//
+//     for p.Next() {
+//         page := p.Page()
+//         // use the page
+//     }
+//     if err := p.Err(); err != nil {
+//         // handle the error
+//     }
+//
+// Paginations are usually used through the generated Paginator types of a
+// service, such as s3.ListObjectsPaginator.
+//
+// Iteration stops when the last page is reached, when a page returns the
+// tokens of a page already seen, or when MaxItems items were returned.
+type Pagination struct {
+	// NewRequest returns a new request for the first page of the operation.
+	// It is called once per page, and the input tokens of the page are set
+	// on a copy of the request's params.
+	NewRequest func() *Request
+
+	// The maximum number of items to return across all pages. The limit of
+	// each page request is lowered to the number of items left. Zero is no
+	// limit. Items are counted in the ResultKeys of the operation's
+	// Paginator, so pages of operations without ResultKeys are not limited.
+	MaxItems int64
+
+	// A token returned by Token to resume the pagination from, instead of
+	// starting from the first page.
+	StartToken string
+
+	req     *Request
+	tokens  []interface{}
+	seen    map[string]bool
+	items   int64
+	started bool
+	err     error
+}
+
+// Next sends the request of the next page. It returns false when there are
+// no more pages, or the request failed.
+func (p *Pagination) Next() bool {
+	if !p.HasNextPage() {
+		return false
+	}
+
+	tokens := p.tokens
+	if !p.started {
+		p.started = true
+		p.seen = map[string]bool{}
+		if p.StartToken != "" {
+			if tokens, p.err = decodePageTokens(p.StartToken); p.err != nil {
+				return false
+			}
+		}
+	}
+
+	req := p.NewRequest()
+	req.Params = awsutil.CopyOf(req.Params)
+	if p.err = setPageTokens(req, tokens); p.err != nil {
+		return false
+	}
+	if pg := req.Operation.Paginator; p.MaxItems > 0 && pg != nil && pg.LimitToken != "" {
+		limit := p.MaxItems - p.items
+		if v := awsutil.ValuesAtAnyPath(req.Params, pg.LimitToken); len(v) > 0 {
+			if l, ok := v[0].(int64); ok && l > 0 && l < limit {
+				limit = l
+			}
+		}
+		awsutil.SetValueAtAnyPath(req.Params, pg.LimitToken, limit)
+	}
+
+	if p.err = req.Send(); p.err != nil {
+		return false
+	}
+	p.req = req
+	p.items += countPageItems(req)
+
+	p.tokens = req.nextPageTokens()
+	if p.tokens != nil {
+		key := fmt.Sprintf("%#v", p.tokens)
+		if p.seen[key] {
+			// the service returned the tokens of a page already seen
+			p.tokens = nil
+		}
+		p.seen[key] = true
+	}
+	return true
+}
+
+// HasNextPage returns whether Next has another page to return.
+func (p *Pagination) HasNextPage() bool {
+	if p.err != nil {
+		return false
+	}
+	if !p.started {
+		return true
+	}
+	if p.MaxItems > 0 && p.items >= p.MaxItems {
+		return false
+	}
+	return p.tokens != nil
+}
+
+// Page returns the output of the current page, or nil before the first
+// call to Next.
+func (p *Pagination) Page() interface{} {
+	if p.req == nil {
+		return nil
+	}
+	return p.req.Data
+}
+
+// Err returns the error which stopped the pagination, if any.
+func (p *Pagination) Err() error {
+	return p.err
+}
+
+// Token returns the serialized tokens of the page after the current page,
+// or an empty string if there are no more pages. A pagination of the same
+// operation resumes from that page when Token is set as its StartToken.
+func (p *Pagination) Token() string {
+	if p.tokens == nil {
+		return ""
+	}
+	b, _ := json.Marshal(p.tokens)
+	return string(b)
+}
+
+// setPageTokens sets the input tokens of the request's params to tokens.
+// Tokens which are nil are not set.
+func setPageTokens(r *Request, tokens []interface{}) error {
+	if tokens == nil {
+		return nil
+	}
+	if r.Operation.Paginator == nil || len(tokens) != len(r.Operation.InputTokens) {
+		return apierr.New("InvalidPageToken", "page token does not match the operation", nil)
+	}
+
+	params := reflect.Indirect(reflect.ValueOf(r.Params))
+	for i, name := range r.Operation.InputTokens {
+		if tokens[i] == nil {
+			continue
+		}
+		f := params.FieldByName(name)
+		if !f.IsValid() || f.Kind() != reflect.Ptr {
+			return apierr.New("InvalidPageToken", "page token does not match the operation", nil)
+		}
+
+		v := reflect.ValueOf(tokens[i])
+		if n, ok := tokens[i].(json.Number); ok {
+			n64, err := n.Int64()
+			if err != nil {
+				return apierr.New("InvalidPageToken", "invalid page token", err)
+			}
+			v = reflect.ValueOf(n64)
+		}
+		if v.Kind() != f.Type().Elem().Kind() {
+			return apierr.New("InvalidPageToken", "page token does not match the operation", nil)
+		}
+
+		f.Set(reflect.New(f.Type().Elem()))
+		f.Elem().Set(v.Convert(f.Type().Elem()))
+	}
+	return nil
+}
+
+// decodePageTokens decodes the tokens serialized by Pagination.Token.
+func decodePageTokens(s string) ([]interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+
+	tokens := []interface{}{}
+	if err := d.Decode(&tokens); err != nil {
+		return nil, apierr.New("InvalidPageToken", "invalid page token", err)
+	}
+	return tokens, nil
+}
+
+// countPageItems returns the number of items in the ResultKeys of the
+// request's output.
+func countPageItems(r *Request) int64 {
+	if r.Operation.Paginator == nil {
+		return 0
+	}
+
+	n := 0
+	for _, key := range r.Operation.ResultKeys {
+		for _, v := range awsutil.ValuesAtAnyPath(r.Data, key) {
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map {
+				n += rv.Len()
+			}
+		}
+	}
+	return int64(n)
+}
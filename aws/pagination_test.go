@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+type pageTestInput struct {
+	Marker *string
+	Limit  *int64
+}
+
+type pageTestOutput struct {
+	Items      []*string
+	NextMarker *string
+}
+
+// paginationService returns a service sending the responses in order, with
+// a 500 status for an empty body, and the params each page was sent with.
+func paginationService(resps []string) (*Service, *[]pageTestInput) {
+	params := []pageTestInput{}
+	s := NewService(&Config{MaxRetries: -1})
+	s.DefaultMaxRetries = 0
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		params = append(params, *r.Params.(*pageTestInput))
+		resp := resps[len(params)-1]
+		if resp == "" {
+			r.HTTPResponse = &http.Response{StatusCode: 500,
+				Body: body(`{"__type":"InternalError","message":"Internal error."}`)}
+		} else {
+			r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(resp)}
+		}
+	})
+	return s, &params
+}
+
+func newTestPagination(s *Service, input *pageTestInput) *Pagination {
+	op := &Operation{Name: "List", Paginator: &Paginator{
+		InputTokens:  []string{"Marker"},
+		OutputTokens: []string{"NextMarker"},
+		LimitToken:   "Limit",
+		ResultKeys:   []string{"Items"},
+	}}
+	return &Pagination{
+		NewRequest: func() *Request {
+			return NewRequest(s, op, input, &pageTestOutput{})
+		},
+	}
+}
+
+func pageItems(p *Pagination) []string {
+	return StringValueSlice(p.Page().(*pageTestOutput).Items)
+}
+
+func TestPagination(t *testing.T) {
+	s, params := paginationService([]string{
+		`{"Items":["a","b"],"NextMarker":"b"}`,
+		`{"Items":["c"],"NextMarker":"c"}`,
+		`{"Items":["d"]}`,
+	})
+	input := &pageTestInput{}
+	p := newTestPagination(s, input)
+
+	assert.Nil(t, p.Page())
+	items := []string{}
+	for p.Next() {
+		items = append(items, pageItems(p)...)
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{"a", "b", "c", "d"}, items)
+	assert.Equal(t, []pageTestInput{{}, {Marker: String("b")}, {Marker: String("c")}}, *params)
+	assert.Nil(t, input.Marker, "input is not modified")
+	assert.Equal(t, "", p.Token())
+}
+
+func TestPaginationMaxItems(t *testing.T) {
+	s, params := paginationService([]string{
+		`{"Items":["a","b"],"NextMarker":"b"}`,
+		`{"Items":["c"],"NextMarker":"c"}`,
+		`{"Items":["d"]}`,
+	})
+	p := newTestPagination(s, &pageTestInput{Limit: Int64(2)})
+	p.MaxItems = 3
+
+	items := []string{}
+	for p.Next() {
+		items = append(items, pageItems(p)...)
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+	assert.Equal(t, []pageTestInput{
+		{Limit: Int64(2)},
+		{Marker: String("b"), Limit: Int64(1)},
+	}, *params)
+	assert.Equal(t, `["c"]`, p.Token())
+}
+
+func TestPaginationRepeatedToken(t *testing.T) {
+	s, params := paginationService([]string{
+		`{"Items":["a"],"NextMarker":"a"}`,
+		`{"Items":["b"],"NextMarker":"b"}`,
+		`{"Items":["a"],"NextMarker":"a"}`,
+		`{"Items":["b"],"NextMarker":"b"}`,
+	})
+	p := newTestPagination(s, &pageTestInput{})
+
+	pages := 0
+	for p.Next() {
+		pages++
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, 3, pages)
+	assert.Len(t, *params, 3)
+}
+
+func TestPaginationResume(t *testing.T) {
+	s, params := paginationService([]string{
+		`{"Items":["a"],"NextMarker":"a"}`,
+		`{"Items":["b"]}`,
+	})
+	p := newTestPagination(s, &pageTestInput{})
+	assert.True(t, p.Next())
+	token := p.Token()
+	assert.Equal(t, `["a"]`, token)
+
+	p = newTestPagination(s, &pageTestInput{})
+	p.StartToken = token
+	assert.True(t, p.Next())
+	assert.Equal(t, []string{"b"}, pageItems(p))
+	assert.False(t, p.Next())
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []pageTestInput{{}, {Marker: String("a")}}, *params)
+}
+
+func TestPaginationInvalidToken(t *testing.T) {
+	s, params := paginationService([]string{})
+	for _, token := range []string{`not json`, `["a","b"]`, `[1]`} {
+		p := newTestPagination(s, &pageTestInput{})
+		p.StartToken = token
+		assert.False(t, p.Next(), token)
+		assert.Equal(t, "InvalidPageToken", p.Err().(awserr.Error).Code(), token)
+	}
+	assert.Empty(t, *params)
+}
+
+func TestPaginationError(t *testing.T) {
+	s, _ := paginationService([]string{
+		`{"Items":["a"],"NextMarker":"a"}`,
+		``,
+	})
+	p := newTestPagination(s, &pageTestInput{})
+
+	assert.True(t, p.Next())
+	assert.True(t, p.HasNextPage())
+	assert.False(t, p.Next())
+	assert.Equal(t, "InternalError", p.Err().(awserr.Error).Code())
+	assert.False(t, p.HasNextPage())
+}
@@ -3,29 +3,90 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/dongfangx/aws-sdk-go/internal/apierr"
 )
 
 // ValidateParameters is a request handler to validate the input parameters.
 // Validating parameters only has meaning if done prior to the request being sent.
+//
+// Besides required parameters, the min, max and pattern constraints of the
+// model, carried in the struct tags of the input shapes, are checked. Enum
+// constraints are only checked if Config.ValidateParamEnums is set. All
+// violations are returned in a single ErrInvalidParams.
 func ValidateParameters(r *Request) {
 	if r.ParamsFilled() {
-		v := validator{errors: []string{}}
+		v := validator{enums: r.Config.ValidateParamEnums}
 		v.validateAny(reflect.ValueOf(r.Params), "")
 
-		if count := len(v.errors); count > 0 {
-			format := "%d validation errors:\n- %s"
-			msg := fmt.Sprintf(format, count, strings.Join(v.errors, "\n- "))
-			r.Error = apierr.New("InvalidParameter", msg, nil)
+		if len(v.errors) > 0 {
+			r.Error = ErrInvalidParams{Errs: v.errors}
 		}
 	}
 }
 
+// An ErrInvalidParam is an input parameter which failed validation.
+type ErrInvalidParam struct {
+	// The path of the parameter, such as "Delete.Objects[0].Key".
+	Field string
+
+	// Why the parameter is invalid, such as "missing required parameter".
+	Reason string
+}
+
+// Error returns the reason and path of the invalid parameter.
+func (e ErrInvalidParam) Error() string {
+	return e.Reason + ": " + e.Field
+}
+
+// ErrInvalidParams is the error of a request whose input parameters failed
+// validation. It satisfies awserr.Error with the code "InvalidParameter",
+// and lists every invalid parameter.
+type ErrInvalidParams struct {
+	Errs []ErrInvalidParam
+}
+
+// Code returns "InvalidParameter".
+func (e ErrInvalidParams) Code() string {
+	return "InvalidParameter"
+}
+
+// Message returns the count and the list of invalid parameters.
+func (e ErrInvalidParams) Message() string {
+	msgs := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d validation errors:\n- %s", len(e.Errs), strings.Join(msgs, "\n- "))
+}
+
+// OrigErr returns nil, validation errors do not wrap another error.
+func (e ErrInvalidParams) OrigErr() error {
+	return nil
+}
+
+// Error returns the string representation of the error.
+func (e ErrInvalidParams) Error() string {
+	return apierr.New(e.Code(), e.Message(), nil).Error()
+}
+
+// String returns the string representation of the error.
+func (e ErrInvalidParams) String() string {
+	return e.Error()
+}
+
 // A validator validates values. Collects validations errors which occurs.
 type validator struct {
-	errors []string
+	enums  bool // validate enum constraints
+	errors []ErrInvalidParam
+}
+
+func (v *validator) addError(path, reason string) {
+	v.errors = append(v.errors, ErrInvalidParam{Field: path, Reason: reason})
 }
 
 // validateAny will validate any struct, slice or map type. All validations
@@ -80,10 +141,119 @@ func (v *validator) validateStruct(value reflect.Value, path string) {
 		}
 
 		if notset {
-			msg := "missing required parameter: " + path + prefix + f.Name
-			v.errors = append(v.errors, msg)
+			v.addError(path+prefix+f.Name, "missing required parameter")
 		} else {
+			v.validateConstraints(fvalue, f.Tag, path+prefix+f.Name)
 			v.validateAny(fvalue, path+prefix+f.Name)
 		}
 	}
 }
+
+// validateConstraints validates the value of a field against the min, max,
+// pattern and, if enabled, enum constraints in the field's tags. The min and
+// max are the length of strings, blobs, lists and maps, and the range of
+// numbers. Fields which are not set are not validated.
+func (v *validator) validateConstraints(value reflect.Value, tag reflect.StructTag, path string) {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	case reflect.Slice, reflect.Map:
+		if value.IsNil() {
+			return
+		}
+	}
+
+	if min := tag.Get("min"); min != "" {
+		if n, isNum, ok := measure(value); ok && n < parseLimit(min) {
+			if isNum {
+				v.addError(path, "parameter less than minimum value of "+min)
+			} else {
+				v.addError(path, "parameter shorter than minimum length of "+min)
+			}
+		}
+	}
+	if max := tag.Get("max"); max != "" {
+		if n, isNum, ok := measure(value); ok && n > parseLimit(max) {
+			if isNum {
+				v.addError(path, "parameter greater than maximum value of "+max)
+			} else {
+				v.addError(path, "parameter longer than maximum length of "+max)
+			}
+		}
+	}
+
+	if pattern := tag.Get("pattern"); pattern != "" && value.Kind() == reflect.String {
+		if !compilePattern(pattern).MatchString(value.String()) {
+			v.addError(path, "parameter does not match pattern "+pattern)
+		}
+	}
+
+	if enum := tag.Get("enum"); enum != "" && v.enums {
+		values := strings.Split(enum, ",")
+		switch value.Kind() {
+		case reflect.String:
+			if !containsString(values, value.String()) {
+				v.addError(path, "parameter is not one of "+enum)
+			}
+		case reflect.Slice:
+			for i := 0; i < value.Len(); i++ {
+				elem := reflect.Indirect(value.Index(i))
+				if elem.Kind() == reflect.String && !containsString(values, elem.String()) {
+					v.addError(path+fmt.Sprintf("[%d]", i), "parameter is not one of "+enum)
+				}
+			}
+		}
+	}
+}
+
+// measure returns the length or the number a min or max constraint applies
+// to, and whether it is a number.
+func measure(value reflect.Value) (n float64, isNum, ok bool) {
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		return float64(value.Len()), false, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true, true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true, true
+	}
+	return 0, false, false
+}
+
+func parseLimit(s string) float64 {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		panic(fmt.Sprintf("invalid min or max tag %q", s))
+	}
+	return n
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// patterns caches the compiled regular expressions of pattern tags.
+var patterns = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+func compilePattern(pattern string) *regexp.Regexp {
+	patterns.Lock()
+	defer patterns.Unlock()
+
+	re, ok := patterns.m[pattern]
+	if !ok {
+		re = regexp.MustCompile(pattern)
+		patterns.m[pattern] = re
+	}
+	return re
+}
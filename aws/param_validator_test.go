@@ -82,3 +82,88 @@ func TestNestedMissingRequiredParameters(t *testing.T) {
 	assert.Equal(t, "3 validation errors:\n- missing required parameter: RequiredList[0].Name\n- missing required parameter: RequiredMap[\"key2\"].Name\n- missing required parameter: OptionalStruct.Name", req.Error.(awserr.Error).Message())
 
 }
+
+type ConstraintShape struct {
+	Name    *string   `type:"string" min:"1" max:"5"`
+	Count   *int64    `type:"integer" min:"1" max:"10"`
+	Ratio   *float64  `type:"double" max:"0.5"`
+	Code    *string   `type:"string" pattern:"^[a-z]+$"`
+	Color   *string   `type:"string" enum:"red,green"`
+	Colors  []*string `type:"list" enum:"red,green"`
+	Tags    []*string `type:"list" min:"1"`
+	Nested  *ConstraintShape
+	Payload []byte `type:"blob" max:"2"`
+
+	metadataStructureShape
+}
+
+func TestConstraintsNoErrors(t *testing.T) {
+	input := &ConstraintShape{
+		Name:    aws.String("abc"),
+		Count:   aws.Long(10),
+		Ratio:   aws.Double(0.5),
+		Code:    aws.String("abc"),
+		Color:   aws.String("red"),
+		Colors:  []*string{aws.String("green")},
+		Tags:    []*string{aws.String("tag")},
+		Payload: []byte("ab"),
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestConstraintErrors(t *testing.T) {
+	input := &ConstraintShape{
+		Name:    aws.String(""),
+		Count:   aws.Long(11),
+		Ratio:   aws.Double(0.75),
+		Code:    aws.String("ABC"),
+		Tags:    []*string{},
+		Nested:  &ConstraintShape{Name: aws.String("abcdef"), Count: aws.Long(0)},
+		Payload: []byte("abc"),
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	err, ok := req.Error.(aws.ErrInvalidParams)
+	assert.True(t, ok)
+	assert.Equal(t, "InvalidParameter", err.Code())
+	assert.Equal(t, []aws.ErrInvalidParam{
+		{Field: "Name", Reason: "parameter shorter than minimum length of 1"},
+		{Field: "Count", Reason: "parameter greater than maximum value of 10"},
+		{Field: "Ratio", Reason: "parameter greater than maximum value of 0.5"},
+		{Field: "Code", Reason: "parameter does not match pattern ^[a-z]+$"},
+		{Field: "Tags", Reason: "parameter shorter than minimum length of 1"},
+		{Field: "Nested.Name", Reason: "parameter longer than maximum length of 5"},
+		{Field: "Nested.Count", Reason: "parameter less than minimum value of 1"},
+		{Field: "Payload", Reason: "parameter longer than maximum length of 2"},
+	}, err.Errs)
+	assert.Contains(t, err.Message(), "8 validation errors:\n- parameter shorter than minimum length of 1: Name\n")
+}
+
+func TestEnumConstraints(t *testing.T) {
+	input := &ConstraintShape{
+		Color:  aws.String("blue"),
+		Colors: []*string{aws.String("red"), aws.String("blue")},
+	}
+
+	// enums are only validated when enabled
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+
+	svc := *service
+	svc.Config = &aws.Config{ValidateParamEnums: true}
+	req = aws.NewRequest(&svc, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+
+	err, ok := req.Error.(aws.ErrInvalidParams)
+	assert.True(t, ok)
+	assert.Equal(t, []aws.ErrInvalidParam{
+		{Field: "Color", Reason: "parameter is not one of red,green"},
+		{Field: "Colors[1]", Reason: "parameter is not one of red,green"},
+	}, err.Errs)
+}
@@ -48,6 +48,10 @@ type Paginator struct {
 	OutputTokens    []string
 	LimitToken      string
 	TruncationToken string
+
+	// The output members holding the items of a page, used to count the
+	// items returned against Pagination.MaxItems.
+	ResultKeys []string
 }
 
 // NewRequest returns a new Request pointer for the service API
@@ -297,6 +301,9 @@ func (r *Request) NextPage() *Request {
 // as the structure "T". The lastPage value represents whether the page is
 // the last page of data or not. The return value of this function should
 // return true to keep iterating or false to stop.
+//
+// EachPage does not limit the number of items returned, nor stop if the
+// service repeats a page token. Use a Pagination for either.
 func (r *Request) EachPage(fn func(data interface{}, isLastPage bool) (shouldContinue bool)) error {
 	for page := r; page != nil; page = page.NextPage() {
 		page.Send()
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/dongfangx/aws-sdk-go/aws/awserr"
-	"github.com/dongfangx/aws-sdk-go/internal/endpoints"
 )
 
 // A Service implements the base service request and response handling
@@ -23,11 +22,14 @@ type Service struct {
 	Endpoint          string
 	SigningName       string
 	SigningRegion     string
+	SignatureVersion  string
 	JSONVersion       string
 	TargetPrefix      string
 	RetryRules        func(*Request) time.Duration
 	ShouldRetry       func(*Request) bool
 	DefaultMaxRetries uint
+
+	endpointErr error // error resolving the endpoint, if any
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
@@ -72,12 +74,21 @@ func (s *Service) Initialize() {
 }
 
 // buildEndpoint builds the endpoint values the service will use to make requests with.
+// Unless the config sets the Endpoint, it is resolved by the config's EndpointResolver.
 func (s *Service) buildEndpoint() {
 	if s.Config.Endpoint != "" {
 		s.Endpoint = s.Config.Endpoint
 	} else {
-		s.Endpoint, s.SigningRegion =
-			endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		e, err := s.Config.ResolveEndpoint(s.ServiceName, s.Config.Region)
+		if err != nil {
+			s.endpointErr = err
+			return
+		}
+		s.Endpoint, s.SigningRegion = e.URL, e.SigningRegion
+		if e.SigningName != "" {
+			s.SigningName = e.SigningName
+		}
+		s.SignatureVersion = e.SignatureVersion
 	}
 	if s.Endpoint != "" && !schemeRE.MatchString(s.Endpoint) {
 		scheme := "https"
@@ -0,0 +1,181 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// The states a WaiterAcceptor can move a Waiter into.
+const (
+	// WaiterStateSuccess stops waiting without an error.
+	WaiterStateSuccess = "success"
+
+	// WaiterStateFailure stops waiting with a ResourceNotReady error.
+	WaiterStateFailure = "failure"
+
+	// WaiterStateRetry makes another attempt after the delay.
+	WaiterStateRetry = "retry"
+)
+
+// The ways a WaiterAcceptor can match the response of an attempt.
+const (
+	// WaiterMatchStatus matches the HTTP status code of the response.
+	WaiterMatchStatus = "status"
+
+	// WaiterMatchError matches the code of the error returned.
+	WaiterMatchError = "error"
+
+	// WaiterMatchPath matches the value at Argument in the output.
+	WaiterMatchPath = "path"
+
+	// WaiterMatchPathAll matches if every value at Argument in the output
+	// equals Expected.
+	WaiterMatchPathAll = "pathAll"
+
+	// WaiterMatchPathAny matches if any value at Argument in the output
+	// equals Expected.
+	WaiterMatchPathAny = "pathAny"
+)
+
+// A WaiterAcceptor decides the state of a Waiter from the response of an
+// attempt.
+type WaiterAcceptor struct {
+	// The state to move into when the acceptor matches.
+	State string
+
+	// How the response is matched, one of the WaiterMatch constants.
+	Matcher string
+
+	// The path of the output value to match, for the path matchers.
+	Argument string
+
+	// The value expected for a match.
+	Expected interface{}
+}
+
+// A WaiterConfig describes how to wait for a resource to reach a state.
+type WaiterConfig struct {
+	// The name of the operation polled.
+	Operation string
+
+	// The delay in seconds between attempts.
+	Delay int
+
+	// The maximum number of attempts before giving up.
+	MaxAttempts int
+
+	// The acceptors checked in order against the response of each attempt.
+	Acceptors []WaiterAcceptor
+}
+
+// A Waiter polls an operation until one of its acceptors moves it into a
+// success or failure state, or it runs out of attempts.
+//
+// Waiters are usually used through the generated WaitUntil methods of a
+// service client, such as s3.S3.WaitUntilBucketExists.
+type Waiter struct {
+	WaiterConfig
+
+	// NewRequest returns a new request for the operation polled. It is
+	// called once per attempt.
+	NewRequest func() *Request
+}
+
+// Wait polls the operation until it reaches a success or failure state.
+// It returns nil on success, and a ResourceNotReady error on failure or
+// when MaxAttempts is exceeded. An error returned by an attempt which no
+// acceptor matched is returned as is.
+func (w *Waiter) Wait() error {
+	for attempt := 1; ; attempt++ {
+		req := w.NewRequest()
+		err := req.Send()
+
+		state := ""
+		for _, a := range w.Acceptors {
+			if w.matches(&a, req, err) {
+				state = a.State
+				break
+			}
+		}
+
+		switch state {
+		case WaiterStateSuccess:
+			return nil
+		case WaiterStateFailure:
+			return apierr.New("ResourceNotReady",
+				"failed waiting for successful resource state", err)
+		case WaiterStateRetry:
+			// make another attempt, even if the attempt failed
+		default:
+			if err != nil {
+				return err
+			}
+		}
+
+		if attempt >= w.MaxAttempts {
+			msg := fmt.Sprintf("exceeded %d wait attempts", w.MaxAttempts)
+			return apierr.New("ResourceNotReady", msg, nil)
+		}
+		sleepDelay(time.Duration(w.Delay) * time.Second)
+	}
+}
+
+// matches returns whether the acceptor a matches the attempt req, which
+// completed with err.
+func (w *Waiter) matches(a *WaiterAcceptor, req *Request, err error) bool {
+	switch a.Matcher {
+	case WaiterMatchStatus:
+		return req.HTTPResponse != nil &&
+			waiterValueEqual(req.HTTPResponse.StatusCode, a.Expected)
+	case WaiterMatchError:
+		awsErr, ok := err.(awserr.Error)
+		return ok && waiterValueEqual(awsErr.Code(), a.Expected)
+	}
+
+	if err != nil {
+		return false
+	}
+
+	vals := awsutil.ValuesAtAnyPath(req.Data, a.Argument)
+	switch a.Matcher {
+	case WaiterMatchPath:
+		return len(vals) > 0 && waiterValueEqual(vals[0], a.Expected)
+	case WaiterMatchPathAll:
+		for _, v := range vals {
+			if !waiterValueEqual(v, a.Expected) {
+				return false
+			}
+		}
+		return len(vals) > 0
+	case WaiterMatchPathAny:
+		for _, v := range vals {
+			if waiterValueEqual(v, a.Expected) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// waiterValueEqual compares an output value with an expected value from a
+// waiter definition. Pointers are dereferenced and values are compared by
+// their string form, so that numbers decoded from JSON compare equal to
+// output integers.
+func waiterValueEqual(v, expected interface{}) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return expected == nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return expected == nil
+	}
+	return fmt.Sprint(rv.Interface()) == fmt.Sprint(expected)
+}
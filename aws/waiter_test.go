@@ -0,0 +1,141 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+type waiterTestData struct {
+	State  *string
+	States []*waiterTestState
+}
+
+type waiterTestState struct {
+	Name *string
+}
+
+// waiterService returns a service sending the responses in order, with a
+// 404 status for an empty body, and the delays slept between attempts.
+func waiterService(resps []string) (*Service, *[]time.Duration) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+
+	reqNum := 0
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Validate.Clear()
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Clear() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		if resps[reqNum] == "" {
+			r.HTTPResponse = &http.Response{StatusCode: 404,
+				Body: body(`{"__type":"NotFound","message":"Not found."}`)}
+		} else {
+			r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(resps[reqNum])}
+		}
+		reqNum++
+	})
+	return s, &delays
+}
+
+func newTestWaiter(s *Service, acceptors []WaiterAcceptor) *Waiter {
+	return &Waiter{
+		WaiterConfig: WaiterConfig{
+			Operation:   "Describe",
+			Delay:       5,
+			MaxAttempts: 3,
+			Acceptors:   acceptors,
+		},
+		NewRequest: func() *Request {
+			return NewRequest(s, &Operation{Name: "Describe"}, nil, &waiterTestData{})
+		},
+	}
+}
+
+func TestWaiterPathSuccess(t *testing.T) {
+	s, delays := waiterService([]string{
+		`{"State":"pending"}`, `{"State":"available"}`,
+	})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "path", Argument: "State", Expected: "available"},
+		{State: "failure", Matcher: "path", Argument: "State", Expected: "deleted"},
+	})
+
+	assert.NoError(t, w.Wait())
+	assert.Equal(t, []time.Duration{5 * time.Second}, *delays)
+}
+
+func TestWaiterPathFailure(t *testing.T) {
+	s, _ := waiterService([]string{`{"State":"deleted"}`})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "path", Argument: "State", Expected: "available"},
+		{State: "failure", Matcher: "path", Argument: "State", Expected: "deleted"},
+	})
+
+	err := w.Wait()
+	assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+}
+
+func TestWaiterPathAllAny(t *testing.T) {
+	s, delays := waiterService([]string{
+		`{"States":[{"Name":"ok"},{"Name":"pending"}]}`,
+		`{"States":[{"Name":"ok"},{"Name":"ok"}]}`,
+	})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "pathAll", Argument: "States[].Name", Expected: "ok"},
+		{State: "failure", Matcher: "pathAny", Argument: "States[].Name", Expected: "failed"},
+	})
+
+	assert.NoError(t, w.Wait())
+	assert.Len(t, *delays, 1)
+}
+
+func TestWaiterStatusRetry(t *testing.T) {
+	s, delays := waiterService([]string{"", "", `{}`})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "status", Expected: 200},
+		{State: "retry", Matcher: "status", Expected: float64(404)},
+	})
+
+	assert.NoError(t, w.Wait())
+	assert.Len(t, *delays, 2)
+}
+
+func TestWaiterErrorMatch(t *testing.T) {
+	s, _ := waiterService([]string{""})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "error", Expected: "NotFound"},
+	})
+
+	assert.NoError(t, w.Wait())
+}
+
+func TestWaiterUnmatchedError(t *testing.T) {
+	s, _ := waiterService([]string{""})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "status", Expected: 200},
+	})
+
+	err := w.Wait()
+	assert.Equal(t, "NotFound", err.(awserr.Error).Code())
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	s, delays := waiterService([]string{
+		`{"State":"pending"}`, `{"State":"pending"}`, `{"State":"pending"}`,
+	})
+	w := newTestWaiter(s, []WaiterAcceptor{
+		{State: "success", Matcher: "path", Argument: "State", Expected: "available"},
+	})
+
+	err := w.Wait()
+	assert.Equal(t, "ResourceNotReady", err.(awserr.Error).Code())
+	assert.Equal(t, "exceeded 3 wait attempts", err.(awserr.Error).Message())
+	assert.Len(t, *delays, 2)
+}
@@ -137,3 +137,75 @@ func (r *RequestError) StatusCode() int {
 func (r *RequestError) RequestID() string {
 	return r.requestID
 }
+
+// ErrorDetails are the extended details a service may return with an error.
+type ErrorDetails struct {
+	// The host ID that served the request.
+	HostID string
+
+	// The resource the request failed on.
+	Resource string
+
+	// The bucket and key of the object the request failed on.
+	BucketName string
+	Key        string
+
+	// The raw body of the error response.
+	Body []byte
+}
+
+// An ExtendedRequestError wraps a request error with the extended details
+// returned by the service.
+//
+// Composed of RequestError for the status code and request ID.
+type ExtendedRequestError struct {
+	*RequestError
+	details ErrorDetails
+}
+
+// NewExtendedRequestError returns a wrapped error with the extended details
+// of a request error.
+func NewExtendedRequestError(reqErr *RequestError, details ErrorDetails) *ExtendedRequestError {
+	return &ExtendedRequestError{
+		RequestError: reqErr,
+		details:      details,
+	}
+}
+
+// Error returns the string representation of the error.
+// Satisfies the error interface.
+func (e *ExtendedRequestError) Error() string {
+	return e.ErrorWithExtra(fmt.Sprintf("status code: %d, request id: [%s], host id: [%s]",
+		e.statusCode, e.requestID, e.details.HostID))
+}
+
+// String returns the string representation of the error.
+// Alias for Error to satisfy the stringer interface.
+func (e *ExtendedRequestError) String() string {
+	return e.Error()
+}
+
+// HostID returns the wrapped host ID
+func (e *ExtendedRequestError) HostID() string {
+	return e.details.HostID
+}
+
+// Resource returns the wrapped resource
+func (e *ExtendedRequestError) Resource() string {
+	return e.details.Resource
+}
+
+// BucketName returns the wrapped bucket name
+func (e *ExtendedRequestError) BucketName() string {
+	return e.details.BucketName
+}
+
+// Key returns the wrapped object key
+func (e *ExtendedRequestError) Key() string {
+	return e.details.Key
+}
+
+// Body returns the wrapped raw response body
+func (e *ExtendedRequestError) Body() []byte {
+	return e.details.Body
+}
@@ -1,31 +1,62 @@
-// Package endpoints validates regional endpoints for services.
+// Package endpoints resolves the regional endpoints of services.
+//
+// Endpoints are resolved from a model of partitions. A partition is a group
+// of regions sharing a DNS suffix, such as the aws, aws-cn and aws-us-gov
+// partitions, with default endpoint templates and per-service overrides.
 package endpoints
 
-//go:generate go run ../model/cli/gen-endpoints/main.go endpoints.json endpoints_map.go
-//go:generate gofmt -s -w endpoints_map.go
+//go:generate go run ../model/cli/gen-endpoints/main.go endpoints.json defaults.go ../../aws/endpoints/ids.go
 
 import "strings"
 
+// Options are the options an endpoint is resolved with.
+type Options struct {
+	// Resolve an endpoint using plain HTTP instead of HTTPS.
+	DisableSSL bool
+
+	// Resolve the dualstack variant of the endpoint, which supports both
+	// IPv4 and IPv6.
+	UseDualStack bool
+
+	// Resolve the FIPS 140-2 validated variant of the endpoint.
+	UseFIPS bool
+}
+
+// A ResolvedEndpoint is the endpoint of a service in a region.
+type ResolvedEndpoint struct {
+	// The URL of the endpoint, including its scheme.
+	URL string
+
+	// The region requests to the endpoint are signed for.
+	SigningRegion string
+
+	// The service name requests to the endpoint are signed for, if it
+	// differs from the name of the service.
+	SigningName string
+
+	// The signature version the endpoint requires, such as "v4", or empty
+	// if the service's default signer is accepted.
+	SignatureVersion string
+}
+
+// Resolve returns the endpoint of a service in a region, resolved from the
+// default partitions.
+func Resolve(service, region string, opts Options) (ResolvedEndpoint, error) {
+	return defaultPartitions.Resolve(service, region, opts)
+}
+
+// DefaultPartitions returns the partitions endpoints are resolved from by
+// default.
+func DefaultPartitions() Partitions {
+	return defaultPartitions
+}
+
 // EndpointForRegion returns an endpoint and its signing region for a service and region.
 // if the service and region pair are not found endpoint and signingRegion will be empty.
 func EndpointForRegion(svcName, region string) (endpoint, signingRegion string) {
-	derivedKeys := []string{
-		region + "/" + svcName,
-		region + "/*",
-		"*/" + svcName,
-		"*/*",
-	}
-
-	for _, key := range derivedKeys {
-		if val, ok := endpointsMap.Endpoints[key]; ok {
-			ep := val.Endpoint
-			ep = strings.Replace(ep, "{region}", region, -1)
-			ep = strings.Replace(ep, "{service}", svcName, -1)
-
-			endpoint = ep
-			signingRegion = val.SigningRegion
-			return
-		}
+	e, err := Resolve(svcName, region, Options{})
+	if err != nil {
+		return "", ""
 	}
-	return
+	return strings.TrimPrefix(e.URL, "https://"), e.SigningRegion
 }
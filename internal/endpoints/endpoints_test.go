@@ -1,8 +1,11 @@
 package endpoints
 
 import (
+	"os"
+	"strings"
 	"testing"
 
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,3 +29,96 @@ func TestServicesInCN(t *testing.T) {
 		assert.Equal(t, name+"."+region+".amazonaws.com.cn", ep)
 	}
 }
+
+func TestResolvePartitions(t *testing.T) {
+	cases := []struct {
+		service, region, url, signingRegion string
+	}{
+		{"ec2", "us-west-2", "https://ec2.us-west-2.amazonaws.com", "us-west-2"},
+		{"ec2", "ca-central-1", "https://ec2.ca-central-1.amazonaws.com", "ca-central-1"},
+		{"ec2", "cn-north-1", "https://ec2.cn-north-1.amazonaws.com.cn", "cn-north-1"},
+		{"ec2", "us-gov-west-1", "https://ec2.us-gov-west-1.amazonaws.com", "us-gov-west-1"},
+		{"iam", "us-gov-west-1", "https://iam.us-gov.amazonaws.com", "us-gov-west-1"},
+		{"s3", "us-east-1", "https://s3.amazonaws.com", "us-east-1"},
+		{"s3", "eu-west-1", "https://s3-eu-west-1.amazonaws.com", "eu-west-1"},
+		{"s3", "eu-central-1", "https://s3.eu-central-1.amazonaws.com", "eu-central-1"},
+		{"sdb", "us-east-1", "https://sdb.amazonaws.com", "us-east-1"},
+	}
+
+	for _, c := range cases {
+		e, err := Resolve(c.service, c.region, Options{})
+		assert.NoError(t, err)
+		assert.Equal(t, c.url, e.URL, c.service+" "+c.region)
+		assert.Equal(t, c.signingRegion, e.SigningRegion, c.service+" "+c.region)
+	}
+}
+
+func TestResolveVariants(t *testing.T) {
+	cases := []struct {
+		service, region string
+		opts            Options
+		url             string
+	}{
+		{"s3", "us-west-2", Options{UseDualStack: true}, "https://s3.dualstack.us-west-2.amazonaws.com"},
+		{"ec2", "us-east-1", Options{UseFIPS: true}, "https://ec2-fips.us-east-1.amazonaws.com"},
+		{"ec2", "us-east-1", Options{UseFIPS: true, UseDualStack: true}, "https://ec2-fips.dualstack.us-east-1.amazonaws.com"},
+		{"iam", "us-east-1", Options{UseFIPS: true}, "https://iam-fips.amazonaws.com"},
+		{"s3", "cn-north-1", Options{UseDualStack: true}, "https://s3.dualstack.cn-north-1.amazonaws.com.cn"},
+		{"ec2", "us-west-2", Options{DisableSSL: true}, "http://ec2.us-west-2.amazonaws.com"},
+	}
+
+	for _, c := range cases {
+		e, err := Resolve(c.service, c.region, c.opts)
+		assert.NoError(t, err)
+		assert.Equal(t, c.url, e.URL)
+	}
+}
+
+func TestResolveUnknownVariant(t *testing.T) {
+	_, err := Resolve("s3", "cn-north-1", Options{UseFIPS: true})
+	assert.Error(t, err)
+	assert.Equal(t, "UnknownEndpoint", err.(awserr.Error).Code())
+
+	_, err = Resolve("cloudfront", "us-east-1", Options{UseDualStack: true})
+	assert.Error(t, err)
+	assert.Equal(t, "UnknownEndpoint", err.(awserr.Error).Code())
+}
+
+func TestResolveMissingRegion(t *testing.T) {
+	_, err := Resolve("ec2", "", Options{})
+	assert.Error(t, err)
+
+	// global endpoints need no region
+	e, err := Resolve("sts", "", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://sts.amazonaws.com", e.URL)
+}
+
+func TestResolveSignatureVersion(t *testing.T) {
+	cases := map[string]string{
+		"us-west-2":    "",
+		"eu-central-1": "v4",
+		"cn-north-1":   "v4",
+	}
+
+	for region, version := range cases {
+		e, err := Resolve("s3", region, Options{})
+		assert.NoError(t, err)
+		assert.Equal(t, version, e.SignatureVersion, region)
+	}
+}
+
+func TestDefaultPartitionsMatchModel(t *testing.T) {
+	f, err := os.Open("endpoints.json")
+	assert.NoError(t, err)
+	defer f.Close()
+
+	ps, err := DecodeModel(f)
+	assert.NoError(t, err)
+	assert.Equal(t, ps, defaultPartitions, "defaults.go is out of date, run go generate")
+}
+
+func TestDecodeModelVersion(t *testing.T) {
+	_, err := DecodeModel(strings.NewReader(`{"version": 2, "endpoints": {}}`))
+	assert.Error(t, err)
+}
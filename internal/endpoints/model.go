@@ -0,0 +1,230 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// Tags of the endpoint variants.
+const (
+	FIPSVariant      = "fips"
+	DualStackVariant = "dualstack"
+)
+
+// Partitions are the partitions of the endpoints model. Regions which are
+// not part of any partition are resolved in the first partition.
+type Partitions []Partition
+
+// A Partition is a group of regions sharing a DNS suffix and the default
+// endpoints of their services.
+type Partition struct {
+	ID          string             `json:"partition"`
+	Name        string             `json:"partitionName"`
+	DNSSuffix   string             `json:"dnsSuffix"`
+	RegionRegex string             `json:"regionRegex"`
+	Defaults    Endpoint           `json:"defaults"`
+	Regions     map[string]Region  `json:"regions"`
+	Services    map[string]Service `json:"services"`
+}
+
+// A Region is a region of a partition.
+type Region struct {
+	Description string `json:"description"`
+}
+
+// A Service is the endpoint overrides of a service in a partition.
+//
+// A service with a PartitionEndpoint is global, every region of the
+// partition without an endpoint of its own is served by that endpoint. A
+// service without endpoints is served by the partition defaults in every
+// region of the partition.
+type Service struct {
+	PartitionEndpoint string              `json:"partitionEndpoint"`
+	Defaults          Endpoint            `json:"defaults"`
+	Endpoints         map[string]Endpoint `json:"endpoints"`
+}
+
+// An Endpoint describes an endpoint. Hostnames may contain the {service},
+// {region} and {dnsSuffix} placeholders. Members which are not set are
+// inherited from the service and partition defaults.
+type Endpoint struct {
+	Hostname         string          `json:"hostname"`
+	SignatureVersion string          `json:"signatureVersion"`
+	CredentialScope  CredentialScope `json:"credentialScope"`
+	Variants         []Variant       `json:"variants"`
+}
+
+// A CredentialScope overrides the region and service requests to an
+// endpoint are signed for.
+type CredentialScope struct {
+	Region  string `json:"region"`
+	Service string `json:"service"`
+}
+
+// A Variant is an alternative hostname of an endpoint, such as its FIPS or
+// dualstack endpoint, identified by its set of tags.
+type Variant struct {
+	Tags     []string `json:"tags"`
+	Hostname string   `json:"hostname"`
+}
+
+// DecodeModel decodes the JSON endpoints model read from r.
+func DecodeModel(r io.Reader) (Partitions, error) {
+	var model struct {
+		Version    int
+		Partitions Partitions
+	}
+	if err := json.NewDecoder(r).Decode(&model); err != nil {
+		return nil, err
+	}
+	if model.Version != 3 {
+		return nil, fmt.Errorf("unsupported endpoints model version %d", model.Version)
+	}
+	return model.Partitions, nil
+}
+
+// Resolve returns the endpoint of a service in a region, resolved in the
+// partition the region belongs to.
+func (ps Partitions) Resolve(service, region string, opts Options) (ResolvedEndpoint, error) {
+	p, ok := ps.partitionFor(region)
+	if !ok {
+		return ResolvedEndpoint{}, apierr.New("UnknownEndpoint",
+			"no partitions in endpoints model", nil)
+	}
+	return p.Resolve(service, region, opts)
+}
+
+// partitionFor returns the partition region belongs to, the partition whose
+// region regex matches it, or the first partition.
+func (ps Partitions) partitionFor(region string) (Partition, bool) {
+	if len(ps) == 0 {
+		return Partition{}, false
+	}
+	for _, p := range ps {
+		if _, ok := p.Regions[region]; ok {
+			return p, true
+		}
+	}
+	for _, p := range ps {
+		if p.RegionRegex != "" && regexp.MustCompile(p.RegionRegex).MatchString(region) {
+			return p, true
+		}
+	}
+	return ps[0], true
+}
+
+// Resolve returns the endpoint of a service in a region of the partition.
+func (p Partition) Resolve(service, region string, opts Options) (ResolvedEndpoint, error) {
+	s := p.Services[service]
+
+	key := region
+	e, ok := s.Endpoints[region]
+	if !ok && s.PartitionEndpoint != "" {
+		key, e = s.PartitionEndpoint, s.Endpoints[s.PartitionEndpoint]
+	}
+	global := s.PartitionEndpoint != "" && key == s.PartitionEndpoint
+
+	merged := p.Defaults.merge(s.Defaults).merge(e)
+	if global {
+		// global endpoints only have the variants they define
+		merged.Variants = e.Variants
+	}
+
+	hostname := merged.Hostname
+	if tags := opts.variantTags(); len(tags) > 0 {
+		v, ok := merged.variant(tags)
+		if !ok {
+			msg := fmt.Sprintf("no %s endpoint of %s in %s", strings.Join(tags, " "), service, region)
+			return ResolvedEndpoint{}, apierr.New("UnknownEndpoint", msg, nil)
+		}
+		hostname = v.Hostname
+	}
+
+	if key == "" && strings.Contains(hostname, "{region}") {
+		msg := fmt.Sprintf("a region is required to resolve the endpoint of %s", service)
+		return ResolvedEndpoint{}, apierr.New("UnknownEndpoint", msg, nil)
+	}
+	hostname = strings.NewReplacer(
+		"{service}", service,
+		"{region}", key,
+		"{dnsSuffix}", p.DNSSuffix,
+	).Replace(hostname)
+
+	scheme := "https"
+	if opts.DisableSSL {
+		scheme = "http"
+	}
+
+	signingRegion := merged.CredentialScope.Region
+	if signingRegion == "" {
+		signingRegion = key
+	}
+
+	return ResolvedEndpoint{
+		URL:              scheme + "://" + hostname,
+		SigningRegion:    signingRegion,
+		SigningName:      merged.CredentialScope.Service,
+		SignatureVersion: merged.SignatureVersion,
+	}, nil
+}
+
+// variantTags returns the sorted tags of the variant the options select.
+func (o Options) variantTags() []string {
+	tags := []string{}
+	if o.UseDualStack {
+		tags = append(tags, DualStackVariant)
+	}
+	if o.UseFIPS {
+		tags = append(tags, FIPSVariant)
+	}
+	return tags
+}
+
+// merge returns e with the members set in o replacing its own. Variants are
+// replaced by those of o with the same tags.
+func (e Endpoint) merge(o Endpoint) Endpoint {
+	if o.Hostname != "" {
+		e.Hostname = o.Hostname
+	}
+	if o.SignatureVersion != "" {
+		e.SignatureVersion = o.SignatureVersion
+	}
+	if o.CredentialScope.Region != "" {
+		e.CredentialScope.Region = o.CredentialScope.Region
+	}
+	if o.CredentialScope.Service != "" {
+		e.CredentialScope.Service = o.CredentialScope.Service
+	}
+
+	variants := []Variant{}
+	for _, v := range e.Variants {
+		if _, ok := o.variant(v.Tags); !ok {
+			variants = append(variants, v)
+		}
+	}
+	e.Variants = append(variants, o.Variants...)
+	return e
+}
+
+// variant returns the variant of e with exactly the tags.
+func (e Endpoint) variant(tags []string) (Variant, bool) {
+	want := sortedTags(tags)
+	for _, v := range e.Variants {
+		if sortedTags(v.Tags) == want {
+			return v, true
+		}
+	}
+	return Variant{}, false
+}
+
+func sortedTags(tags []string) string {
+	s := append([]string{}, tags...)
+	sort.Strings(s)
+	return strings.Join(s, " ")
+}
@@ -17,6 +17,7 @@ type API struct {
 	Metadata   Metadata
 	Operations map[string]*Operation
 	Shapes     map[string]*Shape
+	Waiters    []Waiter
 
 	// Disables inflection checks. Only use this when generating tests
 	NoInflections bool
@@ -92,6 +93,14 @@ func (a *API) NiceName() string {
 	return a.Metadata.ServiceFullName
 }
 
+// SignerPackage returns the package name of the signer this API uses.
+func (a *API) SignerPackage() string {
+	if a.Metadata.SignatureVersion == "v2" {
+		return "v2"
+	}
+	return "v4"
+}
+
 // ProtocolPackage returns the package name of the protocol this API uses.
 func (a *API) ProtocolPackage() string {
 	switch a.Metadata.Protocol {
@@ -190,7 +199,12 @@ var oprw sync.Mutex
 {{ end }}
 
 {{ range $_, $s := .ShapeList }}
-{{ if eq $s.Type "structure" }}{{ $s.GoCode }}{{ end }}
+{{ if and (eq $s.Type "structure") (not $s.EventStream) }}{{ $s.GoCode }}{{ end }}
+
+{{ end }}
+
+{{ range $_, $s := .ShapeList }}
+{{ if $s.IsEnum }}{{ $s.EnumGoCode }}{{ end }}
 
 {{ end }}
 `))
@@ -198,7 +212,9 @@ var oprw sync.Mutex
 // APIGoCode renders the API in Go code. Returning it as a string
 func (a *API) APIGoCode() string {
 	a.resetImports()
+	a.imports["net/url"] = true
 	a.imports["sync"] = true
+	a.imports["time"] = true
 	var buf bytes.Buffer
 	err := tplAPI.Execute(&buf, a)
 	if err != nil {
@@ -237,7 +253,7 @@ func New(config *aws.Config) *{{ .StructName }} {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBack({{ .SignerPackage }}.Sign)
 	service.Handlers.Build.PushBack({{ .ProtocolPackage }}.Build)
 	service.Handlers.Unmarshal.PushBack({{ .ProtocolPackage }}.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack({{ .ProtocolPackage }}.UnmarshalMeta)
@@ -270,7 +286,7 @@ func (c *{{ .StructName }}) newRequest(op *aws.Operation, params, data interface
 // ServiceGoCode renders service go code. Returning it as a string.
 func (a *API) ServiceGoCode() string {
 	a.resetImports()
-	a.imports["github.com/dongfangx/aws-sdk-go/internal/signer/v4"] = true
+	a.imports["github.com/dongfangx/aws-sdk-go/internal/signer/"+a.SignerPackage()] = true
 	a.imports["github.com/dongfangx/aws-sdk-go/internal/protocol/"+a.ProtocolPackage()] = true
 
 	var buf bytes.Buffer
@@ -311,6 +327,9 @@ type {{ .StructName }}API interface {
     {{ range $_, $o := .OperationList }}
         {{ $o.InterfaceSignature }}
     {{ end }}
+    {{ range $_, $w := .Waiters }}
+        {{ $w.InterfaceSignature }}
+    {{ end }}
 }
 `))
 
@@ -320,6 +339,9 @@ type {{ .StructName }}API interface {
 func (a *API) InterfaceGoCode() string {
 	a.resetImports()
 	a.imports = map[string]bool{
+		"net/url":                             true,
+		"time":                                true,
+		"github.com/dongfangx/aws-sdk-go/aws": true,
 		"github.com/dongfangx/aws-sdk-go/service/" + a.PackageName(): true,
 	}
 
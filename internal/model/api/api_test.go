@@ -40,3 +40,16 @@ func TestStructNameForExceptions(t *testing.T) {
 	}
 	assert.Equal(t, a.StructName(), "ConfigService")
 }
+
+func TestSignerPackage(t *testing.T) {
+	cases := map[string]string{
+		"":   "v4",
+		"v2": "v2",
+		"v4": "v4",
+	}
+
+	for version, pkg := range cases {
+		a := API{Metadata: Metadata{SignatureVersion: version}}
+		assert.Equal(t, pkg, a.SignerPackage(), version)
+	}
+}
@@ -25,6 +25,14 @@ func s3Customizations(a *API) {
 	if s, ok := a.Shapes["Rule"]; ok {
 		s.Rename("LifecycleRule")
 	}
+
+	// Keep the exported name of the callback URL header of PutObject
+	if s, ok := a.Shapes["PutObjectInput"]; ok {
+		if ref, ok := s.MemberRefs["CallbackURL"]; ok {
+			delete(s.MemberRefs, "CallbackURL")
+			s.MemberRefs["CallbackUrl"] = ref
+		}
+	}
 }
 
 // cloudfrontCustomizations customized the API generation to replace values
@@ -8,6 +8,7 @@ import (
 
 // Load takes a set of files for each filetype and returns an API pointer.
 // The API will be initialized once all files have been loaded and parsed.
+// The paginators and waiters files are optional and skipped if empty.
 //
 // Will panic if any failure opening the definition JSON files, or there
 // are unrecognized exported names.
@@ -15,8 +16,12 @@ func Load(api, docs, paginators, waiters string) *API {
 	a := API{}
 	a.Attach(api)
 	a.Attach(docs)
-	a.Attach(paginators)
-	a.Attach(waiters)
+	if paginators != "" {
+		a.AttachPaginators(paginators)
+	}
+	if waiters != "" {
+		a.AttachWaiters(waiters)
+	}
 	return &a
 }
 
@@ -60,6 +65,7 @@ func (a *API) Setup() {
 	if !a.NoRemoveUnusedShapes {
 		a.removeUnusedShapes()
 	}
+	a.inflectEnumNames()
 
 	if len(a.unrecognizedNames) > 0 {
 		msg := []string{
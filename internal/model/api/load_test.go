@@ -69,3 +69,90 @@ func TestRenamedShapes(t *testing.T) {
 	assert.Nil(t, a.Shapes["TestVpnIcmp"])
 	assert.NotNil(t, a.Shapes["TestVPNICMP"])
 }
+
+func TestMemberXMLNamespace(t *testing.T) {
+	json := `{
+		"metadata": { "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "TestRequest" }
+			}
+		},
+		"shapes": {
+			"TestRequest": {
+				"type": "structure",
+				"members": {
+					"Config": {
+						"shape": "Config",
+						"locationName": "Config",
+						"xmlNamespace": { "uri": "http://foo/" }
+					}
+				}
+			},
+			"Config": { "type": "structure", "members": {} }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	ref := a.Shapes["OperationNameInput"].MemberRefs["Config"]
+	assert.Equal(t, "`locationName:\"Config\" type:\"structure\" xmlURI:\"http://foo/\"`", ref.GoTags(false, false))
+}
+
+func TestTimestampFormat(t *testing.T) {
+	json := `{
+		"metadata": { "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "TestRequest" }
+			}
+		},
+		"shapes": {
+			"TestRequest": {
+				"type": "structure",
+				"members": {
+					"RetainUntil": { "shape": "RetainUntil", "location": "header", "locationName": "x-retain-until" },
+					"Modified": { "shape": "Timestamp", "location": "header", "locationName": "x-modified" }
+				}
+			},
+			"RetainUntil": { "type": "timestamp", "timestampFormat": "iso8601" },
+			"Timestamp": { "type": "timestamp" }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	in := a.Shapes["OperationNameInput"]
+	assert.Equal(t, "`location:\"header\" locationName:\"x-retain-until\" type:\"timestamp\" timestampFormat:\"iso8601\"`",
+		in.MemberRefs["RetainUntil"].GoTags(false, false))
+	assert.Equal(t, "`location:\"header\" locationName:\"x-modified\" type:\"timestamp\" timestampFormat:\"rfc822\"`",
+		in.MemberRefs["Modified"].GoTags(false, false))
+}
+
+func TestEventStream(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Mock Service", "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"output": { "shape": "TestResult" }
+			}
+		},
+		"shapes": {
+			"TestResult": {
+				"type": "structure",
+				"payload": "Events",
+				"members": {
+					"Events": { "shape": "EventStream" }
+				}
+			},
+			"EventStream": { "type": "structure", "eventstream": true, "members": {} }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	ref := a.Shapes["OperationNameOutput"].MemberRefs["Events"]
+	assert.Equal(t, "`type:\"eventstream\"`", ref.GoTags(false, false))
+
+	// the Go type of an event stream is written by hand
+	code := a.APIGoCode()
+	assert.Contains(t, code, "Events *EventStream `type:\"eventstream\"`")
+	assert.NotContains(t, code, "type EventStream struct")
+}
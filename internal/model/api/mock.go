@@ -0,0 +1,199 @@
+package api
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/dongfangx/aws-sdk-go/internal/util"
+)
+
+// MockPackageName returns the package name of the service's mock client.
+func (a *API) MockPackageName() string {
+	return a.PackageName() + "mock"
+}
+
+// tplMock defines the template for the service's mock client.
+var tplMock = template.Must(template.New("mock").Parse(`
+// {{ .StructName }} is a mock of {{ .InterfacePackageName }}.{{ .StructName }}API. Each
+// operation calls its Func field if it is set, or returns an empty output
+// otherwise. The calls made to the mock are recorded, and returned by Calls
+// and CallsTo.
+//
+// The zero value of {{ .StructName }} is a mock with no stubs.
+type {{ .StructName }} struct {
+{{- range $_, $o := .OperationList }}
+	// {{ $o.ExportedName }}Func, if set, is called by {{ $o.ExportedName }}.
+	{{ $o.ExportedName }}Func func({{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error)
+
+	// {{ $o.ExportedName }}PresignedUrlFunc, if set, is called by {{ $o.ExportedName }}PresignedUrl.
+	{{ $o.ExportedName }}PresignedUrlFunc func({{ $o.InputRef.GoTypeWithPkgName }}, time.Duration) (*url.URL, error)
+{{ if $o.Paginator }}
+	// {{ $o.ExportedName }}PagesFunc, if set, is called by {{ $o.ExportedName }}Pages.
+	{{ $o.ExportedName }}PagesFunc func({{ $o.InputRef.GoTypeWithPkgName }}, func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool) error
+{{ end }}
+{{- end }}
+{{- range $_, $w := .Waiters }}
+	// WaitUntil{{ $w.Name }}Func, if set, is called by WaitUntil{{ $w.Name }}.
+	WaitUntil{{ $w.Name }}Func func({{ $w.Operation.InputRef.GoTypeWithPkgName }}) error
+{{ end }}
+	mu    sync.Mutex
+	calls []Call
+}
+
+// A Call is a call made to a mock.
+type Call struct {
+	// The name of the method called, such as "{{ (index .OperationList 0).ExportedName }}".
+	Method string
+
+	// The input the method was called with.
+	Input interface{}
+}
+
+var _ {{ .InterfacePackageName }}.{{ .StructName }}API = (*{{ .StructName }})(nil)
+
+// Calls returns the calls made to the mock, in the order they were made.
+func (m *{{ .StructName }}) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Call{}, m.calls...)
+}
+
+// CallsTo returns the inputs of the calls made to the method of the mock, in
+// the order they were made.
+func (m *{{ .StructName }}) CallsTo(method string) []interface{} {
+	inputs := []interface{}{}
+	for _, c := range m.Calls() {
+		if c.Method == method {
+			inputs = append(inputs, c.Input)
+		}
+	}
+	return inputs
+}
+
+func (m *{{ .StructName }}) record(method string, input interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, Call{Method: method, Input: input})
+}
+
+// newRequest returns a request for the operation which is sent by calling
+// send instead of sending it to an endpoint.
+func newRequest(name string, input, output interface{}, send func(*aws.Request)) *aws.Request {
+	svc := &aws.Service{Config: &aws.Config{}, ServiceName: "{{ .PackageName }}"}
+	req := aws.NewRequest(svc, &aws.Operation{Name: name}, input, output)
+	req.Handlers.Send.PushBack(send)
+	return req
+}
+{{ range $_, $o := .OperationList }}
+// {{ $o.ExportedName }} records the call and calls {{ $o.ExportedName }}Func, or returns an
+// empty output if it is not set.
+func (m *{{ $.StructName }}) {{ $o.ExportedName }}(input {{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error) {
+	m.record("{{ $o.ExportedName }}", input)
+	if m.{{ $o.ExportedName }}Func != nil {
+		return m.{{ $o.ExportedName }}Func(input)
+	}
+	return &{{ $.PackageName }}.{{ $o.OutputRef.GoTypeElem }}{}, nil
+}
+
+// Return{{ $o.ExportedName }} stubs {{ $o.ExportedName }} to return out and err.
+func (m *{{ $.StructName }}) Return{{ $o.ExportedName }}(out {{ $o.OutputRef.GoTypeWithPkgName }}, err error) {
+	m.{{ $o.ExportedName }}Func = func({{ $o.InputRef.GoTypeWithPkgName }}) ({{ $o.OutputRef.GoTypeWithPkgName }}, error) {
+		return out, err
+	}
+}
+
+// {{ $o.ExportedName }}Request returns a request which calls {{ $o.ExportedName }} when it
+// is sent.
+func (m *{{ $.StructName }}) {{ $o.ExportedName }}Request(input {{ $o.InputRef.GoTypeWithPkgName }}) (*aws.Request, {{ $o.OutputRef.GoTypeWithPkgName }}) {
+	output := &{{ $.PackageName }}.{{ $o.OutputRef.GoTypeElem }}{}
+	req := newRequest("{{ $o.Name }}", input, output, func(r *aws.Request) {
+		out, err := m.{{ $o.ExportedName }}(input)
+		if err != nil {
+			r.Error = err
+		} else if out != nil {
+			*output = *out
+		}
+	})
+	return req, output
+}
+
+// {{ $o.ExportedName }}PresignedUrl records the call and calls {{ $o.ExportedName }}PresignedUrlFunc,
+// or returns an empty URL if it is not set.
+func (m *{{ $.StructName }}) {{ $o.ExportedName }}PresignedUrl(input {{ $o.InputRef.GoTypeWithPkgName }}, expires time.Duration) (*url.URL, error) {
+	m.record("{{ $o.ExportedName }}PresignedUrl", input)
+	if m.{{ $o.ExportedName }}PresignedUrlFunc != nil {
+		return m.{{ $o.ExportedName }}PresignedUrlFunc(input, expires)
+	}
+	return &url.URL{}, nil
+}
+{{ if $o.Paginator }}
+// {{ $o.ExportedName }}Pages records the call and calls {{ $o.ExportedName }}PagesFunc. If it
+// is not set, fn is called with the output of {{ $o.ExportedName }}Func as the only page.
+func (m *{{ $.StructName }}) {{ $o.ExportedName }}Pages(input {{ $o.InputRef.GoTypeWithPkgName }}, fn func(p {{ $o.OutputRef.GoTypeWithPkgName }}, lastPage bool) (shouldContinue bool)) error {
+	m.record("{{ $o.ExportedName }}Pages", input)
+	if m.{{ $o.ExportedName }}PagesFunc != nil {
+		return m.{{ $o.ExportedName }}PagesFunc(input, fn)
+	}
+	out := &{{ $.PackageName }}.{{ $o.OutputRef.GoTypeElem }}{}
+	if m.{{ $o.ExportedName }}Func != nil {
+		var err error
+		if out, err = m.{{ $o.ExportedName }}Func(input); err != nil {
+			return err
+		}
+	}
+	fn(out, true)
+	return nil
+}
+
+// Return{{ $o.ExportedName }}Pages stubs {{ $o.ExportedName }}Pages to call fn with each of
+// the pages, and return err once fn stops or the pages are exhausted.
+func (m *{{ $.StructName }}) Return{{ $o.ExportedName }}Pages(pages []{{ $o.OutputRef.GoTypeWithPkgName }}, err error) {
+	m.{{ $o.ExportedName }}PagesFunc = func(_ {{ $o.InputRef.GoTypeWithPkgName }}, fn func({{ $o.OutputRef.GoTypeWithPkgName }}, bool) bool) error {
+		for i, p := range pages {
+			if !fn(p, i == len(pages)-1) {
+				break
+			}
+		}
+		return err
+	}
+}
+{{ end }}
+{{ end }}
+{{ range $_, $w := .Waiters }}
+// WaitUntil{{ $w.Name }} records the call and calls WaitUntil{{ $w.Name }}Func,
+// or returns nil if it is not set.
+func (m *{{ $.StructName }}) WaitUntil{{ $w.Name }}(input {{ $w.Operation.InputRef.GoTypeWithPkgName }}) error {
+	m.record("WaitUntil{{ $w.Name }}", input)
+	if m.WaitUntil{{ $w.Name }}Func != nil {
+		return m.WaitUntil{{ $w.Name }}Func(input)
+	}
+	return nil
+}
+{{ end }}
+`))
+
+// MockGoCode returns the go code of the service's mock client, which
+// implements the service's interface with stubs. Assumes that the mock is
+// being created in a different package than the service API's package.
+func (a *API) MockGoCode() string {
+	a.resetImports()
+	a.imports = map[string]bool{
+		"net/url":                             true,
+		"sync":                                true,
+		"time":                                true,
+		"github.com/dongfangx/aws-sdk-go/aws": true,
+		"github.com/dongfangx/aws-sdk-go/service/" + a.PackageName():                                  true,
+		"github.com/dongfangx/aws-sdk-go/service/" + a.PackageName() + "/" + a.InterfacePackageName(): true,
+	}
+
+	var buf bytes.Buffer
+	err := tplMock.Execute(&buf, a)
+
+	if err != nil {
+		panic(err)
+	}
+
+	code := a.importsGoCode() + strings.TrimSpace(buf.String())
+	return util.GoFmt(code)
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMockGoCode(t *testing.T) {
+	a := API{NoInflections: true, NoInitMethods: true}
+	a.Metadata.ServiceAbbreviation = "Svc"
+	a.AttachString(`{
+		"operations": {
+			"ListThings": {
+				"name": "ListThings",
+				"input": { "shape": "ListThingsInput" },
+				"output": { "shape": "ListThingsOutput" }
+			}
+		},
+		"shapes": {
+			"ListThingsInput": {
+				"type": "structure",
+				"members": { "Marker": { "shape": "String" } }
+			},
+			"ListThingsOutput": {
+				"type": "structure",
+				"members": { "NextMarker": { "shape": "String" } }
+			},
+			"String": { "type": "string" }
+		}
+	}`)
+	a.Operations["ListThings"].Paginator = &Paginator{
+		InputTokens:  []string{"Marker"},
+		OutputTokens: []string{"NextMarker"},
+	}
+
+	assert.Equal(t, "svcmock", a.MockPackageName())
+
+	code := a.MockGoCode()
+	assert.Contains(t, code, `"github.com/dongfangx/aws-sdk-go/service/svc/svciface"`)
+	assert.Contains(t, code, "var _ svciface.SvcAPI = (*Svc)(nil)")
+	assert.Contains(t, code, "ListThingsFunc func(*svc.ListThingsInput) (*svc.ListThingsOutput, error)")
+	assert.Contains(t, code, "func (m *Svc) ListThings(input *svc.ListThingsInput) (*svc.ListThingsOutput, error) {")
+	assert.Contains(t, code, "func (m *Svc) ReturnListThings(out *svc.ListThingsOutput, err error) {")
+	assert.Contains(t, code, "func (m *Svc) ListThingsRequest(input *svc.ListThingsInput) (*aws.Request, *svc.ListThingsOutput) {")
+	assert.Contains(t, code, `req := newRequest("ListThings", input, output, func(r *aws.Request) {`)
+	assert.Contains(t, code, "func (m *Svc) ListThingsPages(input *svc.ListThingsInput, fn func(p *svc.ListThingsOutput, lastPage bool) (shouldContinue bool)) error {")
+	assert.Contains(t, code, "func (m *Svc) ReturnListThingsPages(pages []*svc.ListThingsOutput, err error) {")
+}
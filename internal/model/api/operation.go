@@ -66,6 +66,8 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 					OutputTokens: {{ .Paginator.OutputTokensString }},
 					LimitToken: "{{ .Paginator.LimitKey }}",
 					TruncationToken: "{{ .Paginator.MoreResults }}",
+					{{ if .Paginator.ResultKeys }}ResultKeys: {{ .Paginator.ResultKeysString }},
+					{{ end }}
 			},
 			{{ end }}
 		}
@@ -87,14 +89,46 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	err := req.Send()
 	return out, err
 }
+func (c *{{ .API.StructName }}) {{ .ExportedName }}PresignedUrl(` +
+	`input {{ .InputRef.GoType }}, expires time.Duration) (*url.URL, error) {
+	req, _ := c.{{ .ExportedName }}Request(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
 
 {{ if .Paginator }}
 func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
 	`input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool)) error {
-	page, _ := c.{{ .ExportedName }}Request(input)
-	return page.EachPage(func(p interface{}, lastPage bool) bool {
-		return fn(p.({{ .OutputRef.GoType }}), lastPage)
-	})
+	p := c.{{ .ExportedName }}Paginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// {{ .ExportedName }}Paginator returns a paginator over the pages of {{ .ExportedName }}
+// results, starting with the page of input.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}Paginator(input {{ .InputRef.GoType }}) *{{ .ExportedName }}Paginator {
+	return &{{ .ExportedName }}Paginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.{{ .ExportedName }}Request(input)
+			return req
+		},
+	}}
+}
+
+// {{ .ExportedName }}Paginator iterates over the pages of {{ .ExportedName }} results.
+type {{ .ExportedName }}Paginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *{{ .ExportedName }}Paginator) Page() {{ .OutputRef.GoType }} {
+	page, _ := p.Pagination.Page().({{ .OutputRef.GoType }})
+	return page
 }
 {{ end }}
 
@@ -114,11 +148,19 @@ func (o *Operation) GoCode() string {
 
 // tplInfSig defines the template for rendering an Operation's signature within an Interface definition.
 var tplInfSig = template.Must(template.New("opsig").Parse(`
+{{ .ExportedName }}Request({{ .InputRef.GoTypeWithPkgName }}) (*aws.Request, {{ .OutputRef.GoTypeWithPkgName }})
+
 {{ .ExportedName }}({{ .InputRef.GoTypeWithPkgName }}) ({{ .OutputRef.GoTypeWithPkgName }}, error)
+
+{{ .ExportedName }}PresignedUrl({{ .InputRef.GoTypeWithPkgName }}, time.Duration) (*url.URL, error)
+{{ if .Paginator }}
+{{ .ExportedName }}Pages({{ .InputRef.GoTypeWithPkgName }}, func({{ .OutputRef.GoTypeWithPkgName }}, bool) bool) error
+{{ end }}
 `))
 
 // InterfaceSignature returns a string representing the Operation's interface{}
-// functional signature.
+// functional signatures, the operation's method and its Request, PresignedUrl
+// and, if the operation is paginated, Pages variants.
 func (o *Operation) InterfaceSignature() string {
 	var buf bytes.Buffer
 	err := tplInfSig.Execute(&buf, o)
@@ -126,7 +168,7 @@ func (o *Operation) InterfaceSignature() string {
 		panic(err)
 	}
 
-	return strings.TrimSpace(util.GoFmt(buf.String()))
+	return strings.TrimSpace(buf.String())
 }
 
 // tplExample defines the template for rendering an Operation example
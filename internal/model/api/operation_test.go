@@ -0,0 +1,109 @@
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const operationTestModel = `{
+	"operations": {
+		"GetThing": {
+			"input": { "shape": "GetThingInput" },
+			"output": { "shape": "GetThingOutput" }
+		}
+	},
+	"shapes": {
+		"GetThingInput": {
+			"type": "structure",
+			"members": { "Marker": { "shape": "String" } }
+		},
+		"GetThingOutput": {
+			"type": "structure",
+			"members": { "NextMarker": { "shape": "String" } }
+		},
+		"String": { "type": "string" }
+	}
+}`
+
+func TestInterfaceSignature(t *testing.T) {
+	a := API{NoInflections: true, NoInitMethods: true}
+	a.Metadata.ServiceAbbreviation = "Svc"
+	a.AttachString(operationTestModel)
+	o := a.Operations["GetThing"]
+
+	assert.Equal(t, `GetThingRequest(*svc.GetThingInput) (*aws.Request, *svc.GetThingOutput)
+
+GetThing(*svc.GetThingInput) (*svc.GetThingOutput, error)
+
+GetThingPresignedUrl(*svc.GetThingInput, time.Duration) (*url.URL, error)`, o.InterfaceSignature())
+
+	o.Paginator = &Paginator{InputTokens: []string{"Marker"}, OutputTokens: []string{"NextMarker"}}
+	assert.Contains(t, o.InterfaceSignature(), `GetThingPresignedUrl(*svc.GetThingInput, time.Duration) (*url.URL, error)
+
+GetThingPages(*svc.GetThingInput, func(*svc.GetThingOutput, bool) bool) error`)
+
+	code := a.InterfaceGoCode()
+	assert.Contains(t, code, `"github.com/dongfangx/aws-sdk-go/aws"`)
+	assert.Contains(t, code, "\tGetThingRequest(*svc.GetThingInput) (*aws.Request, *svc.GetThingOutput)\n")
+	assert.Contains(t, code, "\tGetThingPages(*svc.GetThingInput, func(*svc.GetThingOutput, bool) bool) error\n")
+
+	assert.Contains(t, a.InterfaceTestGoCode(), "assert.Implements(t, (*svciface.SvcAPI)(nil), svc.New(nil))")
+}
+
+func TestOperationPresignedUrl(t *testing.T) {
+	a := API{NoInflections: true, NoInitMethods: true}
+	a.Metadata.ServiceAbbreviation = "Svc"
+	a.AttachString(operationTestModel)
+
+	assert.Contains(t, a.Operations["GetThing"].GoCode(), `func (c *Svc) GetThingPresignedUrl(input *GetThingInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetThingRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}`)
+	assert.Contains(t, a.APIGoCode(), `"net/url"`)
+}
+
+func TestOperationPaginator(t *testing.T) {
+	a := API{NoInflections: true, NoInitMethods: true}
+	a.Metadata.ServiceAbbreviation = "Svc"
+	a.AttachString(operationTestModel)
+
+	f, err := ioutil.TempFile("", "paginators")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString(`{
+		"pagination": {
+			"GetThing": {
+				"input_token": "Marker",
+				"output_token": "NextMarker",
+				"limit_key": "MaxItems",
+				"result_key": "Things"
+			}
+		}
+	}`)
+	f.Close()
+
+	a.AttachPaginators(f.Name())
+	assert.Equal(t, []string{"Things"}, a.Operations["GetThing"].Paginator.ResultKeys)
+
+	code := a.Operations["GetThing"].GoCode()
+	assert.Contains(t, code, `ResultKeys:      []string{"Things"},`)
+	assert.Contains(t, code, `func (c *Svc) GetThingPages(input *GetThingInput, fn func(p *GetThingOutput, lastPage bool) (shouldContinue bool)) error {
+	p := c.GetThingPaginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}`)
+	assert.Contains(t, code, "func (c *Svc) GetThingPaginator(input *GetThingInput) *GetThingPaginator {")
+	assert.Contains(t, code, `type GetThingPaginator struct {
+	aws.Pagination
+}`)
+	assert.Contains(t, code, "func (p *GetThingPaginator) Page() *GetThingOutput {")
+}
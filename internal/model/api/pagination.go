@@ -12,6 +12,7 @@ type Paginator struct {
 	OutputTokens interface{} `json:"output_token"`
 	LimitKey     string      `json:"limit_key"`
 	MoreResults  string      `json:"more_results"`
+	ResultKeys   interface{} `json:"result_key"`
 }
 
 // InputTokensString returns output tokens formatted as a list
@@ -26,6 +27,28 @@ func (p *Paginator) OutputTokensString() string {
 	return fmt.Sprintf("%#v", str)
 }
 
+// ResultKeysString returns result keys formatted as a list
+func (p *Paginator) ResultKeysString() string {
+	str := p.ResultKeys.([]string)
+	return fmt.Sprintf("%#v", str)
+}
+
+// stringList returns the token or list of tokens of a paginator definition
+// as a list.
+func stringList(v interface{}) interface{} {
+	switch t := v.(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		toks := []string{}
+		for _, e := range t {
+			toks = append(toks, e.(string))
+		}
+		return toks
+	}
+	return v
+}
+
 // used for unmarshaling from the paginators JSON file
 type paginationDefinitions struct {
 	*API
@@ -57,27 +80,10 @@ func (p *paginationDefinitions) setup() {
 		}
 		paginator := e
 
-		switch t := paginator.InputTokens.(type) {
-		case string:
-			paginator.InputTokens = []string{t}
-		case []interface{}:
-			toks := []string{}
-			for _, e := range t {
-				s := e.(string)
-				toks = append(toks, s)
-			}
-			paginator.InputTokens = toks
-		}
-		switch t := paginator.OutputTokens.(type) {
-		case string:
-			paginator.OutputTokens = []string{t}
-		case []interface{}:
-			toks := []string{}
-			for _, e := range t {
-				s := e.(string)
-				toks = append(toks, s)
-			}
-			paginator.OutputTokens = toks
+		paginator.InputTokens = stringList(paginator.InputTokens)
+		paginator.OutputTokens = stringList(paginator.OutputTokens)
+		if paginator.ResultKeys != nil {
+			paginator.ResultKeys = stringList(paginator.ResultKeys)
 		}
 
 		n = p.ExportableName(n)
@@ -195,6 +195,19 @@ func (a *API) renameExportable() {
 	}
 }
 
+// inflectEnumNames inflects the constant names of the enum shapes, so that
+// unrecognized names in them are reported along with the other exported
+// names.
+func (a *API) inflectEnumNames() {
+	for _, s := range a.Shapes {
+		if s.IsEnum() {
+			for i := range s.Enum {
+				s.EnumName(i)
+			}
+		}
+	}
+}
+
 // createInputOutputShapes creates toplevel input/output shapes if they
 // have not been defined in the API. This normalizes all APIs to always
 // have an input and output structure in the signature.
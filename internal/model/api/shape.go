@@ -1,9 +1,13 @@
 package api
 
 import (
+	"bytes"
 	"fmt"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/dongfangx/aws-sdk-go/internal/util"
 )
@@ -44,12 +48,22 @@ type Shape struct {
 	Type          string
 	Exception     bool
 	Enum          []string
+	Min           float64 // length of strings, blobs, lists and maps, value of numbers
+	Max           float64
+	Pattern       string
 	Flattened     bool
 	Streaming     bool
 	Location      string
 	LocationName  string
 	XMLNamespace  XMLInfo
 
+	// TimestampFormat overrides the protocol's timestamp format.
+	TimestampFormat string
+
+	// EventStream marks the payload of an event stream. Its Go type is
+	// written by hand in the service package, and is not generated.
+	EventStream bool
+
 	refs []*ShapeRef // References to this shape
 }
 
@@ -182,12 +196,18 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	if ref.Shape.ValueRef.LocationName != "" {
 		code += `locationNameValue:"` + ref.Shape.ValueRef.LocationName + `" `
 	}
-	code += `type:"` + ref.Shape.Type + `" `
+	if ref.Shape.EventStream {
+		code += `type:"eventstream" `
+	} else {
+		code += `type:"` + ref.Shape.Type + `" `
+	}
 
 	// embed the timestamp type for easier lookups
 	if ref.Shape.Type == "timestamp" {
 		code += `timestampFormat:"`
-		if ref.Location == "header" {
+		if ref.Shape.TimestampFormat != "" {
+			code += ref.Shape.TimestampFormat
+		} else if ref.Location == "header" {
 			code += "rfc822"
 		} else {
 			switch ref.API.Metadata.Protocol {
@@ -200,6 +220,8 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 		code += `" `
 	}
 
+	code += ref.constraintTags()
+
 	if ref.Shape.Flattened || ref.Flattened {
 		code += `flattened:"true" `
 	}
@@ -209,7 +231,11 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	}
 
 	if isRequired {
-		code += `required:"true"`
+		code += `required:"true" `
+	}
+
+	if !toplevel && ref.XMLNamespace.URI != "" {
+		code += `xmlURI:"` + ref.XMLNamespace.URI + `" `
 	}
 
 	if toplevel {
@@ -232,12 +258,55 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 	return strings.TrimSpace(code) + "`"
 }
 
-// Docstring returns the godocs formated documentation
+// constraintTags returns the min, max, pattern and enum tags of the shape's
+// constraints, which aws.ValidateParameters validates input parameters with.
+// Patterns Go's regexp package cannot compile are left out.
+func (ref *ShapeRef) constraintTags() string {
+	code := ""
+	if ref.Shape.Min != 0 {
+		code += `min:"` + strconv.FormatFloat(ref.Shape.Min, 'f', -1, 64) + `" `
+	}
+	if ref.Shape.Max != 0 {
+		code += `max:"` + strconv.FormatFloat(ref.Shape.Max, 'f', -1, 64) + `" `
+	}
+	if p := ref.Shape.Pattern; p != "" && !strings.Contains(p, "`") {
+		if _, err := regexp.Compile(p); err == nil {
+			code += `pattern:` + strconv.Quote(p) + ` `
+		}
+	}
+
+	enum := ref.Shape
+	if enum.Type == "list" && enum.MemberRef.Shape != nil {
+		enum = enum.MemberRef.Shape
+	}
+	if enum.IsEnum() {
+		code += `enum:` + strconv.Quote(strings.Join(enum.Enum, ",")) + ` `
+	}
+	return code
+}
+
+// Docstring returns the godocs formated documentation. Members of an enum
+// shape, or lists of one, are linked to the enum's constants.
 func (ref *ShapeRef) Docstring() string {
+	doc := ref.Shape.Docstring()
 	if ref.Documentation != "" {
-		return docstring(ref.Documentation)
+		doc = docstring(ref.Documentation)
+	}
+
+	note := ""
+	if ref.Shape.IsEnum() {
+		note = "// The valid values are the " + ref.Shape.ShapeName + " enum constants.\n"
+	} else if ref.Shape.Type == "list" && ref.Shape.MemberRef.Shape != nil && ref.Shape.MemberRef.Shape.IsEnum() {
+		note = "// The valid values of the elements are the " + ref.Shape.MemberRef.Shape.ShapeName + " enum constants.\n"
+	}
+	switch {
+	case note == "":
+		return doc
+	case doc == "\n":
+		return note
+	default:
+		return doc + "//\n" + note
 	}
-	return ref.Shape.Docstring()
 }
 
 // Docstring returns the godocs formated documentation
@@ -254,19 +323,7 @@ func (s *Shape) GoCode() string {
 		for _, n := range s.MemberNames() {
 			m := s.MemberRefs[n]
 			code += m.Docstring()
-			if (m.Streaming || m.Shape.Streaming) && s.Payload == n {
-				rtype := "io.ReadSeeker"
-				if len(s.refs) > 1 {
-					rtype = "aws.ReaderSeekCloser"
-				} else if strings.HasSuffix(s.ShapeName, "Output") {
-					rtype = "io.ReadCloser"
-				}
-
-				s.API.imports["io"] = true
-				code += n + " " + rtype + " " + m.GoTags(false, s.IsRequired(n)) + "\n\n"
-			} else {
-				code += n + " " + m.GoType() + " " + m.GoTags(false, s.IsRequired(n)) + "\n\n"
-			}
+			code += n + " " + s.memberGoType(n) + " " + m.GoTags(false, s.IsRequired(n)) + "\n\n"
 		}
 		metaStruct := "metadata" + s.ShapeName
 		ref := &ShapeRef{ShapeName: s.ShapeName, API: s.API, Shape: s}
@@ -274,7 +331,8 @@ func (s *Shape) GoCode() string {
 		code += "}\n\n"
 		code += "type " + metaStruct + " struct {\n"
 		code += "SDKShapeTraits bool " + ref.GoTags(true, false)
-		code += "}"
+		code += "}\n\n"
+		code += s.accessorsGoCode()
 	default:
 		panic("Cannot generate toplevel shape for " + s.Type)
 	}
@@ -282,6 +340,140 @@ func (s *Shape) GoCode() string {
 	return util.GoFmt(code)
 }
 
+// memberGoType returns the Go type of the member n of a structure shape.
+// The streaming payload of a shape is a reader.
+func (s *Shape) memberGoType(n string) string {
+	m := s.MemberRefs[n]
+	if (m.Streaming || m.Shape.Streaming) && s.Payload == n {
+		s.API.imports["io"] = true
+		if len(s.refs) > 1 {
+			return "aws.ReaderSeekCloser"
+		} else if strings.HasSuffix(s.ShapeName, "Output") {
+			return "io.ReadCloser"
+		}
+		return "io.ReadSeeker"
+	}
+	return m.GoType()
+}
+
+// zeroValues are the zero values of the types scalar members point to.
+var zeroValues = map[string]string{
+	"string":    `""`,
+	"bool":      "false",
+	"int64":     "0",
+	"float64":   "0",
+	"time.Time": "time.Time{}",
+}
+
+// accessorsGoCode returns the String and GoString methods of a structure
+// shape, and the nil-safe getter and chainable setter of each member.
+// Getters of scalar members return the value the member points to.
+func (s *Shape) accessorsGoCode() string {
+	s.API.imports["github.com/dongfangx/aws-sdk-go/aws/awsutil"] = true
+
+	code := fmt.Sprintf(`// String returns the string representation.
+func (s %[1]s) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s %[1]s) GoString() string {
+	return s.String()
+}
+`, s.ShapeName)
+
+	for _, n := range s.MemberNames() {
+		t := s.memberGoType(n)
+		if zero, ok := zeroValues[strings.TrimPrefix(t, "*")]; ok && strings.HasPrefix(t, "*") {
+			code += fmt.Sprintf(`
+// Get%[2]s returns the value of %[2]s, or the zero value if it is not set.
+func (s *%[1]s) Get%[2]s() %[3]s {
+	if s == nil || s.%[2]s == nil {
+		return %[4]s
+	}
+	return *s.%[2]s
+}
+
+// Set%[2]s sets the value of %[2]s.
+func (s *%[1]s) Set%[2]s(v %[3]s) *%[1]s {
+	s.%[2]s = &v
+	return s
+}
+`, s.ShapeName, n, t[1:], zero)
+		} else {
+			code += fmt.Sprintf(`
+// Get%[2]s returns the value of %[2]s, or nil if it is not set.
+func (s *%[1]s) Get%[2]s() %[3]s {
+	if s == nil {
+		return nil
+	}
+	return s.%[2]s
+}
+
+// Set%[2]s sets the value of %[2]s.
+func (s *%[1]s) Set%[2]s(v %[3]s) *%[1]s {
+	s.%[2]s = v
+	return s
+}
+`, s.ShapeName, n, t)
+		}
+	}
+	return code
+}
+
+// IsEnum returns if the shape is a string shape with enum values.
+func (s *Shape) IsEnum() bool {
+	return s.Type == "string" && len(s.Enum) > 0
+}
+
+var enumDelims = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// EnumName returns the exported name of the constant of the n-th enum value
+// of the shape, such as BucketCannedACLPublicRead for "public-read". The
+// name is inflected like the other exported names of the API.
+func (s *Shape) EnumName(n int) string {
+	name := s.ShapeName
+	for _, part := range enumDelims.Split(s.Enum[n], -1) {
+		if part == "" {
+			continue
+		}
+		if strings.ToUpper(part) == part {
+			part = strings.ToLower(part)
+		}
+		name += strings.ToUpper(part[:1]) + part[1:]
+	}
+	return s.API.ExportableName(name)
+}
+
+var enumTmpl = template.Must(template.New("enum").Parse(`
+// {{ .ShapeName }} is the type of the {{ .ShapeName }} enum values.
+type {{ .ShapeName }} string
+
+// Enum values for {{ .ShapeName }}.
+const (
+	{{ range $i, $v := .Enum }}{{ $.EnumName $i }} {{ $.ShapeName }} = {{ printf "%q" $v }}
+	{{ end }}
+)
+
+// {{ .ShapeName }}Values returns the values of the {{ .ShapeName }} enum.
+func {{ .ShapeName }}Values() []{{ .ShapeName }} {
+	return []{{ .ShapeName }}{
+		{{ range $i, $_ := .Enum }}{{ $.EnumName $i }},
+		{{ end }}
+	}
+}
+`))
+
+// EnumGoCode returns the rendered Go code of the type and constants of an
+// enum shape, and of the function listing them.
+func (s *Shape) EnumGoCode() string {
+	var buf bytes.Buffer
+	if err := enumTmpl.Execute(&buf, s); err != nil {
+		panic(err)
+	}
+	return util.GoFmt(strings.TrimSpace(buf.String()))
+}
+
 // IsRequired returns if member is a required field.
 func (s *Shape) IsRequired(member string) bool {
 	for _, n := range s.Required {
@@ -0,0 +1,169 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumName(t *testing.T) {
+	a := &API{unrecognizedNames: map[string]string{}}
+	s := &Shape{API: a, ShapeName: "Value", Type: "string", Enum: []string{
+		"public-read", "STANDARD_IA", "aws:kms", "s3:ObjectCreated:*", "V_1", "CanonicalUser", "url",
+	}}
+
+	names := []string{}
+	for i := range s.Enum {
+		names = append(names, s.EnumName(i))
+	}
+	assert.Equal(t, []string{
+		"ValuePublicRead", "ValueStandardIA", "ValueAWSKMS", "ValueS3ObjectCreated", "ValueV1", "ValueCanonicalUser", "ValueURL",
+	}, names)
+	assert.Empty(t, a.unrecognizedNames)
+}
+
+func TestEnumGoCode(t *testing.T) {
+	s := &Shape{API: &API{unrecognizedNames: map[string]string{}}, ShapeName: "Payer", Type: "string", Enum: []string{"Requester", "BucketOwner"}}
+
+	assert.Equal(t, `// Payer is the type of the Payer enum values.
+type Payer string
+
+// Enum values for Payer.
+const (
+	PayerRequester   Payer = "Requester"
+	PayerBucketOwner Payer = "BucketOwner"
+)
+
+// PayerValues returns the values of the Payer enum.
+func PayerValues() []Payer {
+	return []Payer{
+		PayerRequester,
+		PayerBucketOwner,
+	}
+}`, s.EnumGoCode())
+}
+
+func TestEnumMemberDocstring(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Mock Service", "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "Input" }
+			}
+		},
+		"shapes": {
+			"Input": {
+				"type": "structure",
+				"members": {
+					"Payer": { "shape": "Payer", "documentation": "<p>Who pays.</p>" },
+					"Owner": { "shape": "Payer" },
+					"Payers": { "shape": "PayerList" },
+					"Name": { "shape": "String" }
+				}
+			},
+			"Payer": { "type": "string", "enum": ["Requester", "BucketOwner"] },
+			"PayerList": { "type": "list", "member": { "shape": "Payer" } },
+			"String": { "type": "string" }
+		}
+	}`
+	a := API{NoInflections: true}
+	a.AttachString(json)
+	refs := a.Shapes["Input"].MemberRefs
+
+	assert.Equal(t, "// Who pays.\n//\n// The valid values are the Payer enum constants.\n", refs["Payer"].Docstring())
+	assert.Equal(t, "// The valid values are the Payer enum constants.\n", refs["Owner"].Docstring())
+	assert.Equal(t, "// The valid values of the elements are the Payer enum constants.\n", refs["Payers"].Docstring())
+	assert.Equal(t, "\n", refs["Name"].Docstring())
+	assert.Contains(t, a.APIGoCode(), "func PayerValues() []Payer {")
+}
+
+func TestConstraintTags(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Mock Service", "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "Input" }
+			}
+		},
+		"shapes": {
+			"Input": {
+				"type": "structure",
+				"required": ["Key"],
+				"members": {
+					"Key": { "shape": "Key" },
+					"PartNumber": { "shape": "PartNumber" },
+					"Payer": { "shape": "Payer" },
+					"Payers": { "shape": "PayerList" },
+					"Lookahead": { "shape": "Lookahead" }
+				}
+			},
+			"Key": { "type": "string", "min": 1, "max": 1024, "pattern": "^[\\w/]+$" },
+			"PartNumber": { "type": "integer", "min": 1, "max": 10000 },
+			"Payer": { "type": "string", "enum": ["Requester", "BucketOwner"] },
+			"PayerList": { "type": "list", "member": { "shape": "Payer" }, "max": 2 },
+			"Lookahead": { "type": "string", "pattern": "^(?!aws).*$" }
+		}
+	}`
+	a := API{NoInflections: true}
+	a.AttachString(json)
+	s := a.Shapes["Input"]
+
+	assert.Equal(t, "`type:\"string\" min:\"1\" max:\"1024\" pattern:\"^[\\\\w/]+$\" required:\"true\"`",
+		s.MemberRefs["Key"].GoTags(false, s.IsRequired("Key")))
+	assert.Equal(t, "`type:\"integer\" min:\"1\" max:\"10000\"`", s.MemberRefs["PartNumber"].GoTags(false, false))
+	assert.Equal(t, "`type:\"string\" enum:\"Requester,BucketOwner\"`", s.MemberRefs["Payer"].GoTags(false, false))
+	assert.Equal(t, "`type:\"list\" max:\"2\" enum:\"Requester,BucketOwner\"`", s.MemberRefs["Payers"].GoTags(false, false))
+
+	// patterns Go cannot compile are left out
+	assert.Equal(t, "`type:\"string\"`", s.MemberRefs["Lookahead"].GoTags(false, false))
+}
+
+func TestAccessorsGoCode(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Mock Service", "protocol": "rest-xml" },
+		"operations": {
+			"OperationName": {
+				"input": { "shape": "Input" }
+			}
+		},
+		"shapes": {
+			"Input": {
+				"type": "structure",
+				"members": {
+					"Name": { "shape": "String" },
+					"Names": { "shape": "StringList" }
+				}
+			},
+			"StringList": { "type": "list", "member": { "shape": "String" } },
+			"String": { "type": "string" }
+		}
+	}`
+	a := API{NoInflections: true}
+	a.AttachString(json)
+	code := a.APIGoCode()
+
+	assert.Contains(t, code, `// String returns the string representation.
+func (s Input) String() string {
+	return awsutil.StringValue(s)
+}`)
+	assert.Contains(t, code, `// GetName returns the value of Name, or the zero value if it is not set.
+func (s *Input) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}`)
+	assert.Contains(t, code, `// SetName sets the value of Name.
+func (s *Input) SetName(v string) *Input {
+	s.Name = &v
+	return s
+}`)
+	assert.Contains(t, code, `// GetNames returns the value of Names, or nil if it is not set.
+func (s *Input) GetNames() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Names
+}`)
+	assert.Contains(t, code, `func (s *Input) SetNames(v []*string) *Input {`)
+}
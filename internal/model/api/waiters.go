@@ -0,0 +1,149 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/dongfangx/aws-sdk-go/internal/util"
+)
+
+// A WaiterAcceptor is a single acceptor of a waiter definition.
+type WaiterAcceptor struct {
+	State    string
+	Matcher  string
+	Argument string
+	Expected interface{}
+}
+
+// A Waiter keeps track of the waiter definition of an API operation.
+type Waiter struct {
+	Name          string
+	Delay         int
+	MaxAttempts   int
+	OperationName string `json:"operation"`
+	Operation     *Operation
+	Acceptors     []WaiterAcceptor
+}
+
+// used for unmarshaling from the waiters JSON file
+type waiterDefinitions struct {
+	*API
+	Waiters map[string]Waiter
+}
+
+// AttachWaiters attaches the waiter definitions from filename to the API.
+func (a *API) AttachWaiters(filename string) {
+	p := waiterDefinitions{API: a}
+
+	f, err := os.Open(filename)
+	defer f.Close()
+	if err != nil {
+		panic(err)
+	}
+	err = json.NewDecoder(f).Decode(&p)
+	if err != nil {
+		panic(err)
+	}
+
+	p.setup()
+}
+
+// setup links each waiter to its operation and sorts the waiters by name.
+func (p *waiterDefinitions) setup() {
+	p.API.Waiters = []Waiter{}
+	i, keys := 0, make([]string, len(p.Waiters))
+	for k := range p.Waiters {
+		keys[i] = k
+		i++
+	}
+	sort.Strings(keys)
+
+	for _, n := range keys {
+		e := p.Waiters[n]
+		n = p.ExportableName(n)
+		e.Name = n
+		e.OperationName = p.ExportableName(e.OperationName)
+		e.Operation = p.API.Operations[e.OperationName]
+		if e.Operation == nil {
+			panic("unknown operation " + e.OperationName + " for waiter " + n)
+		}
+		p.API.Waiters = append(p.API.Waiters, e)
+	}
+}
+
+// ExpectedString returns the Go representation of the expected value.
+func (a *WaiterAcceptor) ExpectedString() string {
+	switch a.Expected.(type) {
+	case string:
+		return fmt.Sprintf("%q", a.Expected)
+	default:
+		return fmt.Sprintf("%v", a.Expected)
+	}
+}
+
+// tplWaiter defines a template for rendering a WaitUntil method.
+var tplWaiter = template.Must(template.New("waiter").Parse(`
+// WaitUntil{{ .Name }} polls {{ .OperationName }} every {{ .Delay }} seconds until the
+// {{ .Name }} condition is met. It returns a ResourceNotReady error if a
+// failure state is reached or the condition is not met within {{ .MaxAttempts }} attempts.
+func (c *{{ .Operation.API.StructName }}) WaitUntil{{ .Name }}(input {{ .Operation.InputRef.GoType }}) error {
+	waiterCfg := aws.WaiterConfig{
+		Operation:   "{{ .OperationName }}",
+		Delay:       {{ .Delay }},
+		MaxAttempts: {{ .MaxAttempts }},
+		Acceptors: []aws.WaiterAcceptor{
+			{{ range $_, $a := .Acceptors }}{
+				State:    "{{ .State }}",
+				Matcher:  "{{ .Matcher }}",
+				Argument: "{{ .Argument }}",
+				Expected: {{ .ExpectedString }},
+			},
+			{{ end }}
+		},
+	}
+
+	w := aws.Waiter{
+		WaiterConfig: waiterCfg,
+		NewRequest: func() *aws.Request {
+			req, _ := c.{{ .OperationName }}Request(input)
+			return req
+		},
+	}
+	return w.Wait()
+}
+`))
+
+// GoCode returns the generated Go code for the Waiter's WaitUntil method.
+func (w *Waiter) GoCode() string {
+	var buf bytes.Buffer
+	if err := tplWaiter.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
+
+// InterfaceSignature returns a string representing the Waiter's interface
+// function signature.
+func (w *Waiter) InterfaceSignature() string {
+	return fmt.Sprintf("WaitUntil%s(%s) error",
+		w.Name, w.Operation.InputRef.GoTypeWithPkgName())
+}
+
+// WaitersGoCode generates the Go code for the WaitUntil methods of the API.
+func (a *API) WaitersGoCode() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "import (\n\t%q\n)",
+		"github.com/dongfangx/aws-sdk-go/aws")
+
+	for _, w := range a.Waiters {
+		buf.WriteString("\n\n")
+		buf.WriteString(w.GoCode())
+	}
+	return util.GoFmt(buf.String())
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitersGoCode(t *testing.T) {
+	a := API{NoInflections: true, NoInitMethods: true}
+	a.Metadata.ServiceAbbreviation = "Svc"
+	a.AttachString(`{
+		"operations": {
+			"DescribeThing": {
+				"input": { "shape": "DescribeThingInput" },
+				"output": { "shape": "DescribeThingOutput" }
+			}
+		},
+		"shapes": {
+			"DescribeThingInput": {
+				"type": "structure",
+				"members": { "Name": { "shape": "String" } }
+			},
+			"DescribeThingOutput": {
+				"type": "structure",
+				"members": { "State": { "shape": "String" } }
+			},
+			"String": { "type": "string" }
+		}
+	}`)
+
+	f, err := ioutil.TempFile("", "waiters")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	f.WriteString(`{
+		"version": 2,
+		"waiters": {
+			"ThingExists": {
+				"delay": 5,
+				"operation": "DescribeThing",
+				"maxAttempts": 20,
+				"acceptors": [
+					{ "expected": "available", "matcher": "path", "state": "success", "argument": "State" },
+					{ "expected": 404, "matcher": "status", "state": "retry" }
+				]
+			}
+		}
+	}`)
+	f.Close()
+
+	a.AttachWaiters(f.Name())
+	assert.Len(t, a.Waiters, 1)
+	assert.Equal(t, "WaitUntilThingExists(*svc.DescribeThingInput) error", a.Waiters[0].InterfaceSignature())
+
+	code := a.WaitersGoCode()
+	assert.Contains(t, code, "// WaitUntilThingExists polls DescribeThing every 5 seconds until the\n")
+	assert.Contains(t, code, "func (c *Svc) WaitUntilThingExists(input *DescribeThingInput) error {")
+	assert.Contains(t, code, `Operation:   "DescribeThing",`)
+	assert.Contains(t, code, "MaxAttempts: 20,")
+	assert.Contains(t, code, `Expected: "available",`)
+	assert.Contains(t, code, "Expected: 404,")
+	assert.Contains(t, code, "req, _ := c.DescribeThingRequest(input)")
+
+	assert.Contains(t, a.InterfaceGoCode(), "WaitUntilThingExists(*svc.DescribeThingInput) error")
+}
@@ -36,6 +36,11 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 		g.API.AttachPaginators(paginatorsFile)
 	}
 
+	waitersFile := strings.Replace(modelFile, ".normal.json", ".waiters-2.json", -1)
+	if _, err := os.Stat(waitersFile); err == nil {
+		g.API.AttachWaiters(waitersFile)
+	}
+
 	if svc := os.Getenv("SERVICES"); svc != "" {
 		svcs := strings.Split(svc, ",")
 		for _, s := range svcs {
@@ -49,13 +54,14 @@ func newGenerateInfo(modelFile, svcPath string) *generateInfo {
 	pkgDir := filepath.Join(svcPath, g.API.PackageName())
 	os.MkdirAll(pkgDir, 0775)
 	os.MkdirAll(filepath.Join(pkgDir, g.API.InterfacePackageName()), 0775)
+	os.MkdirAll(filepath.Join(pkgDir, g.API.InterfacePackageName(), g.API.MockPackageName()), 0775)
 
 	g.PackageDir = pkgDir
 
 	return g
 }
 
-// Generates service api, examples, and interface from api json definition files.
+// Generates service api, examples, interface, and mock from api json definition files.
 //
 // Flags:
 // -path alternative service path to write generated files to for each service.
@@ -123,6 +129,8 @@ func main() {
 					g.writeExamplesFile()
 					g.writeServiceFile()
 					g.writeInterfaceFile()
+					g.writeMockFile()
+					g.writeWaitersFile()
 				}
 			}
 		}()
@@ -179,6 +187,34 @@ func (g *generateInfo) writeInterfaceFile() {
 	)
 }
 
+// writeMockFile writes out the service mock file.
+func (g *generateInfo) writeMockFile() {
+	mockDir := filepath.Join(g.PackageDir, g.API.InterfacePackageName(), g.API.MockPackageName())
+
+	writeGoFile(filepath.Join(mockDir, "mock.go"),
+		codeLayout,
+		fmt.Sprintf("\n// Package %s provides a mock client for the %s.",
+			g.API.MockPackageName(), g.API.Metadata.ServiceFullName),
+		g.API.MockPackageName(),
+		g.API.MockGoCode(),
+	)
+}
+
+// writeWaitersFile writes out the service waiters file, if the service has
+// any waiters.
+func (g *generateInfo) writeWaitersFile() {
+	if len(g.API.Waiters) == 0 {
+		return
+	}
+
+	writeGoFile(filepath.Join(g.PackageDir, "waiters.go"),
+		codeLayout,
+		"",
+		g.API.PackageName(),
+		g.API.WaitersGoCode(),
+	)
+}
+
 // writeAPIFile writes out the service api file.
 func (g *generateInfo) writeAPIFile() {
 	writeGoFile(filepath.Join(g.PackageDir, "api.go"),
@@ -1,13 +1,16 @@
 // Command aws-gen-goendpoints parses a JSON description of the AWS endpoint
-// discovery logic and generates a Go file which returns an endpoint.
+// partitions and generates a Go file with the partitions the endpoints
+// package resolves endpoints from. If a third file is given, the partition,
+// region and service identifiers of the public endpoints package are
+// generated into it.
 //
-//     aws-gen-goendpoints apis/_endpoints.json aws/endpoints_map.go
+//     aws-gen-goendpoints internal/endpoints/endpoints.json internal/endpoints/defaults.go aws/endpoints/ids.go
 package main
 
 import (
-	"encoding/json"
 	"os"
 
+	"github.com/dongfangx/aws-sdk-go/internal/endpoints"
 	"github.com/dongfangx/aws-sdk-go/internal/model"
 )
 
@@ -17,6 +20,7 @@ import (
 //  [0] This file's execution path
 //  [1] The definition file to use
 //  [2] The output file to generate
+//  [3] The output file of the identifiers to generate, optional
 func main() {
 	in, err := os.Open(os.Args[1])
 	if err != nil {
@@ -24,14 +28,8 @@ func main() {
 	}
 	defer in.Close()
 
-	var endpoints struct {
-		Version   int
-		Endpoints map[string]struct {
-			Endpoint      string
-			SigningRegion string
-		}
-	}
-	if err := json.NewDecoder(in).Decode(&endpoints); err != nil {
+	partitions, err := endpoints.DecodeModel(in)
+	if err != nil {
 		panic(err)
 	}
 
@@ -41,7 +39,19 @@ func main() {
 	}
 	defer out.Close()
 
-	if err := model.GenerateEndpoints(endpoints, out); err != nil {
+	if err := model.GenerateEndpoints(partitions, out); err != nil {
 		panic(err)
 	}
+
+	if len(os.Args) > 3 {
+		ids, err := os.Create(os.Args[3])
+		if err != nil {
+			panic(err)
+		}
+		defer ids.Close()
+
+		if err := model.GenerateEndpointIDs(partitions, ids); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -4,18 +4,70 @@ import (
 	"bytes"
 	"go/format"
 	"io"
+	"sort"
+	"strconv"
+	"strings"
 	"text/template"
+
+	"github.com/dongfangx/aws-sdk-go/internal/endpoints"
 )
 
 // GenerateEndpoints writes a Go file to the given writer.
 func GenerateEndpoints(endpoints interface{}, w io.Writer) error {
-	tmpl, err := template.New("endpoints").Parse(t)
+	return generateEndpointsFile(t, endpoints, w)
+}
+
+// GenerateEndpointIDs writes a Go file with the identifier constants of the
+// partitions, regions and services of the model to the given writer.
+func GenerateEndpointIDs(partitions endpoints.Partitions, w io.Writer) error {
+	ids := struct {
+		Partitions endpoints.Partitions
+		Regions    []endpointID
+		Services   []string
+	}{Partitions: partitions}
+
+	regions := map[string]string{}
+	services := map[string]bool{}
+	for _, p := range partitions {
+		for id, r := range p.Regions {
+			regions[id] = r.Description
+		}
+		for id := range p.Services {
+			if !services[id] {
+				services[id] = true
+				ids.Services = append(ids.Services, id)
+			}
+		}
+	}
+	sort.Strings(ids.Services)
+
+	regionIDs := make([]string, 0, len(regions))
+	for id := range regions {
+		regionIDs = append(regionIDs, id)
+	}
+	sort.Strings(regionIDs)
+	for _, id := range regionIDs {
+		ids.Regions = append(ids.Regions, endpointID{id, regions[id]})
+	}
+
+	return generateEndpointsFile(idsTmpl, ids, w)
+}
+
+type endpointID struct {
+	ID, Description string
+}
+
+func generateEndpointsFile(text string, data interface{}, w io.Writer) error {
+	tmpl, err := template.New("endpoints").Funcs(template.FuncMap{
+		"quote": strconv.Quote,
+		"ident": endpointIdent,
+	}).Parse(text)
 	if err != nil {
 		return err
 	}
 
 	out := bytes.NewBuffer(nil)
-	if err := tmpl.Execute(out, endpoints); err != nil {
+	if err := tmpl.Execute(out, data); err != nil {
 		return err
 	}
 
@@ -28,30 +80,103 @@ func GenerateEndpoints(endpoints interface{}, w io.Writer) error {
 	return err
 }
 
+// endpointIdent returns the Go identifier of a partition, region or service
+// ID, such as UsEast1 for us-east-1.
+func endpointIdent(id string) string {
+	parts := strings.Split(id, "-")
+	for i, p := range parts {
+		if p != "" {
+			parts[i] = strings.ToUpper(p[:1]) + p[1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
 const t = `
+{{ define "endpoint" -}}
+	{{ if ne .Hostname "" }}Hostname: {{ quote .Hostname }},
+	{{ end -}}
+	{{ if ne .SignatureVersion "" }}SignatureVersion: {{ quote .SignatureVersion }},
+	{{ end -}}
+	{{ if or (ne .CredentialScope.Region "") (ne .CredentialScope.Service "") }}CredentialScope: CredentialScope{
+		{{ if ne .CredentialScope.Region "" }}Region: {{ quote .CredentialScope.Region }},
+		{{ end -}}
+		{{ if ne .CredentialScope.Service "" }}Service: {{ quote .CredentialScope.Service }},
+		{{ end -}}
+	},
+	{{ end -}}
+	{{ if .Variants }}Variants: []Variant{
+		{{ range .Variants }}{
+			Tags: []string{ {{- range $i, $tag := .Tags }}{{ if $i }}, {{ end }}{{ quote $tag }}{{ end -}} },
+			Hostname: {{ quote .Hostname }},
+		},
+		{{ end -}}
+	},
+	{{ end -}}
+{{ end }}
+
 package endpoints
 
 // THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
 
-type endpointStruct struct {
-	Version   int
-	Endpoints map[string]endpointEntry
-}
-
-type endpointEntry struct {
-	Endpoint      string
-	SigningRegion string
-}
-
-var endpointsMap = endpointStruct{
-	Version: {{ .Version }},
-	Endpoints: map[string]endpointEntry{
-		{{ range $key, $entry := .Endpoints }}"{{ $key }}": endpointEntry{
-			Endpoint:      "{{ $entry.Endpoint }}",
-			{{ if ne $entry.SigningRegion "" }}SigningRegion: "{{ $entry.SigningRegion }}",
+var defaultPartitions = Partitions{
+	{{ range . }}{
+		ID:          {{ quote .ID }},
+		Name:        {{ quote .Name }},
+		DNSSuffix:   {{ quote .DNSSuffix }},
+		RegionRegex: {{ quote .RegionRegex }},
+		Defaults: Endpoint{
+			{{ template "endpoint" .Defaults }}
+		},
+		Regions: map[string]Region{
+			{{ range $name, $region := .Regions }}{{ quote $name }}: {
+				Description: {{ quote $region.Description }},
+			},
+			{{ end }}
+		},
+		Services: map[string]Service{
+			{{ range $name, $service := .Services }}{{ quote $name }}: {
+				{{ if ne $service.PartitionEndpoint "" }}PartitionEndpoint: {{ quote $service.PartitionEndpoint }},
+				{{ end -}}
+				{{ if or (ne $service.Defaults.Hostname "") (ne $service.Defaults.SignatureVersion "") $service.Defaults.Variants }}Defaults: Endpoint{
+					{{ template "endpoint" $service.Defaults }}
+				},
+				{{ end -}}
+				{{ if $service.Endpoints }}Endpoints: map[string]Endpoint{
+					{{ range $key, $endpoint := $service.Endpoints }}{{ quote $key }}: {
+						{{ template "endpoint" $endpoint }}
+					},
+					{{ end }}
+				},
+				{{ end -}}
+			},
 			{{ end }}
 		},
-		{{ end }}
 	},
+	{{ end }}
 }
 `
+
+const idsTmpl = `
+package endpoints
+
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+// Partition identifiers.
+const (
+	{{ range .Partitions }}{{ ident .ID }}PartitionID = {{ quote .ID }} // {{ .Name }}.
+	{{ end -}}
+)
+
+// Region identifiers.
+const (
+	{{ range .Regions }}{{ ident .ID }}RegionID = {{ quote .ID }} // {{ .Description }}.
+	{{ end -}}
+)
+
+// Service identifiers.
+const (
+	{{ range .Services }}{{ ident . }}ServiceID = {{ quote . }}
+	{{ end -}}
+)
+`
@@ -0,0 +1,65 @@
+package eventstream
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// A Decoder reads messages from an event stream.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder returns a Decoder reading messages from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next message of the stream. io.EOF is returned if the
+// stream ends cleanly before a message, and io.ErrUnexpectedEOF if it ends
+// within one. A ChecksumError is returned if the message is corrupt.
+func (d *Decoder) Decode() (Message, error) {
+	prelude := make([]byte, preludeLen+checksumLen)
+	if _, err := io.ReadFull(d.r, prelude); err != nil {
+		return Message{}, err
+	}
+
+	if sum, expect := crc32.ChecksumIEEE(prelude[:preludeLen]),
+		binary.BigEndian.Uint32(prelude[preludeLen:]); sum != expect {
+		return Message{}, ChecksumError{Part: "prelude", Expected: expect, Actual: sum}
+	}
+
+	totalLen := binary.BigEndian.Uint32(prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(prelude[4:8])
+	if totalLen < minMessageLen || totalLen > MaxMessageLen {
+		return Message{}, fmt.Errorf("invalid event stream message length %d", totalLen)
+	}
+	if headersLen > MaxHeadersLen || headersLen > totalLen-minMessageLen {
+		return Message{}, fmt.Errorf("invalid event stream headers length %d", headersLen)
+	}
+
+	msg := make([]byte, totalLen)
+	copy(msg, prelude)
+	if _, err := io.ReadFull(d.r, msg[len(prelude):]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return Message{}, err
+	}
+
+	end := totalLen - checksumLen
+	if sum, expect := crc32.ChecksumIEEE(msg[:end]),
+		binary.BigEndian.Uint32(msg[end:]); sum != expect {
+		return Message{}, ChecksumError{Part: "message", Expected: expect, Actual: sum}
+	}
+
+	start := uint32(len(prelude))
+	headers, err := decodeHeaders(msg[start : start+headersLen])
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{Headers: headers, Payload: msg[start+headersLen : end]}, nil
+}
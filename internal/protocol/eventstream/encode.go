@@ -0,0 +1,49 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// An Encoder writes messages to an event stream.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns an Encoder writing messages to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the message m to the stream, framed with its prelude and
+// checksums.
+func (e *Encoder) Encode(m Message) error {
+	var headers bytes.Buffer
+	if err := encodeHeaders(&headers, m.Headers); err != nil {
+		return err
+	}
+	if headers.Len() > MaxHeadersLen {
+		return fmt.Errorf("event stream headers too long, %d bytes", headers.Len())
+	}
+
+	totalLen := minMessageLen + headers.Len() + len(m.Payload)
+	if totalLen > MaxMessageLen {
+		return fmt.Errorf("event stream message too long, %d bytes", totalLen)
+	}
+
+	msg := make([]byte, preludeLen+checksumLen, totalLen)
+	binary.BigEndian.PutUint32(msg[0:4], uint32(totalLen))
+	binary.BigEndian.PutUint32(msg[4:8], uint32(headers.Len()))
+	binary.BigEndian.PutUint32(msg[8:12], crc32.ChecksumIEEE(msg[:preludeLen]))
+	msg = append(msg, headers.Bytes()...)
+	msg = append(msg, m.Payload...)
+
+	sum := make([]byte, checksumLen)
+	binary.BigEndian.PutUint32(sum, crc32.ChecksumIEEE(msg))
+
+	_, err := e.w.Write(append(msg, sum...))
+	return err
+}
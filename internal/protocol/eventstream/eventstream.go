@@ -0,0 +1,81 @@
+// Package eventstream provides encoding and decoding of messages framed with
+// the vnd.amazon.eventstream binary format.
+//
+// Each message is framed by a prelude holding the total and header lengths
+// with a CRC32 checksum, followed by the headers, the payload and a CRC32
+// checksum of the whole message.
+package eventstream
+
+import (
+	"fmt"
+
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+const (
+	preludeLen  = 8
+	checksumLen = 4
+
+	// minimum length of a message, a message without headers and payload
+	minMessageLen = preludeLen + 2*checksumLen
+
+	// MaxMessageLen is the maximum length of a message, including framing
+	MaxMessageLen = 16 * 1024 * 1024
+
+	// MaxHeadersLen is the maximum length of the encoded headers of a message
+	MaxHeadersLen = 128 * 1024
+)
+
+// Names of the headers describing the type of a message.
+const (
+	MessageTypeHeader   = ":message-type"
+	EventTypeHeader     = ":event-type"
+	ErrorCodeHeader     = ":error-code"
+	ErrorMessageHeader  = ":error-message"
+	ExceptionTypeHeader = ":exception-type"
+	ContentTypeHeader   = ":content-type"
+)
+
+// Values of the :message-type header.
+const (
+	EventMessageType     = "event"
+	ErrorMessageType     = "error"
+	ExceptionMessageType = "exception"
+)
+
+// A Message is a single frame of an event stream.
+type Message struct {
+	Headers Headers
+	Payload []byte
+}
+
+// A ChecksumError is returned when the checksum of a message, or of its
+// prelude, does not match its content.
+type ChecksumError struct {
+	// Part of the message the checksum covers, "prelude" or "message"
+	Part string
+
+	Expected uint32
+	Actual   uint32
+}
+
+// Error satisfies the error interface.
+func (e ChecksumError) Error() string {
+	return fmt.Sprintf("event stream %s checksum mismatch, expected %08x, got %08x",
+		e.Part, e.Expected, e.Actual)
+}
+
+// MessageError returns the error a message of the error or exception type
+// carries, or nil if the message is an event. Error messages carry the code
+// and message of the error in headers, exceptions carry the exception type
+// in a header and the message in the payload.
+func MessageError(m Message) error {
+	switch m.Headers.GetString(MessageTypeHeader) {
+	case ErrorMessageType:
+		return apierr.New(m.Headers.GetString(ErrorCodeHeader),
+			m.Headers.GetString(ErrorMessageHeader), nil)
+	case ExceptionMessageType:
+		return apierr.New(m.Headers.GetString(ExceptionTypeHeader), string(m.Payload), nil)
+	}
+	return nil
+}
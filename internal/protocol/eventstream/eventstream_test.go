@@ -0,0 +1,127 @@
+package eventstream_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/protocol/eventstream"
+	"github.com/stretchr/testify/assert"
+)
+
+// encoded messages of the event stream reference test suite
+var (
+	emptyMessage       = "000000100000000005c248eb7d98c8ff"
+	int32HeaderMessage = "0000002d0000001041c424b80a6576656e742d7479706504" +
+		"0000a00c7b27666f6f273a27626172277d36f480a0"
+)
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestDecodeReferenceMessages(t *testing.T) {
+	d := eventstream.NewDecoder(bytes.NewReader(decodeHex(t, emptyMessage+int32HeaderMessage)))
+
+	m, err := d.Decode()
+	assert.NoError(t, err)
+	assert.Empty(t, m.Headers)
+	assert.Empty(t, m.Payload)
+
+	m, err = d.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, eventstream.Headers{{Name: "event-type", Value: eventstream.Int32Value(40972)}}, m.Headers)
+	assert.Equal(t, "{'foo':'bar'}", string(m.Payload))
+
+	_, err = d.Decode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestEncodeReferenceMessage(t *testing.T) {
+	var buf bytes.Buffer
+	err := eventstream.NewEncoder(&buf).Encode(eventstream.Message{
+		Headers: eventstream.Headers{{Name: "event-type", Value: eventstream.Int32Value(40972)}},
+		Payload: []byte("{'foo':'bar'}"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32HeaderMessage, hex.EncodeToString(buf.Bytes()))
+}
+
+func TestRoundTripHeaderValues(t *testing.T) {
+	ts := time.Date(2018, 5, 6, 7, 8, 9, 123000000, time.UTC)
+	headers := eventstream.Headers{
+		{Name: "true", Value: eventstream.BoolValue(true)},
+		{Name: "false", Value: eventstream.BoolValue(false)},
+		{Name: "int8", Value: eventstream.Int8Value(-8)},
+		{Name: "int16", Value: eventstream.Int16Value(-16)},
+		{Name: "int32", Value: eventstream.Int32Value(-32)},
+		{Name: "int64", Value: eventstream.Int64Value(-64)},
+		{Name: "bytes", Value: eventstream.BytesValue{1, 2, 3}},
+		{Name: "string", Value: eventstream.StringValue("value")},
+		{Name: "timestamp", Value: eventstream.TimestampValue(ts)},
+		{Name: "uuid", Value: eventstream.UUIDValue{0x12, 0x34, 15: 0xff}},
+	}
+
+	var buf bytes.Buffer
+	e := eventstream.NewEncoder(&buf)
+	assert.NoError(t, e.Encode(eventstream.Message{Headers: headers, Payload: []byte("payload")}))
+
+	m, err := eventstream.NewDecoder(&buf).Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, "payload", string(m.Payload))
+	assert.Len(t, m.Headers, len(headers))
+	for i, h := range headers {
+		assert.Equal(t, h.Name, m.Headers[i].Name)
+		assert.Equal(t, h.Value.String(), m.Headers[i].Value.String())
+	}
+	assert.Equal(t, "12340000-0000-0000-0000-0000000000ff", m.Headers.GetString("uuid"))
+	assert.Equal(t, ts, time.Time(m.Headers.Get("timestamp").(eventstream.TimestampValue)).UTC())
+}
+
+func TestDecodeChecksumErrors(t *testing.T) {
+	b := decodeHex(t, int32HeaderMessage)
+	b[9] ^= 0xff
+	_, err := eventstream.NewDecoder(bytes.NewReader(b)).Decode()
+	assert.Equal(t, "prelude", err.(eventstream.ChecksumError).Part)
+
+	b = decodeHex(t, int32HeaderMessage)
+	b[30] ^= 0xff
+	_, err = eventstream.NewDecoder(bytes.NewReader(b)).Decode()
+	assert.Equal(t, "message", err.(eventstream.ChecksumError).Part)
+	assert.Equal(t, uint32(0x36f480a0), err.(eventstream.ChecksumError).Expected)
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	b := decodeHex(t, int32HeaderMessage)
+	_, err := eventstream.NewDecoder(bytes.NewReader(b[:20])).Decode()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	_, err = eventstream.NewDecoder(bytes.NewReader(b[:6])).Decode()
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestMessageError(t *testing.T) {
+	event := eventstream.Message{}
+	event.Headers.Set(eventstream.MessageTypeHeader, eventstream.StringValue(eventstream.EventMessageType))
+	assert.NoError(t, eventstream.MessageError(event))
+
+	msg := eventstream.Message{}
+	msg.Headers.Set(eventstream.MessageTypeHeader, eventstream.StringValue(eventstream.ErrorMessageType))
+	msg.Headers.Set(eventstream.ErrorCodeHeader, eventstream.StringValue("InternalError"))
+	msg.Headers.Set(eventstream.ErrorMessageHeader, eventstream.StringValue("try again"))
+	err := eventstream.MessageError(msg).(awserr.Error)
+	assert.Equal(t, "InternalError", err.Code())
+	assert.Equal(t, "try again", err.Message())
+
+	msg = eventstream.Message{Payload: []byte("bad request")}
+	msg.Headers.Set(eventstream.MessageTypeHeader, eventstream.StringValue(eventstream.ExceptionMessageType))
+	msg.Headers.Set(eventstream.ExceptionTypeHeader, eventstream.StringValue("ValidationException"))
+	err = eventstream.MessageError(msg).(awserr.Error)
+	assert.Equal(t, "ValidationException", err.Code())
+	assert.Equal(t, "bad request", err.Message())
+}
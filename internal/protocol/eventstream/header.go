@@ -0,0 +1,297 @@
+package eventstream
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// A ValueType is the type of a header value as encoded on the wire.
+type ValueType uint8
+
+// Header value types.
+const (
+	TrueValueType ValueType = iota
+	FalseValueType
+	Int8ValueType
+	Int16ValueType
+	Int32ValueType
+	Int64ValueType
+	BytesValueType
+	StringValueType
+	TimestampValueType
+	UUIDValueType
+)
+
+// A Value is the value of a message header.
+type Value interface {
+	valueType() ValueType
+	encode(w io.Writer) error
+	String() string
+}
+
+// BoolValue is a boolean header value.
+type BoolValue bool
+
+func (v BoolValue) valueType() ValueType {
+	if v {
+		return TrueValueType
+	}
+	return FalseValueType
+}
+
+func (v BoolValue) encode(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, v.valueType())
+}
+
+func (v BoolValue) String() string { return strconv.FormatBool(bool(v)) }
+
+// Int8Value is a single byte header value.
+type Int8Value int8
+
+func (v Int8Value) valueType() ValueType { return Int8ValueType }
+
+func (v Int8Value) encode(w io.Writer) error { return writeTyped(w, v.valueType(), v) }
+
+func (v Int8Value) String() string { return strconv.FormatInt(int64(v), 10) }
+
+// Int16Value is a 16 bit integer header value.
+type Int16Value int16
+
+func (v Int16Value) valueType() ValueType { return Int16ValueType }
+
+func (v Int16Value) encode(w io.Writer) error { return writeTyped(w, v.valueType(), v) }
+
+func (v Int16Value) String() string { return strconv.FormatInt(int64(v), 10) }
+
+// Int32Value is a 32 bit integer header value.
+type Int32Value int32
+
+func (v Int32Value) valueType() ValueType { return Int32ValueType }
+
+func (v Int32Value) encode(w io.Writer) error { return writeTyped(w, v.valueType(), v) }
+
+func (v Int32Value) String() string { return strconv.FormatInt(int64(v), 10) }
+
+// Int64Value is a 64 bit integer header value.
+type Int64Value int64
+
+func (v Int64Value) valueType() ValueType { return Int64ValueType }
+
+func (v Int64Value) encode(w io.Writer) error { return writeTyped(w, v.valueType(), v) }
+
+func (v Int64Value) String() string { return strconv.FormatInt(int64(v), 10) }
+
+// BytesValue is a byte array header value.
+type BytesValue []byte
+
+func (v BytesValue) valueType() ValueType { return BytesValueType }
+
+func (v BytesValue) encode(w io.Writer) error { return writeBytes(w, v.valueType(), v) }
+
+func (v BytesValue) String() string { return base64.StdEncoding.EncodeToString(v) }
+
+// StringValue is a string header value.
+type StringValue string
+
+func (v StringValue) valueType() ValueType { return StringValueType }
+
+func (v StringValue) encode(w io.Writer) error { return writeBytes(w, v.valueType(), []byte(v)) }
+
+func (v StringValue) String() string { return string(v) }
+
+// TimestampValue is a timestamp header value, encoded with millisecond
+// precision.
+type TimestampValue time.Time
+
+func (v TimestampValue) valueType() ValueType { return TimestampValueType }
+
+func (v TimestampValue) encode(w io.Writer) error {
+	ms := time.Time(v).UnixNano() / int64(time.Millisecond)
+	return writeTyped(w, v.valueType(), ms)
+}
+
+func (v TimestampValue) String() string { return time.Time(v).UTC().Format(time.RFC3339Nano) }
+
+// UUIDValue is a UUID header value.
+type UUIDValue [16]byte
+
+func (v UUIDValue) valueType() ValueType { return UUIDValueType }
+
+func (v UUIDValue) encode(w io.Writer) error { return writeTyped(w, v.valueType(), v) }
+
+func (v UUIDValue) String() string {
+	s := hex.EncodeToString(v[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+func writeTyped(w io.Writer, t ValueType, v interface{}) error {
+	if err := binary.Write(w, binary.BigEndian, t); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func writeBytes(w io.Writer, t ValueType, b []byte) error {
+	if len(b) > 1<<16-1 {
+		return fmt.Errorf("event stream header value too long, %d bytes", len(b))
+	}
+	if err := binary.Write(w, binary.BigEndian, t); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.BigEndian, uint16(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// A Header is a name and value pair of message metadata.
+type Header struct {
+	Name  string
+	Value Value
+}
+
+// Headers are the headers of a message, in the order they are encoded.
+type Headers []Header
+
+// Get returns the value of the header name, or nil if it is not set.
+func (hs Headers) Get(name string) Value {
+	for _, h := range hs {
+		if h.Name == name {
+			return h.Value
+		}
+	}
+	return nil
+}
+
+// GetString returns the value of the header name as a string, or "" if it
+// is not set.
+func (hs Headers) GetString(name string) string {
+	if v := hs.Get(name); v != nil {
+		return v.String()
+	}
+	return ""
+}
+
+// Set sets the value of the header name, replacing its current value.
+func (hs *Headers) Set(name string, value Value) {
+	for i, h := range *hs {
+		if h.Name == name {
+			(*hs)[i].Value = value
+			return
+		}
+	}
+	*hs = append(*hs, Header{Name: name, Value: value})
+}
+
+// Del removes the header name.
+func (hs *Headers) Del(name string) {
+	for i, h := range *hs {
+		if h.Name == name {
+			*hs = append((*hs)[:i], (*hs)[i+1:]...)
+			return
+		}
+	}
+}
+
+func encodeHeaders(w io.Writer, hs Headers) error {
+	for _, h := range hs {
+		if len(h.Name) == 0 || len(h.Name) > 255 {
+			return fmt.Errorf("invalid event stream header name %q", h.Name)
+		}
+		if _, err := w.Write(append([]byte{byte(len(h.Name))}, h.Name...)); err != nil {
+			return err
+		}
+		if err := h.Value.encode(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeHeaders(b []byte) (Headers, error) {
+	hs := Headers{}
+	for len(b) > 0 {
+		n := int(b[0])
+		if n == 0 || len(b) < 1+n+1 {
+			return nil, fmt.Errorf("malformed event stream header")
+		}
+		name := string(b[1 : 1+n])
+		t := ValueType(b[1+n])
+		b = b[2+n:]
+
+		v, rest, err := decodeValue(t, b)
+		if err != nil {
+			return nil, fmt.Errorf("malformed event stream header %s, %v", name, err)
+		}
+		hs = append(hs, Header{Name: name, Value: v})
+		b = rest
+	}
+	return hs, nil
+}
+
+func decodeValue(t ValueType, b []byte) (Value, []byte, error) {
+	need := func(n int) error {
+		if len(b) < n {
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}
+
+	switch t {
+	case TrueValueType:
+		return BoolValue(true), b, nil
+	case FalseValueType:
+		return BoolValue(false), b, nil
+	case Int8ValueType:
+		if err := need(1); err != nil {
+			return nil, nil, err
+		}
+		return Int8Value(b[0]), b[1:], nil
+	case Int16ValueType:
+		if err := need(2); err != nil {
+			return nil, nil, err
+		}
+		return Int16Value(binary.BigEndian.Uint16(b)), b[2:], nil
+	case Int32ValueType:
+		if err := need(4); err != nil {
+			return nil, nil, err
+		}
+		return Int32Value(binary.BigEndian.Uint32(b)), b[4:], nil
+	case Int64ValueType, TimestampValueType:
+		if err := need(8); err != nil {
+			return nil, nil, err
+		}
+		i := int64(binary.BigEndian.Uint64(b))
+		if t == TimestampValueType {
+			return TimestampValue(time.Unix(0, i*int64(time.Millisecond))), b[8:], nil
+		}
+		return Int64Value(i), b[8:], nil
+	case BytesValueType, StringValueType:
+		if err := need(2); err != nil {
+			return nil, nil, err
+		}
+		n := int(binary.BigEndian.Uint16(b))
+		b = b[2:]
+		if err := need(n); err != nil {
+			return nil, nil, err
+		}
+		if t == StringValueType {
+			return StringValue(b[:n]), b[n:], nil
+		}
+		return BytesValue(append([]byte{}, b[:n]...)), b[n:], nil
+	case UUIDValueType:
+		if err := need(16); err != nil {
+			return nil, nil, err
+		}
+		var u UUIDValue
+		copy(u[:], b)
+		return u, b[16:], nil
+	}
+	return nil, nil, fmt.Errorf("unknown value type %d", t)
+}
@@ -19,6 +19,10 @@ import (
 // RFC822 returns an RFC822 formatted timestamp for AWS protocols
 const RFC822 = "Mon, 2 Jan 2006 15:04:05 GMT"
 
+// ISO8601 returns an ISO8601 formatted timestamp for header members modeled
+// with the iso8601 timestamp format
+const ISO8601 = "2006-01-02T15:04:05Z"
+
 // Whether the byte value can be sent without escaping in AWS URLs
 var noEscape [256]bool
 
@@ -70,7 +74,7 @@ func buildLocationElements(r *aws.Request, v reflect.Value) {
 			case "headers": // header maps
 				buildHeaderMap(r, m, field.Tag.Get("locationName"))
 			case "header":
-				buildHeader(r, m, name)
+				buildHeader(r, m, name, field.Tag)
 			case "uri":
 				buildURI(r, m, name)
 			case "querystring":
@@ -111,8 +115,15 @@ func buildBody(r *aws.Request, v reflect.Value) {
 	}
 }
 
-func buildHeader(r *aws.Request, v reflect.Value, name string) {
-	str, err := convertType(v)
+func buildHeader(r *aws.Request, v reflect.Value, name string, tag reflect.StructTag) {
+	var str *string
+	var err error
+	if t, ok := v.Interface().(time.Time); ok && tag.Get("timestampFormat") == "iso8601" {
+		s := t.UTC().Format(ISO8601)
+		str = &s
+	} else {
+		str, err = convertType(v)
+	}
 	if err != nil {
 		r.Error = apierr.New("Marshal", "failed to encode REST request", err)
 	} else if str != nil {
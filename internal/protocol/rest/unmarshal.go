@@ -27,7 +27,9 @@ func unmarshalBody(r *aws.Request, v reflect.Value) {
 	if field, ok := v.Type().FieldByName("SDKShapeTraits"); ok {
 		if payloadName := field.Tag.Get("payload"); payloadName != "" {
 			pfield, _ := v.Type().FieldByName(payloadName)
-			if ptag := pfield.Tag.Get("type"); ptag != "" && ptag != "structure" {
+			// event stream payloads are decoded by the service as the
+			// response body is read
+			if ptag := pfield.Tag.Get("type"); ptag != "" && ptag != "structure" && ptag != "eventstream" {
 				payload := v.FieldByName(payloadName)
 				if payload.IsValid() {
 					switch payload.Interface().(type) {
@@ -81,7 +83,7 @@ func unmarshalLocationElements(r *aws.Request, v reflect.Value) {
 			case "statusCode":
 				unmarshalStatusCode(m, r.HTTPResponse.StatusCode)
 			case "header":
-				err := unmarshalHeader(m, r.HTTPResponse.Header.Get(name))
+				err := unmarshalHeader(m, r.HTTPResponse.Header.Get(name), field.Tag)
 				if err != nil {
 					r.Error = apierr.New("Unmarshal", "failed to decode REST response", err)
 					break
@@ -128,7 +130,7 @@ func unmarshalHeaderMap(r reflect.Value, headers http.Header, prefix string) err
 	return nil
 }
 
-func unmarshalHeader(v reflect.Value, header string) error {
+func unmarshalHeader(v reflect.Value, header string, tag reflect.StructTag) error {
 	if !v.IsValid() || (header == "" && v.Elem().Kind() != reflect.String) {
 		return nil
 	}
@@ -162,6 +164,9 @@ func unmarshalHeader(v reflect.Value, header string) error {
 		v.Set(reflect.ValueOf(&f))
 	case *time.Time:
 		t, err := time.Parse(RFC822, header)
+		if err != nil && tag.Get("timestampFormat") == "iso8601" {
+			t, err = time.Parse(time.RFC3339, header)
+		}
 		if err != nil {
 			return err
 		}
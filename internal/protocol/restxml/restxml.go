@@ -35,7 +35,7 @@ func Unmarshal(r *aws.Request) {
 	if t := rest.PayloadType(r.Data); t == "structure" || t == "" {
 		defer r.HTTPResponse.Body.Close()
 		decoder := xml.NewDecoder(r.HTTPResponse.Body)
-		err := xmlutil.UnmarshalXML(r.Data, decoder, "")
+		err := xmlutil.DecodeXML(r.Data, decoder)
 		if err != nil {
 			r.Error = apierr.New("Unmarshal", "failed to decode REST XML response", err)
 			return
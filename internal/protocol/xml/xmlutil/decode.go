@@ -0,0 +1,359 @@
+package xmlutil
+
+import (
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// DecodeXML deserializes the root element read from the xml.Decoder into the
+// container v, populating v directly from the tokens of the decoder.
+//
+// DecodeXML decodes the same shapes as UnmarshalXML without building the
+// XMLNode tree of the document first, so large documents, such as listings
+// of many keys, are decoded with far fewer allocations. Elements are matched
+// by their local name, so namespaced elements are decoded like unqualified
+// ones. Elements which do not match a member of the shape are skipped.
+func DecodeXML(v interface{}, d *xml.Decoder) error {
+	for {
+		tok, err := d.RawToken()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if start, ok := tok.(xml.StartElement); ok {
+			if err := decodeValue(d, reflect.ValueOf(v), start, ""); err != nil {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// decodeValue deserializes the element started by start into r. The type tag
+// is used to infer the type, or reflect will be used to determine the type
+// from r.
+func decodeValue(d *xml.Decoder, r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	rtype := r.Type()
+	if rtype.Kind() == reflect.Ptr {
+		rtype = rtype.Elem() // check kind of actual element type
+	}
+
+	t := tag.Get("type")
+	if t == "" {
+		switch rtype.Kind() {
+		case reflect.Struct:
+			t = "structure"
+		case reflect.Slice:
+			t = "list"
+		case reflect.Map:
+			t = "map"
+		}
+	}
+
+	switch t {
+	case "structure":
+		if field, ok := rtype.FieldByName("SDKShapeTraits"); ok {
+			tag = field.Tag
+		}
+		return decodeStruct(d, r, start, tag)
+	case "list":
+		return decodeList(d, r, start, tag)
+	case "map":
+		return decodeMap(d, r, start, tag)
+	default:
+		text, err := elementText(d)
+		if err != nil {
+			return err
+		}
+		return setScalar(r, text)
+	}
+}
+
+// decodeStruct deserializes a structure and its members from the element
+// started by start.
+func decodeStruct(d *xml.Decoder, r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	if r.Kind() == reflect.Ptr {
+		if r.IsNil() { // create the structure if it's nil
+			r.Set(reflect.New(r.Type().Elem()))
+		}
+		r = r.Elem()
+	}
+
+	// unwrap any payloads
+	if payload := tag.Get("payload"); payload != "" {
+		field, _ := r.Type().FieldByName(payload)
+		return decodeStruct(d, r.FieldByName(payload), start, field.Tag)
+	}
+
+	fields := structFields(r.Type())
+
+	for _, a := range start.Attr {
+		if f, ok := fields[a.Name.Local]; ok {
+			if err := setScalar(r.Field(f.index), a.Value); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		tok, err := elementToken(d)
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			f, ok := fields[typed.Name.Local]
+			if !ok {
+				if err := skip(d); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := decodeValue(d, r.Field(f.index), typed, f.tag); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeList deserializes a list from the element started by start. A
+// flattened list element is itself an entry of the list, otherwise its
+// children are.
+func decodeList(d *xml.Decoder, r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	if tag.Get("flattened") != "" {
+		return appendListEntry(d, r, start)
+	}
+
+	mname := "member"
+	if name := tag.Get("locationNameList"); name != "" {
+		mname = name
+	}
+
+	for {
+		tok, err := elementToken(d)
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			if typed.Name.Local != mname {
+				if err := skip(d); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := appendListEntry(d, r, typed); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+func appendListEntry(d *xml.Decoder, r reflect.Value, start xml.StartElement) error {
+	entry := reflect.New(r.Type().Elem()).Elem()
+	if err := decodeValue(d, entry, start, ""); err != nil {
+		return err
+	}
+	r.Set(reflect.Append(r, entry))
+	return nil
+}
+
+// decodeMap deserializes a map from the element started by start. A
+// flattened map element is itself an entry of the map, otherwise its entry
+// children are.
+func decodeMap(d *xml.Decoder, r reflect.Value, start xml.StartElement, tag reflect.StructTag) error {
+	if r.IsNil() {
+		r.Set(reflect.MakeMap(r.Type()))
+	}
+
+	if tag.Get("flattened") != "" {
+		return decodeMapEntry(d, r, tag)
+	}
+
+	for {
+		tok, err := elementToken(d)
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			if typed.Name.Local != "entry" {
+				if err := skip(d); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := decodeMapEntry(d, r, tag); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// decodeMapEntry deserializes the key and value children of a map entry
+// element, whose start has been read.
+func decodeMapEntry(d *xml.Decoder, r reflect.Value, tag reflect.StructTag) error {
+	kname, vname := "key", "value"
+	if n := tag.Get("locationNameKey"); n != "" {
+		kname = n
+	}
+	if n := tag.Get("locationNameValue"); n != "" {
+		vname = n
+	}
+
+	var key *string
+	value := reflect.New(r.Type().Elem()).Elem()
+	for {
+		tok, err := elementToken(d)
+		if err != nil {
+			return err
+		}
+
+		switch typed := tok.(type) {
+		case xml.StartElement:
+			switch typed.Name.Local {
+			case kname:
+				text, err := elementText(d)
+				if err != nil {
+					return err
+				}
+				key = &text
+			case vname:
+				if err := decodeValue(d, value, typed, ""); err != nil {
+					return err
+				}
+			default:
+				if err := skip(d); err != nil {
+					return err
+				}
+			}
+		case xml.EndElement:
+			if key != nil {
+				r.SetMapIndex(reflect.ValueOf(*key), value)
+			}
+			return nil
+		}
+	}
+}
+
+// elementText returns the text of the element whose start has been read,
+// and reads up to its end. The content of child elements is ignored.
+func elementText(d *xml.Decoder) (string, error) {
+	var text []byte
+	for {
+		tok, err := elementToken(d)
+		if err != nil {
+			return "", err
+		}
+
+		switch typed := tok.(type) {
+		case xml.CharData:
+			text = append(text, typed...)
+		case xml.StartElement:
+			if err := skip(d); err != nil {
+				return "", err
+			}
+		case xml.EndElement:
+			return string(text), nil
+		}
+	}
+}
+
+// elementToken returns the next token within an element. The namespace
+// prefixes of names are not translated, as names are matched by their local
+// part. The document ending within an element is an io.ErrUnexpectedEOF.
+func elementToken(d *xml.Decoder) (xml.Token, error) {
+	tok, err := d.RawToken()
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return tok, err
+}
+
+// skip reads up to the end of the element whose start has been read.
+func skip(d *xml.Decoder) error {
+	for depth := 1; depth > 0; {
+		tok, err := elementToken(d)
+		if err != nil {
+			return err
+		}
+
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	return nil
+}
+
+// A fieldInfo is the index and tag of a structure member.
+type fieldInfo struct {
+	index int
+	tag   reflect.StructTag
+}
+
+var fieldCache = struct {
+	sync.RWMutex
+	m map[reflect.Type]map[string]fieldInfo
+}{m: map[reflect.Type]map[string]fieldInfo{}}
+
+// structFields returns the exported members of the structure type t by the
+// local name of the element or attribute they are decoded from.
+func structFields(t reflect.Type) map[string]fieldInfo {
+	fieldCache.RLock()
+	fields, ok := fieldCache.m[t]
+	fieldCache.RUnlock()
+	if ok {
+		return fields
+	}
+
+	fields = map[string]fieldInfo{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if c := field.Name[0:1]; strings.ToLower(c) == c {
+			continue // ignore unexported fields
+		}
+
+		// figure out what this field is called
+		name := field.Name
+		if field.Tag.Get("flattened") != "" && field.Tag.Get("locationNameList") != "" {
+			name = field.Tag.Get("locationNameList")
+		} else if locName := field.Tag.Get("locationName"); locName != "" {
+			name = locName
+		}
+
+		// attributes are matched by their local name, without namespace prefix
+		if field.Tag.Get("xmlAttribute") != "" {
+			if i := strings.Index(name, ":"); i >= 0 {
+				name = name[i+1:]
+			}
+		}
+
+		if _, ok := fields[name]; !ok {
+			fields[name] = fieldInfo{index: i, tag: field.Tag}
+		}
+	}
+
+	fieldCache.Lock()
+	fieldCache.m[t] = fields
+	fieldCache.Unlock()
+
+	return fields
+}
@@ -0,0 +1,243 @@
+package xmlutil_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/internal/protocol/xml/xmlutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type listing struct {
+	CommonPrefixes []*commonPrefix `type:"list" flattened:"true"`
+
+	Contents []*object `type:"list" flattened:"true"`
+
+	IsTruncated *bool `type:"boolean"`
+
+	Metadata map[string]*string `type:"map"`
+
+	Name *string `type:"string"`
+
+	Tags map[string]*string `locationName:"Tag" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
+
+	metadataListing `json:"-" xml:"-"`
+}
+
+type metadataListing struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type commonPrefix struct {
+	Prefix *string `type:"string"`
+
+	metadataCommonPrefix `json:"-" xml:"-"`
+}
+
+type metadataCommonPrefix struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type object struct {
+	ETag *string `type:"string"`
+
+	Key *string `type:"string"`
+
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	Owner *owner `type:"structure"`
+
+	Size *int64 `type:"integer"`
+
+	Versions []*string `locationNameList:"Version" type:"list"`
+
+	metadataObject `json:"-" xml:"-"`
+}
+
+type metadataObject struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type owner struct {
+	DisplayName *string `type:"string"`
+
+	ID *string `type:"string"`
+
+	metadataOwner `json:"-" xml:"-"`
+}
+
+type metadataOwner struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type payloadOutput struct {
+	Owner *owner `type:"structure"`
+
+	metadataPayloadOutput `json:"-" xml:"-"`
+}
+
+type metadataPayloadOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Owner"`
+}
+
+type grantee struct {
+	ID *string `type:"string"`
+
+	Type *string `locationName:"xsi:type" type:"string" xmlAttribute:"true"`
+
+	metadataGrantee `json:"-" xml:"-"`
+}
+
+type metadataGrantee struct {
+	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
+}
+
+const listingXML = `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>bucket</Name>
+  <IsTruncated>true</IsTruncated>
+  <Unknown><Nested>skipped</Nested></Unknown>
+  <Contents>
+    <Key>a &amp; b</Key>
+    <LastModified>2015-01-25T08:00:00Z</LastModified>
+    <ETag>&quot;etag&quot;</ETag>
+    <Size>10</Size>
+    <Owner><ID>id</ID><DisplayName>name</DisplayName></Owner>
+    <Versions><Version>1</Version><Version>2</Version></Versions>
+  </Contents>
+  <Contents>
+    <Key><![CDATA[c<d]]></Key>
+    <Size>0</Size>
+    <Versions></Versions>
+  </Contents>
+  <CommonPrefixes><Prefix>photos/</Prefix></CommonPrefixes>
+  <Metadata>
+    <entry><key>a</key><value>1</value></entry>
+    <entry><value>2</value><key>b</key></entry>
+  </Metadata>
+  <Tag><Name>x</Name><Value>y</Value></Tag>
+  <Tag><Name>z</Name><Value>w</Value></Tag>
+</ListBucketResult>`
+
+func TestDecodeXMLMatchesUnmarshalXML(t *testing.T) {
+	tree := listing{}
+	err := xmlutil.UnmarshalXML(&tree, xml.NewDecoder(strings.NewReader(listingXML)), "")
+	assert.NoError(t, err)
+
+	stream := listing{}
+	err = xmlutil.DecodeXML(&stream, xml.NewDecoder(strings.NewReader(listingXML)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, tree, stream)
+}
+
+func TestDecodeXML(t *testing.T) {
+	out := listing{}
+	err := xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader(listingXML)))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "bucket", *out.Name)
+	assert.True(t, *out.IsTruncated)
+	assert.Len(t, out.Contents, 2)
+	assert.Equal(t, "a & b", *out.Contents[0].Key)
+	assert.Equal(t, `"etag"`, *out.Contents[0].ETag)
+	assert.Equal(t, int64(10), *out.Contents[0].Size)
+	assert.Equal(t, time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC), *out.Contents[0].LastModified)
+	assert.Equal(t, "name", *out.Contents[0].Owner.DisplayName)
+	assert.Equal(t, []*string{aString("1"), aString("2")}, out.Contents[0].Versions)
+	assert.Equal(t, "c<d", *out.Contents[1].Key)
+	assert.Nil(t, out.Contents[1].Versions)
+	assert.Equal(t, "photos/", *out.CommonPrefixes[0].Prefix)
+	assert.Equal(t, map[string]*string{"a": aString("1"), "b": aString("2")}, out.Metadata)
+	assert.Equal(t, map[string]*string{"x": aString("y"), "z": aString("w")}, out.Tags)
+}
+
+func TestDecodeXMLPayload(t *testing.T) {
+	out := payloadOutput{}
+	err := xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader(
+		`<Owner><ID>id</ID><DisplayName>name</DisplayName></Owner>`)))
+	assert.NoError(t, err)
+	assert.Equal(t, "id", *out.Owner.ID)
+	assert.Equal(t, "name", *out.Owner.DisplayName)
+}
+
+func TestDecodeXMLAttributes(t *testing.T) {
+	out := grantee{}
+	err := xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader(
+		`<Grantee xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:type="CanonicalUser">`+
+			`<ID>id</ID></Grantee>`)))
+	assert.NoError(t, err)
+	assert.Equal(t, "CanonicalUser", *out.Type)
+	assert.Equal(t, "id", *out.ID)
+}
+
+func TestDecodeXMLEmpty(t *testing.T) {
+	out := listing{}
+	err := xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader("")))
+	assert.NoError(t, err)
+	assert.Nil(t, out.Name)
+}
+
+func TestDecodeXMLErrors(t *testing.T) {
+	out := listing{}
+	err := xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader(
+		`<ListBucketResult><Name>bucket</Name><Contents><Key>a</Key>`)))
+	assert.Equal(t, io.ErrUnexpectedEOF, err)
+
+	out = listing{}
+	err = xmlutil.DecodeXML(&out, xml.NewDecoder(strings.NewReader(
+		`<ListBucketResult><IsTruncated>maybe</IsTruncated></ListBucketResult>`)))
+	assert.Error(t, err)
+}
+
+func aString(s string) *string {
+	return &s
+}
+
+// listingOf returns a listing document of n keys.
+func listingOf(n int) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(`<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Name>bucket</Name>`)
+	buf.WriteString(`<IsTruncated>true</IsTruncated>`)
+	for i := 0; i < n; i++ {
+		fmt.Fprintf(&buf, `<Contents><Key>photos/2015/01/%06d.jpg</Key>`+
+			`<LastModified>2015-01-25T08:00:00Z</LastModified><ETag>"bf1d737a4d46a19f3bced6905cc8b902"</ETag>`+
+			`<Size>%d</Size><Owner><ID>75aa57f09aa0c8caeab4f8c24e99d10f8e7faeebf76c078efc7c6caea54ba06a</ID>`+
+			`<DisplayName>owner</DisplayName></Owner><StorageClass>STANDARD</StorageClass></Contents>`, i, i*1024)
+	}
+	buf.WriteString(`</ListBucketResult>`)
+	return buf.Bytes()
+}
+
+func BenchmarkUnmarshalXMLListing(b *testing.B) {
+	doc := listingOf(1000)
+	b.SetBytes(int64(len(doc)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		out := listing{}
+		if err := xmlutil.UnmarshalXML(&out, xml.NewDecoder(bytes.NewReader(doc)), ""); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeXMLListing(b *testing.B) {
+	doc := listingOf(1000)
+	b.SetBytes(int64(len(doc)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		out := listing{}
+		if err := xmlutil.DecodeXML(&out, xml.NewDecoder(bytes.NewReader(doc))); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
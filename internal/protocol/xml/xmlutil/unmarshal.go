@@ -218,39 +218,45 @@ func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error
 // Error is returned if the deserialization fails due to invalid type conversion,
 // or unsupported interface type.
 func parseScalar(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+	return setScalar(r, node.Text)
+}
+
+// setScalar deserializes the text of an element or attribute into a concrete
+// type based on the interface type of r.
+func setScalar(r reflect.Value, text string) error {
 	switch r.Interface().(type) {
 	case *string:
-		r.Set(reflect.ValueOf(&node.Text))
+		r.Set(reflect.ValueOf(&text))
 		return nil
 	case []byte:
-		b, err := base64.StdEncoding.DecodeString(node.Text)
+		b, err := base64.StdEncoding.DecodeString(text)
 		if err != nil {
 			return err
 		}
 		r.Set(reflect.ValueOf(b))
 	case *bool:
-		v, err := strconv.ParseBool(node.Text)
+		v, err := strconv.ParseBool(text)
 		if err != nil {
 			return err
 		}
 		r.Set(reflect.ValueOf(&v))
 	case *int64:
-		v, err := strconv.ParseInt(node.Text, 10, 64)
+		v, err := strconv.ParseInt(text, 10, 64)
 		if err != nil {
 			return err
 		}
 		r.Set(reflect.ValueOf(&v))
 	case *float64:
-		v, err := strconv.ParseFloat(node.Text, 64)
+		v, err := strconv.ParseFloat(text, 64)
 		if err != nil {
 			return err
 		}
 		r.Set(reflect.ValueOf(&v))
 	case *time.Time:
 		const ISO8601UTC = "2006-01-02T15:04:05Z"
-		t, err := time.Parse(ISO8601UTC, node.Text)
+		t, err := time.Parse(ISO8601UTC, text)
 		if err != nil {
-			t, err = time.Parse("2006-01-02T15:04:05-07:00", node.Text)
+			t, err = time.Parse("2006-01-02T15:04:05-07:00", text)
 			if err != nil {
 				return err
 			}
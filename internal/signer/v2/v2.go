@@ -8,13 +8,16 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
 	"github.com/dongfangx/aws-sdk-go/aws/credentials"
 	"github.com/dongfangx/aws-sdk-go/internal/protocol/rest"
+	"github.com/dongfangx/aws-sdk-go/internal/signer/v4"
 
 	"github.com/dongfangx/aws-sdk-go/aws"
 )
@@ -66,6 +69,7 @@ type signer struct {
 	Credentials *credentials.Credentials
 	Query       url.Values
 	Body        io.ReadSeeker
+	Bucket      string
 	Debug       uint
 	Logger      io.Writer
 
@@ -84,6 +88,12 @@ func Sign(req *aws.Request) {
 		return
 	}
 
+	// Endpoints which only accept signature version 4 are signed with it.
+	if req.Service.SignatureVersion == "v4" {
+		v4.Sign(req)
+		return
+	}
+
 	region := req.Service.SigningRegion
 	if region == "" {
 		region = req.Service.Config.Region
@@ -101,6 +111,7 @@ func Sign(req *aws.Request) {
 		ExpireTime:  req.ExpireTime,
 		Query:       req.HTTPRequest.URL.Query(),
 		Body:        req.Body,
+		Bucket:      bucketParam(req),
 		ServiceName: name,
 		Region:      region,
 		Credentials: req.Service.Config.Credentials,
@@ -111,6 +122,22 @@ func Sign(req *aws.Request) {
 	req.Error = s.sign()
 }
 
+var schemeRE = regexp.MustCompile("^[^:]+://")
+
+// bucketParam returns the bucket of the request, or an empty string if it
+// has none.
+func bucketParam(req *aws.Request) string {
+	if !req.ParamsFilled() {
+		return ""
+	}
+	if v := awsutil.ValuesAtPath(req.Params, "Bucket"); len(v) > 0 {
+		if bucket, ok := v[0].(string); ok {
+			return bucket
+		}
+	}
+	return ""
+}
+
 func (v2 *signer) sign() error {
 	if v2.ExpireTime != 0 {
 		v2.isPresign = true
@@ -217,7 +244,15 @@ func (v2 *signer) buildCanonicalResource() {
 	uri := v2.Request.URL.Opaque
 
 	bucketInHost := ""
-	if !pathStyle {
+	host := v2.Request.URL.Host
+	if v2.Service.Config.S3BucketEndpoint || !strings.Contains(url, schemeRE.ReplaceAllString(endpoint, "")) {
+		// The host is not derived from the service endpoint, as with bucket
+		// endpoints, accelerated and dualstack endpoints. It holds the bucket
+		// only if it names the bucket.
+		if v2.Bucket != "" && (host == v2.Bucket || strings.HasPrefix(host, v2.Bucket+".")) {
+			bucketInHost = v2.Bucket
+		}
+	} else if !pathStyle {
 		if strings.HasPrefix(url, "http://") {
 			url = url[7:]
 			endpoint = endpoint[7:]
@@ -0,0 +1,41 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShapeSetters(t *testing.T) {
+	in := (&s3.PutObjectInput{}).SetBucket("bucket").SetKey("key").SetMetadata(map[string]*string{
+		"foo": aws.String("bar"),
+	})
+
+	assert.Equal(t, &s3.PutObjectInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		Metadata: map[string]*string{"foo": aws.String("bar")},
+	}, in)
+}
+
+func TestShapeGetters(t *testing.T) {
+	var owner *s3.Owner
+	assert.Equal(t, "", owner.GetDisplayName())
+
+	out := &s3.ListObjectsOutput{}
+	assert.False(t, out.GetIsTruncated())
+	assert.Nil(t, out.GetContents())
+	assert.Nil(t, (*s3.ListObjectsOutput)(nil).GetContents())
+
+	out.SetIsTruncated(true).SetContents([]*s3.Object{{Key: aws.String("key")}})
+	assert.True(t, out.GetIsTruncated())
+	assert.Equal(t, "key", out.GetContents()[0].GetKey())
+}
+
+func TestShapeString(t *testing.T) {
+	o := &s3.Owner{DisplayName: aws.String("name"), ID: aws.String("id")}
+	assert.Equal(t, "{\n  DisplayName: \"name\",\n  ID: \"id\"\n}", o.String())
+	assert.Equal(t, o.String(), o.GoString())
+}
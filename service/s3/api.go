@@ -4,11 +4,13 @@
 package s3
 
 import (
-	"github.com/dongfangx/aws-sdk-go/aws"
 	"io"
 	"net/url"
 	"sync"
 	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
 )
 
 var oprw sync.Mutex
@@ -194,11 +196,11 @@ func (c *S3) CreateMultipartUploadRequest(input *CreateMultipartUploadInput) (re
 
 // Initiates a multipart upload and returns an upload ID.
 //
-// Note: After you initiate multipart upload and upload one or more parts,
-// you must either complete or abort multipart upload in order to stop getting
-// charged for storage of the uploaded parts. Only after you either complete
-// or abort multipart upload, Amazon S3 frees up the parts storage and stops
-// charging you for the parts storage.
+// Note: After you initiate multipart upload and upload one or more parts, you
+// must either complete or abort multipart upload in order to stop getting charged
+// for storage of the uploaded parts. Only after you either complete or abort
+// multipart upload, Amazon S3 frees up the parts storage and stops charging
+// you for the parts storage.
 func (c *S3) CreateMultipartUpload(input *CreateMultipartUploadInput) (*CreateMultipartUploadOutput, error) {
 	req, out := c.CreateMultipartUploadRequest(input)
 	err := req.Send()
@@ -252,6 +254,45 @@ func (c *S3) DeleteBucketPresignedUrl(input *DeleteBucketInput, expires time.Dur
 
 var opDeleteBucket *aws.Operation
 
+// DeleteBucketAnalyticsConfigurationRequest generates a request for the DeleteBucketAnalyticsConfiguration operation.
+func (c *S3) DeleteBucketAnalyticsConfigurationRequest(input *DeleteBucketAnalyticsConfigurationInput) (req *aws.Request, output *DeleteBucketAnalyticsConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeleteBucketAnalyticsConfiguration == nil {
+		opDeleteBucketAnalyticsConfiguration = &aws.Operation{
+			Name:       "DeleteBucketAnalyticsConfiguration",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}?analytics",
+		}
+	}
+
+	if input == nil {
+		input = &DeleteBucketAnalyticsConfigurationInput{}
+	}
+
+	req = c.newRequest(opDeleteBucketAnalyticsConfiguration, input, output)
+	output = &DeleteBucketAnalyticsConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Deletes an analytics configuration (identified by the an analytics configuration
+// ID) from the bucket.
+func (c *S3) DeleteBucketAnalyticsConfiguration(input *DeleteBucketAnalyticsConfigurationInput) (*DeleteBucketAnalyticsConfigurationOutput, error) {
+	req, out := c.DeleteBucketAnalyticsConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeleteBucketAnalyticsConfigurationPresignedUrl(input *DeleteBucketAnalyticsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeleteBucketAnalyticsConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeleteBucketAnalyticsConfiguration *aws.Operation
+
 // DeleteBucketCORSRequest generates a request for the DeleteBucketCORS operation.
 func (c *S3) DeleteBucketCORSRequest(input *DeleteBucketCORSInput) (req *aws.Request, output *DeleteBucketCORSOutput) {
 	oprw.Lock()
@@ -290,6 +331,83 @@ func (c *S3) DeleteBucketCORSPresignedUrl(input *DeleteBucketCORSInput, expires
 
 var opDeleteBucketCORS *aws.Operation
 
+// DeleteBucketEncryptionRequest generates a request for the DeleteBucketEncryption operation.
+func (c *S3) DeleteBucketEncryptionRequest(input *DeleteBucketEncryptionInput) (req *aws.Request, output *DeleteBucketEncryptionOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeleteBucketEncryption == nil {
+		opDeleteBucketEncryption = &aws.Operation{
+			Name:       "DeleteBucketEncryption",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}?encryption",
+		}
+	}
+
+	if input == nil {
+		input = &DeleteBucketEncryptionInput{}
+	}
+
+	req = c.newRequest(opDeleteBucketEncryption, input, output)
+	output = &DeleteBucketEncryptionOutput{}
+	req.Data = output
+	return
+}
+
+// Deletes the server-side encryption configuration from the bucket.
+func (c *S3) DeleteBucketEncryption(input *DeleteBucketEncryptionInput) (*DeleteBucketEncryptionOutput, error) {
+	req, out := c.DeleteBucketEncryptionRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeleteBucketEncryptionPresignedUrl(input *DeleteBucketEncryptionInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeleteBucketEncryptionRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeleteBucketEncryption *aws.Operation
+
+// DeleteBucketInventoryConfigurationRequest generates a request for the DeleteBucketInventoryConfiguration operation.
+func (c *S3) DeleteBucketInventoryConfigurationRequest(input *DeleteBucketInventoryConfigurationInput) (req *aws.Request, output *DeleteBucketInventoryConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeleteBucketInventoryConfiguration == nil {
+		opDeleteBucketInventoryConfiguration = &aws.Operation{
+			Name:       "DeleteBucketInventoryConfiguration",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}?inventory",
+		}
+	}
+
+	if input == nil {
+		input = &DeleteBucketInventoryConfigurationInput{}
+	}
+
+	req = c.newRequest(opDeleteBucketInventoryConfiguration, input, output)
+	output = &DeleteBucketInventoryConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Deletes an inventory configuration (identified by the an inventory configuration
+// ID) from the bucket.
+func (c *S3) DeleteBucketInventoryConfiguration(input *DeleteBucketInventoryConfigurationInput) (*DeleteBucketInventoryConfigurationOutput, error) {
+	req, out := c.DeleteBucketInventoryConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeleteBucketInventoryConfigurationPresignedUrl(input *DeleteBucketInventoryConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeleteBucketInventoryConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeleteBucketInventoryConfiguration *aws.Operation
+
 // DeleteBucketLifecycleRequest generates a request for the DeleteBucketLifecycle operation.
 func (c *S3) DeleteBucketLifecycleRequest(input *DeleteBucketLifecycleInput) (req *aws.Request, output *DeleteBucketLifecycleOutput) {
 	oprw.Lock()
@@ -328,6 +446,45 @@ func (c *S3) DeleteBucketLifecyclePresignedUrl(input *DeleteBucketLifecycleInput
 
 var opDeleteBucketLifecycle *aws.Operation
 
+// DeleteBucketMetricsConfigurationRequest generates a request for the DeleteBucketMetricsConfiguration operation.
+func (c *S3) DeleteBucketMetricsConfigurationRequest(input *DeleteBucketMetricsConfigurationInput) (req *aws.Request, output *DeleteBucketMetricsConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeleteBucketMetricsConfiguration == nil {
+		opDeleteBucketMetricsConfiguration = &aws.Operation{
+			Name:       "DeleteBucketMetricsConfiguration",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}?metrics",
+		}
+	}
+
+	if input == nil {
+		input = &DeleteBucketMetricsConfigurationInput{}
+	}
+
+	req = c.newRequest(opDeleteBucketMetricsConfiguration, input, output)
+	output = &DeleteBucketMetricsConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Deletes a metrics configuration (identified by the a metrics configuration
+// ID) from the bucket.
+func (c *S3) DeleteBucketMetricsConfiguration(input *DeleteBucketMetricsConfigurationInput) (*DeleteBucketMetricsConfigurationOutput, error) {
+	req, out := c.DeleteBucketMetricsConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeleteBucketMetricsConfigurationPresignedUrl(input *DeleteBucketMetricsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeleteBucketMetricsConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeleteBucketMetricsConfiguration *aws.Operation
+
 // DeleteBucketPolicyRequest generates a request for the DeleteBucketPolicy operation.
 func (c *S3) DeleteBucketPolicyRequest(input *DeleteBucketPolicyInput) (req *aws.Request, output *DeleteBucketPolicyOutput) {
 	oprw.Lock()
@@ -519,6 +676,44 @@ func (c *S3) DeleteObjectPresignedUrl(input *DeleteObjectInput, expires time.Dur
 
 var opDeleteObject *aws.Operation
 
+// DeleteObjectTaggingRequest generates a request for the DeleteObjectTagging operation.
+func (c *S3) DeleteObjectTaggingRequest(input *DeleteObjectTaggingInput) (req *aws.Request, output *DeleteObjectTaggingOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeleteObjectTagging == nil {
+		opDeleteObjectTagging = &aws.Operation{
+			Name:       "DeleteObjectTagging",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}/{Key+}?tagging",
+		}
+	}
+
+	if input == nil {
+		input = &DeleteObjectTaggingInput{}
+	}
+
+	req = c.newRequest(opDeleteObjectTagging, input, output)
+	output = &DeleteObjectTaggingOutput{}
+	req.Data = output
+	return
+}
+
+// Removes the tag-set from an existing object.
+func (c *S3) DeleteObjectTagging(input *DeleteObjectTaggingInput) (*DeleteObjectTaggingOutput, error) {
+	req, out := c.DeleteObjectTaggingRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeleteObjectTaggingPresignedUrl(input *DeleteObjectTaggingInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeleteObjectTaggingRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeleteObjectTagging *aws.Operation
+
 // DeleteObjectsRequest generates a request for the DeleteObjects operation.
 func (c *S3) DeleteObjectsRequest(input *DeleteObjectsInput) (req *aws.Request, output *DeleteObjectsOutput) {
 	oprw.Lock()
@@ -558,6 +753,44 @@ func (c *S3) DeleteObjectsPresignedUrl(input *DeleteObjectsInput, expires time.D
 
 var opDeleteObjects *aws.Operation
 
+// DeletePublicAccessBlockRequest generates a request for the DeletePublicAccessBlock operation.
+func (c *S3) DeletePublicAccessBlockRequest(input *DeletePublicAccessBlockInput) (req *aws.Request, output *DeletePublicAccessBlockOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opDeletePublicAccessBlock == nil {
+		opDeletePublicAccessBlock = &aws.Operation{
+			Name:       "DeletePublicAccessBlock",
+			HTTPMethod: "DELETE",
+			HTTPPath:   "/{Bucket}?publicAccessBlock",
+		}
+	}
+
+	if input == nil {
+		input = &DeletePublicAccessBlockInput{}
+	}
+
+	req = c.newRequest(opDeletePublicAccessBlock, input, output)
+	output = &DeletePublicAccessBlockOutput{}
+	req.Data = output
+	return
+}
+
+// Removes the PublicAccessBlock configuration from an Amazon S3 bucket.
+func (c *S3) DeletePublicAccessBlock(input *DeletePublicAccessBlockInput) (*DeletePublicAccessBlockOutput, error) {
+	req, out := c.DeletePublicAccessBlockRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) DeletePublicAccessBlockPresignedUrl(input *DeletePublicAccessBlockInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.DeletePublicAccessBlockRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opDeletePublicAccessBlock *aws.Operation
+
 // GetBucketACLRequest generates a request for the GetBucketACL operation.
 func (c *S3) GetBucketACLRequest(input *GetBucketACLInput) (req *aws.Request, output *GetBucketACLOutput) {
 	oprw.Lock()
@@ -596,6 +829,45 @@ func (c *S3) GetBucketACLPresignedUrl(input *GetBucketACLInput, expires time.Dur
 
 var opGetBucketACL *aws.Operation
 
+// GetBucketAnalyticsConfigurationRequest generates a request for the GetBucketAnalyticsConfiguration operation.
+func (c *S3) GetBucketAnalyticsConfigurationRequest(input *GetBucketAnalyticsConfigurationInput) (req *aws.Request, output *GetBucketAnalyticsConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetBucketAnalyticsConfiguration == nil {
+		opGetBucketAnalyticsConfiguration = &aws.Operation{
+			Name:       "GetBucketAnalyticsConfiguration",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?analytics",
+		}
+	}
+
+	if input == nil {
+		input = &GetBucketAnalyticsConfigurationInput{}
+	}
+
+	req = c.newRequest(opGetBucketAnalyticsConfiguration, input, output)
+	output = &GetBucketAnalyticsConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Gets an analytics configuration (identified by the an analytics configuration
+// ID) from the bucket.
+func (c *S3) GetBucketAnalyticsConfiguration(input *GetBucketAnalyticsConfigurationInput) (*GetBucketAnalyticsConfigurationOutput, error) {
+	req, out := c.GetBucketAnalyticsConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetBucketAnalyticsConfigurationPresignedUrl(input *GetBucketAnalyticsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketAnalyticsConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetBucketAnalyticsConfiguration *aws.Operation
+
 // GetBucketCORSRequest generates a request for the GetBucketCORS operation.
 func (c *S3) GetBucketCORSRequest(input *GetBucketCORSInput) (req *aws.Request, output *GetBucketCORSOutput) {
 	oprw.Lock()
@@ -634,6 +906,83 @@ func (c *S3) GetBucketCORSPresignedUrl(input *GetBucketCORSInput, expires time.D
 
 var opGetBucketCORS *aws.Operation
 
+// GetBucketEncryptionRequest generates a request for the GetBucketEncryption operation.
+func (c *S3) GetBucketEncryptionRequest(input *GetBucketEncryptionInput) (req *aws.Request, output *GetBucketEncryptionOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetBucketEncryption == nil {
+		opGetBucketEncryption = &aws.Operation{
+			Name:       "GetBucketEncryption",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?encryption",
+		}
+	}
+
+	if input == nil {
+		input = &GetBucketEncryptionInput{}
+	}
+
+	req = c.newRequest(opGetBucketEncryption, input, output)
+	output = &GetBucketEncryptionOutput{}
+	req.Data = output
+	return
+}
+
+// Returns the server-side encryption configuration of a bucket.
+func (c *S3) GetBucketEncryption(input *GetBucketEncryptionInput) (*GetBucketEncryptionOutput, error) {
+	req, out := c.GetBucketEncryptionRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetBucketEncryptionPresignedUrl(input *GetBucketEncryptionInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketEncryptionRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetBucketEncryption *aws.Operation
+
+// GetBucketInventoryConfigurationRequest generates a request for the GetBucketInventoryConfiguration operation.
+func (c *S3) GetBucketInventoryConfigurationRequest(input *GetBucketInventoryConfigurationInput) (req *aws.Request, output *GetBucketInventoryConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetBucketInventoryConfiguration == nil {
+		opGetBucketInventoryConfiguration = &aws.Operation{
+			Name:       "GetBucketInventoryConfiguration",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?inventory",
+		}
+	}
+
+	if input == nil {
+		input = &GetBucketInventoryConfigurationInput{}
+	}
+
+	req = c.newRequest(opGetBucketInventoryConfiguration, input, output)
+	output = &GetBucketInventoryConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Gets an inventory configuration (identified by the an inventory configuration
+// ID) from the bucket.
+func (c *S3) GetBucketInventoryConfiguration(input *GetBucketInventoryConfigurationInput) (*GetBucketInventoryConfigurationOutput, error) {
+	req, out := c.GetBucketInventoryConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetBucketInventoryConfigurationPresignedUrl(input *GetBucketInventoryConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketInventoryConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetBucketInventoryConfiguration *aws.Operation
+
 // GetBucketLifecycleRequest generates a request for the GetBucketLifecycle operation.
 func (c *S3) GetBucketLifecycleRequest(input *GetBucketLifecycleInput) (req *aws.Request, output *GetBucketLifecycleOutput) {
 	oprw.Lock()
@@ -672,6 +1021,44 @@ func (c *S3) GetBucketLifecyclePresignedUrl(input *GetBucketLifecycleInput, expi
 
 var opGetBucketLifecycle *aws.Operation
 
+// GetBucketLifecycleConfigurationRequest generates a request for the GetBucketLifecycleConfiguration operation.
+func (c *S3) GetBucketLifecycleConfigurationRequest(input *GetBucketLifecycleConfigurationInput) (req *aws.Request, output *GetBucketLifecycleConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetBucketLifecycleConfiguration == nil {
+		opGetBucketLifecycleConfiguration = &aws.Operation{
+			Name:       "GetBucketLifecycleConfiguration",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?lifecycle",
+		}
+	}
+
+	if input == nil {
+		input = &GetBucketLifecycleConfigurationInput{}
+	}
+
+	req = c.newRequest(opGetBucketLifecycleConfiguration, input, output)
+	output = &GetBucketLifecycleConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Returns the lifecycle configuration information set on the bucket.
+func (c *S3) GetBucketLifecycleConfiguration(input *GetBucketLifecycleConfigurationInput) (*GetBucketLifecycleConfigurationOutput, error) {
+	req, out := c.GetBucketLifecycleConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetBucketLifecycleConfigurationPresignedUrl(input *GetBucketLifecycleConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketLifecycleConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetBucketLifecycleConfiguration *aws.Operation
+
 // GetBucketLocationRequest generates a request for the GetBucketLocation operation.
 func (c *S3) GetBucketLocationRequest(input *GetBucketLocationInput) (req *aws.Request, output *GetBucketLocationOutput) {
 	oprw.Lock()
@@ -749,51 +1136,90 @@ func (c *S3) GetBucketLoggingPresignedUrl(input *GetBucketLoggingInput, expires
 
 var opGetBucketLogging *aws.Operation
 
-// GetBucketNotificationRequest generates a request for the GetBucketNotification operation.
-func (c *S3) GetBucketNotificationRequest(input *GetBucketNotificationConfigurationRequest) (req *aws.Request, output *NotificationConfigurationDeprecated) {
+// GetBucketMetricsConfigurationRequest generates a request for the GetBucketMetricsConfiguration operation.
+func (c *S3) GetBucketMetricsConfigurationRequest(input *GetBucketMetricsConfigurationInput) (req *aws.Request, output *GetBucketMetricsConfigurationOutput) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opGetBucketNotification == nil {
-		opGetBucketNotification = &aws.Operation{
-			Name:       "GetBucketNotification",
+	if opGetBucketMetricsConfiguration == nil {
+		opGetBucketMetricsConfiguration = &aws.Operation{
+			Name:       "GetBucketMetricsConfiguration",
 			HTTPMethod: "GET",
-			HTTPPath:   "/{Bucket}?notification",
+			HTTPPath:   "/{Bucket}?metrics",
 		}
 	}
 
 	if input == nil {
-		input = &GetBucketNotificationConfigurationRequest{}
+		input = &GetBucketMetricsConfigurationInput{}
 	}
 
-	req = c.newRequest(opGetBucketNotification, input, output)
-	output = &NotificationConfigurationDeprecated{}
+	req = c.newRequest(opGetBucketMetricsConfiguration, input, output)
+	output = &GetBucketMetricsConfigurationOutput{}
 	req.Data = output
 	return
 }
 
-// Deprecated, see the GetBucketNotificationConfiguration operation.
-func (c *S3) GetBucketNotification(input *GetBucketNotificationConfigurationRequest) (*NotificationConfigurationDeprecated, error) {
-	req, out := c.GetBucketNotificationRequest(input)
+// Gets a metrics configuration (identified by the a metrics configuration ID)
+// from the bucket.
+func (c *S3) GetBucketMetricsConfiguration(input *GetBucketMetricsConfigurationInput) (*GetBucketMetricsConfigurationOutput, error) {
+	req, out := c.GetBucketMetricsConfigurationRequest(input)
 	err := req.Send()
 	return out, err
 }
-func (c *S3) GetBucketNotificationPresignedUrl(input *GetBucketNotificationConfigurationRequest, expires time.Duration) (*url.URL, error) {
-	req, _ := c.GetBucketNotificationRequest(input)
+func (c *S3) GetBucketMetricsConfigurationPresignedUrl(input *GetBucketMetricsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketMetricsConfigurationRequest(input)
 	req.ExpireTime = expires
 	err := req.Sign()
 	return req.HTTPRequest.URL, err
 }
 
-var opGetBucketNotification *aws.Operation
+var opGetBucketMetricsConfiguration *aws.Operation
 
-// GetBucketNotificationConfigurationRequest generates a request for the GetBucketNotificationConfiguration operation.
-func (c *S3) GetBucketNotificationConfigurationRequest(input *GetBucketNotificationConfigurationRequest) (req *aws.Request, output *NotificationConfiguration) {
+// GetBucketNotificationRequest generates a request for the GetBucketNotification operation.
+func (c *S3) GetBucketNotificationRequest(input *GetBucketNotificationConfigurationRequest) (req *aws.Request, output *NotificationConfigurationDeprecated) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opGetBucketNotificationConfiguration == nil {
-		opGetBucketNotificationConfiguration = &aws.Operation{
+	if opGetBucketNotification == nil {
+		opGetBucketNotification = &aws.Operation{
+			Name:       "GetBucketNotification",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?notification",
+		}
+	}
+
+	if input == nil {
+		input = &GetBucketNotificationConfigurationRequest{}
+	}
+
+	req = c.newRequest(opGetBucketNotification, input, output)
+	output = &NotificationConfigurationDeprecated{}
+	req.Data = output
+	return
+}
+
+// Deprecated, see the GetBucketNotificationConfiguration operation.
+func (c *S3) GetBucketNotification(input *GetBucketNotificationConfigurationRequest) (*NotificationConfigurationDeprecated, error) {
+	req, out := c.GetBucketNotificationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetBucketNotificationPresignedUrl(input *GetBucketNotificationConfigurationRequest, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetBucketNotificationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetBucketNotification *aws.Operation
+
+// GetBucketNotificationConfigurationRequest generates a request for the GetBucketNotificationConfiguration operation.
+func (c *S3) GetBucketNotificationConfigurationRequest(input *GetBucketNotificationConfigurationRequest) (req *aws.Request, output *NotificationConfiguration) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetBucketNotificationConfiguration == nil {
+		opGetBucketNotificationConfiguration = &aws.Operation{
 			Name:       "GetBucketNotificationConfiguration",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}?notification",
@@ -1128,6 +1554,160 @@ func (c *S3) GetObjectACLPresignedUrl(input *GetObjectACLInput, expires time.Dur
 
 var opGetObjectACL *aws.Operation
 
+// GetObjectLegalHoldRequest generates a request for the GetObjectLegalHold operation.
+func (c *S3) GetObjectLegalHoldRequest(input *GetObjectLegalHoldInput) (req *aws.Request, output *GetObjectLegalHoldOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetObjectLegalHold == nil {
+		opGetObjectLegalHold = &aws.Operation{
+			Name:       "GetObjectLegalHold",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+		}
+	}
+
+	if input == nil {
+		input = &GetObjectLegalHoldInput{}
+	}
+
+	req = c.newRequest(opGetObjectLegalHold, input, output)
+	output = &GetObjectLegalHoldOutput{}
+	req.Data = output
+	return
+}
+
+// Gets an object's current Legal Hold status.
+func (c *S3) GetObjectLegalHold(input *GetObjectLegalHoldInput) (*GetObjectLegalHoldOutput, error) {
+	req, out := c.GetObjectLegalHoldRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetObjectLegalHoldPresignedUrl(input *GetObjectLegalHoldInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetObjectLegalHoldRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetObjectLegalHold *aws.Operation
+
+// GetObjectLockConfigurationRequest generates a request for the GetObjectLockConfiguration operation.
+func (c *S3) GetObjectLockConfigurationRequest(input *GetObjectLockConfigurationInput) (req *aws.Request, output *GetObjectLockConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetObjectLockConfiguration == nil {
+		opGetObjectLockConfiguration = &aws.Operation{
+			Name:       "GetObjectLockConfiguration",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?object-lock",
+		}
+	}
+
+	if input == nil {
+		input = &GetObjectLockConfigurationInput{}
+	}
+
+	req = c.newRequest(opGetObjectLockConfiguration, input, output)
+	output = &GetObjectLockConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Gets the Object Lock configuration for a bucket. The rule specified in the
+// Object Lock configuration will be applied by default to every new object
+// placed in the specified bucket.
+func (c *S3) GetObjectLockConfiguration(input *GetObjectLockConfigurationInput) (*GetObjectLockConfigurationOutput, error) {
+	req, out := c.GetObjectLockConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetObjectLockConfigurationPresignedUrl(input *GetObjectLockConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetObjectLockConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetObjectLockConfiguration *aws.Operation
+
+// GetObjectRetentionRequest generates a request for the GetObjectRetention operation.
+func (c *S3) GetObjectRetentionRequest(input *GetObjectRetentionInput) (req *aws.Request, output *GetObjectRetentionOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetObjectRetention == nil {
+		opGetObjectRetention = &aws.Operation{
+			Name:       "GetObjectRetention",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}/{Key+}?retention",
+		}
+	}
+
+	if input == nil {
+		input = &GetObjectRetentionInput{}
+	}
+
+	req = c.newRequest(opGetObjectRetention, input, output)
+	output = &GetObjectRetentionOutput{}
+	req.Data = output
+	return
+}
+
+// Retrieves an object's retention settings.
+func (c *S3) GetObjectRetention(input *GetObjectRetentionInput) (*GetObjectRetentionOutput, error) {
+	req, out := c.GetObjectRetentionRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetObjectRetentionPresignedUrl(input *GetObjectRetentionInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetObjectRetentionRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetObjectRetention *aws.Operation
+
+// GetObjectTaggingRequest generates a request for the GetObjectTagging operation.
+func (c *S3) GetObjectTaggingRequest(input *GetObjectTaggingInput) (req *aws.Request, output *GetObjectTaggingOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetObjectTagging == nil {
+		opGetObjectTagging = &aws.Operation{
+			Name:       "GetObjectTagging",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}/{Key+}?tagging",
+		}
+	}
+
+	if input == nil {
+		input = &GetObjectTaggingInput{}
+	}
+
+	req = c.newRequest(opGetObjectTagging, input, output)
+	output = &GetObjectTaggingOutput{}
+	req.Data = output
+	return
+}
+
+// Returns the tag-set of an object.
+func (c *S3) GetObjectTagging(input *GetObjectTaggingInput) (*GetObjectTaggingOutput, error) {
+	req, out := c.GetObjectTaggingRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetObjectTaggingPresignedUrl(input *GetObjectTaggingInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetObjectTaggingRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetObjectTagging *aws.Operation
+
 // GetObjectTorrentRequest generates a request for the GetObjectTorrent operation.
 func (c *S3) GetObjectTorrentRequest(input *GetObjectTorrentInput) (req *aws.Request, output *GetObjectTorrentOutput) {
 	oprw.Lock()
@@ -1166,6 +1746,44 @@ func (c *S3) GetObjectTorrentPresignedUrl(input *GetObjectTorrentInput, expires
 
 var opGetObjectTorrent *aws.Operation
 
+// GetPublicAccessBlockRequest generates a request for the GetPublicAccessBlock operation.
+func (c *S3) GetPublicAccessBlockRequest(input *GetPublicAccessBlockInput) (req *aws.Request, output *GetPublicAccessBlockOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opGetPublicAccessBlock == nil {
+		opGetPublicAccessBlock = &aws.Operation{
+			Name:       "GetPublicAccessBlock",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?publicAccessBlock",
+		}
+	}
+
+	if input == nil {
+		input = &GetPublicAccessBlockInput{}
+	}
+
+	req = c.newRequest(opGetPublicAccessBlock, input, output)
+	output = &GetPublicAccessBlockOutput{}
+	req.Data = output
+	return
+}
+
+// Retrieves the PublicAccessBlock configuration for an Amazon S3 bucket.
+func (c *S3) GetPublicAccessBlock(input *GetPublicAccessBlockInput) (*GetPublicAccessBlockOutput, error) {
+	req, out := c.GetPublicAccessBlockRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) GetPublicAccessBlockPresignedUrl(input *GetPublicAccessBlockInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.GetPublicAccessBlockRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opGetPublicAccessBlock *aws.Operation
+
 // HeadBucketRequest generates a request for the HeadBucket operation.
 func (c *S3) HeadBucketRequest(input *HeadBucketInput) (req *aws.Request, output *HeadBucketOutput) {
 	oprw.Lock()
@@ -1245,6 +1863,120 @@ func (c *S3) HeadObjectPresignedUrl(input *HeadObjectInput, expires time.Duratio
 
 var opHeadObject *aws.Operation
 
+// ListBucketAnalyticsConfigurationsRequest generates a request for the ListBucketAnalyticsConfigurations operation.
+func (c *S3) ListBucketAnalyticsConfigurationsRequest(input *ListBucketAnalyticsConfigurationsInput) (req *aws.Request, output *ListBucketAnalyticsConfigurationsOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opListBucketAnalyticsConfigurations == nil {
+		opListBucketAnalyticsConfigurations = &aws.Operation{
+			Name:       "ListBucketAnalyticsConfigurations",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?analytics",
+		}
+	}
+
+	if input == nil {
+		input = &ListBucketAnalyticsConfigurationsInput{}
+	}
+
+	req = c.newRequest(opListBucketAnalyticsConfigurations, input, output)
+	output = &ListBucketAnalyticsConfigurationsOutput{}
+	req.Data = output
+	return
+}
+
+// Lists the an analytics configurations for the bucket.
+func (c *S3) ListBucketAnalyticsConfigurations(input *ListBucketAnalyticsConfigurationsInput) (*ListBucketAnalyticsConfigurationsOutput, error) {
+	req, out := c.ListBucketAnalyticsConfigurationsRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) ListBucketAnalyticsConfigurationsPresignedUrl(input *ListBucketAnalyticsConfigurationsInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.ListBucketAnalyticsConfigurationsRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opListBucketAnalyticsConfigurations *aws.Operation
+
+// ListBucketInventoryConfigurationsRequest generates a request for the ListBucketInventoryConfigurations operation.
+func (c *S3) ListBucketInventoryConfigurationsRequest(input *ListBucketInventoryConfigurationsInput) (req *aws.Request, output *ListBucketInventoryConfigurationsOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opListBucketInventoryConfigurations == nil {
+		opListBucketInventoryConfigurations = &aws.Operation{
+			Name:       "ListBucketInventoryConfigurations",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?inventory",
+		}
+	}
+
+	if input == nil {
+		input = &ListBucketInventoryConfigurationsInput{}
+	}
+
+	req = c.newRequest(opListBucketInventoryConfigurations, input, output)
+	output = &ListBucketInventoryConfigurationsOutput{}
+	req.Data = output
+	return
+}
+
+// Lists the an inventory configurations for the bucket.
+func (c *S3) ListBucketInventoryConfigurations(input *ListBucketInventoryConfigurationsInput) (*ListBucketInventoryConfigurationsOutput, error) {
+	req, out := c.ListBucketInventoryConfigurationsRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) ListBucketInventoryConfigurationsPresignedUrl(input *ListBucketInventoryConfigurationsInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.ListBucketInventoryConfigurationsRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opListBucketInventoryConfigurations *aws.Operation
+
+// ListBucketMetricsConfigurationsRequest generates a request for the ListBucketMetricsConfigurations operation.
+func (c *S3) ListBucketMetricsConfigurationsRequest(input *ListBucketMetricsConfigurationsInput) (req *aws.Request, output *ListBucketMetricsConfigurationsOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opListBucketMetricsConfigurations == nil {
+		opListBucketMetricsConfigurations = &aws.Operation{
+			Name:       "ListBucketMetricsConfigurations",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}?metrics",
+		}
+	}
+
+	if input == nil {
+		input = &ListBucketMetricsConfigurationsInput{}
+	}
+
+	req = c.newRequest(opListBucketMetricsConfigurations, input, output)
+	output = &ListBucketMetricsConfigurationsOutput{}
+	req.Data = output
+	return
+}
+
+// Lists the a metrics configurations for the bucket.
+func (c *S3) ListBucketMetricsConfigurations(input *ListBucketMetricsConfigurationsInput) (*ListBucketMetricsConfigurationsOutput, error) {
+	req, out := c.ListBucketMetricsConfigurationsRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) ListBucketMetricsConfigurationsPresignedUrl(input *ListBucketMetricsConfigurationsInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.ListBucketMetricsConfigurationsRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opListBucketMetricsConfigurations *aws.Operation
+
 // ListBucketsRequest generates a request for the ListBuckets operation.
 func (c *S3) ListBucketsRequest(input *ListBucketsInput) (req *aws.Request, output *ListBucketsOutput) {
 	oprw.Lock()
@@ -1298,6 +2030,7 @@ func (c *S3) ListMultipartUploadsRequest(input *ListMultipartUploadsInput) (req
 				OutputTokens:    []string{"NextKeyMarker", "NextUploadIdMarker"},
 				LimitToken:      "MaxUploads",
 				TruncationToken: "IsTruncated",
+				ResultKeys:      []string{"Uploads", "CommonPrefixes"},
 			},
 		}
 	}
@@ -1326,10 +2059,35 @@ func (c *S3) ListMultipartUploadsPresignedUrl(input *ListMultipartUploadsInput,
 }
 
 func (c *S3) ListMultipartUploadsPages(input *ListMultipartUploadsInput, fn func(p *ListMultipartUploadsOutput, lastPage bool) (shouldContinue bool)) error {
-	page, _ := c.ListMultipartUploadsRequest(input)
-	return page.EachPage(func(p interface{}, lastPage bool) bool {
-		return fn(p.(*ListMultipartUploadsOutput), lastPage)
-	})
+	p := c.ListMultipartUploadsPaginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// ListMultipartUploadsPaginator returns a paginator over the pages of ListMultipartUploads
+// results, starting with the page of input.
+func (c *S3) ListMultipartUploadsPaginator(input *ListMultipartUploadsInput) *ListMultipartUploadsPaginator {
+	return &ListMultipartUploadsPaginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.ListMultipartUploadsRequest(input)
+			return req
+		},
+	}}
+}
+
+// ListMultipartUploadsPaginator iterates over the pages of ListMultipartUploads results.
+type ListMultipartUploadsPaginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *ListMultipartUploadsPaginator) Page() *ListMultipartUploadsOutput {
+	page, _ := p.Pagination.Page().(*ListMultipartUploadsOutput)
+	return page
 }
 
 var opListMultipartUploads *aws.Operation
@@ -1349,6 +2107,7 @@ func (c *S3) ListObjectVersionsRequest(input *ListObjectVersionsInput) (req *aws
 				OutputTokens:    []string{"NextKeyMarker", "NextVersionIdMarker"},
 				LimitToken:      "MaxKeys",
 				TruncationToken: "IsTruncated",
+				ResultKeys:      []string{"Versions", "DeleteMarkers", "CommonPrefixes"},
 			},
 		}
 	}
@@ -1377,10 +2136,35 @@ func (c *S3) ListObjectVersionsPresignedUrl(input *ListObjectVersionsInput, expi
 }
 
 func (c *S3) ListObjectVersionsPages(input *ListObjectVersionsInput, fn func(p *ListObjectVersionsOutput, lastPage bool) (shouldContinue bool)) error {
-	page, _ := c.ListObjectVersionsRequest(input)
-	return page.EachPage(func(p interface{}, lastPage bool) bool {
-		return fn(p.(*ListObjectVersionsOutput), lastPage)
-	})
+	p := c.ListObjectVersionsPaginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// ListObjectVersionsPaginator returns a paginator over the pages of ListObjectVersions
+// results, starting with the page of input.
+func (c *S3) ListObjectVersionsPaginator(input *ListObjectVersionsInput) *ListObjectVersionsPaginator {
+	return &ListObjectVersionsPaginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.ListObjectVersionsRequest(input)
+			return req
+		},
+	}}
+}
+
+// ListObjectVersionsPaginator iterates over the pages of ListObjectVersions results.
+type ListObjectVersionsPaginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *ListObjectVersionsPaginator) Page() *ListObjectVersionsOutput {
+	page, _ := p.Pagination.Page().(*ListObjectVersionsOutput)
+	return page
 }
 
 var opListObjectVersions *aws.Operation
@@ -1400,6 +2184,7 @@ func (c *S3) ListObjectsRequest(input *ListObjectsInput) (req *aws.Request, outp
 				OutputTokens:    []string{"NextMarker || Contents[-1].Key"},
 				LimitToken:      "MaxKeys",
 				TruncationToken: "IsTruncated",
+				ResultKeys:      []string{"Contents", "CommonPrefixes"},
 			},
 		}
 	}
@@ -1430,29 +2215,135 @@ func (c *S3) ListObjectsPresignedUrl(input *ListObjectsInput, expires time.Durat
 }
 
 func (c *S3) ListObjectsPages(input *ListObjectsInput, fn func(p *ListObjectsOutput, lastPage bool) (shouldContinue bool)) error {
-	page, _ := c.ListObjectsRequest(input)
-	return page.EachPage(func(p interface{}, lastPage bool) bool {
-		return fn(p.(*ListObjectsOutput), lastPage)
-	})
+	p := c.ListObjectsPaginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// ListObjectsPaginator returns a paginator over the pages of ListObjects
+// results, starting with the page of input.
+func (c *S3) ListObjectsPaginator(input *ListObjectsInput) *ListObjectsPaginator {
+	return &ListObjectsPaginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.ListObjectsRequest(input)
+			return req
+		},
+	}}
+}
+
+// ListObjectsPaginator iterates over the pages of ListObjects results.
+type ListObjectsPaginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *ListObjectsPaginator) Page() *ListObjectsOutput {
+	page, _ := p.Pagination.Page().(*ListObjectsOutput)
+	return page
 }
 
 var opListObjects *aws.Operation
 
-// ListPartsRequest generates a request for the ListParts operation.
-func (c *S3) ListPartsRequest(input *ListPartsInput) (req *aws.Request, output *ListPartsOutput) {
+// ListObjectsV2Request generates a request for the ListObjectsV2 operation.
+func (c *S3) ListObjectsV2Request(input *ListObjectsV2Input) (req *aws.Request, output *ListObjectsV2Output) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opListParts == nil {
-		opListParts = &aws.Operation{
-			Name:       "ListParts",
+	if opListObjectsV2 == nil {
+		opListObjectsV2 = &aws.Operation{
+			Name:       "ListObjectsV2",
 			HTTPMethod: "GET",
-			HTTPPath:   "/{Bucket}/{Key+}",
+			HTTPPath:   "/{Bucket}?list-type=2",
 			Paginator: &aws.Paginator{
-				InputTokens:     []string{"PartNumberMarker"},
-				OutputTokens:    []string{"NextPartNumberMarker"},
+				InputTokens:     []string{"ContinuationToken"},
+				OutputTokens:    []string{"NextContinuationToken"},
+				LimitToken:      "MaxKeys",
+				TruncationToken: "",
+				ResultKeys:      []string{"Contents", "CommonPrefixes"},
+			},
+		}
+	}
+
+	if input == nil {
+		input = &ListObjectsV2Input{}
+	}
+
+	req = c.newRequest(opListObjectsV2, input, output)
+	output = &ListObjectsV2Output{}
+	req.Data = output
+	return
+}
+
+// Returns some or all (up to 1000) of the objects in a bucket. You can use
+// the request parameters as selection criteria to return a subset of the objects
+// in a bucket. Note: ListObjectsV2 is the revised List Objects API and we recommend
+// you use this revised API for new application development.
+func (c *S3) ListObjectsV2(input *ListObjectsV2Input) (*ListObjectsV2Output, error) {
+	req, out := c.ListObjectsV2Request(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) ListObjectsV2PresignedUrl(input *ListObjectsV2Input, expires time.Duration) (*url.URL, error) {
+	req, _ := c.ListObjectsV2Request(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+func (c *S3) ListObjectsV2Pages(input *ListObjectsV2Input, fn func(p *ListObjectsV2Output, lastPage bool) (shouldContinue bool)) error {
+	p := c.ListObjectsV2Paginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// ListObjectsV2Paginator returns a paginator over the pages of ListObjectsV2
+// results, starting with the page of input.
+func (c *S3) ListObjectsV2Paginator(input *ListObjectsV2Input) *ListObjectsV2Paginator {
+	return &ListObjectsV2Paginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.ListObjectsV2Request(input)
+			return req
+		},
+	}}
+}
+
+// ListObjectsV2Paginator iterates over the pages of ListObjectsV2 results.
+type ListObjectsV2Paginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *ListObjectsV2Paginator) Page() *ListObjectsV2Output {
+	page, _ := p.Pagination.Page().(*ListObjectsV2Output)
+	return page
+}
+
+var opListObjectsV2 *aws.Operation
+
+// ListPartsRequest generates a request for the ListParts operation.
+func (c *S3) ListPartsRequest(input *ListPartsInput) (req *aws.Request, output *ListPartsOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opListParts == nil {
+		opListParts = &aws.Operation{
+			Name:       "ListParts",
+			HTTPMethod: "GET",
+			HTTPPath:   "/{Bucket}/{Key+}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PartNumberMarker"},
+				OutputTokens:    []string{"NextPartNumberMarker"},
 				LimitToken:      "MaxParts",
 				TruncationToken: "IsTruncated",
+				ResultKeys:      []string{"Parts"},
 			},
 		}
 	}
@@ -1481,10 +2372,35 @@ func (c *S3) ListPartsPresignedUrl(input *ListPartsInput, expires time.Duration)
 }
 
 func (c *S3) ListPartsPages(input *ListPartsInput, fn func(p *ListPartsOutput, lastPage bool) (shouldContinue bool)) error {
-	page, _ := c.ListPartsRequest(input)
-	return page.EachPage(func(p interface{}, lastPage bool) bool {
-		return fn(p.(*ListPartsOutput), lastPage)
-	})
+	p := c.ListPartsPaginator(input)
+	for p.Next() {
+		if !fn(p.Page(), !p.HasNextPage()) {
+			break
+		}
+	}
+	return p.Err()
+}
+
+// ListPartsPaginator returns a paginator over the pages of ListParts
+// results, starting with the page of input.
+func (c *S3) ListPartsPaginator(input *ListPartsInput) *ListPartsPaginator {
+	return &ListPartsPaginator{aws.Pagination{
+		NewRequest: func() *aws.Request {
+			req, _ := c.ListPartsRequest(input)
+			return req
+		},
+	}}
+}
+
+// ListPartsPaginator iterates over the pages of ListParts results.
+type ListPartsPaginator struct {
+	aws.Pagination
+}
+
+// Page returns the current page, or nil before the first call to Next.
+func (p *ListPartsPaginator) Page() *ListPartsOutput {
+	page, _ := p.Pagination.Page().(*ListPartsOutput)
+	return page
 }
 
 var opListParts *aws.Operation
@@ -1527,6 +2443,45 @@ func (c *S3) PutBucketACLPresignedUrl(input *PutBucketACLInput, expires time.Dur
 
 var opPutBucketACL *aws.Operation
 
+// PutBucketAnalyticsConfigurationRequest generates a request for the PutBucketAnalyticsConfiguration operation.
+func (c *S3) PutBucketAnalyticsConfigurationRequest(input *PutBucketAnalyticsConfigurationInput) (req *aws.Request, output *PutBucketAnalyticsConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opPutBucketAnalyticsConfiguration == nil {
+		opPutBucketAnalyticsConfiguration = &aws.Operation{
+			Name:       "PutBucketAnalyticsConfiguration",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?analytics",
+		}
+	}
+
+	if input == nil {
+		input = &PutBucketAnalyticsConfigurationInput{}
+	}
+
+	req = c.newRequest(opPutBucketAnalyticsConfiguration, input, output)
+	output = &PutBucketAnalyticsConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Sets an analytics configuration (identified by the an analytics configuration
+// ID) for the bucket.
+func (c *S3) PutBucketAnalyticsConfiguration(input *PutBucketAnalyticsConfigurationInput) (*PutBucketAnalyticsConfigurationOutput, error) {
+	req, out := c.PutBucketAnalyticsConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutBucketAnalyticsConfigurationPresignedUrl(input *PutBucketAnalyticsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutBucketAnalyticsConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opPutBucketAnalyticsConfiguration *aws.Operation
+
 // PutBucketCORSRequest generates a request for the PutBucketCORS operation.
 func (c *S3) PutBucketCORSRequest(input *PutBucketCORSInput) (req *aws.Request, output *PutBucketCORSOutput) {
 	oprw.Lock()
@@ -1565,6 +2520,84 @@ func (c *S3) PutBucketCORSPresignedUrl(input *PutBucketCORSInput, expires time.D
 
 var opPutBucketCORS *aws.Operation
 
+// PutBucketEncryptionRequest generates a request for the PutBucketEncryption operation.
+func (c *S3) PutBucketEncryptionRequest(input *PutBucketEncryptionInput) (req *aws.Request, output *PutBucketEncryptionOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opPutBucketEncryption == nil {
+		opPutBucketEncryption = &aws.Operation{
+			Name:       "PutBucketEncryption",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?encryption",
+		}
+	}
+
+	if input == nil {
+		input = &PutBucketEncryptionInput{}
+	}
+
+	req = c.newRequest(opPutBucketEncryption, input, output)
+	output = &PutBucketEncryptionOutput{}
+	req.Data = output
+	return
+}
+
+// Creates a new server-side encryption configuration (or replaces an existing
+// one, if present).
+func (c *S3) PutBucketEncryption(input *PutBucketEncryptionInput) (*PutBucketEncryptionOutput, error) {
+	req, out := c.PutBucketEncryptionRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutBucketEncryptionPresignedUrl(input *PutBucketEncryptionInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutBucketEncryptionRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opPutBucketEncryption *aws.Operation
+
+// PutBucketInventoryConfigurationRequest generates a request for the PutBucketInventoryConfiguration operation.
+func (c *S3) PutBucketInventoryConfigurationRequest(input *PutBucketInventoryConfigurationInput) (req *aws.Request, output *PutBucketInventoryConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opPutBucketInventoryConfiguration == nil {
+		opPutBucketInventoryConfiguration = &aws.Operation{
+			Name:       "PutBucketInventoryConfiguration",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?inventory",
+		}
+	}
+
+	if input == nil {
+		input = &PutBucketInventoryConfigurationInput{}
+	}
+
+	req = c.newRequest(opPutBucketInventoryConfiguration, input, output)
+	output = &PutBucketInventoryConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Sets an inventory configuration (identified by the an inventory configuration
+// ID) for the bucket.
+func (c *S3) PutBucketInventoryConfiguration(input *PutBucketInventoryConfigurationInput) (*PutBucketInventoryConfigurationOutput, error) {
+	req, out := c.PutBucketInventoryConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutBucketInventoryConfigurationPresignedUrl(input *PutBucketInventoryConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutBucketInventoryConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opPutBucketInventoryConfiguration *aws.Operation
+
 // PutBucketLifecycleRequest generates a request for the PutBucketLifecycle operation.
 func (c *S3) PutBucketLifecycleRequest(input *PutBucketLifecycleInput) (req *aws.Request, output *PutBucketLifecycleOutput) {
 	oprw.Lock()
@@ -1604,6 +2637,45 @@ func (c *S3) PutBucketLifecyclePresignedUrl(input *PutBucketLifecycleInput, expi
 
 var opPutBucketLifecycle *aws.Operation
 
+// PutBucketLifecycleConfigurationRequest generates a request for the PutBucketLifecycleConfiguration operation.
+func (c *S3) PutBucketLifecycleConfigurationRequest(input *PutBucketLifecycleConfigurationInput) (req *aws.Request, output *PutBucketLifecycleConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opPutBucketLifecycleConfiguration == nil {
+		opPutBucketLifecycleConfiguration = &aws.Operation{
+			Name:       "PutBucketLifecycleConfiguration",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?lifecycle",
+		}
+	}
+
+	if input == nil {
+		input = &PutBucketLifecycleConfigurationInput{}
+	}
+
+	req = c.newRequest(opPutBucketLifecycleConfiguration, input, output)
+	output = &PutBucketLifecycleConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Sets lifecycle configuration for your bucket. If a lifecycle configuration
+// exists, it replaces it. Rules may select objects by prefix, tags or both.
+func (c *S3) PutBucketLifecycleConfiguration(input *PutBucketLifecycleConfigurationInput) (*PutBucketLifecycleConfigurationOutput, error) {
+	req, out := c.PutBucketLifecycleConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutBucketLifecycleConfigurationPresignedUrl(input *PutBucketLifecycleConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutBucketLifecycleConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opPutBucketLifecycleConfiguration *aws.Operation
+
 // PutBucketLoggingRequest generates a request for the PutBucketLogging operation.
 func (c *S3) PutBucketLoggingRequest(input *PutBucketLoggingInput) (req *aws.Request, output *PutBucketLoggingOutput) {
 	oprw.Lock()
@@ -1644,6 +2716,45 @@ func (c *S3) PutBucketLoggingPresignedUrl(input *PutBucketLoggingInput, expires
 
 var opPutBucketLogging *aws.Operation
 
+// PutBucketMetricsConfigurationRequest generates a request for the PutBucketMetricsConfiguration operation.
+func (c *S3) PutBucketMetricsConfigurationRequest(input *PutBucketMetricsConfigurationInput) (req *aws.Request, output *PutBucketMetricsConfigurationOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
+
+	if opPutBucketMetricsConfiguration == nil {
+		opPutBucketMetricsConfiguration = &aws.Operation{
+			Name:       "PutBucketMetricsConfiguration",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?metrics",
+		}
+	}
+
+	if input == nil {
+		input = &PutBucketMetricsConfigurationInput{}
+	}
+
+	req = c.newRequest(opPutBucketMetricsConfiguration, input, output)
+	output = &PutBucketMetricsConfigurationOutput{}
+	req.Data = output
+	return
+}
+
+// Sets a metrics configuration (identified by the a metrics configuration ID)
+// for the bucket.
+func (c *S3) PutBucketMetricsConfiguration(input *PutBucketMetricsConfigurationInput) (*PutBucketMetricsConfigurationOutput, error) {
+	req, out := c.PutBucketMetricsConfigurationRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutBucketMetricsConfigurationPresignedUrl(input *PutBucketMetricsConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutBucketMetricsConfigurationRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
+}
+
+var opPutBucketMetricsConfiguration *aws.Operation
+
 // PutBucketNotificationRequest generates a request for the PutBucketNotification operation.
 func (c *S3) PutBucketNotificationRequest(input *PutBucketNotificationInput) (req *aws.Request, output *PutBucketNotificationOutput) {
 	oprw.Lock()
@@ -2032,1425 +3143,2183 @@ func (c *S3) PutObjectACLPresignedUrl(input *PutObjectACLInput, expires time.Dur
 
 var opPutObjectACL *aws.Operation
 
-// RestoreObjectRequest generates a request for the RestoreObject operation.
-func (c *S3) RestoreObjectRequest(input *RestoreObjectInput) (req *aws.Request, output *RestoreObjectOutput) {
+// PutObjectLegalHoldRequest generates a request for the PutObjectLegalHold operation.
+func (c *S3) PutObjectLegalHoldRequest(input *PutObjectLegalHoldInput) (req *aws.Request, output *PutObjectLegalHoldOutput) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opRestoreObject == nil {
-		opRestoreObject = &aws.Operation{
-			Name:       "RestoreObject",
-			HTTPMethod: "POST",
-			HTTPPath:   "/{Bucket}/{Key+}?restore",
+	if opPutObjectLegalHold == nil {
+		opPutObjectLegalHold = &aws.Operation{
+			Name:       "PutObjectLegalHold",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
 		}
 	}
 
 	if input == nil {
-		input = &RestoreObjectInput{}
+		input = &PutObjectLegalHoldInput{}
 	}
 
-	req = c.newRequest(opRestoreObject, input, output)
-	output = &RestoreObjectOutput{}
+	req = c.newRequest(opPutObjectLegalHold, input, output)
+	output = &PutObjectLegalHoldOutput{}
 	req.Data = output
 	return
 }
 
-// Restores an archived copy of an object back into Amazon S3
-func (c *S3) RestoreObject(input *RestoreObjectInput) (*RestoreObjectOutput, error) {
-	req, out := c.RestoreObjectRequest(input)
+// Applies a Legal Hold configuration to the specified object.
+func (c *S3) PutObjectLegalHold(input *PutObjectLegalHoldInput) (*PutObjectLegalHoldOutput, error) {
+	req, out := c.PutObjectLegalHoldRequest(input)
 	err := req.Send()
 	return out, err
 }
-func (c *S3) RestoreObjectPresignedUrl(input *RestoreObjectInput, expires time.Duration) (*url.URL, error) {
-	req, _ := c.RestoreObjectRequest(input)
+func (c *S3) PutObjectLegalHoldPresignedUrl(input *PutObjectLegalHoldInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutObjectLegalHoldRequest(input)
 	req.ExpireTime = expires
 	err := req.Sign()
 	return req.HTTPRequest.URL, err
 }
 
-var opRestoreObject *aws.Operation
+var opPutObjectLegalHold *aws.Operation
 
-// UploadPartRequest generates a request for the UploadPart operation.
-func (c *S3) UploadPartRequest(input *UploadPartInput) (req *aws.Request, output *UploadPartOutput) {
+// PutObjectLockConfigurationRequest generates a request for the PutObjectLockConfiguration operation.
+func (c *S3) PutObjectLockConfigurationRequest(input *PutObjectLockConfigurationInput) (req *aws.Request, output *PutObjectLockConfigurationOutput) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opUploadPart == nil {
-		opUploadPart = &aws.Operation{
-			Name:       "UploadPart",
+	if opPutObjectLockConfiguration == nil {
+		opPutObjectLockConfiguration = &aws.Operation{
+			Name:       "PutObjectLockConfiguration",
 			HTTPMethod: "PUT",
-			HTTPPath:   "/{Bucket}/{Key+}",
+			HTTPPath:   "/{Bucket}?object-lock",
 		}
 	}
 
 	if input == nil {
-		input = &UploadPartInput{}
+		input = &PutObjectLockConfigurationInput{}
 	}
 
-	req = c.newRequest(opUploadPart, input, output)
-	output = &UploadPartOutput{}
+	req = c.newRequest(opPutObjectLockConfiguration, input, output)
+	output = &PutObjectLockConfigurationOutput{}
 	req.Data = output
 	return
 }
 
-// Uploads a part in a multipart upload.
-//
-// Note: After you initiate multipart upload and upload one or more parts,
-// you must either complete or abort multipart upload in order to stop getting
-// charged for storage of the uploaded parts. Only after you either complete
-// or abort multipart upload, Amazon S3 frees up the parts storage and stops
-// charging you for the parts storage.
-func (c *S3) UploadPart(input *UploadPartInput) (*UploadPartOutput, error) {
-	req, out := c.UploadPartRequest(input)
+// Places an Object Lock configuration on the specified bucket. The rule specified
+// in the Object Lock configuration will be applied by default to every new
+// object placed in the specified bucket.
+func (c *S3) PutObjectLockConfiguration(input *PutObjectLockConfigurationInput) (*PutObjectLockConfigurationOutput, error) {
+	req, out := c.PutObjectLockConfigurationRequest(input)
 	err := req.Send()
 	return out, err
 }
-func (c *S3) UploadPartPresignedUrl(input *UploadPartInput, expires time.Duration) (*url.URL, error) {
-	req, _ := c.UploadPartRequest(input)
+func (c *S3) PutObjectLockConfigurationPresignedUrl(input *PutObjectLockConfigurationInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutObjectLockConfigurationRequest(input)
 	req.ExpireTime = expires
 	err := req.Sign()
 	return req.HTTPRequest.URL, err
 }
 
-var opUploadPart *aws.Operation
+var opPutObjectLockConfiguration *aws.Operation
 
-// UploadPartCopyRequest generates a request for the UploadPartCopy operation.
-func (c *S3) UploadPartCopyRequest(input *UploadPartCopyInput) (req *aws.Request, output *UploadPartCopyOutput) {
+// PutObjectRetentionRequest generates a request for the PutObjectRetention operation.
+func (c *S3) PutObjectRetentionRequest(input *PutObjectRetentionInput) (req *aws.Request, output *PutObjectRetentionOutput) {
 	oprw.Lock()
 	defer oprw.Unlock()
 
-	if opUploadPartCopy == nil {
-		opUploadPartCopy = &aws.Operation{
-			Name:       "UploadPartCopy",
+	if opPutObjectRetention == nil {
+		opPutObjectRetention = &aws.Operation{
+			Name:       "PutObjectRetention",
 			HTTPMethod: "PUT",
-			HTTPPath:   "/{Bucket}/{Key+}",
+			HTTPPath:   "/{Bucket}/{Key+}?retention",
 		}
 	}
 
 	if input == nil {
-		input = &UploadPartCopyInput{}
+		input = &PutObjectRetentionInput{}
 	}
 
-	req = c.newRequest(opUploadPartCopy, input, output)
-	output = &UploadPartCopyOutput{}
+	req = c.newRequest(opPutObjectRetention, input, output)
+	output = &PutObjectRetentionOutput{}
 	req.Data = output
 	return
 }
 
-// Uploads a part by copying data from an existing object as data source.
-func (c *S3) UploadPartCopy(input *UploadPartCopyInput) (*UploadPartCopyOutput, error) {
-	req, out := c.UploadPartCopyRequest(input)
+// Places an Object Retention configuration on an object.
+func (c *S3) PutObjectRetention(input *PutObjectRetentionInput) (*PutObjectRetentionOutput, error) {
+	req, out := c.PutObjectRetentionRequest(input)
 	err := req.Send()
 	return out, err
 }
-func (c *S3) UploadPartCopyPresignedUrl(input *UploadPartCopyInput, expires time.Duration) (*url.URL, error) {
-	req, _ := c.UploadPartCopyRequest(input)
+func (c *S3) PutObjectRetentionPresignedUrl(input *PutObjectRetentionInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutObjectRetentionRequest(input)
 	req.ExpireTime = expires
 	err := req.Sign()
 	return req.HTTPRequest.URL, err
 }
 
-var opUploadPartCopy *aws.Operation
-
-type AbortMultipartUploadInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+var opPutObjectRetention *aws.Operation
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// PutObjectTaggingRequest generates a request for the PutObjectTagging operation.
+func (c *S3) PutObjectTaggingRequest(input *PutObjectTaggingInput) (req *aws.Request, output *PutObjectTaggingOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+	if opPutObjectTagging == nil {
+		opPutObjectTagging = &aws.Operation{
+			Name:       "PutObjectTagging",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}/{Key+}?tagging",
+		}
+	}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	if input == nil {
+		input = &PutObjectTaggingInput{}
+	}
 
-	metadataAbortMultipartUploadInput `json:"-" xml:"-"`
+	req = c.newRequest(opPutObjectTagging, input, output)
+	output = &PutObjectTaggingOutput{}
+	req.Data = output
+	return
 }
 
-type metadataAbortMultipartUploadInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// Sets the supplied tag-set to an object that already exists in a bucket
+func (c *S3) PutObjectTagging(input *PutObjectTaggingInput) (*PutObjectTaggingOutput, error) {
+	req, out := c.PutObjectTaggingRequest(input)
+	err := req.Send()
+	return out, err
 }
-
-type AbortMultipartUploadOutput struct {
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
-
-	metadataAbortMultipartUploadOutput `json:"-" xml:"-"`
+func (c *S3) PutObjectTaggingPresignedUrl(input *PutObjectTaggingInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutObjectTaggingRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type metadataAbortMultipartUploadOutput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+var opPutObjectTagging *aws.Operation
 
-type AccessControlPolicy struct {
-	// A list of grants.
-	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
+// PutPublicAccessBlockRequest generates a request for the PutPublicAccessBlock operation.
+func (c *S3) PutPublicAccessBlockRequest(input *PutPublicAccessBlockInput) (req *aws.Request, output *PutPublicAccessBlockOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-	Owner *Owner `type:"structure"`
+	if opPutPublicAccessBlock == nil {
+		opPutPublicAccessBlock = &aws.Operation{
+			Name:       "PutPublicAccessBlock",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}?publicAccessBlock",
+		}
+	}
 
-	metadataAccessControlPolicy `json:"-" xml:"-"`
+	if input == nil {
+		input = &PutPublicAccessBlockInput{}
+	}
+
+	req = c.newRequest(opPutPublicAccessBlock, input, output)
+	output = &PutPublicAccessBlockOutput{}
+	req.Data = output
+	return
 }
 
-type metadataAccessControlPolicy struct {
-	SDKShapeTraits bool `type:"structure"`
+// Creates or modifies the PublicAccessBlock configuration for an Amazon S3
+// bucket.
+func (c *S3) PutPublicAccessBlock(input *PutPublicAccessBlockInput) (*PutPublicAccessBlockOutput, error) {
+	req, out := c.PutPublicAccessBlockRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) PutPublicAccessBlockPresignedUrl(input *PutPublicAccessBlockInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.PutPublicAccessBlockRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type Bucket struct {
-	// Date the bucket was created.
-	CreationDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+var opPutPublicAccessBlock *aws.Operation
 
-	// The name of the bucket.
-	Name *string `type:"string"`
+// RestoreObjectRequest generates a request for the RestoreObject operation.
+func (c *S3) RestoreObjectRequest(input *RestoreObjectInput) (req *aws.Request, output *RestoreObjectOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-	Region *string `type:"string"`
+	if opRestoreObject == nil {
+		opRestoreObject = &aws.Operation{
+			Name:       "RestoreObject",
+			HTTPMethod: "POST",
+			HTTPPath:   "/{Bucket}/{Key+}?restore",
+		}
+	}
 
-	metadataBucket `json:"-" xml:"-"`
+	if input == nil {
+		input = &RestoreObjectInput{}
+	}
+
+	req = c.newRequest(opRestoreObject, input, output)
+	output = &RestoreObjectOutput{}
+	req.Data = output
+	return
 }
 
-type metadataBucket struct {
-	SDKShapeTraits bool `type:"structure"`
+// Restores an archived copy of an object back into Amazon S3
+func (c *S3) RestoreObject(input *RestoreObjectInput) (*RestoreObjectOutput, error) {
+	req, out := c.RestoreObjectRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) RestoreObjectPresignedUrl(input *RestoreObjectInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.RestoreObjectRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type BucketLoggingStatus struct {
-	LoggingEnabled *LoggingEnabled `type:"structure"`
+var opRestoreObject *aws.Operation
 
-	metadataBucketLoggingStatus `json:"-" xml:"-"`
-}
+// SelectObjectContentRequest generates a request for the SelectObjectContent operation.
+func (c *S3) SelectObjectContentRequest(input *SelectObjectContentInput) (req *aws.Request, output *SelectObjectContentOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-type metadataBucketLoggingStatus struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	if opSelectObjectContent == nil {
+		opSelectObjectContent = &aws.Operation{
+			Name:       "SelectObjectContent",
+			HTTPMethod: "POST",
+			HTTPPath:   "/{Bucket}/{Key+}?select&select-type=2",
+		}
+	}
 
-type CORSConfiguration struct {
-	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
+	if input == nil {
+		input = &SelectObjectContentInput{}
+	}
 
-	metadataCORSConfiguration `json:"-" xml:"-"`
+	req = c.newRequest(opSelectObjectContent, input, output)
+	output = &SelectObjectContentOutput{}
+	req.Data = output
+	return
 }
 
-type metadataCORSConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// This operation filters the contents of an Amazon S3 object based on a simple
+// Structured Query Language (SQL) statement. Amazon S3 uses this to parse object
+// data into records, and returns only records that match the specified SQL
+// expression. The records are returned as a stream of events, read from the
+// EventStream of the output.
+func (c *S3) SelectObjectContent(input *SelectObjectContentInput) (*SelectObjectContentOutput, error) {
+	req, out := c.SelectObjectContentRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) SelectObjectContentPresignedUrl(input *SelectObjectContentInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.SelectObjectContentRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type CORSRule struct {
-	// Specifies which headers are allowed in a pre-flight OPTIONS request.
-	AllowedHeaders []*string `locationName:"AllowedHeader" type:"list" flattened:"true"`
-
-	// Identifies HTTP methods that the domain/origin specified in the rule is allowed
-	// to execute.
-	AllowedMethods []*string `locationName:"AllowedMethod" type:"list" flattened:"true"`
+var opSelectObjectContent *aws.Operation
 
-	// One or more origins you want customers to be able to access the bucket from.
-	AllowedOrigins []*string `locationName:"AllowedOrigin" type:"list" flattened:"true"`
+// UploadPartRequest generates a request for the UploadPart operation.
+func (c *S3) UploadPartRequest(input *UploadPartInput) (req *aws.Request, output *UploadPartOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-	// One or more headers in the response that you want customers to be able to
-	// access from their applications (for example, from a JavaScript XMLHttpRequest
-	// object).
-	ExposeHeaders []*string `locationName:"ExposeHeader" type:"list" flattened:"true"`
+	if opUploadPart == nil {
+		opUploadPart = &aws.Operation{
+			Name:       "UploadPart",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}/{Key+}",
+		}
+	}
 
-	// The time in seconds that your browser is to cache the preflight response
-	// for the specified resource.
-	MaxAgeSeconds *int64 `type:"integer"`
+	if input == nil {
+		input = &UploadPartInput{}
+	}
 
-	metadataCORSRule `json:"-" xml:"-"`
+	req = c.newRequest(opUploadPart, input, output)
+	output = &UploadPartOutput{}
+	req.Data = output
+	return
 }
 
-type metadataCORSRule struct {
-	SDKShapeTraits bool `type:"structure"`
+// Uploads a part in a multipart upload.
+//
+// Note: After you initiate multipart upload and upload one or more parts, you
+// must either complete or abort multipart upload in order to stop getting charged
+// for storage of the uploaded parts. Only after you either complete or abort
+// multipart upload, Amazon S3 frees up the parts storage and stops charging
+// you for the parts storage.
+func (c *S3) UploadPart(input *UploadPartInput) (*UploadPartOutput, error) {
+	req, out := c.UploadPartRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) UploadPartPresignedUrl(input *UploadPartInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.UploadPartRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type CloudFunctionConfiguration struct {
-	CloudFunction *string `type:"string"`
+var opUploadPart *aws.Operation
 
-	// Bucket event for which to send notifications.
-	Event *string `type:"string"`
+// UploadPartCopyRequest generates a request for the UploadPartCopy operation.
+func (c *S3) UploadPartCopyRequest(input *UploadPartCopyInput) (req *aws.Request, output *UploadPartCopyOutput) {
+	oprw.Lock()
+	defer oprw.Unlock()
 
-	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+	if opUploadPartCopy == nil {
+		opUploadPartCopy = &aws.Operation{
+			Name:       "UploadPartCopy",
+			HTTPMethod: "PUT",
+			HTTPPath:   "/{Bucket}/{Key+}",
+		}
+	}
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+	if input == nil {
+		input = &UploadPartCopyInput{}
+	}
 
-	InvocationRole *string `type:"string"`
+	req = c.newRequest(opUploadPartCopy, input, output)
+	output = &UploadPartCopyOutput{}
+	req.Data = output
+	return
+}
 
-	metadataCloudFunctionConfiguration `json:"-" xml:"-"`
+// Uploads a part by copying data from an existing object as data source.
+func (c *S3) UploadPartCopy(input *UploadPartCopyInput) (*UploadPartCopyOutput, error) {
+	req, out := c.UploadPartCopyRequest(input)
+	err := req.Send()
+	return out, err
+}
+func (c *S3) UploadPartCopyPresignedUrl(input *UploadPartCopyInput, expires time.Duration) (*url.URL, error) {
+	req, _ := c.UploadPartCopyRequest(input)
+	req.ExpireTime = expires
+	err := req.Sign()
+	return req.HTTPRequest.URL, err
 }
 
-type metadataCloudFunctionConfiguration struct {
+var opUploadPartCopy *aws.Operation
+
+type AbortIncompleteMultipartUpload struct {
+	// Indicates the number of days that must pass since initiation for Lifecycle
+	// to abort an Incomplete Multipart Upload.
+	DaysAfterInitiation *int64 `type:"integer"`
+
+	metadataAbortIncompleteMultipartUpload `json:"-" xml:"-"`
+}
+
+type metadataAbortIncompleteMultipartUpload struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type CommonPrefix struct {
-	Prefix *string `type:"string"`
+// String returns the string representation.
+func (s AbortIncompleteMultipartUpload) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataCommonPrefix `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s AbortIncompleteMultipartUpload) GoString() string {
+	return s.String()
 }
 
-type metadataCommonPrefix struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetDaysAfterInitiation returns the value of DaysAfterInitiation, or the zero value if it is not set.
+func (s *AbortIncompleteMultipartUpload) GetDaysAfterInitiation() int64 {
+	if s == nil || s.DaysAfterInitiation == nil {
+		return 0
+	}
+	return *s.DaysAfterInitiation
 }
 
-type CompleteMultipartUploadInput struct {
+// SetDaysAfterInitiation sets the value of DaysAfterInitiation.
+func (s *AbortIncompleteMultipartUpload) SetDaysAfterInitiation(v int64) *AbortIncompleteMultipartUpload {
+	s.DaysAfterInitiation = &v
+	return s
+}
+
+type AbortMultipartUploadInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	MultipartUpload *CompletedMultipartUpload `locationName:"CompleteMultipartUpload" type:"structure"`
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
 	// Confirms that the requester knows that she or he will be charged for the
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
-
-	metadataCompleteMultipartUploadInput `json:"-" xml:"-"`
+	metadataAbortMultipartUploadInput `json:"-" xml:"-"`
 }
 
-type metadataCompleteMultipartUploadInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"MultipartUpload"`
+type metadataAbortMultipartUploadInput struct {
+	SDKShapeTraits bool `type:"structure"`
 }
 
-type CompleteMultipartUploadOutput struct {
-	Bucket *string `type:"string"`
+// String returns the string representation.
+func (s AbortMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Entity tag of the object.
-	ETag *string `type:"string"`
+// GoString returns the string representation.
+func (s AbortMultipartUploadInput) GoString() string {
+	return s.String()
+}
 
-	// If the object expiration is configured, this will contain the expiration
-	// date (expiry-date) and rule ID (rule-id). The value of rule-id is URL encoded.
-	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
 
-	Key *string `type:"string"`
+// SetBucket sets the value of Bucket.
+func (s *AbortMultipartUploadInput) SetBucket(v string) *AbortMultipartUploadInput {
+	s.Bucket = &v
+	return s
+}
 
-	Location *string `type:"string"`
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// SetContentType sets the value of ContentType.
+func (s *AbortMultipartUploadInput) SetContentType(v string) *AbortMultipartUploadInput {
+	s.ContentType = &v
+	return s
+}
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *AbortMultipartUploadInput) SetExpectedBucketOwner(v string) *AbortMultipartUploadInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
 
-	// Version of the object.
-	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
 
-	metadataCompleteMultipartUploadOutput `json:"-" xml:"-"`
+// SetKey sets the value of Key.
+func (s *AbortMultipartUploadInput) SetKey(v string) *AbortMultipartUploadInput {
+	s.Key = &v
+	return s
 }
 
-type metadataCompleteMultipartUploadOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
 }
 
-type CompletedMultipartUpload struct {
-	Parts []*CompletedPart `locationName:"Part" type:"list" flattened:"true"`
+// SetRequestPayer sets the value of RequestPayer.
+func (s *AbortMultipartUploadInput) SetRequestPayer(v string) *AbortMultipartUploadInput {
+	s.RequestPayer = &v
+	return s
+}
 
-	metadataCompletedMultipartUpload `json:"-" xml:"-"`
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *AbortMultipartUploadInput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
 }
 
-type metadataCompletedMultipartUpload struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetUploadID sets the value of UploadID.
+func (s *AbortMultipartUploadInput) SetUploadID(v string) *AbortMultipartUploadInput {
+	s.UploadID = &v
+	return s
 }
 
-type CompletedPart struct {
-	// Entity tag returned when the part was uploaded.
-	ETag *string `type:"string"`
+type AbortMultipartUploadOutput struct {
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
-	// Part number that identifies the part.
-	PartNumber *int64 `type:"integer"`
-
-	metadataCompletedPart `json:"-" xml:"-"`
+	metadataAbortMultipartUploadOutput `json:"-" xml:"-"`
 }
 
-type metadataCompletedPart struct {
+type metadataAbortMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type Condition struct {
-	// The HTTP error code when the redirect is applied. In the event of an error,
-	// if the error code equals this value, then the specified redirect is applied.
-	// Required when parent element Condition is specified and sibling KeyPrefixEquals
-	// is not specified. If both are specified, then both must be true for the redirect
-	// to be applied.
-	HTTPErrorCodeReturnedEquals *string `locationName:"HttpErrorCodeReturnedEquals" type:"string"`
-
-	// The object key name prefix when the redirect is applied. For example, to
-	// redirect requests for ExamplePage.html, the key prefix will be ExamplePage.html.
-	// To redirect request for all pages with the prefix docs/, the key prefix will
-	// be /docs, which identifies all objects in the docs/ folder. Required when
-	// the parent element Condition is specified and sibling HttpErrorCodeReturnedEquals
-	// is not specified. If both conditions are specified, both must be true for
-	// the redirect to be applied.
-	KeyPrefixEquals *string `type:"string"`
-
-	metadataCondition `json:"-" xml:"-"`
+// String returns the string representation.
+func (s AbortMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataCondition struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s AbortMultipartUploadOutput) GoString() string {
+	return s.String()
 }
 
-type CopyObjectInput struct {
-	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
-
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *AbortMultipartUploadOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
 
-	// Specifies caching behavior along the request/reply chain.
-	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+// SetRequestCharged sets the value of RequestCharged.
+func (s *AbortMultipartUploadOutput) SetRequestCharged(v string) *AbortMultipartUploadOutput {
+	s.RequestCharged = &v
+	return s
+}
 
-	// Specifies presentational information for the object.
-	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+type AccessControlPolicy struct {
+	// A list of grants.
+	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
 
-	// Specifies what content encodings have been applied to the object and thus
-	// what decoding mechanisms must be applied to obtain the media-type referenced
-	// by the Content-Type header field.
-	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+	Owner *Owner `type:"structure"`
 
-	// The language the content is in.
-	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+	metadataAccessControlPolicy `json:"-" xml:"-"`
+}
 
-	// A standard MIME type describing the format of the object data.
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+type metadataAccessControlPolicy struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// The name of the source bucket and key name of the source object, separated
-	// by a slash (/). Must be URL-encoded.
-	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
+// String returns the string representation.
+func (s AccessControlPolicy) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Copies the object if its entity tag (ETag) matches the specified tag.
-	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
+// GoString returns the string representation.
+func (s AccessControlPolicy) GoString() string {
+	return s.String()
+}
 
-	// Copies the object if it has been modified since the specified time.
-	CopySourceIfModifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-modified-since" type:"timestamp" timestampFormat:"rfc822"`
+// GetGrants returns the value of Grants, or nil if it is not set.
+func (s *AccessControlPolicy) GetGrants() []*Grant {
+	if s == nil {
+		return nil
+	}
+	return s.Grants
+}
 
-	// Copies the object if its entity tag (ETag) is different than the specified
-	// ETag.
-	CopySourceIfNoneMatch *string `location:"header" locationName:"x-amz-copy-source-if-none-match" type:"string"`
+// SetGrants sets the value of Grants.
+func (s *AccessControlPolicy) SetGrants(v []*Grant) *AccessControlPolicy {
+	s.Grants = v
+	return s
+}
 
-	// Copies the object if it hasn't been modified since the specified time.
-	CopySourceIfUnmodifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-unmodified-since" type:"timestamp" timestampFormat:"rfc822"`
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *AccessControlPolicy) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
 
-	// Specifies the algorithm to use when decrypting the source object (e.g., AES256).
-	CopySourceSSECustomerAlgorithm *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-algorithm" type:"string"`
+// SetOwner sets the value of Owner.
+func (s *AccessControlPolicy) SetOwner(v *Owner) *AccessControlPolicy {
+	s.Owner = v
+	return s
+}
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
-	// the source object. The encryption key provided in this header must be one
-	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+type AnalyticsAndOperator struct {
+	// The prefix to use when evaluating an AND predicate.
+	Prefix *string `type:"string"`
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	CopySourceSSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key-MD5" type:"string"`
+	// The list of tags to use when evaluating an AND predicate.
+	Tags []*Tag `locationName:"Tag" locationNameList:"Tag" type:"list" flattened:"true"`
 
-	// The date and time at which the object is no longer cacheable.
-	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+	metadataAnalyticsAndOperator `json:"-" xml:"-"`
+}
 
-	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+type metadataAnalyticsAndOperator struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Allows grantee to read the object data and its metadata.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+// String returns the string representation.
+func (s AnalyticsAndOperator) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Allows grantee to read the object ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+// GoString returns the string representation.
+func (s AnalyticsAndOperator) GoString() string {
+	return s.String()
+}
 
-	// Allows grantee to write the ACL for the applicable object.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *AnalyticsAndOperator) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// SetPrefix sets the value of Prefix.
+func (s *AnalyticsAndOperator) SetPrefix(v string) *AnalyticsAndOperator {
+	s.Prefix = &v
+	return s
+}
 
-	// A map of metadata to store with the object in S3.
-	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+// GetTags returns the value of Tags, or nil if it is not set.
+func (s *AnalyticsAndOperator) GetTags() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tags
+}
 
-	// Specifies whether the metadata is copied from the source object or replaced
-	// with metadata provided in the request.
-	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string"`
+// SetTags sets the value of Tags.
+func (s *AnalyticsAndOperator) SetTags(v []*Tag) *AnalyticsAndOperator {
+	s.Tags = v
+	return s
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+type AnalyticsConfiguration struct {
+	// The filter used to describe a set of objects for analyses. A filter must
+	// have exactly one prefix, one tag, or one conjunction (AnalyticsAndOperator).
+	// If no filter is provided, all objects will be considered in any analysis.
+	Filter *AnalyticsFilter `type:"structure"`
 
-	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
-	// aws:kms).
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+	// The identifier used to represent an analytics configuration.
+	ID *string `locationName:"Id" type:"string" required:"true"`
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
-	// data. This value is used to store the object and then it is discarded; Amazon
-	// does not store the encryption key. The key must be appropriate for use with
-	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	// If present, it indicates that data related to access patterns will be collected
+	// and made available to analyze the tradeoffs between different storage classes.
+	StorageClassAnalysis *StorageClassAnalysis `type:"structure" required:"true"`
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+	metadataAnalyticsConfiguration `json:"-" xml:"-"`
+}
 
-	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
-	// requests for an object protected by AWS KMS will fail if not made via SSL
-	// or using SigV4. Documentation on configuring any of the officially supported
-	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+type metadataAnalyticsConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// String returns the string representation.
+func (s AnalyticsConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+// GoString returns the string representation.
+func (s AnalyticsConfiguration) GoString() string {
+	return s.String()
+}
 
-	// If the bucket is configured as a website, redirects requests for this object
-	// to another object in the same bucket or to an external URL. Amazon S3 stores
-	// the value of this header in the object metadata.
-	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+// GetFilter returns the value of Filter, or nil if it is not set.
+func (s *AnalyticsConfiguration) GetFilter() *AnalyticsFilter {
+	if s == nil {
+		return nil
+	}
+	return s.Filter
+}
 
-	metadataCopyObjectInput `json:"-" xml:"-"`
+// SetFilter sets the value of Filter.
+func (s *AnalyticsConfiguration) SetFilter(v *AnalyticsFilter) *AnalyticsConfiguration {
+	s.Filter = v
+	return s
 }
 
-type metadataCopyObjectInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *AnalyticsConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
 }
 
-type CopyObjectOutput struct {
-	CopyObjectResult *CopyObjectResult `type:"structure"`
+// SetID sets the value of ID.
+func (s *AnalyticsConfiguration) SetID(v string) *AnalyticsConfiguration {
+	s.ID = &v
+	return s
+}
 
-	CopySourceVersionID *string `location:"header" locationName:"x-amz-copy-source-version-id" type:"string"`
+// GetStorageClassAnalysis returns the value of StorageClassAnalysis, or nil if it is not set.
+func (s *AnalyticsConfiguration) GetStorageClassAnalysis() *StorageClassAnalysis {
+	if s == nil {
+		return nil
+	}
+	return s.StorageClassAnalysis
+}
 
-	// If the object expiration is configured, the response includes this header.
-	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+// SetStorageClassAnalysis sets the value of StorageClassAnalysis.
+func (s *AnalyticsConfiguration) SetStorageClassAnalysis(v *StorageClassAnalysis) *AnalyticsConfiguration {
+	s.StorageClassAnalysis = v
+	return s
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+type AnalyticsExportDestination struct {
+	// A destination signifying output to an S3 bucket.
+	S3BucketDestination *AnalyticsS3BucketDestination `type:"structure" required:"true"`
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+	metadataAnalyticsExportDestination `json:"-" xml:"-"`
+}
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+type metadataAnalyticsExportDestination struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+// String returns the string representation.
+func (s AnalyticsExportDestination) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// GoString returns the string representation.
+func (s AnalyticsExportDestination) GoString() string {
+	return s.String()
+}
 
-	metadataCopyObjectOutput `json:"-" xml:"-"`
+// GetS3BucketDestination returns the value of S3BucketDestination, or nil if it is not set.
+func (s *AnalyticsExportDestination) GetS3BucketDestination() *AnalyticsS3BucketDestination {
+	if s == nil {
+		return nil
+	}
+	return s.S3BucketDestination
 }
 
-type metadataCopyObjectOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"CopyObjectResult"`
+// SetS3BucketDestination sets the value of S3BucketDestination.
+func (s *AnalyticsExportDestination) SetS3BucketDestination(v *AnalyticsS3BucketDestination) *AnalyticsExportDestination {
+	s.S3BucketDestination = v
+	return s
 }
 
-type CopyObjectResult struct {
-	ETag *string `type:"string"`
+type AnalyticsFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating an
+	// analytics filter. The operator must have at least two predicates.
+	And *AnalyticsAndOperator `type:"structure"`
 
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	// The prefix to use when evaluating an analytics filter.
+	Prefix *string `type:"string"`
 
-	metadataCopyObjectResult `json:"-" xml:"-"`
+	// The tag to use when evaluating an analytics filter.
+	Tag *Tag `type:"structure"`
+
+	metadataAnalyticsFilter `json:"-" xml:"-"`
 }
 
-type metadataCopyObjectResult struct {
+type metadataAnalyticsFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type CopyPartResult struct {
-	// Entity tag of the object.
-	ETag *string `type:"string"`
+// String returns the string representation.
+func (s AnalyticsFilter) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Date and time at which the object was uploaded.
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+// GoString returns the string representation.
+func (s AnalyticsFilter) GoString() string {
+	return s.String()
+}
 
-	metadataCopyPartResult `json:"-" xml:"-"`
+// GetAnd returns the value of And, or nil if it is not set.
+func (s *AnalyticsFilter) GetAnd() *AnalyticsAndOperator {
+	if s == nil {
+		return nil
+	}
+	return s.And
 }
 
-type metadataCopyPartResult struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetAnd sets the value of And.
+func (s *AnalyticsFilter) SetAnd(v *AnalyticsAndOperator) *AnalyticsFilter {
+	s.And = v
+	return s
 }
 
-type CreateBucketConfiguration struct {
-	// Specifies the region where the bucket will be created. If you don't specify
-	// a region, the bucket will be created in US Standard.
-	LocationConstraint *string `type:"string"`
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *AnalyticsFilter) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
 
-	metadataCreateBucketConfiguration `json:"-" xml:"-"`
+// SetPrefix sets the value of Prefix.
+func (s *AnalyticsFilter) SetPrefix(v string) *AnalyticsFilter {
+	s.Prefix = &v
+	return s
 }
 
-type metadataCreateBucketConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetTag returns the value of Tag, or nil if it is not set.
+func (s *AnalyticsFilter) GetTag() *Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tag
 }
 
-type CreateBucketInput struct {
-	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+// SetTag sets the value of Tag.
+func (s *AnalyticsFilter) SetTag(v *Tag) *AnalyticsFilter {
+	s.Tag = v
+	return s
+}
 
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type AnalyticsS3BucketDestination struct {
+	// The Amazon resource name (ARN) of the bucket to which data is exported.
+	Bucket *string `type:"string" required:"true"`
 
-	CreateBucketConfiguration *CreateBucketConfiguration `locationName:"CreateBucketConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+	// The account ID that owns the destination bucket. If no account ID is provided,
+	// the owner will not be validated prior to exporting data.
+	BucketAccountID *string `locationName:"BucketAccountId" type:"string"`
 
-	// Allows grantee the read, write, read ACP, and write ACP permissions on the
-	// bucket.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+	// The file format used when exporting data to Amazon S3.
+	//
+	// The valid values are the AnalyticsS3ExportFileFormat enum constants.
+	Format *string `type:"string" enum:"CSV" required:"true"`
 
-	// Allows grantee to list the objects in the bucket.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+	// The prefix to use when exporting data. The exported data begins with this
+	// prefix.
+	Prefix *string `type:"string"`
 
-	// Allows grantee to read the bucket ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+	metadataAnalyticsS3BucketDestination `json:"-" xml:"-"`
+}
 
-	// Allows grantee to create, overwrite, and delete any object in the bucket.
-	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+type metadataAnalyticsS3BucketDestination struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Allows grantee to write the ACL for the applicable bucket.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+// String returns the string representation.
+func (s AnalyticsS3BucketDestination) String() string {
+	return awsutil.StringValue(s)
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GoString returns the string representation.
+func (s AnalyticsS3BucketDestination) GoString() string {
+	return s.String()
+}
 
-	MetadataCreateBucketInput `json:"-" xml:"-"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *AnalyticsS3BucketDestination) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
 }
 
-type MetadataCreateBucketInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"CreateBucketConfiguration"`
+// SetBucket sets the value of Bucket.
+func (s *AnalyticsS3BucketDestination) SetBucket(v string) *AnalyticsS3BucketDestination {
+	s.Bucket = &v
+	return s
 }
 
-type CreateBucketOutput struct {
-	Location *string `location:"header" locationName:"Location" type:"string"`
+// GetBucketAccountID returns the value of BucketAccountID, or the zero value if it is not set.
+func (s *AnalyticsS3BucketDestination) GetBucketAccountID() string {
+	if s == nil || s.BucketAccountID == nil {
+		return ""
+	}
+	return *s.BucketAccountID
+}
 
-	metadataCreateBucketOutput `json:"-" xml:"-"`
+// SetBucketAccountID sets the value of BucketAccountID.
+func (s *AnalyticsS3BucketDestination) SetBucketAccountID(v string) *AnalyticsS3BucketDestination {
+	s.BucketAccountID = &v
+	return s
 }
 
-type metadataCreateBucketOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetFormat returns the value of Format, or the zero value if it is not set.
+func (s *AnalyticsS3BucketDestination) GetFormat() string {
+	if s == nil || s.Format == nil {
+		return ""
+	}
+	return *s.Format
 }
 
-type CreateMultipartUploadInput struct {
-	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+// SetFormat sets the value of Format.
+func (s *AnalyticsS3BucketDestination) SetFormat(v string) *AnalyticsS3BucketDestination {
+	s.Format = &v
+	return s
+}
 
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *AnalyticsS3BucketDestination) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
 
-	// Specifies caching behavior along the request/reply chain.
-	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+// SetPrefix sets the value of Prefix.
+func (s *AnalyticsS3BucketDestination) SetPrefix(v string) *AnalyticsS3BucketDestination {
+	s.Prefix = &v
+	return s
+}
 
-	// Specifies presentational information for the object.
-	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
-
-	// Specifies what content encodings have been applied to the object and thus
-	// what decoding mechanisms must be applied to obtain the media-type referenced
-	// by the Content-Type header field.
-	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+type Bucket struct {
+	// Date the bucket was created.
+	CreationDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
-	// The language the content is in.
-	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+	// The name of the bucket.
+	Name *string `type:"string"`
 
-	// A standard MIME type describing the format of the object data.
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	Region *string `type:"string"`
 
-	// The date and time at which the object is no longer cacheable.
-	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+	metadataBucket `json:"-" xml:"-"`
+}
 
-	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+type metadataBucket struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Allows grantee to read the object data and its metadata.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+// String returns the string representation.
+func (s Bucket) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Allows grantee to read the object ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+// GoString returns the string representation.
+func (s Bucket) GoString() string {
+	return s.String()
+}
 
-	// Allows grantee to write the ACL for the applicable object.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+// GetCreationDate returns the value of CreationDate, or the zero value if it is not set.
+func (s *Bucket) GetCreationDate() time.Time {
+	if s == nil || s.CreationDate == nil {
+		return time.Time{}
+	}
+	return *s.CreationDate
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// SetCreationDate sets the value of CreationDate.
+func (s *Bucket) SetCreationDate(v time.Time) *Bucket {
+	s.CreationDate = &v
+	return s
+}
 
-	// A map of metadata to store with the object in S3.
-	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+// GetName returns the value of Name, or the zero value if it is not set.
+func (s *Bucket) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// SetName sets the value of Name.
+func (s *Bucket) SetName(v string) *Bucket {
+	s.Name = &v
+	return s
+}
 
-	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
-	// aws:kms).
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// GetRegion returns the value of Region, or the zero value if it is not set.
+func (s *Bucket) GetRegion() string {
+	if s == nil || s.Region == nil {
+		return ""
+	}
+	return *s.Region
+}
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
-	// data. This value is used to store the object and then it is discarded; Amazon
-	// does not store the encryption key. The key must be appropriate for use with
-	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+// SetRegion sets the value of Region.
+func (s *Bucket) SetRegion(v string) *Bucket {
+	s.Region = &v
+	return s
+}
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+type BucketLifecycleConfiguration struct {
+	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
 
-	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
-	// requests for an object protected by AWS KMS will fail if not made via SSL
-	// or using SigV4. Documentation on configuring any of the officially supported
-	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	metadataBucketLifecycleConfiguration `json:"-" xml:"-"`
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+type metadataBucketLifecycleConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+// String returns the string representation.
+func (s BucketLifecycleConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// If the bucket is configured as a website, redirects requests for this object
-	// to another object in the same bucket or to an external URL. Amazon S3 stores
-	// the value of this header in the object metadata.
-	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+// GoString returns the string representation.
+func (s BucketLifecycleConfiguration) GoString() string {
+	return s.String()
+}
 
-	metadataCreateMultipartUploadInput `json:"-" xml:"-"`
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *BucketLifecycleConfiguration) GetRules() []*LifecycleRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
 }
 
-type metadataCreateMultipartUploadInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetRules sets the value of Rules.
+func (s *BucketLifecycleConfiguration) SetRules(v []*LifecycleRule) *BucketLifecycleConfiguration {
+	s.Rules = v
+	return s
 }
 
-type CreateMultipartUploadOutput struct {
-	// Name of the bucket to which the multipart upload was initiated.
-	Bucket *string `locationName:"Bucket" type:"string"`
+type BucketLoggingStatus struct {
+	LoggingEnabled *LoggingEnabled `type:"structure"`
 
-	// Object key for which the multipart upload was initiated.
-	Key *string `type:"string"`
+	metadataBucketLoggingStatus `json:"-" xml:"-"`
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+type metadataBucketLoggingStatus struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// String returns the string representation.
+func (s BucketLoggingStatus) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+// GoString returns the string representation.
+func (s BucketLoggingStatus) GoString() string {
+	return s.String()
+}
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+// GetLoggingEnabled returns the value of LoggingEnabled, or nil if it is not set.
+func (s *BucketLoggingStatus) GetLoggingEnabled() *LoggingEnabled {
+	if s == nil {
+		return nil
+	}
+	return s.LoggingEnabled
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// SetLoggingEnabled sets the value of LoggingEnabled.
+func (s *BucketLoggingStatus) SetLoggingEnabled(v *LoggingEnabled) *BucketLoggingStatus {
+	s.LoggingEnabled = v
+	return s
+}
 
-	// ID for the initiated multipart upload.
-	UploadID *string `locationName:"UploadId" type:"string"`
+type CORSConfiguration struct {
+	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
 
-	metadataCreateMultipartUploadOutput `json:"-" xml:"-"`
+	metadataCORSConfiguration `json:"-" xml:"-"`
 }
 
-type metadataCreateMultipartUploadOutput struct {
+type metadataCORSConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type Delete struct {
-	Objects []*ObjectIdentifier `locationName:"Object" type:"list" flattened:"true" required:"true"`
+// String returns the string representation.
+func (s CORSConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Element to enable quiet mode for the request. When you add this element,
-	// you must set its value to true.
-	Quiet *bool `type:"boolean"`
+// GoString returns the string representation.
+func (s CORSConfiguration) GoString() string {
+	return s.String()
+}
 
-	metadataDelete `json:"-" xml:"-"`
+// GetCORSRules returns the value of CORSRules, or nil if it is not set.
+func (s *CORSConfiguration) GetCORSRules() []*CORSRule {
+	if s == nil {
+		return nil
+	}
+	return s.CORSRules
 }
 
-type metadataDelete struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetCORSRules sets the value of CORSRules.
+func (s *CORSConfiguration) SetCORSRules(v []*CORSRule) *CORSConfiguration {
+	s.CORSRules = v
+	return s
 }
 
-type DeleteBucketCORSInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type CORSRule struct {
+	// Specifies which headers are allowed in a pre-flight OPTIONS request.
+	AllowedHeaders []*string `locationName:"AllowedHeader" type:"list" flattened:"true"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Identifies HTTP methods that the domain/origin specified in the rule is allowed
+	// to execute.
+	AllowedMethods []*string `locationName:"AllowedMethod" type:"list" flattened:"true"`
 
-	metadataDeleteBucketCORSInput `json:"-" xml:"-"`
-}
+	// One or more origins you want customers to be able to access the bucket from.
+	AllowedOrigins []*string `locationName:"AllowedOrigin" type:"list" flattened:"true"`
 
-type metadataDeleteBucketCORSInput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// One or more headers in the response that you want customers to be able to
+	// access from their applications (for example, from a JavaScript XMLHttpRequest
+	// object).
+	ExposeHeaders []*string `locationName:"ExposeHeader" type:"list" flattened:"true"`
 
-type DeleteBucketCORSOutput struct {
-	metadataDeleteBucketCORSOutput `json:"-" xml:"-"`
+	// The time in seconds that your browser is to cache the preflight response
+	// for the specified resource.
+	MaxAgeSeconds *int64 `type:"integer"`
+
+	metadataCORSRule `json:"-" xml:"-"`
 }
 
-type metadataDeleteBucketCORSOutput struct {
+type metadataCORSRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeleteBucketInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// String returns the string representation.
+func (s CORSRule) String() string {
+	return awsutil.StringValue(s)
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GoString returns the string representation.
+func (s CORSRule) GoString() string {
+	return s.String()
+}
 
-	metadataDeleteBucketInput `json:"-" xml:"-"`
+// GetAllowedHeaders returns the value of AllowedHeaders, or nil if it is not set.
+func (s *CORSRule) GetAllowedHeaders() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.AllowedHeaders
 }
 
-type metadataDeleteBucketInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetAllowedHeaders sets the value of AllowedHeaders.
+func (s *CORSRule) SetAllowedHeaders(v []*string) *CORSRule {
+	s.AllowedHeaders = v
+	return s
 }
 
-type DeleteBucketLifecycleInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetAllowedMethods returns the value of AllowedMethods, or nil if it is not set.
+func (s *CORSRule) GetAllowedMethods() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.AllowedMethods
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetAllowedMethods sets the value of AllowedMethods.
+func (s *CORSRule) SetAllowedMethods(v []*string) *CORSRule {
+	s.AllowedMethods = v
+	return s
+}
 
-	metadataDeleteBucketLifecycleInput `json:"-" xml:"-"`
+// GetAllowedOrigins returns the value of AllowedOrigins, or nil if it is not set.
+func (s *CORSRule) GetAllowedOrigins() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.AllowedOrigins
 }
 
-type metadataDeleteBucketLifecycleInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetAllowedOrigins sets the value of AllowedOrigins.
+func (s *CORSRule) SetAllowedOrigins(v []*string) *CORSRule {
+	s.AllowedOrigins = v
+	return s
 }
 
-type DeleteBucketLifecycleOutput struct {
-	metadataDeleteBucketLifecycleOutput `json:"-" xml:"-"`
+// GetExposeHeaders returns the value of ExposeHeaders, or nil if it is not set.
+func (s *CORSRule) GetExposeHeaders() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.ExposeHeaders
 }
 
-type metadataDeleteBucketLifecycleOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetExposeHeaders sets the value of ExposeHeaders.
+func (s *CORSRule) SetExposeHeaders(v []*string) *CORSRule {
+	s.ExposeHeaders = v
+	return s
 }
 
-type DeleteBucketOutput struct {
-	metadataDeleteBucketOutput `json:"-" xml:"-"`
+// GetMaxAgeSeconds returns the value of MaxAgeSeconds, or the zero value if it is not set.
+func (s *CORSRule) GetMaxAgeSeconds() int64 {
+	if s == nil || s.MaxAgeSeconds == nil {
+		return 0
+	}
+	return *s.MaxAgeSeconds
 }
 
-type metadataDeleteBucketOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetMaxAgeSeconds sets the value of MaxAgeSeconds.
+func (s *CORSRule) SetMaxAgeSeconds(v int64) *CORSRule {
+	s.MaxAgeSeconds = &v
+	return s
 }
 
-type DeleteBucketPolicyInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type CSVInput struct {
+	// Specifies that CSV field values may contain quoted record delimiters and
+	// such records should be allowed.
+	AllowQuotedRecordDelimiter *bool `type:"boolean"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// A single character used to indicate that a row should be ignored when the
+	// character is present at the start of that row.
+	Comments *string `type:"string"`
 
-	metadataDeleteBucketPolicyInput `json:"-" xml:"-"`
+	// A single character used to separate individual fields in a record.
+	FieldDelimiter *string `type:"string"`
+
+	// Describes the first line of input. Valid values are NONE, IGNORE and USE.
+	//
+	// The valid values are the FileHeaderInfo enum constants.
+	FileHeaderInfo *string `type:"string" enum:"USE,IGNORE,NONE"`
+
+	// A single character used for escaping when the field delimiter is part of
+	// the value.
+	QuoteCharacter *string `type:"string"`
+
+	// A single character used for escaping the quotation mark character inside
+	// an already escaped value.
+	QuoteEscapeCharacter *string `type:"string"`
+
+	// A single character used to separate individual records in the input.
+	RecordDelimiter *string `type:"string"`
+
+	metadataCSVInput `json:"-" xml:"-"`
 }
 
-type metadataDeleteBucketPolicyInput struct {
+type metadataCSVInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeleteBucketPolicyOutput struct {
-	metadataDeleteBucketPolicyOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s CSVInput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataDeleteBucketPolicyOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s CSVInput) GoString() string {
+	return s.String()
 }
 
-type DeleteBucketReplicationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetAllowQuotedRecordDelimiter returns the value of AllowQuotedRecordDelimiter, or the zero value if it is not set.
+func (s *CSVInput) GetAllowQuotedRecordDelimiter() bool {
+	if s == nil || s.AllowQuotedRecordDelimiter == nil {
+		return false
+	}
+	return *s.AllowQuotedRecordDelimiter
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetAllowQuotedRecordDelimiter sets the value of AllowQuotedRecordDelimiter.
+func (s *CSVInput) SetAllowQuotedRecordDelimiter(v bool) *CSVInput {
+	s.AllowQuotedRecordDelimiter = &v
+	return s
+}
 
-	metadataDeleteBucketReplicationInput `json:"-" xml:"-"`
+// GetComments returns the value of Comments, or the zero value if it is not set.
+func (s *CSVInput) GetComments() string {
+	if s == nil || s.Comments == nil {
+		return ""
+	}
+	return *s.Comments
 }
 
-type metadataDeleteBucketReplicationInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetComments sets the value of Comments.
+func (s *CSVInput) SetComments(v string) *CSVInput {
+	s.Comments = &v
+	return s
 }
 
-type DeleteBucketReplicationOutput struct {
-	metadataDeleteBucketReplicationOutput `json:"-" xml:"-"`
+// GetFieldDelimiter returns the value of FieldDelimiter, or the zero value if it is not set.
+func (s *CSVInput) GetFieldDelimiter() string {
+	if s == nil || s.FieldDelimiter == nil {
+		return ""
+	}
+	return *s.FieldDelimiter
 }
 
-type metadataDeleteBucketReplicationOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetFieldDelimiter sets the value of FieldDelimiter.
+func (s *CSVInput) SetFieldDelimiter(v string) *CSVInput {
+	s.FieldDelimiter = &v
+	return s
 }
 
-type DeleteBucketTaggingInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetFileHeaderInfo returns the value of FileHeaderInfo, or the zero value if it is not set.
+func (s *CSVInput) GetFileHeaderInfo() string {
+	if s == nil || s.FileHeaderInfo == nil {
+		return ""
+	}
+	return *s.FileHeaderInfo
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetFileHeaderInfo sets the value of FileHeaderInfo.
+func (s *CSVInput) SetFileHeaderInfo(v string) *CSVInput {
+	s.FileHeaderInfo = &v
+	return s
+}
 
-	metadataDeleteBucketTaggingInput `json:"-" xml:"-"`
+// GetQuoteCharacter returns the value of QuoteCharacter, or the zero value if it is not set.
+func (s *CSVInput) GetQuoteCharacter() string {
+	if s == nil || s.QuoteCharacter == nil {
+		return ""
+	}
+	return *s.QuoteCharacter
 }
 
-type metadataDeleteBucketTaggingInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetQuoteCharacter sets the value of QuoteCharacter.
+func (s *CSVInput) SetQuoteCharacter(v string) *CSVInput {
+	s.QuoteCharacter = &v
+	return s
 }
 
-type DeleteBucketTaggingOutput struct {
-	metadataDeleteBucketTaggingOutput `json:"-" xml:"-"`
+// GetQuoteEscapeCharacter returns the value of QuoteEscapeCharacter, or the zero value if it is not set.
+func (s *CSVInput) GetQuoteEscapeCharacter() string {
+	if s == nil || s.QuoteEscapeCharacter == nil {
+		return ""
+	}
+	return *s.QuoteEscapeCharacter
 }
 
-type metadataDeleteBucketTaggingOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetQuoteEscapeCharacter sets the value of QuoteEscapeCharacter.
+func (s *CSVInput) SetQuoteEscapeCharacter(v string) *CSVInput {
+	s.QuoteEscapeCharacter = &v
+	return s
 }
 
-type DeleteBucketWebsiteInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetRecordDelimiter returns the value of RecordDelimiter, or the zero value if it is not set.
+func (s *CSVInput) GetRecordDelimiter() string {
+	if s == nil || s.RecordDelimiter == nil {
+		return ""
+	}
+	return *s.RecordDelimiter
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetRecordDelimiter sets the value of RecordDelimiter.
+func (s *CSVInput) SetRecordDelimiter(v string) *CSVInput {
+	s.RecordDelimiter = &v
+	return s
+}
 
-	metadataDeleteBucketWebsiteInput `json:"-" xml:"-"`
+type CSVOutput struct {
+	// The value used to separate individual fields in a record.
+	FieldDelimiter *string `type:"string"`
+
+	// A single character used for escaping when the field delimiter is part of
+	// the value.
+	QuoteCharacter *string `type:"string"`
+
+	// A single character used for escaping the quote character inside an already
+	// escaped value.
+	QuoteEscapeCharacter *string `type:"string"`
+
+	// Indicates whether to use quotation marks around output fields. Valid values
+	// are ALWAYS and ASNEEDED.
+	//
+	// The valid values are the QuoteFields enum constants.
+	QuoteFields *string `type:"string" enum:"ALWAYS,ASNEEDED"`
+
+	// A single character used to separate individual records in the output.
+	RecordDelimiter *string `type:"string"`
+
+	metadataCSVOutput `json:"-" xml:"-"`
 }
 
-type metadataDeleteBucketWebsiteInput struct {
+type metadataCSVOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeleteBucketWebsiteOutput struct {
-	metadataDeleteBucketWebsiteOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s CSVOutput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataDeleteBucketWebsiteOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s CSVOutput) GoString() string {
+	return s.String()
 }
 
-type DeleteMarkerEntry struct {
-	// Specifies whether the object is (true) or is not (false) the latest version
-	// of an object.
-	IsLatest *bool `type:"boolean"`
+// GetFieldDelimiter returns the value of FieldDelimiter, or the zero value if it is not set.
+func (s *CSVOutput) GetFieldDelimiter() string {
+	if s == nil || s.FieldDelimiter == nil {
+		return ""
+	}
+	return *s.FieldDelimiter
+}
 
-	// The object key.
-	Key *string `type:"string"`
+// SetFieldDelimiter sets the value of FieldDelimiter.
+func (s *CSVOutput) SetFieldDelimiter(v string) *CSVOutput {
+	s.FieldDelimiter = &v
+	return s
+}
 
-	// Date and time the object was last modified.
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+// GetQuoteCharacter returns the value of QuoteCharacter, or the zero value if it is not set.
+func (s *CSVOutput) GetQuoteCharacter() string {
+	if s == nil || s.QuoteCharacter == nil {
+		return ""
+	}
+	return *s.QuoteCharacter
+}
 
-	Owner *Owner `type:"structure"`
+// SetQuoteCharacter sets the value of QuoteCharacter.
+func (s *CSVOutput) SetQuoteCharacter(v string) *CSVOutput {
+	s.QuoteCharacter = &v
+	return s
+}
 
-	// Version ID of an object.
-	VersionID *string `locationName:"VersionId" type:"string"`
+// GetQuoteEscapeCharacter returns the value of QuoteEscapeCharacter, or the zero value if it is not set.
+func (s *CSVOutput) GetQuoteEscapeCharacter() string {
+	if s == nil || s.QuoteEscapeCharacter == nil {
+		return ""
+	}
+	return *s.QuoteEscapeCharacter
+}
 
-	metadataDeleteMarkerEntry `json:"-" xml:"-"`
+// SetQuoteEscapeCharacter sets the value of QuoteEscapeCharacter.
+func (s *CSVOutput) SetQuoteEscapeCharacter(v string) *CSVOutput {
+	s.QuoteEscapeCharacter = &v
+	return s
 }
 
-type metadataDeleteMarkerEntry struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetQuoteFields returns the value of QuoteFields, or the zero value if it is not set.
+func (s *CSVOutput) GetQuoteFields() string {
+	if s == nil || s.QuoteFields == nil {
+		return ""
+	}
+	return *s.QuoteFields
 }
 
-type DeleteObjectInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetQuoteFields sets the value of QuoteFields.
+func (s *CSVOutput) SetQuoteFields(v string) *CSVOutput {
+	s.QuoteFields = &v
+	return s
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// GetRecordDelimiter returns the value of RecordDelimiter, or the zero value if it is not set.
+func (s *CSVOutput) GetRecordDelimiter() string {
+	if s == nil || s.RecordDelimiter == nil {
+		return ""
+	}
+	return *s.RecordDelimiter
+}
 
-	// The concatenation of the authentication device's serial number, a space,
-	// and the value that is displayed on your authentication device.
-	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+// SetRecordDelimiter sets the value of RecordDelimiter.
+func (s *CSVOutput) SetRecordDelimiter(v string) *CSVOutput {
+	s.RecordDelimiter = &v
+	return s
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+type CloudFunctionConfiguration struct {
+	CloudFunction *string `type:"string"`
 
-	// VersionId used to reference a specific version of the object.
-	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+	// Bucket event for which to send notifications.
+	//
+	// The valid values are the Event enum constants.
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true"`
 
-	metadataDeleteObjectInput `json:"-" xml:"-"`
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
+
+	InvocationRole *string `type:"string"`
+
+	metadataCloudFunctionConfiguration `json:"-" xml:"-"`
 }
 
-type metadataDeleteObjectInput struct {
+type metadataCloudFunctionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeleteObjectOutput struct {
-	// Specifies whether the versioned object that was permanently deleted was (true)
-	// or was not (false) a delete marker.
-	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
-
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
-
-	// Returns the version ID of the delete marker created as a result of the DELETE
-	// operation.
-	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+// String returns the string representation.
+func (s CloudFunctionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataDeleteObjectOutput `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s CloudFunctionConfiguration) GoString() string {
+	return s.String()
 }
 
-type metadataDeleteObjectOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetCloudFunction returns the value of CloudFunction, or the zero value if it is not set.
+func (s *CloudFunctionConfiguration) GetCloudFunction() string {
+	if s == nil || s.CloudFunction == nil {
+		return ""
+	}
+	return *s.CloudFunction
 }
 
-type DeleteObjectsInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetCloudFunction sets the value of CloudFunction.
+func (s *CloudFunctionConfiguration) SetCloudFunction(v string) *CloudFunctionConfiguration {
+	s.CloudFunction = &v
+	return s
+}
 
-	Delete *Delete `locationName:"Delete" type:"structure" required:"true"`
+// GetEvent returns the value of Event, or the zero value if it is not set.
+func (s *CloudFunctionConfiguration) GetEvent() string {
+	if s == nil || s.Event == nil {
+		return ""
+	}
+	return *s.Event
+}
 
-	// The concatenation of the authentication device's serial number, a space,
-	// and the value that is displayed on your authentication device.
-	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+// SetEvent sets the value of Event.
+func (s *CloudFunctionConfiguration) SetEvent(v string) *CloudFunctionConfiguration {
+	s.Event = &v
+	return s
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *CloudFunctionConfiguration) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetEvents sets the value of Events.
+func (s *CloudFunctionConfiguration) SetEvents(v []*string) *CloudFunctionConfiguration {
+	s.Events = v
+	return s
+}
 
-	metadataDeleteObjectsInput `json:"-" xml:"-"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *CloudFunctionConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
 }
 
-type metadataDeleteObjectsInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Delete"`
+// SetID sets the value of ID.
+func (s *CloudFunctionConfiguration) SetID(v string) *CloudFunctionConfiguration {
+	s.ID = &v
+	return s
 }
 
-type DeleteObjectsOutput struct {
-	Deleted []*DeletedObject `type:"list" flattened:"true"`
+// GetInvocationRole returns the value of InvocationRole, or the zero value if it is not set.
+func (s *CloudFunctionConfiguration) GetInvocationRole() string {
+	if s == nil || s.InvocationRole == nil {
+		return ""
+	}
+	return *s.InvocationRole
+}
 
-	Errors []*Error `locationName:"Error" type:"list" flattened:"true"`
+// SetInvocationRole sets the value of InvocationRole.
+func (s *CloudFunctionConfiguration) SetInvocationRole(v string) *CloudFunctionConfiguration {
+	s.InvocationRole = &v
+	return s
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+type CommonPrefix struct {
+	Prefix *string `type:"string"`
 
-	metadataDeleteObjectsOutput `json:"-" xml:"-"`
+	metadataCommonPrefix `json:"-" xml:"-"`
 }
 
-type metadataDeleteObjectsOutput struct {
+type metadataCommonPrefix struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type DeletedObject struct {
-	DeleteMarker *bool `type:"boolean"`
-
-	DeleteMarkerVersionID *string `locationName:"DeleteMarkerVersionId" type:"string"`
-
-	Key *string `type:"string"`
+// String returns the string representation.
+func (s CommonPrefix) String() string {
+	return awsutil.StringValue(s)
+}
 
-	VersionID *string `locationName:"VersionId" type:"string"`
+// GoString returns the string representation.
+func (s CommonPrefix) GoString() string {
+	return s.String()
+}
 
-	metadataDeletedObject `json:"-" xml:"-"`
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *CommonPrefix) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
 }
 
-type metadataDeletedObject struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetPrefix sets the value of Prefix.
+func (s *CommonPrefix) SetPrefix(v string) *CommonPrefix {
+	s.Prefix = &v
+	return s
 }
 
-type Destination struct {
-	// Amazon resource name (ARN) of the bucket where you want Amazon S3 to store
-	// replicas of the object identified by the rule.
-	Bucket *string `type:"string" required:"true"`
+type CompleteMultipartUploadInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	metadataDestination `json:"-" xml:"-"`
-}
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-type metadataDestination struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
 
-type Error struct {
-	Code *string `type:"string"`
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	Key *string `type:"string"`
+	MultipartUpload *CompletedMultipartUpload `locationName:"CompleteMultipartUpload" type:"structure"`
 
-	Message *string `type:"string"`
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
-	VersionID *string `locationName:"VersionId" type:"string"`
+	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
-	metadataError `json:"-" xml:"-"`
+	metadataCompleteMultipartUploadInput `json:"-" xml:"-"`
 }
 
-type metadataError struct {
-	SDKShapeTraits bool `type:"structure"`
+type metadataCompleteMultipartUploadInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"MultipartUpload"`
 }
 
-type ErrorDocument struct {
-	// The object key name to use when a 4XX class error occurs.
-	Key *string `type:"string" required:"true"`
-
-	metadataErrorDocument `json:"-" xml:"-"`
+// String returns the string representation.
+func (s CompleteMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataErrorDocument struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s CompleteMultipartUploadInput) GoString() string {
+	return s.String()
 }
 
-type GetBucketACLInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
 
-	metadataGetBucketACLInput `json:"-" xml:"-"`
+// SetBucket sets the value of Bucket.
+func (s *CompleteMultipartUploadInput) SetBucket(v string) *CompleteMultipartUploadInput {
+	s.Bucket = &v
+	return s
 }
 
-type metadataGetBucketACLInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
 }
 
-type GetBucketACLOutput struct {
-	// A list of grants.
-	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
+// SetContentType sets the value of ContentType.
+func (s *CompleteMultipartUploadInput) SetContentType(v string) *CompleteMultipartUploadInput {
+	s.ContentType = &v
+	return s
+}
 
-	Owner *Owner `type:"structure"`
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
 
-	metadataGetBucketACLOutput `json:"-" xml:"-"`
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *CompleteMultipartUploadInput) SetExpectedBucketOwner(v string) *CompleteMultipartUploadInput {
+	s.ExpectedBucketOwner = &v
+	return s
 }
 
-type metadataGetBucketACLOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
 }
 
-type GetBucketCORSInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetKey sets the value of Key.
+func (s *CompleteMultipartUploadInput) SetKey(v string) *CompleteMultipartUploadInput {
+	s.Key = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetMultipartUpload returns the value of MultipartUpload, or nil if it is not set.
+func (s *CompleteMultipartUploadInput) GetMultipartUpload() *CompletedMultipartUpload {
+	if s == nil {
+		return nil
+	}
+	return s.MultipartUpload
+}
 
-	metadataGetBucketCORSInput `json:"-" xml:"-"`
+// SetMultipartUpload sets the value of MultipartUpload.
+func (s *CompleteMultipartUploadInput) SetMultipartUpload(v *CompletedMultipartUpload) *CompleteMultipartUploadInput {
+	s.MultipartUpload = v
+	return s
 }
 
-type metadataGetBucketCORSInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
 }
 
-type GetBucketCORSOutput struct {
-	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
+// SetRequestPayer sets the value of RequestPayer.
+func (s *CompleteMultipartUploadInput) SetRequestPayer(v string) *CompleteMultipartUploadInput {
+	s.RequestPayer = &v
+	return s
+}
 
-	metadataGetBucketCORSOutput `json:"-" xml:"-"`
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *CompleteMultipartUploadInput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
 }
 
-type metadataGetBucketCORSOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetUploadID sets the value of UploadID.
+func (s *CompleteMultipartUploadInput) SetUploadID(v string) *CompleteMultipartUploadInput {
+	s.UploadID = &v
+	return s
 }
 
-type GetBucketLifecycleInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type CompleteMultipartUploadOutput struct {
+	Bucket *string `type:"string"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Entity tag of the object.
+	ETag *string `type:"string"`
 
-	metadataGetBucketLifecycleInput `json:"-" xml:"-"`
-}
+	// If the object expiration is configured, this will contain the expiration
+	// date (expiry-date) and rule ID (rule-id). The value of rule-id is URL encoded.
+	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
 
-type metadataGetBucketLifecycleInput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	Key *string `type:"string"`
 
-type GetBucketLifecycleOutput struct {
-	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true"`
+	Location *string `type:"string"`
 
-	metadataGetBucketLifecycleOutput `json:"-" xml:"-"`
-}
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
-type metadataGetBucketLifecycleOutput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
 
-type GetBucketLocationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Version of the object.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
 
-	metadataGetBucketLocationInput `json:"-" xml:"-"`
+	metadataCompleteMultipartUploadOutput `json:"-" xml:"-"`
 }
 
-type metadataGetBucketLocationInput struct {
+type metadataCompleteMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetBucketLocationOutput struct {
-	LocationConstraint *string `type:"string"`
+// String returns the string representation.
+func (s CompleteMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataGetBucketLocationOutput `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s CompleteMultipartUploadOutput) GoString() string {
+	return s.String()
 }
 
-type metadataGetBucketLocationOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
 }
 
-type GetBucketLoggingInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetBucket sets the value of Bucket.
+func (s *CompleteMultipartUploadOutput) SetBucket(v string) *CompleteMultipartUploadOutput {
+	s.Bucket = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
 
-	metadataGetBucketLoggingInput `json:"-" xml:"-"`
+// SetETag sets the value of ETag.
+func (s *CompleteMultipartUploadOutput) SetETag(v string) *CompleteMultipartUploadOutput {
+	s.ETag = &v
+	return s
 }
 
-type metadataGetBucketLoggingInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetExpiration returns the value of Expiration, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetExpiration() string {
+	if s == nil || s.Expiration == nil {
+		return ""
+	}
+	return *s.Expiration
 }
 
-type GetBucketLoggingOutput struct {
-	LoggingEnabled *LoggingEnabled `type:"structure"`
+// SetExpiration sets the value of Expiration.
+func (s *CompleteMultipartUploadOutput) SetExpiration(v string) *CompleteMultipartUploadOutput {
+	s.Expiration = &v
+	return s
+}
 
-	metadataGetBucketLoggingOutput `json:"-" xml:"-"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
 }
 
-type metadataGetBucketLoggingOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetKey sets the value of Key.
+func (s *CompleteMultipartUploadOutput) SetKey(v string) *CompleteMultipartUploadOutput {
+	s.Key = &v
+	return s
 }
 
-type GetBucketNotificationConfigurationRequest struct {
-	// Name of the buket to get the notification configuration for.
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	metadataGetBucketNotificationConfigurationRequest `json:"-" xml:"-"`
+// GetLocation returns the value of Location, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetLocation() string {
+	if s == nil || s.Location == nil {
+		return ""
+	}
+	return *s.Location
 }
 
-type metadataGetBucketNotificationConfigurationRequest struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetLocation sets the value of Location.
+func (s *CompleteMultipartUploadOutput) SetLocation(v string) *CompleteMultipartUploadOutput {
+	s.Location = &v
+	return s
 }
 
-type GetBucketPolicyInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetRequestCharged sets the value of RequestCharged.
+func (s *CompleteMultipartUploadOutput) SetRequestCharged(v string) *CompleteMultipartUploadOutput {
+	s.RequestCharged = &v
+	return s
+}
 
-	metadataGetBucketPolicyInput `json:"-" xml:"-"`
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
 }
 
-type metadataGetBucketPolicyInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *CompleteMultipartUploadOutput) SetSSEKMSKeyID(v string) *CompleteMultipartUploadOutput {
+	s.SSEKMSKeyID = &v
+	return s
 }
 
-type GetBucketPolicyOutput struct {
-	// The bucket policy as a JSON document.
-	Policy *string `type:"string"`
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
 
-	metadataGetBucketPolicyOutput `json:"-" xml:"-"`
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *CompleteMultipartUploadOutput) SetServerSideEncryption(v string) *CompleteMultipartUploadOutput {
+	s.ServerSideEncryption = &v
+	return s
 }
 
-type metadataGetBucketPolicyOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Policy"`
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *CompleteMultipartUploadOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
 }
 
-type GetBucketReplicationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetVersionID sets the value of VersionID.
+func (s *CompleteMultipartUploadOutput) SetVersionID(v string) *CompleteMultipartUploadOutput {
+	s.VersionID = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+type CompletedMultipartUpload struct {
+	Parts []*CompletedPart `locationName:"Part" type:"list" flattened:"true"`
 
-	metadataGetBucketReplicationInput `json:"-" xml:"-"`
+	metadataCompletedMultipartUpload `json:"-" xml:"-"`
 }
 
-type metadataGetBucketReplicationInput struct {
+type metadataCompletedMultipartUpload struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetBucketReplicationOutput struct {
-	// Container for replication rules. You can add as many as 1,000 rules. Total
-	// replication configuration size can be up to 2 MB.
-	ReplicationConfiguration *ReplicationConfiguration `type:"structure"`
-
-	metadataGetBucketReplicationOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s CompletedMultipartUpload) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataGetBucketReplicationOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
+// GoString returns the string representation.
+func (s CompletedMultipartUpload) GoString() string {
+	return s.String()
 }
 
-type GetBucketRequestPaymentInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
-
-	metadataGetBucketRequestPaymentInput `json:"-" xml:"-"`
+// GetParts returns the value of Parts, or nil if it is not set.
+func (s *CompletedMultipartUpload) GetParts() []*CompletedPart {
+	if s == nil {
+		return nil
+	}
+	return s.Parts
 }
 
-type metadataGetBucketRequestPaymentInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetParts sets the value of Parts.
+func (s *CompletedMultipartUpload) SetParts(v []*CompletedPart) *CompletedMultipartUpload {
+	s.Parts = v
+	return s
 }
 
-type GetBucketRequestPaymentOutput struct {
-	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string"`
+type CompletedPart struct {
+	// Entity tag returned when the part was uploaded.
+	ETag *string `type:"string"`
 
-	metadataGetBucketRequestPaymentOutput `json:"-" xml:"-"`
+	// Part number that identifies the part.
+	PartNumber *int64 `type:"integer"`
+
+	metadataCompletedPart `json:"-" xml:"-"`
 }
 
-type metadataGetBucketRequestPaymentOutput struct {
+type metadataCompletedPart struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetBucketTaggingInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// String returns the string representation.
+func (s CompletedPart) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataGetBucketTaggingInput `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s CompletedPart) GoString() string {
+	return s.String()
 }
 
-type metadataGetBucketTaggingInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *CompletedPart) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
 }
 
-type GetBucketTaggingOutput struct {
-	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
+// SetETag sets the value of ETag.
+func (s *CompletedPart) SetETag(v string) *CompletedPart {
+	s.ETag = &v
+	return s
+}
 
-	metadataGetBucketTaggingOutput `json:"-" xml:"-"`
+// GetPartNumber returns the value of PartNumber, or the zero value if it is not set.
+func (s *CompletedPart) GetPartNumber() int64 {
+	if s == nil || s.PartNumber == nil {
+		return 0
+	}
+	return *s.PartNumber
 }
 
-type metadataGetBucketTaggingOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetPartNumber sets the value of PartNumber.
+func (s *CompletedPart) SetPartNumber(v int64) *CompletedPart {
+	s.PartNumber = &v
+	return s
 }
 
-type GetBucketVersioningInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type Condition struct {
+	// The HTTP error code when the redirect is applied. In the event of an error,
+	// if the error code equals this value, then the specified redirect is applied.
+	// Required when parent element Condition is specified and sibling KeyPrefixEquals
+	// is not specified. If both are specified, then both must be true for the redirect
+	// to be applied.
+	HTTPErrorCodeReturnedEquals *string `locationName:"HttpErrorCodeReturnedEquals" type:"string"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// The object key name prefix when the redirect is applied. For example, to
+	// redirect requests for ExamplePage.html, the key prefix will be ExamplePage.html.
+	// To redirect request for all pages with the prefix docs/, the key prefix will
+	// be /docs, which identifies all objects in the docs/ folder. Required when
+	// the parent element Condition is specified and sibling HttpErrorCodeReturnedEquals
+	// is not specified. If both conditions are specified, both must be true for
+	// the redirect to be applied.
+	KeyPrefixEquals *string `type:"string"`
 
-	metadataGetBucketVersioningInput `json:"-" xml:"-"`
+	metadataCondition `json:"-" xml:"-"`
 }
 
-type metadataGetBucketVersioningInput struct {
+type metadataCondition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetBucketVersioningOutput struct {
-	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
-	// This element is only returned if the bucket has been configured with MFA
-	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+// String returns the string representation.
+func (s Condition) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// The versioning state of the bucket.
-	Status *string `type:"string"`
+// GoString returns the string representation.
+func (s Condition) GoString() string {
+	return s.String()
+}
 
-	metadataGetBucketVersioningOutput `json:"-" xml:"-"`
+// GetHTTPErrorCodeReturnedEquals returns the value of HTTPErrorCodeReturnedEquals, or the zero value if it is not set.
+func (s *Condition) GetHTTPErrorCodeReturnedEquals() string {
+	if s == nil || s.HTTPErrorCodeReturnedEquals == nil {
+		return ""
+	}
+	return *s.HTTPErrorCodeReturnedEquals
 }
 
-type metadataGetBucketVersioningOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetHTTPErrorCodeReturnedEquals sets the value of HTTPErrorCodeReturnedEquals.
+func (s *Condition) SetHTTPErrorCodeReturnedEquals(v string) *Condition {
+	s.HTTPErrorCodeReturnedEquals = &v
+	return s
 }
 
-type GetBucketWebsiteInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetKeyPrefixEquals returns the value of KeyPrefixEquals, or the zero value if it is not set.
+func (s *Condition) GetKeyPrefixEquals() string {
+	if s == nil || s.KeyPrefixEquals == nil {
+		return ""
+	}
+	return *s.KeyPrefixEquals
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetKeyPrefixEquals sets the value of KeyPrefixEquals.
+func (s *Condition) SetKeyPrefixEquals(v string) *Condition {
+	s.KeyPrefixEquals = &v
+	return s
+}
 
-	metadataGetBucketWebsiteInput `json:"-" xml:"-"`
+type ContinuationEvent struct {
+	metadataContinuationEvent `json:"-" xml:"-"`
 }
 
-type metadataGetBucketWebsiteInput struct {
+type metadataContinuationEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetBucketWebsiteOutput struct {
-	ErrorDocument *ErrorDocument `type:"structure"`
-
-	IndexDocument *IndexDocument `type:"structure"`
-
-	RedirectAllRequestsTo *RedirectAllRequestsTo `type:"structure"`
-
-	RoutingRules []*RoutingRule `locationNameList:"RoutingRule" type:"list"`
-
-	metadataGetBucketWebsiteOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s ContinuationEvent) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataGetBucketWebsiteOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s ContinuationEvent) GoString() string {
+	return s.String()
 }
 
-type GetObjectACLInput struct {
+type CopyObjectInput struct {
+	// The canned ACL to apply to the object.
+	//
+	// The valid values are the ObjectCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,aws-exec-read,bucket-owner-read,bucket-owner-full-control"`
+
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
 
-	// VersionId used to reference a specific version of the object.
-	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
 
+	// A standard MIME type describing the format of the object data.
 	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	metadataGetObjectACLInput `json:"-" xml:"-"`
-}
+	// The name of the source bucket and key name of the source object, separated
+	// by a slash (/). Must be URL-encoded.
+	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
 
-type metadataGetObjectACLInput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// Copies the object if its entity tag (ETag) matches the specified tag.
+	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
 
-type GetObjectACLOutput struct {
-	// A list of grants.
-	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
+	// Copies the object if it has been modified since the specified time.
+	CopySourceIfModifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-modified-since" type:"timestamp" timestampFormat:"rfc822"`
 
-	Owner *Owner `type:"structure"`
+	// Copies the object if its entity tag (ETag) is different than the specified
+	// ETag.
+	CopySourceIfNoneMatch *string `location:"header" locationName:"x-amz-copy-source-if-none-match" type:"string"`
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	// Copies the object if it hasn't been modified since the specified time.
+	CopySourceIfUnmodifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-unmodified-since" type:"timestamp" timestampFormat:"rfc822"`
 
-	metadataGetObjectACLOutput `json:"-" xml:"-"`
-}
+	// Specifies the algorithm to use when decrypting the source object (e.g., AES256).
+	CopySourceSSECustomerAlgorithm *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-algorithm" type:"string"`
 
-type metadataGetObjectACLOutput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
+	// the source object. The encryption key provided in this header must be one
+	// that was used when the source object was created.
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
 
-type GetObjectInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	CopySourceSSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key-MD5" type:"string"`
 
-	// Return the object only if its entity tag (ETag) is the same as the one specified,
-	// otherwise return a 412 (precondition failed).
-	IfMatch *string `location:"header" locationName:"If-Match" type:"string"`
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
 
-	// Return the object only if it has been modified since the specified time,
-	// otherwise return a 304 (not modified).
-	IfModifiedSince *time.Time `location:"header" locationName:"If-Modified-Since" type:"timestamp" timestampFormat:"rfc822"`
+	// The account ID of the expected source bucket owner. If the source bucket
+	// is owned by a different account, the request fails with an HTTP 403 (Access
+	// Denied) error.
+	ExpectedSourceBucketOwner *string `location:"header" locationName:"x-amz-source-expected-bucket-owner" type:"string"`
 
-	// Return the object only if its entity tag (ETag) is different from the one
-	// specified, otherwise return a 304 (not modified).
-	IfNoneMatch *string `location:"header" locationName:"If-None-Match" type:"string"`
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
 
-	// Return the object only if it has not been modified since the specified time,
-	// otherwise return a 412 (precondition failed).
-	IfUnmodifiedSince *time.Time `location:"header" locationName:"If-Unmodified-Since" type:"timestamp" timestampFormat:"rfc822"`
+	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+	// Allows grantee to read the object data and its metadata.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
 
-	// Downloads the specified range bytes of an object. For more information about
-	// the HTTP Range header, go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
-	Range *string `location:"header" locationName:"Range" type:"string"`
+	// Allows grantee to read the object ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	// Allows grantee to write the ACL for the applicable object.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
 
-	// Sets the Cache-Control header of the response.
-	ResponseCacheControl *string `location:"querystring" locationName:"response-cache-control" type:"string"`
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	// Sets the Content-Disposition header of the response
-	ResponseContentDisposition *string `location:"querystring" locationName:"response-content-disposition" type:"string"`
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
 
-	// Sets the Content-Encoding header of the response.
-	ResponseContentEncoding *string `location:"querystring" locationName:"response-content-encoding" type:"string"`
+	// Specifies whether the metadata is copied from the source object or replaced
+	// with metadata provided in the request.
+	//
+	// The valid values are the MetadataDirective enum constants.
+	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string" enum:"COPY,REPLACE"`
 
-	// Sets the Content-Language header of the response.
-	ResponseContentLanguage *string `location:"querystring" locationName:"response-content-language" type:"string"`
+	// Specifies whether a legal hold will be applied to this object.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	ObjectLockLegalHoldStatus *string `location:"header" locationName:"x-amz-object-lock-legal-hold" type:"string" enum:"ON,OFF"`
 
-	// Sets the Content-Type header of the response.
-	ResponseContentType *string `location:"querystring" locationName:"response-content-type" type:"string"`
+	// The Object Lock mode that you want to apply to this object.
+	//
+	// The valid values are the ObjectLockMode enum constants.
+	ObjectLockMode *string `location:"header" locationName:"x-amz-object-lock-mode" type:"string" enum:"GOVERNANCE,COMPLIANCE"`
 
-	// Sets the Expires header of the response.
-	ResponseExpires *time.Time `location:"querystring" locationName:"response-expires" type:"timestamp" timestampFormat:"iso8601"`
+	// The date and time when you want this object's Object Lock to expire.
+	ObjectLockRetainUntilDate *time.Time `location:"header" locationName:"x-amz-object-lock-retain-until-date" type:"timestamp" timestampFormat:"iso8601"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -3468,1430 +5337,18986 @@ type GetObjectInput struct {
 	// key was transmitted without error.
 	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
 
-	// VersionId used to reference a specific version of the object.
-	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
+	// requests for an object protected by AWS KMS will fail if not made via SSL
+	// or using SigV4. Documentation on configuring any of the officially supported
+	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// The type of storage to use for the object. Defaults to 'STANDARD'.
+	//
+	// The valid values are the StorageClass enum constants.
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,GLACIER,DEEP_ARCHIVE"`
+
+	// The tag-set for the object. The tag-set must be encoded as URL Query parameters.
+	Tagging *string `location:"header" locationName:"x-amz-tagging" type:"string"`
+
+	// Specifies whether the object tag-set are copied from the source object or
+	// replaced with tag-set provided in the request.
+	//
+	// The valid values are the TaggingDirective enum constants.
+	TaggingDirective *string `location:"header" locationName:"x-amz-tagging-directive" type:"string" enum:"COPY,REPLACE"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+
+	metadataCopyObjectInput `json:"-" xml:"-"`
+}
+
+type metadataCopyObjectInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CopyObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CopyObjectInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *CopyObjectInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *CopyObjectInput) SetACL(v string) *CopyObjectInput {
+	s.ACL = &v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CopyObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *CopyObjectInput) SetBucket(v string) *CopyObjectInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetCacheControl returns the value of CacheControl, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCacheControl() string {
+	if s == nil || s.CacheControl == nil {
+		return ""
+	}
+	return *s.CacheControl
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *CopyObjectInput) SetCacheControl(v string) *CopyObjectInput {
+	s.CacheControl = &v
+	return s
+}
+
+// GetContentDisposition returns the value of ContentDisposition, or the zero value if it is not set.
+func (s *CopyObjectInput) GetContentDisposition() string {
+	if s == nil || s.ContentDisposition == nil {
+		return ""
+	}
+	return *s.ContentDisposition
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *CopyObjectInput) SetContentDisposition(v string) *CopyObjectInput {
+	s.ContentDisposition = &v
+	return s
+}
+
+// GetContentEncoding returns the value of ContentEncoding, or the zero value if it is not set.
+func (s *CopyObjectInput) GetContentEncoding() string {
+	if s == nil || s.ContentEncoding == nil {
+		return ""
+	}
+	return *s.ContentEncoding
+}
+
+// SetContentEncoding sets the value of ContentEncoding.
+func (s *CopyObjectInput) SetContentEncoding(v string) *CopyObjectInput {
+	s.ContentEncoding = &v
+	return s
+}
+
+// GetContentLanguage returns the value of ContentLanguage, or the zero value if it is not set.
+func (s *CopyObjectInput) GetContentLanguage() string {
+	if s == nil || s.ContentLanguage == nil {
+		return ""
+	}
+	return *s.ContentLanguage
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *CopyObjectInput) SetContentLanguage(v string) *CopyObjectInput {
+	s.ContentLanguage = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *CopyObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CopyObjectInput) SetContentType(v string) *CopyObjectInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetCopySource returns the value of CopySource, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySource() string {
+	if s == nil || s.CopySource == nil {
+		return ""
+	}
+	return *s.CopySource
+}
+
+// SetCopySource sets the value of CopySource.
+func (s *CopyObjectInput) SetCopySource(v string) *CopyObjectInput {
+	s.CopySource = &v
+	return s
+}
+
+// GetCopySourceIfMatch returns the value of CopySourceIfMatch, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceIfMatch() string {
+	if s == nil || s.CopySourceIfMatch == nil {
+		return ""
+	}
+	return *s.CopySourceIfMatch
+}
+
+// SetCopySourceIfMatch sets the value of CopySourceIfMatch.
+func (s *CopyObjectInput) SetCopySourceIfMatch(v string) *CopyObjectInput {
+	s.CopySourceIfMatch = &v
+	return s
+}
+
+// GetCopySourceIfModifiedSince returns the value of CopySourceIfModifiedSince, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceIfModifiedSince() time.Time {
+	if s == nil || s.CopySourceIfModifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.CopySourceIfModifiedSince
+}
+
+// SetCopySourceIfModifiedSince sets the value of CopySourceIfModifiedSince.
+func (s *CopyObjectInput) SetCopySourceIfModifiedSince(v time.Time) *CopyObjectInput {
+	s.CopySourceIfModifiedSince = &v
+	return s
+}
+
+// GetCopySourceIfNoneMatch returns the value of CopySourceIfNoneMatch, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceIfNoneMatch() string {
+	if s == nil || s.CopySourceIfNoneMatch == nil {
+		return ""
+	}
+	return *s.CopySourceIfNoneMatch
+}
+
+// SetCopySourceIfNoneMatch sets the value of CopySourceIfNoneMatch.
+func (s *CopyObjectInput) SetCopySourceIfNoneMatch(v string) *CopyObjectInput {
+	s.CopySourceIfNoneMatch = &v
+	return s
+}
+
+// GetCopySourceIfUnmodifiedSince returns the value of CopySourceIfUnmodifiedSince, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceIfUnmodifiedSince() time.Time {
+	if s == nil || s.CopySourceIfUnmodifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.CopySourceIfUnmodifiedSince
+}
+
+// SetCopySourceIfUnmodifiedSince sets the value of CopySourceIfUnmodifiedSince.
+func (s *CopyObjectInput) SetCopySourceIfUnmodifiedSince(v time.Time) *CopyObjectInput {
+	s.CopySourceIfUnmodifiedSince = &v
+	return s
+}
+
+// GetCopySourceSSECustomerAlgorithm returns the value of CopySourceSSECustomerAlgorithm, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceSSECustomerAlgorithm() string {
+	if s == nil || s.CopySourceSSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerAlgorithm
+}
+
+// SetCopySourceSSECustomerAlgorithm sets the value of CopySourceSSECustomerAlgorithm.
+func (s *CopyObjectInput) SetCopySourceSSECustomerAlgorithm(v string) *CopyObjectInput {
+	s.CopySourceSSECustomerAlgorithm = &v
+	return s
+}
+
+// GetCopySourceSSECustomerKey returns the value of CopySourceSSECustomerKey, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceSSECustomerKey() string {
+	if s == nil || s.CopySourceSSECustomerKey == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerKey
+}
+
+// SetCopySourceSSECustomerKey sets the value of CopySourceSSECustomerKey.
+func (s *CopyObjectInput) SetCopySourceSSECustomerKey(v string) *CopyObjectInput {
+	s.CopySourceSSECustomerKey = &v
+	return s
+}
+
+// GetCopySourceSSECustomerKeyMD5 returns the value of CopySourceSSECustomerKeyMD5, or the zero value if it is not set.
+func (s *CopyObjectInput) GetCopySourceSSECustomerKeyMD5() string {
+	if s == nil || s.CopySourceSSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerKeyMD5
+}
+
+// SetCopySourceSSECustomerKeyMD5 sets the value of CopySourceSSECustomerKeyMD5.
+func (s *CopyObjectInput) SetCopySourceSSECustomerKeyMD5(v string) *CopyObjectInput {
+	s.CopySourceSSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *CopyObjectInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *CopyObjectInput) SetExpectedBucketOwner(v string) *CopyObjectInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetExpectedSourceBucketOwner returns the value of ExpectedSourceBucketOwner, or the zero value if it is not set.
+func (s *CopyObjectInput) GetExpectedSourceBucketOwner() string {
+	if s == nil || s.ExpectedSourceBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedSourceBucketOwner
+}
+
+// SetExpectedSourceBucketOwner sets the value of ExpectedSourceBucketOwner.
+func (s *CopyObjectInput) SetExpectedSourceBucketOwner(v string) *CopyObjectInput {
+	s.ExpectedSourceBucketOwner = &v
+	return s
+}
+
+// GetExpires returns the value of Expires, or the zero value if it is not set.
+func (s *CopyObjectInput) GetExpires() time.Time {
+	if s == nil || s.Expires == nil {
+		return time.Time{}
+	}
+	return *s.Expires
+}
+
+// SetExpires sets the value of Expires.
+func (s *CopyObjectInput) SetExpires(v time.Time) *CopyObjectInput {
+	s.Expires = &v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *CopyObjectInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *CopyObjectInput) SetGrantFullControl(v string) *CopyObjectInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *CopyObjectInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *CopyObjectInput) SetGrantRead(v string) *CopyObjectInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *CopyObjectInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *CopyObjectInput) SetGrantReadACP(v string) *CopyObjectInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *CopyObjectInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *CopyObjectInput) SetGrantWriteACP(v string) *CopyObjectInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *CopyObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *CopyObjectInput) SetKey(v string) *CopyObjectInput {
+	s.Key = &v
+	return s
+}
+
+// GetMetadata returns the value of Metadata, or nil if it is not set.
+func (s *CopyObjectInput) GetMetadata() map[string]*string {
+	if s == nil {
+		return nil
+	}
+	return s.Metadata
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *CopyObjectInput) SetMetadata(v map[string]*string) *CopyObjectInput {
+	s.Metadata = v
+	return s
+}
+
+// GetMetadataDirective returns the value of MetadataDirective, or the zero value if it is not set.
+func (s *CopyObjectInput) GetMetadataDirective() string {
+	if s == nil || s.MetadataDirective == nil {
+		return ""
+	}
+	return *s.MetadataDirective
+}
+
+// SetMetadataDirective sets the value of MetadataDirective.
+func (s *CopyObjectInput) SetMetadataDirective(v string) *CopyObjectInput {
+	s.MetadataDirective = &v
+	return s
+}
+
+// GetObjectLockLegalHoldStatus returns the value of ObjectLockLegalHoldStatus, or the zero value if it is not set.
+func (s *CopyObjectInput) GetObjectLockLegalHoldStatus() string {
+	if s == nil || s.ObjectLockLegalHoldStatus == nil {
+		return ""
+	}
+	return *s.ObjectLockLegalHoldStatus
+}
+
+// SetObjectLockLegalHoldStatus sets the value of ObjectLockLegalHoldStatus.
+func (s *CopyObjectInput) SetObjectLockLegalHoldStatus(v string) *CopyObjectInput {
+	s.ObjectLockLegalHoldStatus = &v
+	return s
+}
+
+// GetObjectLockMode returns the value of ObjectLockMode, or the zero value if it is not set.
+func (s *CopyObjectInput) GetObjectLockMode() string {
+	if s == nil || s.ObjectLockMode == nil {
+		return ""
+	}
+	return *s.ObjectLockMode
+}
+
+// SetObjectLockMode sets the value of ObjectLockMode.
+func (s *CopyObjectInput) SetObjectLockMode(v string) *CopyObjectInput {
+	s.ObjectLockMode = &v
+	return s
+}
+
+// GetObjectLockRetainUntilDate returns the value of ObjectLockRetainUntilDate, or the zero value if it is not set.
+func (s *CopyObjectInput) GetObjectLockRetainUntilDate() time.Time {
+	if s == nil || s.ObjectLockRetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.ObjectLockRetainUntilDate
+}
+
+// SetObjectLockRetainUntilDate sets the value of ObjectLockRetainUntilDate.
+func (s *CopyObjectInput) SetObjectLockRetainUntilDate(v time.Time) *CopyObjectInput {
+	s.ObjectLockRetainUntilDate = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *CopyObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *CopyObjectInput) SetRequestPayer(v string) *CopyObjectInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *CopyObjectInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *CopyObjectInput) SetSSECustomerAlgorithm(v string) *CopyObjectInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *CopyObjectInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *CopyObjectInput) SetSSECustomerKey(v string) *CopyObjectInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *CopyObjectInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *CopyObjectInput) SetSSECustomerKeyMD5(v string) *CopyObjectInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *CopyObjectInput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *CopyObjectInput) SetSSEKMSKeyID(v string) *CopyObjectInput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *CopyObjectInput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *CopyObjectInput) SetServerSideEncryption(v string) *CopyObjectInput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *CopyObjectInput) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *CopyObjectInput) SetStorageClass(v string) *CopyObjectInput {
+	s.StorageClass = &v
+	return s
+}
+
+// GetTagging returns the value of Tagging, or the zero value if it is not set.
+func (s *CopyObjectInput) GetTagging() string {
+	if s == nil || s.Tagging == nil {
+		return ""
+	}
+	return *s.Tagging
+}
+
+// SetTagging sets the value of Tagging.
+func (s *CopyObjectInput) SetTagging(v string) *CopyObjectInput {
+	s.Tagging = &v
+	return s
+}
+
+// GetTaggingDirective returns the value of TaggingDirective, or the zero value if it is not set.
+func (s *CopyObjectInput) GetTaggingDirective() string {
+	if s == nil || s.TaggingDirective == nil {
+		return ""
+	}
+	return *s.TaggingDirective
+}
+
+// SetTaggingDirective sets the value of TaggingDirective.
+func (s *CopyObjectInput) SetTaggingDirective(v string) *CopyObjectInput {
+	s.TaggingDirective = &v
+	return s
+}
+
+// GetWebsiteRedirectLocation returns the value of WebsiteRedirectLocation, or the zero value if it is not set.
+func (s *CopyObjectInput) GetWebsiteRedirectLocation() string {
+	if s == nil || s.WebsiteRedirectLocation == nil {
+		return ""
+	}
+	return *s.WebsiteRedirectLocation
+}
+
+// SetWebsiteRedirectLocation sets the value of WebsiteRedirectLocation.
+func (s *CopyObjectInput) SetWebsiteRedirectLocation(v string) *CopyObjectInput {
+	s.WebsiteRedirectLocation = &v
+	return s
+}
+
+type CopyObjectOutput struct {
+	CopyObjectResult *CopyObjectResult `type:"structure"`
+
+	CopySourceVersionID *string `location:"header" locationName:"x-amz-copy-source-version-id" type:"string"`
+
+	// If the object expiration is configured, the response includes this header.
+	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	metadataCopyObjectOutput `json:"-" xml:"-"`
+}
+
+type metadataCopyObjectOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"CopyObjectResult"`
+}
+
+// String returns the string representation.
+func (s CopyObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CopyObjectOutput) GoString() string {
+	return s.String()
+}
+
+// GetCopyObjectResult returns the value of CopyObjectResult, or nil if it is not set.
+func (s *CopyObjectOutput) GetCopyObjectResult() *CopyObjectResult {
+	if s == nil {
+		return nil
+	}
+	return s.CopyObjectResult
+}
+
+// SetCopyObjectResult sets the value of CopyObjectResult.
+func (s *CopyObjectOutput) SetCopyObjectResult(v *CopyObjectResult) *CopyObjectOutput {
+	s.CopyObjectResult = v
+	return s
+}
+
+// GetCopySourceVersionID returns the value of CopySourceVersionID, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetCopySourceVersionID() string {
+	if s == nil || s.CopySourceVersionID == nil {
+		return ""
+	}
+	return *s.CopySourceVersionID
+}
+
+// SetCopySourceVersionID sets the value of CopySourceVersionID.
+func (s *CopyObjectOutput) SetCopySourceVersionID(v string) *CopyObjectOutput {
+	s.CopySourceVersionID = &v
+	return s
+}
+
+// GetExpiration returns the value of Expiration, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetExpiration() string {
+	if s == nil || s.Expiration == nil {
+		return ""
+	}
+	return *s.Expiration
+}
+
+// SetExpiration sets the value of Expiration.
+func (s *CopyObjectOutput) SetExpiration(v string) *CopyObjectOutput {
+	s.Expiration = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *CopyObjectOutput) SetRequestCharged(v string) *CopyObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *CopyObjectOutput) SetSSECustomerAlgorithm(v string) *CopyObjectOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *CopyObjectOutput) SetSSECustomerKeyMD5(v string) *CopyObjectOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *CopyObjectOutput) SetSSEKMSKeyID(v string) *CopyObjectOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *CopyObjectOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *CopyObjectOutput) SetServerSideEncryption(v string) *CopyObjectOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+type CopyObjectResult struct {
+	ETag *string `type:"string"`
+
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	metadataCopyObjectResult `json:"-" xml:"-"`
+}
+
+type metadataCopyObjectResult struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CopyObjectResult) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CopyObjectResult) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *CopyObjectResult) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *CopyObjectResult) SetETag(v string) *CopyObjectResult {
+	s.ETag = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *CopyObjectResult) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *CopyObjectResult) SetLastModified(v time.Time) *CopyObjectResult {
+	s.LastModified = &v
+	return s
+}
+
+type CopyPartResult struct {
+	// Entity tag of the object.
+	ETag *string `type:"string"`
+
+	// Date and time at which the object was uploaded.
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	metadataCopyPartResult `json:"-" xml:"-"`
+}
+
+type metadataCopyPartResult struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CopyPartResult) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CopyPartResult) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *CopyPartResult) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *CopyPartResult) SetETag(v string) *CopyPartResult {
+	s.ETag = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *CopyPartResult) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *CopyPartResult) SetLastModified(v time.Time) *CopyPartResult {
+	s.LastModified = &v
+	return s
+}
+
+type CreateBucketConfiguration struct {
+	// Specifies the region where the bucket will be created. If you don't specify
+	// a region, the bucket will be created in US Standard.
+	//
+	// The valid values are the BucketLocationConstraint enum constants.
+	LocationConstraint *string `type:"string" enum:"EU,eu-west-1,us-west-1,us-west-2,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1,eu-central-1"`
+
+	metadataCreateBucketConfiguration `json:"-" xml:"-"`
+}
+
+type metadataCreateBucketConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CreateBucketConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CreateBucketConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetLocationConstraint returns the value of LocationConstraint, or the zero value if it is not set.
+func (s *CreateBucketConfiguration) GetLocationConstraint() string {
+	if s == nil || s.LocationConstraint == nil {
+		return ""
+	}
+	return *s.LocationConstraint
+}
+
+// SetLocationConstraint sets the value of LocationConstraint.
+func (s *CreateBucketConfiguration) SetLocationConstraint(v string) *CreateBucketConfiguration {
+	s.LocationConstraint = &v
+	return s
+}
+
+type CreateBucketInput struct {
+	// The canned ACL to apply to the bucket.
+	//
+	// The valid values are the BucketCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	CreateBucketConfiguration *CreateBucketConfiguration `locationName:"CreateBucketConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// Allows grantee the read, write, read ACP, and write ACP permissions on the
+	// bucket.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to list the objects in the bucket.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the bucket ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to create, overwrite, and delete any object in the bucket.
+	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable bucket.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	// Specifies whether you want S3 Object Lock to be enabled for the new bucket.
+	ObjectLockEnabledForBucket *bool `location:"header" locationName:"x-amz-bucket-object-lock-enabled" type:"boolean"`
+
+	metadataCreateBucketInput `json:"-" xml:"-"`
+}
+
+type metadataCreateBucketInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"CreateBucketConfiguration"`
+}
+
+// String returns the string representation.
+func (s CreateBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CreateBucketInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *CreateBucketInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *CreateBucketInput) SetACL(v string) *CreateBucketInput {
+	s.ACL = &v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CreateBucketInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *CreateBucketInput) SetBucket(v string) *CreateBucketInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *CreateBucketInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CreateBucketInput) SetContentType(v string) *CreateBucketInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetCreateBucketConfiguration returns the value of CreateBucketConfiguration, or nil if it is not set.
+func (s *CreateBucketInput) GetCreateBucketConfiguration() *CreateBucketConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.CreateBucketConfiguration
+}
+
+// SetCreateBucketConfiguration sets the value of CreateBucketConfiguration.
+func (s *CreateBucketInput) SetCreateBucketConfiguration(v *CreateBucketConfiguration) *CreateBucketInput {
+	s.CreateBucketConfiguration = v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *CreateBucketInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *CreateBucketInput) SetGrantFullControl(v string) *CreateBucketInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *CreateBucketInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *CreateBucketInput) SetGrantRead(v string) *CreateBucketInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *CreateBucketInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *CreateBucketInput) SetGrantReadACP(v string) *CreateBucketInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWrite returns the value of GrantWrite, or the zero value if it is not set.
+func (s *CreateBucketInput) GetGrantWrite() string {
+	if s == nil || s.GrantWrite == nil {
+		return ""
+	}
+	return *s.GrantWrite
+}
+
+// SetGrantWrite sets the value of GrantWrite.
+func (s *CreateBucketInput) SetGrantWrite(v string) *CreateBucketInput {
+	s.GrantWrite = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *CreateBucketInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *CreateBucketInput) SetGrantWriteACP(v string) *CreateBucketInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+// GetObjectLockEnabledForBucket returns the value of ObjectLockEnabledForBucket, or the zero value if it is not set.
+func (s *CreateBucketInput) GetObjectLockEnabledForBucket() bool {
+	if s == nil || s.ObjectLockEnabledForBucket == nil {
+		return false
+	}
+	return *s.ObjectLockEnabledForBucket
+}
+
+// SetObjectLockEnabledForBucket sets the value of ObjectLockEnabledForBucket.
+func (s *CreateBucketInput) SetObjectLockEnabledForBucket(v bool) *CreateBucketInput {
+	s.ObjectLockEnabledForBucket = &v
+	return s
+}
+
+type CreateBucketOutput struct {
+	Location *string `location:"header" locationName:"Location" type:"string"`
+
+	metadataCreateBucketOutput `json:"-" xml:"-"`
+}
+
+type metadataCreateBucketOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CreateBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CreateBucketOutput) GoString() string {
+	return s.String()
+}
+
+// GetLocation returns the value of Location, or the zero value if it is not set.
+func (s *CreateBucketOutput) GetLocation() string {
+	if s == nil || s.Location == nil {
+		return ""
+	}
+	return *s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateBucketOutput) SetLocation(v string) *CreateBucketOutput {
+	s.Location = &v
+	return s
+}
+
+type CreateMultipartUploadInput struct {
+	// The canned ACL to apply to the object.
+	//
+	// The valid values are the ObjectCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,aws-exec-read,bucket-owner-read,bucket-owner-full-control"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+
+	// A standard MIME type describing the format of the object data.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to read the object data and its metadata.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the object ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable object.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+
+	// Specifies whether a legal hold will be applied to this object.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	ObjectLockLegalHoldStatus *string `location:"header" locationName:"x-amz-object-lock-legal-hold" type:"string" enum:"ON,OFF"`
+
+	// The Object Lock mode that you want to apply to this object.
+	//
+	// The valid values are the ObjectLockMode enum constants.
+	ObjectLockMode *string `location:"header" locationName:"x-amz-object-lock-mode" type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The date and time when you want this object's Object Lock to expire.
+	ObjectLockRetainUntilDate *time.Time `location:"header" locationName:"x-amz-object-lock-retain-until-date" type:"timestamp" timestampFormat:"iso8601"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
+	// requests for an object protected by AWS KMS will fail if not made via SSL
+	// or using SigV4. Documentation on configuring any of the officially supported
+	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// The type of storage to use for the object. Defaults to 'STANDARD'.
+	//
+	// The valid values are the StorageClass enum constants.
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,GLACIER,DEEP_ARCHIVE"`
+
+	// The tag-set for the object. The tag-set must be encoded as URL Query parameters.
+	Tagging *string `location:"header" locationName:"x-amz-tagging" type:"string"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+
+	metadataCreateMultipartUploadInput `json:"-" xml:"-"`
+}
+
+type metadataCreateMultipartUploadInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CreateMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CreateMultipartUploadInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *CreateMultipartUploadInput) SetACL(v string) *CreateMultipartUploadInput {
+	s.ACL = &v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *CreateMultipartUploadInput) SetBucket(v string) *CreateMultipartUploadInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetCacheControl returns the value of CacheControl, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetCacheControl() string {
+	if s == nil || s.CacheControl == nil {
+		return ""
+	}
+	return *s.CacheControl
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *CreateMultipartUploadInput) SetCacheControl(v string) *CreateMultipartUploadInput {
+	s.CacheControl = &v
+	return s
+}
+
+// GetContentDisposition returns the value of ContentDisposition, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetContentDisposition() string {
+	if s == nil || s.ContentDisposition == nil {
+		return ""
+	}
+	return *s.ContentDisposition
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *CreateMultipartUploadInput) SetContentDisposition(v string) *CreateMultipartUploadInput {
+	s.ContentDisposition = &v
+	return s
+}
+
+// GetContentEncoding returns the value of ContentEncoding, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetContentEncoding() string {
+	if s == nil || s.ContentEncoding == nil {
+		return ""
+	}
+	return *s.ContentEncoding
+}
+
+// SetContentEncoding sets the value of ContentEncoding.
+func (s *CreateMultipartUploadInput) SetContentEncoding(v string) *CreateMultipartUploadInput {
+	s.ContentEncoding = &v
+	return s
+}
+
+// GetContentLanguage returns the value of ContentLanguage, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetContentLanguage() string {
+	if s == nil || s.ContentLanguage == nil {
+		return ""
+	}
+	return *s.ContentLanguage
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *CreateMultipartUploadInput) SetContentLanguage(v string) *CreateMultipartUploadInput {
+	s.ContentLanguage = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *CreateMultipartUploadInput) SetContentType(v string) *CreateMultipartUploadInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *CreateMultipartUploadInput) SetExpectedBucketOwner(v string) *CreateMultipartUploadInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetExpires returns the value of Expires, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetExpires() time.Time {
+	if s == nil || s.Expires == nil {
+		return time.Time{}
+	}
+	return *s.Expires
+}
+
+// SetExpires sets the value of Expires.
+func (s *CreateMultipartUploadInput) SetExpires(v time.Time) *CreateMultipartUploadInput {
+	s.Expires = &v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *CreateMultipartUploadInput) SetGrantFullControl(v string) *CreateMultipartUploadInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *CreateMultipartUploadInput) SetGrantRead(v string) *CreateMultipartUploadInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *CreateMultipartUploadInput) SetGrantReadACP(v string) *CreateMultipartUploadInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *CreateMultipartUploadInput) SetGrantWriteACP(v string) *CreateMultipartUploadInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *CreateMultipartUploadInput) SetKey(v string) *CreateMultipartUploadInput {
+	s.Key = &v
+	return s
+}
+
+// GetMetadata returns the value of Metadata, or nil if it is not set.
+func (s *CreateMultipartUploadInput) GetMetadata() map[string]*string {
+	if s == nil {
+		return nil
+	}
+	return s.Metadata
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *CreateMultipartUploadInput) SetMetadata(v map[string]*string) *CreateMultipartUploadInput {
+	s.Metadata = v
+	return s
+}
+
+// GetObjectLockLegalHoldStatus returns the value of ObjectLockLegalHoldStatus, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetObjectLockLegalHoldStatus() string {
+	if s == nil || s.ObjectLockLegalHoldStatus == nil {
+		return ""
+	}
+	return *s.ObjectLockLegalHoldStatus
+}
+
+// SetObjectLockLegalHoldStatus sets the value of ObjectLockLegalHoldStatus.
+func (s *CreateMultipartUploadInput) SetObjectLockLegalHoldStatus(v string) *CreateMultipartUploadInput {
+	s.ObjectLockLegalHoldStatus = &v
+	return s
+}
+
+// GetObjectLockMode returns the value of ObjectLockMode, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetObjectLockMode() string {
+	if s == nil || s.ObjectLockMode == nil {
+		return ""
+	}
+	return *s.ObjectLockMode
+}
+
+// SetObjectLockMode sets the value of ObjectLockMode.
+func (s *CreateMultipartUploadInput) SetObjectLockMode(v string) *CreateMultipartUploadInput {
+	s.ObjectLockMode = &v
+	return s
+}
+
+// GetObjectLockRetainUntilDate returns the value of ObjectLockRetainUntilDate, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetObjectLockRetainUntilDate() time.Time {
+	if s == nil || s.ObjectLockRetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.ObjectLockRetainUntilDate
+}
+
+// SetObjectLockRetainUntilDate sets the value of ObjectLockRetainUntilDate.
+func (s *CreateMultipartUploadInput) SetObjectLockRetainUntilDate(v time.Time) *CreateMultipartUploadInput {
+	s.ObjectLockRetainUntilDate = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *CreateMultipartUploadInput) SetRequestPayer(v string) *CreateMultipartUploadInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *CreateMultipartUploadInput) SetSSECustomerAlgorithm(v string) *CreateMultipartUploadInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *CreateMultipartUploadInput) SetSSECustomerKey(v string) *CreateMultipartUploadInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *CreateMultipartUploadInput) SetSSECustomerKeyMD5(v string) *CreateMultipartUploadInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *CreateMultipartUploadInput) SetSSEKMSKeyID(v string) *CreateMultipartUploadInput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *CreateMultipartUploadInput) SetServerSideEncryption(v string) *CreateMultipartUploadInput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *CreateMultipartUploadInput) SetStorageClass(v string) *CreateMultipartUploadInput {
+	s.StorageClass = &v
+	return s
+}
+
+// GetTagging returns the value of Tagging, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetTagging() string {
+	if s == nil || s.Tagging == nil {
+		return ""
+	}
+	return *s.Tagging
+}
+
+// SetTagging sets the value of Tagging.
+func (s *CreateMultipartUploadInput) SetTagging(v string) *CreateMultipartUploadInput {
+	s.Tagging = &v
+	return s
+}
+
+// GetWebsiteRedirectLocation returns the value of WebsiteRedirectLocation, or the zero value if it is not set.
+func (s *CreateMultipartUploadInput) GetWebsiteRedirectLocation() string {
+	if s == nil || s.WebsiteRedirectLocation == nil {
+		return ""
+	}
+	return *s.WebsiteRedirectLocation
+}
+
+// SetWebsiteRedirectLocation sets the value of WebsiteRedirectLocation.
+func (s *CreateMultipartUploadInput) SetWebsiteRedirectLocation(v string) *CreateMultipartUploadInput {
+	s.WebsiteRedirectLocation = &v
+	return s
+}
+
+type CreateMultipartUploadOutput struct {
+	// Name of the bucket to which the multipart upload was initiated.
+	Bucket *string `locationName:"Bucket" type:"string"`
+
+	// Object key for which the multipart upload was initiated.
+	Key *string `type:"string"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// ID for the initiated multipart upload.
+	UploadID *string `locationName:"UploadId" type:"string"`
+
+	metadataCreateMultipartUploadOutput `json:"-" xml:"-"`
+}
+
+type metadataCreateMultipartUploadOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s CreateMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s CreateMultipartUploadOutput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *CreateMultipartUploadOutput) SetBucket(v string) *CreateMultipartUploadOutput {
+	s.Bucket = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *CreateMultipartUploadOutput) SetKey(v string) *CreateMultipartUploadOutput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *CreateMultipartUploadOutput) SetRequestCharged(v string) *CreateMultipartUploadOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *CreateMultipartUploadOutput) SetSSECustomerAlgorithm(v string) *CreateMultipartUploadOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *CreateMultipartUploadOutput) SetSSECustomerKeyMD5(v string) *CreateMultipartUploadOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *CreateMultipartUploadOutput) SetSSEKMSKeyID(v string) *CreateMultipartUploadOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *CreateMultipartUploadOutput) SetServerSideEncryption(v string) *CreateMultipartUploadOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *CreateMultipartUploadOutput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
+}
+
+// SetUploadID sets the value of UploadID.
+func (s *CreateMultipartUploadOutput) SetUploadID(v string) *CreateMultipartUploadOutput {
+	s.UploadID = &v
+	return s
+}
+
+type DefaultRetention struct {
+	// The number of days that you want to specify for the default retention period.
+	Days *int64 `type:"integer"`
+
+	// The default Object Lock retention mode you want to apply to new objects placed
+	// in the specified bucket. Valid values are GOVERNANCE and COMPLIANCE.
+	//
+	// The valid values are the ObjectLockRetentionMode enum constants.
+	Mode *string `type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The number of years that you want to specify for the default retention period.
+	Years *int64 `type:"integer"`
+
+	metadataDefaultRetention `json:"-" xml:"-"`
+}
+
+type metadataDefaultRetention struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DefaultRetention) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DefaultRetention) GoString() string {
+	return s.String()
+}
+
+// GetDays returns the value of Days, or the zero value if it is not set.
+func (s *DefaultRetention) GetDays() int64 {
+	if s == nil || s.Days == nil {
+		return 0
+	}
+	return *s.Days
+}
+
+// SetDays sets the value of Days.
+func (s *DefaultRetention) SetDays(v int64) *DefaultRetention {
+	s.Days = &v
+	return s
+}
+
+// GetMode returns the value of Mode, or the zero value if it is not set.
+func (s *DefaultRetention) GetMode() string {
+	if s == nil || s.Mode == nil {
+		return ""
+	}
+	return *s.Mode
+}
+
+// SetMode sets the value of Mode.
+func (s *DefaultRetention) SetMode(v string) *DefaultRetention {
+	s.Mode = &v
+	return s
+}
+
+// GetYears returns the value of Years, or the zero value if it is not set.
+func (s *DefaultRetention) GetYears() int64 {
+	if s == nil || s.Years == nil {
+		return 0
+	}
+	return *s.Years
+}
+
+// SetYears sets the value of Years.
+func (s *DefaultRetention) SetYears(v int64) *DefaultRetention {
+	s.Years = &v
+	return s
+}
+
+type Delete struct {
+	Objects []*ObjectIdentifier `locationName:"Object" type:"list" flattened:"true" required:"true"`
+
+	// Element to enable quiet mode for the request. When you add this element,
+	// you must set its value to true.
+	Quiet *bool `type:"boolean"`
+
+	metadataDelete `json:"-" xml:"-"`
+}
+
+type metadataDelete struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Delete) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Delete) GoString() string {
+	return s.String()
+}
+
+// GetObjects returns the value of Objects, or nil if it is not set.
+func (s *Delete) GetObjects() []*ObjectIdentifier {
+	if s == nil {
+		return nil
+	}
+	return s.Objects
+}
+
+// SetObjects sets the value of Objects.
+func (s *Delete) SetObjects(v []*ObjectIdentifier) *Delete {
+	s.Objects = v
+	return s
+}
+
+// GetQuiet returns the value of Quiet, or the zero value if it is not set.
+func (s *Delete) GetQuiet() bool {
+	if s == nil || s.Quiet == nil {
+		return false
+	}
+	return *s.Quiet
+}
+
+// SetQuiet sets the value of Quiet.
+func (s *Delete) SetQuiet(v bool) *Delete {
+	s.Quiet = &v
+	return s
+}
+
+type DeleteBucketAnalyticsConfigurationInput struct {
+	// The name of the bucket from which the an analytics configuration is deleted.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an analytics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataDeleteBucketAnalyticsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketAnalyticsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketAnalyticsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketAnalyticsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketAnalyticsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketAnalyticsConfigurationInput) SetBucket(v string) *DeleteBucketAnalyticsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketAnalyticsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketAnalyticsConfigurationInput) SetContentType(v string) *DeleteBucketAnalyticsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *DeleteBucketAnalyticsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeleteBucketAnalyticsConfigurationInput) SetID(v string) *DeleteBucketAnalyticsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type DeleteBucketAnalyticsConfigurationOutput struct {
+	metadataDeleteBucketAnalyticsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketAnalyticsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketAnalyticsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketAnalyticsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketCORSInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketCORSInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketCORSInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketCORSInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketCORSInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketCORSInput) SetBucket(v string) *DeleteBucketCORSInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketCORSInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketCORSInput) SetContentType(v string) *DeleteBucketCORSInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketCORSOutput struct {
+	metadataDeleteBucketCORSOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketCORSOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketCORSOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketEncryptionInput struct {
+	// The name of the bucket containing the server-side encryption configuration
+	// to delete.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketEncryptionInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketEncryptionInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketEncryptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketEncryptionInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketEncryptionInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketEncryptionInput) SetBucket(v string) *DeleteBucketEncryptionInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketEncryptionInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketEncryptionInput) SetContentType(v string) *DeleteBucketEncryptionInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketEncryptionOutput struct {
+	metadataDeleteBucketEncryptionOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketEncryptionOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketEncryptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketEncryptionOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketInput) SetBucket(v string) *DeleteBucketInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketInput) SetContentType(v string) *DeleteBucketInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketInventoryConfigurationInput struct {
+	// The name of the bucket from which the an inventory configuration is deleted.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an inventory configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataDeleteBucketInventoryConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketInventoryConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketInventoryConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketInventoryConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketInventoryConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketInventoryConfigurationInput) SetBucket(v string) *DeleteBucketInventoryConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketInventoryConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketInventoryConfigurationInput) SetContentType(v string) *DeleteBucketInventoryConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *DeleteBucketInventoryConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeleteBucketInventoryConfigurationInput) SetID(v string) *DeleteBucketInventoryConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type DeleteBucketInventoryConfigurationOutput struct {
+	metadataDeleteBucketInventoryConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketInventoryConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketInventoryConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketInventoryConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketLifecycleInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketLifecycleInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketLifecycleInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketLifecycleInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketLifecycleInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketLifecycleInput) SetBucket(v string) *DeleteBucketLifecycleInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketLifecycleInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketLifecycleInput) SetContentType(v string) *DeleteBucketLifecycleInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketLifecycleOutput struct {
+	metadataDeleteBucketLifecycleOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketLifecycleOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketLifecycleOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketMetricsConfigurationInput struct {
+	// The name of the bucket from which the a metrics configuration is deleted.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the a metrics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataDeleteBucketMetricsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketMetricsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketMetricsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketMetricsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketMetricsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketMetricsConfigurationInput) SetBucket(v string) *DeleteBucketMetricsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketMetricsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketMetricsConfigurationInput) SetContentType(v string) *DeleteBucketMetricsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *DeleteBucketMetricsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *DeleteBucketMetricsConfigurationInput) SetID(v string) *DeleteBucketMetricsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type DeleteBucketMetricsConfigurationOutput struct {
+	metadataDeleteBucketMetricsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketMetricsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketMetricsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketMetricsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketOutput struct {
+	metadataDeleteBucketOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketPolicyInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketPolicyInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketPolicyInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketPolicyInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketPolicyInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketPolicyInput) SetBucket(v string) *DeleteBucketPolicyInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketPolicyInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketPolicyInput) SetContentType(v string) *DeleteBucketPolicyInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketPolicyOutput struct {
+	metadataDeleteBucketPolicyOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketPolicyOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketPolicyOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketReplicationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketReplicationInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketReplicationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketReplicationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketReplicationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketReplicationInput) SetBucket(v string) *DeleteBucketReplicationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketReplicationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketReplicationInput) SetContentType(v string) *DeleteBucketReplicationInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketReplicationOutput struct {
+	metadataDeleteBucketReplicationOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketReplicationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketReplicationOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketTaggingInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketTaggingInput) SetBucket(v string) *DeleteBucketTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketTaggingInput) SetContentType(v string) *DeleteBucketTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketTaggingOutput struct {
+	metadataDeleteBucketTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketTaggingOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteBucketWebsiteInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeleteBucketWebsiteInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketWebsiteInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketWebsiteInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteBucketWebsiteInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteBucketWebsiteInput) SetBucket(v string) *DeleteBucketWebsiteInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteBucketWebsiteInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteBucketWebsiteInput) SetContentType(v string) *DeleteBucketWebsiteInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeleteBucketWebsiteOutput struct {
+	metadataDeleteBucketWebsiteOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteBucketWebsiteOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteBucketWebsiteOutput) GoString() string {
+	return s.String()
+}
+
+type DeleteMarkerEntry struct {
+	// Specifies whether the object is (true) or is not (false) the latest version
+	// of an object.
+	IsLatest *bool `type:"boolean"`
+
+	// The object key.
+	Key *string `type:"string"`
+
+	// Date and time the object was last modified.
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	Owner *Owner `type:"structure"`
+
+	// Version ID of an object.
+	VersionID *string `locationName:"VersionId" type:"string"`
+
+	metadataDeleteMarkerEntry `json:"-" xml:"-"`
+}
+
+type metadataDeleteMarkerEntry struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteMarkerEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteMarkerEntry) GoString() string {
+	return s.String()
+}
+
+// GetIsLatest returns the value of IsLatest, or the zero value if it is not set.
+func (s *DeleteMarkerEntry) GetIsLatest() bool {
+	if s == nil || s.IsLatest == nil {
+		return false
+	}
+	return *s.IsLatest
+}
+
+// SetIsLatest sets the value of IsLatest.
+func (s *DeleteMarkerEntry) SetIsLatest(v bool) *DeleteMarkerEntry {
+	s.IsLatest = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *DeleteMarkerEntry) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *DeleteMarkerEntry) SetKey(v string) *DeleteMarkerEntry {
+	s.Key = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *DeleteMarkerEntry) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *DeleteMarkerEntry) SetLastModified(v time.Time) *DeleteMarkerEntry {
+	s.LastModified = &v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *DeleteMarkerEntry) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *DeleteMarkerEntry) SetOwner(v *Owner) *DeleteMarkerEntry {
+	s.Owner = v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeleteMarkerEntry) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeleteMarkerEntry) SetVersionID(v string) *DeleteMarkerEntry {
+	s.VersionID = &v
+	return s
+}
+
+type DeleteObjectInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// The concatenation of the authentication device's serial number, a space,
+	// and the value that is displayed on your authentication device.
+	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// VersionId used to reference a specific version of the object.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataDeleteObjectInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteObjectInput) SetBucket(v string) *DeleteObjectInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteObjectInput) SetContentType(v string) *DeleteObjectInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *DeleteObjectInput) SetExpectedBucketOwner(v string) *DeleteObjectInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *DeleteObjectInput) SetKey(v string) *DeleteObjectInput {
+	s.Key = &v
+	return s
+}
+
+// GetMFA returns the value of MFA, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetMFA() string {
+	if s == nil || s.MFA == nil {
+		return ""
+	}
+	return *s.MFA
+}
+
+// SetMFA sets the value of MFA.
+func (s *DeleteObjectInput) SetMFA(v string) *DeleteObjectInput {
+	s.MFA = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *DeleteObjectInput) SetRequestPayer(v string) *DeleteObjectInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeleteObjectInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeleteObjectInput) SetVersionID(v string) *DeleteObjectInput {
+	s.VersionID = &v
+	return s
+}
+
+type DeleteObjectOutput struct {
+	// Specifies whether the versioned object that was permanently deleted was (true)
+	// or was not (false) a delete marker.
+	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// Returns the version ID of the delete marker created as a result of the DELETE
+	// operation.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	metadataDeleteObjectOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectOutput) GoString() string {
+	return s.String()
+}
+
+// GetDeleteMarker returns the value of DeleteMarker, or the zero value if it is not set.
+func (s *DeleteObjectOutput) GetDeleteMarker() bool {
+	if s == nil || s.DeleteMarker == nil {
+		return false
+	}
+	return *s.DeleteMarker
+}
+
+// SetDeleteMarker sets the value of DeleteMarker.
+func (s *DeleteObjectOutput) SetDeleteMarker(v bool) *DeleteObjectOutput {
+	s.DeleteMarker = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *DeleteObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *DeleteObjectOutput) SetRequestCharged(v string) *DeleteObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeleteObjectOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeleteObjectOutput) SetVersionID(v string) *DeleteObjectOutput {
+	s.VersionID = &v
+	return s
+}
+
+type DeleteObjectTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// The versionId of the object that the tag-set will be removed from.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataDeleteObjectTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectTaggingInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteObjectTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteObjectTaggingInput) SetBucket(v string) *DeleteObjectTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteObjectTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteObjectTaggingInput) SetContentType(v string) *DeleteObjectTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *DeleteObjectTaggingInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *DeleteObjectTaggingInput) SetKey(v string) *DeleteObjectTaggingInput {
+	s.Key = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeleteObjectTaggingInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeleteObjectTaggingInput) SetVersionID(v string) *DeleteObjectTaggingInput {
+	s.VersionID = &v
+	return s
+}
+
+type DeleteObjectTaggingOutput struct {
+	// The versionId of the object the tag-set was removed from.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	metadataDeleteObjectTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectTaggingOutput) GoString() string {
+	return s.String()
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeleteObjectTaggingOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeleteObjectTaggingOutput) SetVersionID(v string) *DeleteObjectTaggingOutput {
+	s.VersionID = &v
+	return s
+}
+
+type DeleteObjectsInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Delete *Delete `locationName:"Delete" type:"structure" required:"true"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// The concatenation of the authentication device's serial number, a space,
+	// and the value that is displayed on your authentication device.
+	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	metadataDeleteObjectsInput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectsInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Delete"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeleteObjectsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeleteObjectsInput) SetBucket(v string) *DeleteObjectsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeleteObjectsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeleteObjectsInput) SetContentType(v string) *DeleteObjectsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDelete returns the value of Delete, or nil if it is not set.
+func (s *DeleteObjectsInput) GetDelete() *Delete {
+	if s == nil {
+		return nil
+	}
+	return s.Delete
+}
+
+// SetDelete sets the value of Delete.
+func (s *DeleteObjectsInput) SetDelete(v *Delete) *DeleteObjectsInput {
+	s.Delete = v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *DeleteObjectsInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *DeleteObjectsInput) SetExpectedBucketOwner(v string) *DeleteObjectsInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetMFA returns the value of MFA, or the zero value if it is not set.
+func (s *DeleteObjectsInput) GetMFA() string {
+	if s == nil || s.MFA == nil {
+		return ""
+	}
+	return *s.MFA
+}
+
+// SetMFA sets the value of MFA.
+func (s *DeleteObjectsInput) SetMFA(v string) *DeleteObjectsInput {
+	s.MFA = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *DeleteObjectsInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *DeleteObjectsInput) SetRequestPayer(v string) *DeleteObjectsInput {
+	s.RequestPayer = &v
+	return s
+}
+
+type DeleteObjectsOutput struct {
+	Deleted []*DeletedObject `type:"list" flattened:"true"`
+
+	Errors []*Error `locationName:"Error" type:"list" flattened:"true"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataDeleteObjectsOutput `json:"-" xml:"-"`
+}
+
+type metadataDeleteObjectsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeleteObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeleteObjectsOutput) GoString() string {
+	return s.String()
+}
+
+// GetDeleted returns the value of Deleted, or nil if it is not set.
+func (s *DeleteObjectsOutput) GetDeleted() []*DeletedObject {
+	if s == nil {
+		return nil
+	}
+	return s.Deleted
+}
+
+// SetDeleted sets the value of Deleted.
+func (s *DeleteObjectsOutput) SetDeleted(v []*DeletedObject) *DeleteObjectsOutput {
+	s.Deleted = v
+	return s
+}
+
+// GetErrors returns the value of Errors, or nil if it is not set.
+func (s *DeleteObjectsOutput) GetErrors() []*Error {
+	if s == nil {
+		return nil
+	}
+	return s.Errors
+}
+
+// SetErrors sets the value of Errors.
+func (s *DeleteObjectsOutput) SetErrors(v []*Error) *DeleteObjectsOutput {
+	s.Errors = v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *DeleteObjectsOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *DeleteObjectsOutput) SetRequestCharged(v string) *DeleteObjectsOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type DeletePublicAccessBlockInput struct {
+	// The Amazon S3 bucket whose PublicAccessBlock configuration you want to delete.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataDeletePublicAccessBlockInput `json:"-" xml:"-"`
+}
+
+type metadataDeletePublicAccessBlockInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeletePublicAccessBlockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeletePublicAccessBlockInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *DeletePublicAccessBlockInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *DeletePublicAccessBlockInput) SetBucket(v string) *DeletePublicAccessBlockInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *DeletePublicAccessBlockInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *DeletePublicAccessBlockInput) SetContentType(v string) *DeletePublicAccessBlockInput {
+	s.ContentType = &v
+	return s
+}
+
+type DeletePublicAccessBlockOutput struct {
+	metadataDeletePublicAccessBlockOutput `json:"-" xml:"-"`
+}
+
+type metadataDeletePublicAccessBlockOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeletePublicAccessBlockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeletePublicAccessBlockOutput) GoString() string {
+	return s.String()
+}
+
+type DeletedObject struct {
+	DeleteMarker *bool `type:"boolean"`
+
+	DeleteMarkerVersionID *string `locationName:"DeleteMarkerVersionId" type:"string"`
+
+	Key *string `type:"string"`
+
+	VersionID *string `locationName:"VersionId" type:"string"`
+
+	metadataDeletedObject `json:"-" xml:"-"`
+}
+
+type metadataDeletedObject struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s DeletedObject) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s DeletedObject) GoString() string {
+	return s.String()
+}
+
+// GetDeleteMarker returns the value of DeleteMarker, or the zero value if it is not set.
+func (s *DeletedObject) GetDeleteMarker() bool {
+	if s == nil || s.DeleteMarker == nil {
+		return false
+	}
+	return *s.DeleteMarker
+}
+
+// SetDeleteMarker sets the value of DeleteMarker.
+func (s *DeletedObject) SetDeleteMarker(v bool) *DeletedObject {
+	s.DeleteMarker = &v
+	return s
+}
+
+// GetDeleteMarkerVersionID returns the value of DeleteMarkerVersionID, or the zero value if it is not set.
+func (s *DeletedObject) GetDeleteMarkerVersionID() string {
+	if s == nil || s.DeleteMarkerVersionID == nil {
+		return ""
+	}
+	return *s.DeleteMarkerVersionID
+}
+
+// SetDeleteMarkerVersionID sets the value of DeleteMarkerVersionID.
+func (s *DeletedObject) SetDeleteMarkerVersionID(v string) *DeletedObject {
+	s.DeleteMarkerVersionID = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *DeletedObject) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *DeletedObject) SetKey(v string) *DeletedObject {
+	s.Key = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *DeletedObject) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *DeletedObject) SetVersionID(v string) *DeletedObject {
+	s.VersionID = &v
+	return s
+}
+
+type Destination struct {
+	// Amazon resource name (ARN) of the bucket where you want Amazon S3 to store
+	// replicas of the object identified by the rule.
+	Bucket *string `type:"string" required:"true"`
+
+	metadataDestination `json:"-" xml:"-"`
+}
+
+type metadataDestination struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Destination) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Destination) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *Destination) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *Destination) SetBucket(v string) *Destination {
+	s.Bucket = &v
+	return s
+}
+
+type EndEvent struct {
+	metadataEndEvent `json:"-" xml:"-"`
+}
+
+type metadataEndEvent struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s EndEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s EndEvent) GoString() string {
+	return s.String()
+}
+
+type Error struct {
+	Code *string `type:"string"`
+
+	Key *string `type:"string"`
+
+	Message *string `type:"string"`
+
+	VersionID *string `locationName:"VersionId" type:"string"`
+
+	metadataError `json:"-" xml:"-"`
+}
+
+type metadataError struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Error) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Error) GoString() string {
+	return s.String()
+}
+
+// GetCode returns the value of Code, or the zero value if it is not set.
+func (s *Error) GetCode() string {
+	if s == nil || s.Code == nil {
+		return ""
+	}
+	return *s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *Error) SetCode(v string) *Error {
+	s.Code = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *Error) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *Error) SetKey(v string) *Error {
+	s.Key = &v
+	return s
+}
+
+// GetMessage returns the value of Message, or the zero value if it is not set.
+func (s *Error) GetMessage() string {
+	if s == nil || s.Message == nil {
+		return ""
+	}
+	return *s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(v string) *Error {
+	s.Message = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *Error) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *Error) SetVersionID(v string) *Error {
+	s.VersionID = &v
+	return s
+}
+
+type ErrorDocument struct {
+	// The object key name to use when a 4XX class error occurs.
+	Key *string `type:"string" required:"true"`
+
+	metadataErrorDocument `json:"-" xml:"-"`
+}
+
+type metadataErrorDocument struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ErrorDocument) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ErrorDocument) GoString() string {
+	return s.String()
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *ErrorDocument) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *ErrorDocument) SetKey(v string) *ErrorDocument {
+	s.Key = &v
+	return s
+}
+
+type GetBucketACLInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketACLInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketACLInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketACLInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketACLInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketACLInput) SetBucket(v string) *GetBucketACLInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketACLInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketACLInput) SetContentType(v string) *GetBucketACLInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketACLOutput struct {
+	// A list of grants.
+	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
+
+	Owner *Owner `type:"structure"`
+
+	metadataGetBucketACLOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketACLOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketACLOutput) GoString() string {
+	return s.String()
+}
+
+// GetGrants returns the value of Grants, or nil if it is not set.
+func (s *GetBucketACLOutput) GetGrants() []*Grant {
+	if s == nil {
+		return nil
+	}
+	return s.Grants
+}
+
+// SetGrants sets the value of Grants.
+func (s *GetBucketACLOutput) SetGrants(v []*Grant) *GetBucketACLOutput {
+	s.Grants = v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *GetBucketACLOutput) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *GetBucketACLOutput) SetOwner(v *Owner) *GetBucketACLOutput {
+	s.Owner = v
+	return s
+}
+
+type GetBucketAnalyticsConfigurationInput struct {
+	// The name of the bucket from which the an analytics configuration is retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an analytics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataGetBucketAnalyticsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketAnalyticsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketAnalyticsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketAnalyticsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketAnalyticsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketAnalyticsConfigurationInput) SetBucket(v string) *GetBucketAnalyticsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketAnalyticsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketAnalyticsConfigurationInput) SetContentType(v string) *GetBucketAnalyticsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *GetBucketAnalyticsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetBucketAnalyticsConfigurationInput) SetID(v string) *GetBucketAnalyticsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type GetBucketAnalyticsConfigurationOutput struct {
+	// The an analytics configuration and filter.
+	AnalyticsConfiguration *AnalyticsConfiguration `type:"structure"`
+
+	metadataGetBucketAnalyticsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketAnalyticsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"AnalyticsConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetBucketAnalyticsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketAnalyticsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetAnalyticsConfiguration returns the value of AnalyticsConfiguration, or nil if it is not set.
+func (s *GetBucketAnalyticsConfigurationOutput) GetAnalyticsConfiguration() *AnalyticsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.AnalyticsConfiguration
+}
+
+// SetAnalyticsConfiguration sets the value of AnalyticsConfiguration.
+func (s *GetBucketAnalyticsConfigurationOutput) SetAnalyticsConfiguration(v *AnalyticsConfiguration) *GetBucketAnalyticsConfigurationOutput {
+	s.AnalyticsConfiguration = v
+	return s
+}
+
+type GetBucketCORSInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketCORSInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketCORSInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketCORSInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketCORSInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketCORSInput) SetBucket(v string) *GetBucketCORSInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketCORSInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketCORSInput) SetContentType(v string) *GetBucketCORSInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketCORSOutput struct {
+	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
+
+	metadataGetBucketCORSOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketCORSOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketCORSOutput) GoString() string {
+	return s.String()
+}
+
+// GetCORSRules returns the value of CORSRules, or nil if it is not set.
+func (s *GetBucketCORSOutput) GetCORSRules() []*CORSRule {
+	if s == nil {
+		return nil
+	}
+	return s.CORSRules
+}
+
+// SetCORSRules sets the value of CORSRules.
+func (s *GetBucketCORSOutput) SetCORSRules(v []*CORSRule) *GetBucketCORSOutput {
+	s.CORSRules = v
+	return s
+}
+
+type GetBucketEncryptionInput struct {
+	// The name of the bucket from which the server-side encryption configuration
+	// is retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketEncryptionInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketEncryptionInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketEncryptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketEncryptionInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketEncryptionInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketEncryptionInput) SetBucket(v string) *GetBucketEncryptionInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketEncryptionInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketEncryptionInput) SetContentType(v string) *GetBucketEncryptionInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketEncryptionOutput struct {
+	// Container for server-side encryption configuration rules. Currently S3 supports
+	// one rule only.
+	ServerSideEncryptionConfiguration *ServerSideEncryptionConfiguration `type:"structure"`
+
+	metadataGetBucketEncryptionOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketEncryptionOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetBucketEncryptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketEncryptionOutput) GoString() string {
+	return s.String()
+}
+
+// GetServerSideEncryptionConfiguration returns the value of ServerSideEncryptionConfiguration, or nil if it is not set.
+func (s *GetBucketEncryptionOutput) GetServerSideEncryptionConfiguration() *ServerSideEncryptionConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ServerSideEncryptionConfiguration
+}
+
+// SetServerSideEncryptionConfiguration sets the value of ServerSideEncryptionConfiguration.
+func (s *GetBucketEncryptionOutput) SetServerSideEncryptionConfiguration(v *ServerSideEncryptionConfiguration) *GetBucketEncryptionOutput {
+	s.ServerSideEncryptionConfiguration = v
+	return s
+}
+
+type GetBucketInventoryConfigurationInput struct {
+	// The name of the bucket from which the an inventory configuration is retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an inventory configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataGetBucketInventoryConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketInventoryConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketInventoryConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketInventoryConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketInventoryConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketInventoryConfigurationInput) SetBucket(v string) *GetBucketInventoryConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketInventoryConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketInventoryConfigurationInput) SetContentType(v string) *GetBucketInventoryConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *GetBucketInventoryConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetBucketInventoryConfigurationInput) SetID(v string) *GetBucketInventoryConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type GetBucketInventoryConfigurationOutput struct {
+	// The an inventory configuration and filter.
+	InventoryConfiguration *InventoryConfiguration `type:"structure"`
+
+	metadataGetBucketInventoryConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketInventoryConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"InventoryConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetBucketInventoryConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketInventoryConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetInventoryConfiguration returns the value of InventoryConfiguration, or nil if it is not set.
+func (s *GetBucketInventoryConfigurationOutput) GetInventoryConfiguration() *InventoryConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.InventoryConfiguration
+}
+
+// SetInventoryConfiguration sets the value of InventoryConfiguration.
+func (s *GetBucketInventoryConfigurationOutput) SetInventoryConfiguration(v *InventoryConfiguration) *GetBucketInventoryConfigurationOutput {
+	s.InventoryConfiguration = v
+	return s
+}
+
+type GetBucketLifecycleConfigurationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketLifecycleConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLifecycleConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLifecycleConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLifecycleConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketLifecycleConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketLifecycleConfigurationInput) SetBucket(v string) *GetBucketLifecycleConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketLifecycleConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketLifecycleConfigurationInput) SetContentType(v string) *GetBucketLifecycleConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketLifecycleConfigurationOutput struct {
+	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true"`
+
+	metadataGetBucketLifecycleConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLifecycleConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLifecycleConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLifecycleConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *GetBucketLifecycleConfigurationOutput) GetRules() []*LifecycleRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *GetBucketLifecycleConfigurationOutput) SetRules(v []*LifecycleRule) *GetBucketLifecycleConfigurationOutput {
+	s.Rules = v
+	return s
+}
+
+type GetBucketLifecycleInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketLifecycleInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLifecycleInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLifecycleInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketLifecycleInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketLifecycleInput) SetBucket(v string) *GetBucketLifecycleInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketLifecycleInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketLifecycleInput) SetContentType(v string) *GetBucketLifecycleInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketLifecycleOutput struct {
+	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true"`
+
+	metadataGetBucketLifecycleOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLifecycleOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLifecycleOutput) GoString() string {
+	return s.String()
+}
+
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *GetBucketLifecycleOutput) GetRules() []*LifecycleRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *GetBucketLifecycleOutput) SetRules(v []*LifecycleRule) *GetBucketLifecycleOutput {
+	s.Rules = v
+	return s
+}
+
+type GetBucketLocationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketLocationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLocationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLocationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketLocationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketLocationInput) SetBucket(v string) *GetBucketLocationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketLocationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketLocationInput) SetContentType(v string) *GetBucketLocationInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketLocationOutput struct {
+	// The valid values are the BucketLocationConstraint enum constants.
+	LocationConstraint *string `type:"string" enum:"EU,eu-west-1,us-west-1,us-west-2,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1,eu-central-1"`
+
+	metadataGetBucketLocationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLocationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLocationOutput) GoString() string {
+	return s.String()
+}
+
+// GetLocationConstraint returns the value of LocationConstraint, or the zero value if it is not set.
+func (s *GetBucketLocationOutput) GetLocationConstraint() string {
+	if s == nil || s.LocationConstraint == nil {
+		return ""
+	}
+	return *s.LocationConstraint
+}
+
+// SetLocationConstraint sets the value of LocationConstraint.
+func (s *GetBucketLocationOutput) SetLocationConstraint(v string) *GetBucketLocationOutput {
+	s.LocationConstraint = &v
+	return s
+}
+
+type GetBucketLoggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketLoggingInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLoggingInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLoggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketLoggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketLoggingInput) SetBucket(v string) *GetBucketLoggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketLoggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketLoggingInput) SetContentType(v string) *GetBucketLoggingInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketLoggingOutput struct {
+	LoggingEnabled *LoggingEnabled `type:"structure"`
+
+	metadataGetBucketLoggingOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketLoggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketLoggingOutput) GoString() string {
+	return s.String()
+}
+
+// GetLoggingEnabled returns the value of LoggingEnabled, or nil if it is not set.
+func (s *GetBucketLoggingOutput) GetLoggingEnabled() *LoggingEnabled {
+	if s == nil {
+		return nil
+	}
+	return s.LoggingEnabled
+}
+
+// SetLoggingEnabled sets the value of LoggingEnabled.
+func (s *GetBucketLoggingOutput) SetLoggingEnabled(v *LoggingEnabled) *GetBucketLoggingOutput {
+	s.LoggingEnabled = v
+	return s
+}
+
+type GetBucketMetricsConfigurationInput struct {
+	// The name of the bucket from which the a metrics configuration is retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the a metrics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataGetBucketMetricsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketMetricsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketMetricsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketMetricsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketMetricsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketMetricsConfigurationInput) SetBucket(v string) *GetBucketMetricsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketMetricsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketMetricsConfigurationInput) SetContentType(v string) *GetBucketMetricsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *GetBucketMetricsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *GetBucketMetricsConfigurationInput) SetID(v string) *GetBucketMetricsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type GetBucketMetricsConfigurationOutput struct {
+	// The a metrics configuration and filter.
+	MetricsConfiguration *MetricsConfiguration `type:"structure"`
+
+	metadataGetBucketMetricsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketMetricsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"MetricsConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetBucketMetricsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketMetricsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetMetricsConfiguration returns the value of MetricsConfiguration, or nil if it is not set.
+func (s *GetBucketMetricsConfigurationOutput) GetMetricsConfiguration() *MetricsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.MetricsConfiguration
+}
+
+// SetMetricsConfiguration sets the value of MetricsConfiguration.
+func (s *GetBucketMetricsConfigurationOutput) SetMetricsConfiguration(v *MetricsConfiguration) *GetBucketMetricsConfigurationOutput {
+	s.MetricsConfiguration = v
+	return s
+}
+
+type GetBucketNotificationConfigurationRequest struct {
+	// Name of the buket to get the notification configuration for.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	metadataGetBucketNotificationConfigurationRequest `json:"-" xml:"-"`
+}
+
+type metadataGetBucketNotificationConfigurationRequest struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketNotificationConfigurationRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketNotificationConfigurationRequest) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketNotificationConfigurationRequest) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketNotificationConfigurationRequest) SetBucket(v string) *GetBucketNotificationConfigurationRequest {
+	s.Bucket = &v
+	return s
+}
+
+type GetBucketPolicyInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketPolicyInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketPolicyInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketPolicyInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketPolicyInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketPolicyInput) SetBucket(v string) *GetBucketPolicyInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketPolicyInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketPolicyInput) SetContentType(v string) *GetBucketPolicyInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketPolicyOutput struct {
+	// The bucket policy as a JSON document.
+	Policy *string `type:"string"`
+
+	metadataGetBucketPolicyOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketPolicyOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Policy"`
+}
+
+// String returns the string representation.
+func (s GetBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketPolicyOutput) GoString() string {
+	return s.String()
+}
+
+// GetPolicy returns the value of Policy, or the zero value if it is not set.
+func (s *GetBucketPolicyOutput) GetPolicy() string {
+	if s == nil || s.Policy == nil {
+		return ""
+	}
+	return *s.Policy
+}
+
+// SetPolicy sets the value of Policy.
+func (s *GetBucketPolicyOutput) SetPolicy(v string) *GetBucketPolicyOutput {
+	s.Policy = &v
+	return s
+}
+
+type GetBucketReplicationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketReplicationInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketReplicationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketReplicationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketReplicationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketReplicationInput) SetBucket(v string) *GetBucketReplicationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketReplicationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketReplicationInput) SetContentType(v string) *GetBucketReplicationInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketReplicationOutput struct {
+	// Container for replication rules. You can add as many as 1,000 rules. Total
+	// replication configuration size can be up to 2 MB.
+	ReplicationConfiguration *ReplicationConfiguration `type:"structure"`
+
+	metadataGetBucketReplicationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketReplicationOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketReplicationOutput) GoString() string {
+	return s.String()
+}
+
+// GetReplicationConfiguration returns the value of ReplicationConfiguration, or nil if it is not set.
+func (s *GetBucketReplicationOutput) GetReplicationConfiguration() *ReplicationConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ReplicationConfiguration
+}
+
+// SetReplicationConfiguration sets the value of ReplicationConfiguration.
+func (s *GetBucketReplicationOutput) SetReplicationConfiguration(v *ReplicationConfiguration) *GetBucketReplicationOutput {
+	s.ReplicationConfiguration = v
+	return s
+}
+
+type GetBucketRequestPaymentInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketRequestPaymentInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketRequestPaymentInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketRequestPaymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketRequestPaymentInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketRequestPaymentInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketRequestPaymentInput) SetBucket(v string) *GetBucketRequestPaymentInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketRequestPaymentInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketRequestPaymentInput) SetContentType(v string) *GetBucketRequestPaymentInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketRequestPaymentOutput struct {
+	// Specifies who pays for the download and request fees.
+	//
+	// The valid values are the Payer enum constants.
+	Payer *string `type:"string" enum:"Requester,BucketOwner"`
+
+	metadataGetBucketRequestPaymentOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketRequestPaymentOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketRequestPaymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketRequestPaymentOutput) GoString() string {
+	return s.String()
+}
+
+// GetPayer returns the value of Payer, or the zero value if it is not set.
+func (s *GetBucketRequestPaymentOutput) GetPayer() string {
+	if s == nil || s.Payer == nil {
+		return ""
+	}
+	return *s.Payer
+}
+
+// SetPayer sets the value of Payer.
+func (s *GetBucketRequestPaymentOutput) SetPayer(v string) *GetBucketRequestPaymentOutput {
+	s.Payer = &v
+	return s
+}
+
+type GetBucketTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketTaggingInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketTaggingInput) SetBucket(v string) *GetBucketTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketTaggingInput) SetContentType(v string) *GetBucketTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketTaggingOutput struct {
+	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
+
+	metadataGetBucketTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketTaggingOutput) GoString() string {
+	return s.String()
+}
+
+// GetTagSet returns the value of TagSet, or nil if it is not set.
+func (s *GetBucketTaggingOutput) GetTagSet() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.TagSet
+}
+
+// SetTagSet sets the value of TagSet.
+func (s *GetBucketTaggingOutput) SetTagSet(v []*Tag) *GetBucketTaggingOutput {
+	s.TagSet = v
+	return s
+}
+
+type GetBucketVersioningInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketVersioningInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketVersioningInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketVersioningInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketVersioningInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketVersioningInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketVersioningInput) SetBucket(v string) *GetBucketVersioningInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketVersioningInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketVersioningInput) SetContentType(v string) *GetBucketVersioningInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketVersioningOutput struct {
+	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
+	// This element is only returned if the bucket has been configured with MFA
+	// delete. If the bucket has never been so configured, this element is not returned.
+	//
+	// The valid values are the MFADeleteStatus enum constants.
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
+
+	// The versioning state of the bucket.
+	//
+	// The valid values are the BucketVersioningStatus enum constants.
+	Status *string `type:"string" enum:"Enabled,Suspended"`
+
+	metadataGetBucketVersioningOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketVersioningOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketVersioningOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketVersioningOutput) GoString() string {
+	return s.String()
+}
+
+// GetMFADelete returns the value of MFADelete, or the zero value if it is not set.
+func (s *GetBucketVersioningOutput) GetMFADelete() string {
+	if s == nil || s.MFADelete == nil {
+		return ""
+	}
+	return *s.MFADelete
+}
+
+// SetMFADelete sets the value of MFADelete.
+func (s *GetBucketVersioningOutput) SetMFADelete(v string) *GetBucketVersioningOutput {
+	s.MFADelete = &v
+	return s
+}
+
+// GetStatus returns the value of Status, or the zero value if it is not set.
+func (s *GetBucketVersioningOutput) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *GetBucketVersioningOutput) SetStatus(v string) *GetBucketVersioningOutput {
+	s.Status = &v
+	return s
+}
+
+type GetBucketWebsiteInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetBucketWebsiteInput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketWebsiteInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketWebsiteInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetBucketWebsiteInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetBucketWebsiteInput) SetBucket(v string) *GetBucketWebsiteInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetBucketWebsiteInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetBucketWebsiteInput) SetContentType(v string) *GetBucketWebsiteInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetBucketWebsiteOutput struct {
+	ErrorDocument *ErrorDocument `type:"structure"`
+
+	IndexDocument *IndexDocument `type:"structure"`
+
+	RedirectAllRequestsTo *RedirectAllRequestsTo `type:"structure"`
+
+	RoutingRules []*RoutingRule `locationNameList:"RoutingRule" type:"list"`
+
+	metadataGetBucketWebsiteOutput `json:"-" xml:"-"`
+}
+
+type metadataGetBucketWebsiteOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetBucketWebsiteOutput) GoString() string {
+	return s.String()
+}
+
+// GetErrorDocument returns the value of ErrorDocument, or nil if it is not set.
+func (s *GetBucketWebsiteOutput) GetErrorDocument() *ErrorDocument {
+	if s == nil {
+		return nil
+	}
+	return s.ErrorDocument
+}
+
+// SetErrorDocument sets the value of ErrorDocument.
+func (s *GetBucketWebsiteOutput) SetErrorDocument(v *ErrorDocument) *GetBucketWebsiteOutput {
+	s.ErrorDocument = v
+	return s
+}
+
+// GetIndexDocument returns the value of IndexDocument, or nil if it is not set.
+func (s *GetBucketWebsiteOutput) GetIndexDocument() *IndexDocument {
+	if s == nil {
+		return nil
+	}
+	return s.IndexDocument
+}
+
+// SetIndexDocument sets the value of IndexDocument.
+func (s *GetBucketWebsiteOutput) SetIndexDocument(v *IndexDocument) *GetBucketWebsiteOutput {
+	s.IndexDocument = v
+	return s
+}
+
+// GetRedirectAllRequestsTo returns the value of RedirectAllRequestsTo, or nil if it is not set.
+func (s *GetBucketWebsiteOutput) GetRedirectAllRequestsTo() *RedirectAllRequestsTo {
+	if s == nil {
+		return nil
+	}
+	return s.RedirectAllRequestsTo
+}
+
+// SetRedirectAllRequestsTo sets the value of RedirectAllRequestsTo.
+func (s *GetBucketWebsiteOutput) SetRedirectAllRequestsTo(v *RedirectAllRequestsTo) *GetBucketWebsiteOutput {
+	s.RedirectAllRequestsTo = v
+	return s
+}
+
+// GetRoutingRules returns the value of RoutingRules, or nil if it is not set.
+func (s *GetBucketWebsiteOutput) GetRoutingRules() []*RoutingRule {
+	if s == nil {
+		return nil
+	}
+	return s.RoutingRules
+}
+
+// SetRoutingRules sets the value of RoutingRules.
+func (s *GetBucketWebsiteOutput) SetRoutingRules(v []*RoutingRule) *GetBucketWebsiteOutput {
+	s.RoutingRules = v
+	return s
+}
+
+type GetObjectACLInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// VersionId used to reference a specific version of the object.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataGetObjectACLInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectACLInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectACLInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectACLInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectACLInput) SetBucket(v string) *GetObjectACLInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectACLInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectACLInput) SetContentType(v string) *GetObjectACLInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectACLInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectACLInput) SetKey(v string) *GetObjectACLInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *GetObjectACLInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *GetObjectACLInput) SetRequestPayer(v string) *GetObjectACLInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectACLInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectACLInput) SetVersionID(v string) *GetObjectACLInput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectACLOutput struct {
+	// A list of grants.
+	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
+
+	Owner *Owner `type:"structure"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataGetObjectACLOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectACLOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectACLOutput) GoString() string {
+	return s.String()
+}
+
+// GetGrants returns the value of Grants, or nil if it is not set.
+func (s *GetObjectACLOutput) GetGrants() []*Grant {
+	if s == nil {
+		return nil
+	}
+	return s.Grants
+}
+
+// SetGrants sets the value of Grants.
+func (s *GetObjectACLOutput) SetGrants(v []*Grant) *GetObjectACLOutput {
+	s.Grants = v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *GetObjectACLOutput) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *GetObjectACLOutput) SetOwner(v *Owner) *GetObjectACLOutput {
+	s.Owner = v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *GetObjectACLOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *GetObjectACLOutput) SetRequestCharged(v string) *GetObjectACLOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type GetObjectInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// Return the object only if its entity tag (ETag) is the same as the one specified,
+	// otherwise return a 412 (precondition failed).
+	IfMatch *string `location:"header" locationName:"If-Match" type:"string"`
+
+	// Return the object only if it has been modified since the specified time,
+	// otherwise return a 304 (not modified).
+	IfModifiedSince *time.Time `location:"header" locationName:"If-Modified-Since" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Return the object only if its entity tag (ETag) is different from the one
+	// specified, otherwise return a 304 (not modified).
+	IfNoneMatch *string `location:"header" locationName:"If-None-Match" type:"string"`
+
+	// Return the object only if it has not been modified since the specified time,
+	// otherwise return a 412 (precondition failed).
+	IfUnmodifiedSince *time.Time `location:"header" locationName:"If-Unmodified-Since" type:"timestamp" timestampFormat:"rfc822"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Downloads the specified range bytes of an object. For more information about
+	// the HTTP Range header, go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
+	Range *string `location:"header" locationName:"Range" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// Sets the Cache-Control header of the response.
+	ResponseCacheControl *string `location:"querystring" locationName:"response-cache-control" type:"string"`
+
+	// Sets the Content-Disposition header of the response
+	ResponseContentDisposition *string `location:"querystring" locationName:"response-content-disposition" type:"string"`
+
+	// Sets the Content-Encoding header of the response.
+	ResponseContentEncoding *string `location:"querystring" locationName:"response-content-encoding" type:"string"`
+
+	// Sets the Content-Language header of the response.
+	ResponseContentLanguage *string `location:"querystring" locationName:"response-content-language" type:"string"`
+
+	// Sets the Content-Type header of the response.
+	ResponseContentType *string `location:"querystring" locationName:"response-content-type" type:"string"`
+
+	// Sets the Expires header of the response.
+	ResponseExpires *time.Time `location:"querystring" locationName:"response-expires" type:"timestamp" timestampFormat:"iso8601"`
+
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// VersionId used to reference a specific version of the object.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataGetObjectInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectInput) SetBucket(v string) *GetObjectInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectInput) SetContentType(v string) *GetObjectInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *GetObjectInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *GetObjectInput) SetExpectedBucketOwner(v string) *GetObjectInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetIfMatch returns the value of IfMatch, or the zero value if it is not set.
+func (s *GetObjectInput) GetIfMatch() string {
+	if s == nil || s.IfMatch == nil {
+		return ""
+	}
+	return *s.IfMatch
+}
+
+// SetIfMatch sets the value of IfMatch.
+func (s *GetObjectInput) SetIfMatch(v string) *GetObjectInput {
+	s.IfMatch = &v
+	return s
+}
+
+// GetIfModifiedSince returns the value of IfModifiedSince, or the zero value if it is not set.
+func (s *GetObjectInput) GetIfModifiedSince() time.Time {
+	if s == nil || s.IfModifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.IfModifiedSince
+}
+
+// SetIfModifiedSince sets the value of IfModifiedSince.
+func (s *GetObjectInput) SetIfModifiedSince(v time.Time) *GetObjectInput {
+	s.IfModifiedSince = &v
+	return s
+}
+
+// GetIfNoneMatch returns the value of IfNoneMatch, or the zero value if it is not set.
+func (s *GetObjectInput) GetIfNoneMatch() string {
+	if s == nil || s.IfNoneMatch == nil {
+		return ""
+	}
+	return *s.IfNoneMatch
+}
+
+// SetIfNoneMatch sets the value of IfNoneMatch.
+func (s *GetObjectInput) SetIfNoneMatch(v string) *GetObjectInput {
+	s.IfNoneMatch = &v
+	return s
+}
+
+// GetIfUnmodifiedSince returns the value of IfUnmodifiedSince, or the zero value if it is not set.
+func (s *GetObjectInput) GetIfUnmodifiedSince() time.Time {
+	if s == nil || s.IfUnmodifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.IfUnmodifiedSince
+}
+
+// SetIfUnmodifiedSince sets the value of IfUnmodifiedSince.
+func (s *GetObjectInput) SetIfUnmodifiedSince(v time.Time) *GetObjectInput {
+	s.IfUnmodifiedSince = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectInput) SetKey(v string) *GetObjectInput {
+	s.Key = &v
+	return s
+}
+
+// GetRange returns the value of Range, or the zero value if it is not set.
+func (s *GetObjectInput) GetRange() string {
+	if s == nil || s.Range == nil {
+		return ""
+	}
+	return *s.Range
+}
+
+// SetRange sets the value of Range.
+func (s *GetObjectInput) SetRange(v string) *GetObjectInput {
+	s.Range = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *GetObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *GetObjectInput) SetRequestPayer(v string) *GetObjectInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetResponseCacheControl returns the value of ResponseCacheControl, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseCacheControl() string {
+	if s == nil || s.ResponseCacheControl == nil {
+		return ""
+	}
+	return *s.ResponseCacheControl
+}
+
+// SetResponseCacheControl sets the value of ResponseCacheControl.
+func (s *GetObjectInput) SetResponseCacheControl(v string) *GetObjectInput {
+	s.ResponseCacheControl = &v
+	return s
+}
+
+// GetResponseContentDisposition returns the value of ResponseContentDisposition, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseContentDisposition() string {
+	if s == nil || s.ResponseContentDisposition == nil {
+		return ""
+	}
+	return *s.ResponseContentDisposition
+}
+
+// SetResponseContentDisposition sets the value of ResponseContentDisposition.
+func (s *GetObjectInput) SetResponseContentDisposition(v string) *GetObjectInput {
+	s.ResponseContentDisposition = &v
+	return s
+}
+
+// GetResponseContentEncoding returns the value of ResponseContentEncoding, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseContentEncoding() string {
+	if s == nil || s.ResponseContentEncoding == nil {
+		return ""
+	}
+	return *s.ResponseContentEncoding
+}
+
+// SetResponseContentEncoding sets the value of ResponseContentEncoding.
+func (s *GetObjectInput) SetResponseContentEncoding(v string) *GetObjectInput {
+	s.ResponseContentEncoding = &v
+	return s
+}
+
+// GetResponseContentLanguage returns the value of ResponseContentLanguage, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseContentLanguage() string {
+	if s == nil || s.ResponseContentLanguage == nil {
+		return ""
+	}
+	return *s.ResponseContentLanguage
+}
+
+// SetResponseContentLanguage sets the value of ResponseContentLanguage.
+func (s *GetObjectInput) SetResponseContentLanguage(v string) *GetObjectInput {
+	s.ResponseContentLanguage = &v
+	return s
+}
+
+// GetResponseContentType returns the value of ResponseContentType, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseContentType() string {
+	if s == nil || s.ResponseContentType == nil {
+		return ""
+	}
+	return *s.ResponseContentType
+}
+
+// SetResponseContentType sets the value of ResponseContentType.
+func (s *GetObjectInput) SetResponseContentType(v string) *GetObjectInput {
+	s.ResponseContentType = &v
+	return s
+}
+
+// GetResponseExpires returns the value of ResponseExpires, or the zero value if it is not set.
+func (s *GetObjectInput) GetResponseExpires() time.Time {
+	if s == nil || s.ResponseExpires == nil {
+		return time.Time{}
+	}
+	return *s.ResponseExpires
+}
+
+// SetResponseExpires sets the value of ResponseExpires.
+func (s *GetObjectInput) SetResponseExpires(v time.Time) *GetObjectInput {
+	s.ResponseExpires = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *GetObjectInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *GetObjectInput) SetSSECustomerAlgorithm(v string) *GetObjectInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *GetObjectInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *GetObjectInput) SetSSECustomerKey(v string) *GetObjectInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *GetObjectInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *GetObjectInput) SetSSECustomerKeyMD5(v string) *GetObjectInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectInput) SetVersionID(v string) *GetObjectInput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectLegalHoldInput struct {
+	// The bucket containing the object whose Legal Hold status you want to retrieve.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The key name for the object whose Legal Hold status you want to retrieve.
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// The version ID of the object whose Legal Hold status you want to retrieve.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataGetObjectLegalHoldInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectLegalHoldInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectLegalHoldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectLegalHoldInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectLegalHoldInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectLegalHoldInput) SetBucket(v string) *GetObjectLegalHoldInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectLegalHoldInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectLegalHoldInput) SetContentType(v string) *GetObjectLegalHoldInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectLegalHoldInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectLegalHoldInput) SetKey(v string) *GetObjectLegalHoldInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *GetObjectLegalHoldInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *GetObjectLegalHoldInput) SetRequestPayer(v string) *GetObjectLegalHoldInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectLegalHoldInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectLegalHoldInput) SetVersionID(v string) *GetObjectLegalHoldInput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectLegalHoldOutput struct {
+	// The current Legal Hold status for the specified object.
+	LegalHold *ObjectLockLegalHold `type:"structure"`
+
+	metadataGetObjectLegalHoldOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectLegalHoldOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"LegalHold"`
+}
+
+// String returns the string representation.
+func (s GetObjectLegalHoldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectLegalHoldOutput) GoString() string {
+	return s.String()
+}
+
+// GetLegalHold returns the value of LegalHold, or nil if it is not set.
+func (s *GetObjectLegalHoldOutput) GetLegalHold() *ObjectLockLegalHold {
+	if s == nil {
+		return nil
+	}
+	return s.LegalHold
+}
+
+// SetLegalHold sets the value of LegalHold.
+func (s *GetObjectLegalHoldOutput) SetLegalHold(v *ObjectLockLegalHold) *GetObjectLegalHoldOutput {
+	s.LegalHold = v
+	return s
+}
+
+type GetObjectLockConfigurationInput struct {
+	// The bucket whose Object Lock configuration you want to retrieve.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetObjectLockConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectLockConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectLockConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectLockConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectLockConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectLockConfigurationInput) SetBucket(v string) *GetObjectLockConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectLockConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectLockConfigurationInput) SetContentType(v string) *GetObjectLockConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetObjectLockConfigurationOutput struct {
+	// The specified bucket's Object Lock configuration.
+	ObjectLockConfiguration *ObjectLockConfiguration `type:"structure"`
+
+	metadataGetObjectLockConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectLockConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ObjectLockConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetObjectLockConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectLockConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetObjectLockConfiguration returns the value of ObjectLockConfiguration, or nil if it is not set.
+func (s *GetObjectLockConfigurationOutput) GetObjectLockConfiguration() *ObjectLockConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ObjectLockConfiguration
+}
+
+// SetObjectLockConfiguration sets the value of ObjectLockConfiguration.
+func (s *GetObjectLockConfigurationOutput) SetObjectLockConfiguration(v *ObjectLockConfiguration) *GetObjectLockConfigurationOutput {
+	s.ObjectLockConfiguration = v
+	return s
+}
+
+type GetObjectOutput struct {
+	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
+
+	// Object data.
+	Body io.ReadCloser `type:"blob"`
+
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+
+	// Size of the body in bytes.
+	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+
+	// The portion of the object returned in the response.
+	ContentRange *string `location:"header" locationName:"Content-Range" type:"string"`
+
+	// A standard MIME type describing the format of the object data.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Specifies whether the object retrieved was (true) or was not (false) a Delete
+	// Marker. If false, this response header does not appear in the response.
+	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
+
+	// An ETag is an opaque identifier assigned by a web server to a specific version
+	// of a resource found at a URL
+	ETag *string `location:"header" locationName:"ETag" type:"string"`
+
+	// If the object expiration is configured (see PUT Bucket lifecycle), the response
+	// includes this header. It includes the expiry-date and rule-id key value pairs
+	// providing object expiration information. The value of the rule-id is URL
+	// encoded.
+	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Last modified date of the object
+	LastModified *time.Time `location:"header" locationName:"Last-Modified" type:"timestamp" timestampFormat:"rfc822"`
+
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+
+	// This is set to the number of metadata entries not returned in x-amz-meta
+	// headers. This can happen if you create metadata using an API like SOAP that
+	// supports more flexible metadata than the REST API. For example, using SOAP,
+	// you can create metadata whose values are not legal HTTP headers.
+	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
+
+	// Indicates whether this object has an active legal hold.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	ObjectLockLegalHoldStatus *string `location:"header" locationName:"x-amz-object-lock-legal-hold" type:"string" enum:"ON,OFF"`
+
+	// The Object Lock mode currently in place for this object.
+	//
+	// The valid values are the ObjectLockMode enum constants.
+	ObjectLockMode *string `location:"header" locationName:"x-amz-object-lock-mode" type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The date and time when this object's Object Lock will expire.
+	ObjectLockRetainUntilDate *time.Time `location:"header" locationName:"x-amz-object-lock-retain-until-date" type:"timestamp" timestampFormat:"iso8601"`
+
+	// The valid values are the ReplicationStatus enum constants.
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// Provides information about object restoration operation and expiration time
+	// of the restored object copy.
+	Restore *string `location:"header" locationName:"x-amz-restore" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// The number of tags, if any, on the object.
+	TagCount *int64 `location:"header" locationName:"x-amz-tagging-count" type:"integer"`
+
+	// Version of the object.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+
+	metadataGetObjectOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+// String returns the string representation.
+func (s GetObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectOutput) GoString() string {
+	return s.String()
+}
+
+// GetAcceptRanges returns the value of AcceptRanges, or the zero value if it is not set.
+func (s *GetObjectOutput) GetAcceptRanges() string {
+	if s == nil || s.AcceptRanges == nil {
+		return ""
+	}
+	return *s.AcceptRanges
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *GetObjectOutput) SetAcceptRanges(v string) *GetObjectOutput {
+	s.AcceptRanges = &v
+	return s
+}
+
+// GetBody returns the value of Body, or nil if it is not set.
+func (s *GetObjectOutput) GetBody() io.ReadCloser {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// SetBody sets the value of Body.
+func (s *GetObjectOutput) SetBody(v io.ReadCloser) *GetObjectOutput {
+	s.Body = v
+	return s
+}
+
+// GetCacheControl returns the value of CacheControl, or the zero value if it is not set.
+func (s *GetObjectOutput) GetCacheControl() string {
+	if s == nil || s.CacheControl == nil {
+		return ""
+	}
+	return *s.CacheControl
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetObjectOutput) SetCacheControl(v string) *GetObjectOutput {
+	s.CacheControl = &v
+	return s
+}
+
+// GetContentDisposition returns the value of ContentDisposition, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentDisposition() string {
+	if s == nil || s.ContentDisposition == nil {
+		return ""
+	}
+	return *s.ContentDisposition
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *GetObjectOutput) SetContentDisposition(v string) *GetObjectOutput {
+	s.ContentDisposition = &v
+	return s
+}
+
+// GetContentEncoding returns the value of ContentEncoding, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentEncoding() string {
+	if s == nil || s.ContentEncoding == nil {
+		return ""
+	}
+	return *s.ContentEncoding
+}
+
+// SetContentEncoding sets the value of ContentEncoding.
+func (s *GetObjectOutput) SetContentEncoding(v string) *GetObjectOutput {
+	s.ContentEncoding = &v
+	return s
+}
+
+// GetContentLanguage returns the value of ContentLanguage, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentLanguage() string {
+	if s == nil || s.ContentLanguage == nil {
+		return ""
+	}
+	return *s.ContentLanguage
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *GetObjectOutput) SetContentLanguage(v string) *GetObjectOutput {
+	s.ContentLanguage = &v
+	return s
+}
+
+// GetContentLength returns the value of ContentLength, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentLength() int64 {
+	if s == nil || s.ContentLength == nil {
+		return 0
+	}
+	return *s.ContentLength
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *GetObjectOutput) SetContentLength(v int64) *GetObjectOutput {
+	s.ContentLength = &v
+	return s
+}
+
+// GetContentRange returns the value of ContentRange, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentRange() string {
+	if s == nil || s.ContentRange == nil {
+		return ""
+	}
+	return *s.ContentRange
+}
+
+// SetContentRange sets the value of ContentRange.
+func (s *GetObjectOutput) SetContentRange(v string) *GetObjectOutput {
+	s.ContentRange = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectOutput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectOutput) SetContentType(v string) *GetObjectOutput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDeleteMarker returns the value of DeleteMarker, or the zero value if it is not set.
+func (s *GetObjectOutput) GetDeleteMarker() bool {
+	if s == nil || s.DeleteMarker == nil {
+		return false
+	}
+	return *s.DeleteMarker
+}
+
+// SetDeleteMarker sets the value of DeleteMarker.
+func (s *GetObjectOutput) SetDeleteMarker(v bool) *GetObjectOutput {
+	s.DeleteMarker = &v
+	return s
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *GetObjectOutput) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *GetObjectOutput) SetETag(v string) *GetObjectOutput {
+	s.ETag = &v
+	return s
+}
+
+// GetExpiration returns the value of Expiration, or the zero value if it is not set.
+func (s *GetObjectOutput) GetExpiration() string {
+	if s == nil || s.Expiration == nil {
+		return ""
+	}
+	return *s.Expiration
+}
+
+// SetExpiration sets the value of Expiration.
+func (s *GetObjectOutput) SetExpiration(v string) *GetObjectOutput {
+	s.Expiration = &v
+	return s
+}
+
+// GetExpires returns the value of Expires, or the zero value if it is not set.
+func (s *GetObjectOutput) GetExpires() time.Time {
+	if s == nil || s.Expires == nil {
+		return time.Time{}
+	}
+	return *s.Expires
+}
+
+// SetExpires sets the value of Expires.
+func (s *GetObjectOutput) SetExpires(v time.Time) *GetObjectOutput {
+	s.Expires = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *GetObjectOutput) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *GetObjectOutput) SetLastModified(v time.Time) *GetObjectOutput {
+	s.LastModified = &v
+	return s
+}
+
+// GetMetadata returns the value of Metadata, or nil if it is not set.
+func (s *GetObjectOutput) GetMetadata() map[string]*string {
+	if s == nil {
+		return nil
+	}
+	return s.Metadata
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *GetObjectOutput) SetMetadata(v map[string]*string) *GetObjectOutput {
+	s.Metadata = v
+	return s
+}
+
+// GetMissingMeta returns the value of MissingMeta, or the zero value if it is not set.
+func (s *GetObjectOutput) GetMissingMeta() int64 {
+	if s == nil || s.MissingMeta == nil {
+		return 0
+	}
+	return *s.MissingMeta
+}
+
+// SetMissingMeta sets the value of MissingMeta.
+func (s *GetObjectOutput) SetMissingMeta(v int64) *GetObjectOutput {
+	s.MissingMeta = &v
+	return s
+}
+
+// GetObjectLockLegalHoldStatus returns the value of ObjectLockLegalHoldStatus, or the zero value if it is not set.
+func (s *GetObjectOutput) GetObjectLockLegalHoldStatus() string {
+	if s == nil || s.ObjectLockLegalHoldStatus == nil {
+		return ""
+	}
+	return *s.ObjectLockLegalHoldStatus
+}
+
+// SetObjectLockLegalHoldStatus sets the value of ObjectLockLegalHoldStatus.
+func (s *GetObjectOutput) SetObjectLockLegalHoldStatus(v string) *GetObjectOutput {
+	s.ObjectLockLegalHoldStatus = &v
+	return s
+}
+
+// GetObjectLockMode returns the value of ObjectLockMode, or the zero value if it is not set.
+func (s *GetObjectOutput) GetObjectLockMode() string {
+	if s == nil || s.ObjectLockMode == nil {
+		return ""
+	}
+	return *s.ObjectLockMode
+}
+
+// SetObjectLockMode sets the value of ObjectLockMode.
+func (s *GetObjectOutput) SetObjectLockMode(v string) *GetObjectOutput {
+	s.ObjectLockMode = &v
+	return s
+}
+
+// GetObjectLockRetainUntilDate returns the value of ObjectLockRetainUntilDate, or the zero value if it is not set.
+func (s *GetObjectOutput) GetObjectLockRetainUntilDate() time.Time {
+	if s == nil || s.ObjectLockRetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.ObjectLockRetainUntilDate
+}
+
+// SetObjectLockRetainUntilDate sets the value of ObjectLockRetainUntilDate.
+func (s *GetObjectOutput) SetObjectLockRetainUntilDate(v time.Time) *GetObjectOutput {
+	s.ObjectLockRetainUntilDate = &v
+	return s
+}
+
+// GetReplicationStatus returns the value of ReplicationStatus, or the zero value if it is not set.
+func (s *GetObjectOutput) GetReplicationStatus() string {
+	if s == nil || s.ReplicationStatus == nil {
+		return ""
+	}
+	return *s.ReplicationStatus
+}
+
+// SetReplicationStatus sets the value of ReplicationStatus.
+func (s *GetObjectOutput) SetReplicationStatus(v string) *GetObjectOutput {
+	s.ReplicationStatus = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *GetObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *GetObjectOutput) SetRequestCharged(v string) *GetObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetRestore returns the value of Restore, or the zero value if it is not set.
+func (s *GetObjectOutput) GetRestore() string {
+	if s == nil || s.Restore == nil {
+		return ""
+	}
+	return *s.Restore
+}
+
+// SetRestore sets the value of Restore.
+func (s *GetObjectOutput) SetRestore(v string) *GetObjectOutput {
+	s.Restore = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *GetObjectOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *GetObjectOutput) SetSSECustomerAlgorithm(v string) *GetObjectOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *GetObjectOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *GetObjectOutput) SetSSECustomerKeyMD5(v string) *GetObjectOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *GetObjectOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *GetObjectOutput) SetSSEKMSKeyID(v string) *GetObjectOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *GetObjectOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *GetObjectOutput) SetServerSideEncryption(v string) *GetObjectOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetTagCount returns the value of TagCount, or the zero value if it is not set.
+func (s *GetObjectOutput) GetTagCount() int64 {
+	if s == nil || s.TagCount == nil {
+		return 0
+	}
+	return *s.TagCount
+}
+
+// SetTagCount sets the value of TagCount.
+func (s *GetObjectOutput) SetTagCount(v int64) *GetObjectOutput {
+	s.TagCount = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectOutput) SetVersionID(v string) *GetObjectOutput {
+	s.VersionID = &v
+	return s
+}
+
+// GetWebsiteRedirectLocation returns the value of WebsiteRedirectLocation, or the zero value if it is not set.
+func (s *GetObjectOutput) GetWebsiteRedirectLocation() string {
+	if s == nil || s.WebsiteRedirectLocation == nil {
+		return ""
+	}
+	return *s.WebsiteRedirectLocation
+}
+
+// SetWebsiteRedirectLocation sets the value of WebsiteRedirectLocation.
+func (s *GetObjectOutput) SetWebsiteRedirectLocation(v string) *GetObjectOutput {
+	s.WebsiteRedirectLocation = &v
+	return s
+}
+
+type GetObjectRetentionInput struct {
+	// The bucket containing the object whose retention settings you want to retrieve.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The key name for the object whose retention settings you want to retrieve.
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// The version ID for the object whose retention settings you want to retrieve.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataGetObjectRetentionInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectRetentionInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectRetentionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectRetentionInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectRetentionInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectRetentionInput) SetBucket(v string) *GetObjectRetentionInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectRetentionInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectRetentionInput) SetContentType(v string) *GetObjectRetentionInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectRetentionInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectRetentionInput) SetKey(v string) *GetObjectRetentionInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *GetObjectRetentionInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *GetObjectRetentionInput) SetRequestPayer(v string) *GetObjectRetentionInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectRetentionInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectRetentionInput) SetVersionID(v string) *GetObjectRetentionInput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectRetentionOutput struct {
+	// The container element for an object's retention settings.
+	Retention *ObjectLockRetention `type:"structure"`
+
+	metadataGetObjectRetentionOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectRetentionOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Retention"`
+}
+
+// String returns the string representation.
+func (s GetObjectRetentionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectRetentionOutput) GoString() string {
+	return s.String()
+}
+
+// GetRetention returns the value of Retention, or nil if it is not set.
+func (s *GetObjectRetentionOutput) GetRetention() *ObjectLockRetention {
+	if s == nil {
+		return nil
+	}
+	return s.Retention
+}
+
+// SetRetention sets the value of Retention.
+func (s *GetObjectRetentionOutput) SetRetention(v *ObjectLockRetention) *GetObjectRetentionOutput {
+	s.Retention = v
+	return s
+}
+
+type GetObjectTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataGetObjectTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectTaggingInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectTaggingInput) SetBucket(v string) *GetObjectTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectTaggingInput) SetContentType(v string) *GetObjectTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectTaggingInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectTaggingInput) SetKey(v string) *GetObjectTaggingInput {
+	s.Key = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectTaggingInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectTaggingInput) SetVersionID(v string) *GetObjectTaggingInput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectTaggingOutput struct {
+	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
+
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	metadataGetObjectTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectTaggingOutput) GoString() string {
+	return s.String()
+}
+
+// GetTagSet returns the value of TagSet, or nil if it is not set.
+func (s *GetObjectTaggingOutput) GetTagSet() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.TagSet
+}
+
+// SetTagSet sets the value of TagSet.
+func (s *GetObjectTaggingOutput) SetTagSet(v []*Tag) *GetObjectTaggingOutput {
+	s.TagSet = v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *GetObjectTaggingOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *GetObjectTaggingOutput) SetVersionID(v string) *GetObjectTaggingOutput {
+	s.VersionID = &v
+	return s
+}
+
+type GetObjectTorrentInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	metadataGetObjectTorrentInput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectTorrentInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetObjectTorrentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectTorrentInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetObjectTorrentInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetObjectTorrentInput) SetBucket(v string) *GetObjectTorrentInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetObjectTorrentInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetObjectTorrentInput) SetContentType(v string) *GetObjectTorrentInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *GetObjectTorrentInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *GetObjectTorrentInput) SetKey(v string) *GetObjectTorrentInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *GetObjectTorrentInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *GetObjectTorrentInput) SetRequestPayer(v string) *GetObjectTorrentInput {
+	s.RequestPayer = &v
+	return s
+}
+
+type GetObjectTorrentOutput struct {
+	Body io.ReadCloser `type:"blob"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataGetObjectTorrentOutput `json:"-" xml:"-"`
+}
+
+type metadataGetObjectTorrentOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+// String returns the string representation.
+func (s GetObjectTorrentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetObjectTorrentOutput) GoString() string {
+	return s.String()
+}
+
+// GetBody returns the value of Body, or nil if it is not set.
+func (s *GetObjectTorrentOutput) GetBody() io.ReadCloser {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// SetBody sets the value of Body.
+func (s *GetObjectTorrentOutput) SetBody(v io.ReadCloser) *GetObjectTorrentOutput {
+	s.Body = v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *GetObjectTorrentOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *GetObjectTorrentOutput) SetRequestCharged(v string) *GetObjectTorrentOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type GetPublicAccessBlockInput struct {
+	// The name of the Amazon S3 bucket whose PublicAccessBlock configuration you
+	// want to retrieve.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataGetPublicAccessBlockInput `json:"-" xml:"-"`
+}
+
+type metadataGetPublicAccessBlockInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s GetPublicAccessBlockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetPublicAccessBlockInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *GetPublicAccessBlockInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *GetPublicAccessBlockInput) SetBucket(v string) *GetPublicAccessBlockInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *GetPublicAccessBlockInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *GetPublicAccessBlockInput) SetContentType(v string) *GetPublicAccessBlockInput {
+	s.ContentType = &v
+	return s
+}
+
+type GetPublicAccessBlockOutput struct {
+	// The PublicAccessBlock configuration currently in effect for this Amazon S3
+	// bucket.
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `type:"structure"`
+
+	metadataGetPublicAccessBlockOutput `json:"-" xml:"-"`
+}
+
+type metadataGetPublicAccessBlockOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"PublicAccessBlockConfiguration"`
+}
+
+// String returns the string representation.
+func (s GetPublicAccessBlockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s GetPublicAccessBlockOutput) GoString() string {
+	return s.String()
+}
+
+// GetPublicAccessBlockConfiguration returns the value of PublicAccessBlockConfiguration, or nil if it is not set.
+func (s *GetPublicAccessBlockOutput) GetPublicAccessBlockConfiguration() *PublicAccessBlockConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.PublicAccessBlockConfiguration
+}
+
+// SetPublicAccessBlockConfiguration sets the value of PublicAccessBlockConfiguration.
+func (s *GetPublicAccessBlockOutput) SetPublicAccessBlockConfiguration(v *PublicAccessBlockConfiguration) *GetPublicAccessBlockOutput {
+	s.PublicAccessBlockConfiguration = v
+	return s
+}
+
+type Grant struct {
+	Grantee *Grantee `type:"structure"`
+
+	// Specifies the permission given to the grantee.
+	//
+	// The valid values are the Permission enum constants.
+	Permission *string `type:"string" enum:"FULL_CONTROL,WRITE,WRITE_ACP,READ,READ_ACP"`
+
+	metadataGrant `json:"-" xml:"-"`
+}
+
+type metadataGrant struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Grant) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Grant) GoString() string {
+	return s.String()
+}
+
+// GetGrantee returns the value of Grantee, or nil if it is not set.
+func (s *Grant) GetGrantee() *Grantee {
+	if s == nil {
+		return nil
+	}
+	return s.Grantee
+}
+
+// SetGrantee sets the value of Grantee.
+func (s *Grant) SetGrantee(v *Grantee) *Grant {
+	s.Grantee = v
+	return s
+}
+
+// GetPermission returns the value of Permission, or the zero value if it is not set.
+func (s *Grant) GetPermission() string {
+	if s == nil || s.Permission == nil {
+		return ""
+	}
+	return *s.Permission
+}
+
+// SetPermission sets the value of Permission.
+func (s *Grant) SetPermission(v string) *Grant {
+	s.Permission = &v
+	return s
+}
+
+type Grantee struct {
+	// Screen name of the grantee.
+	DisplayName *string `type:"string"`
+
+	// Email address of the grantee.
+	EmailAddress *string `type:"string"`
+
+	// The canonical user ID of the grantee.
+	ID *string `type:"string"`
+
+	// Type of grantee
+	//
+	// The valid values are the Type enum constants.
+	Type *string `locationName:"xsi:type" type:"string" enum:"CanonicalUser,AmazonCustomerByEmail,Group" xmlAttribute:"true" required:"true"`
+
+	// URI of the grantee group.
+	URI *string `type:"string"`
+
+	metadataGrantee `json:"-" xml:"-"`
+}
+
+type metadataGrantee struct {
+	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
+}
+
+// String returns the string representation.
+func (s Grantee) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Grantee) GoString() string {
+	return s.String()
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value if it is not set.
+func (s *Grantee) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *Grantee) SetDisplayName(v string) *Grantee {
+	s.DisplayName = &v
+	return s
+}
+
+// GetEmailAddress returns the value of EmailAddress, or the zero value if it is not set.
+func (s *Grantee) GetEmailAddress() string {
+	if s == nil || s.EmailAddress == nil {
+		return ""
+	}
+	return *s.EmailAddress
+}
+
+// SetEmailAddress sets the value of EmailAddress.
+func (s *Grantee) SetEmailAddress(v string) *Grantee {
+	s.EmailAddress = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *Grantee) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *Grantee) SetID(v string) *Grantee {
+	s.ID = &v
+	return s
+}
+
+// GetType returns the value of Type, or the zero value if it is not set.
+func (s *Grantee) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// SetType sets the value of Type.
+func (s *Grantee) SetType(v string) *Grantee {
+	s.Type = &v
+	return s
+}
+
+// GetURI returns the value of URI, or the zero value if it is not set.
+func (s *Grantee) GetURI() string {
+	if s == nil || s.URI == nil {
+		return ""
+	}
+	return *s.URI
+}
+
+// SetURI sets the value of URI.
+func (s *Grantee) SetURI(v string) *Grantee {
+	s.URI = &v
+	return s
+}
+
+type HeadBucketInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataHeadBucketInput `json:"-" xml:"-"`
+}
+
+type metadataHeadBucketInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s HeadBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s HeadBucketInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *HeadBucketInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *HeadBucketInput) SetBucket(v string) *HeadBucketInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *HeadBucketInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *HeadBucketInput) SetContentType(v string) *HeadBucketInput {
+	s.ContentType = &v
+	return s
+}
+
+type HeadBucketOutput struct {
+	metadataHeadBucketOutput `json:"-" xml:"-"`
+}
+
+type metadataHeadBucketOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s HeadBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s HeadBucketOutput) GoString() string {
+	return s.String()
+}
+
+type HeadObjectInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// Return the object only if its entity tag (ETag) is the same as the one specified,
+	// otherwise return a 412 (precondition failed).
+	IfMatch *string `location:"header" locationName:"If-Match" type:"string"`
+
+	// Return the object only if it has been modified since the specified time,
+	// otherwise return a 304 (not modified).
+	IfModifiedSince *time.Time `location:"header" locationName:"If-Modified-Since" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Return the object only if its entity tag (ETag) is different from the one
+	// specified, otherwise return a 304 (not modified).
+	IfNoneMatch *string `location:"header" locationName:"If-None-Match" type:"string"`
+
+	// Return the object only if it has not been modified since the specified time,
+	// otherwise return a 412 (precondition failed).
+	IfUnmodifiedSince *time.Time `location:"header" locationName:"If-Unmodified-Since" type:"timestamp" timestampFormat:"rfc822"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Downloads the specified range bytes of an object. For more information about
+	// the HTTP Range header, go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
+	Range *string `location:"header" locationName:"Range" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// VersionId used to reference a specific version of the object.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataHeadObjectInput `json:"-" xml:"-"`
+}
+
+type metadataHeadObjectInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s HeadObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s HeadObjectInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *HeadObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *HeadObjectInput) SetBucket(v string) *HeadObjectInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *HeadObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *HeadObjectInput) SetContentType(v string) *HeadObjectInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *HeadObjectInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *HeadObjectInput) SetExpectedBucketOwner(v string) *HeadObjectInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetIfMatch returns the value of IfMatch, or the zero value if it is not set.
+func (s *HeadObjectInput) GetIfMatch() string {
+	if s == nil || s.IfMatch == nil {
+		return ""
+	}
+	return *s.IfMatch
+}
+
+// SetIfMatch sets the value of IfMatch.
+func (s *HeadObjectInput) SetIfMatch(v string) *HeadObjectInput {
+	s.IfMatch = &v
+	return s
+}
+
+// GetIfModifiedSince returns the value of IfModifiedSince, or the zero value if it is not set.
+func (s *HeadObjectInput) GetIfModifiedSince() time.Time {
+	if s == nil || s.IfModifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.IfModifiedSince
+}
+
+// SetIfModifiedSince sets the value of IfModifiedSince.
+func (s *HeadObjectInput) SetIfModifiedSince(v time.Time) *HeadObjectInput {
+	s.IfModifiedSince = &v
+	return s
+}
+
+// GetIfNoneMatch returns the value of IfNoneMatch, or the zero value if it is not set.
+func (s *HeadObjectInput) GetIfNoneMatch() string {
+	if s == nil || s.IfNoneMatch == nil {
+		return ""
+	}
+	return *s.IfNoneMatch
+}
+
+// SetIfNoneMatch sets the value of IfNoneMatch.
+func (s *HeadObjectInput) SetIfNoneMatch(v string) *HeadObjectInput {
+	s.IfNoneMatch = &v
+	return s
+}
+
+// GetIfUnmodifiedSince returns the value of IfUnmodifiedSince, or the zero value if it is not set.
+func (s *HeadObjectInput) GetIfUnmodifiedSince() time.Time {
+	if s == nil || s.IfUnmodifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.IfUnmodifiedSince
+}
+
+// SetIfUnmodifiedSince sets the value of IfUnmodifiedSince.
+func (s *HeadObjectInput) SetIfUnmodifiedSince(v time.Time) *HeadObjectInput {
+	s.IfUnmodifiedSince = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *HeadObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *HeadObjectInput) SetKey(v string) *HeadObjectInput {
+	s.Key = &v
+	return s
+}
+
+// GetRange returns the value of Range, or the zero value if it is not set.
+func (s *HeadObjectInput) GetRange() string {
+	if s == nil || s.Range == nil {
+		return ""
+	}
+	return *s.Range
+}
+
+// SetRange sets the value of Range.
+func (s *HeadObjectInput) SetRange(v string) *HeadObjectInput {
+	s.Range = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *HeadObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *HeadObjectInput) SetRequestPayer(v string) *HeadObjectInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *HeadObjectInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *HeadObjectInput) SetSSECustomerAlgorithm(v string) *HeadObjectInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *HeadObjectInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *HeadObjectInput) SetSSECustomerKey(v string) *HeadObjectInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *HeadObjectInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *HeadObjectInput) SetSSECustomerKeyMD5(v string) *HeadObjectInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *HeadObjectInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *HeadObjectInput) SetVersionID(v string) *HeadObjectInput {
+	s.VersionID = &v
+	return s
+}
+
+type HeadObjectOutput struct {
+	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
+
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+
+	// Size of the body in bytes.
+	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+
+	// A standard MIME type describing the format of the object data.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Specifies whether the object retrieved was (true) or was not (false) a Delete
+	// Marker. If false, this response header does not appear in the response.
+	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
+
+	// An ETag is an opaque identifier assigned by a web server to a specific version
+	// of a resource found at a URL
+	ETag *string `location:"header" locationName:"ETag" type:"string"`
+
+	// If the object expiration is configured (see PUT Bucket lifecycle), the response
+	// includes this header. It includes the expiry-date and rule-id key value pairs
+	// providing object expiration information. The value of the rule-id is URL
+	// encoded.
+	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Last modified date of the object
+	LastModified *time.Time `location:"header" locationName:"Last-Modified" type:"timestamp" timestampFormat:"rfc822"`
+
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+
+	// This is set to the number of metadata entries not returned in x-amz-meta
+	// headers. This can happen if you create metadata using an API like SOAP that
+	// supports more flexible metadata than the REST API. For example, using SOAP,
+	// you can create metadata whose values are not legal HTTP headers.
+	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
+
+	// Indicates whether this object has an active legal hold.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	ObjectLockLegalHoldStatus *string `location:"header" locationName:"x-amz-object-lock-legal-hold" type:"string" enum:"ON,OFF"`
+
+	// The Object Lock mode currently in place for this object.
+	//
+	// The valid values are the ObjectLockMode enum constants.
+	ObjectLockMode *string `location:"header" locationName:"x-amz-object-lock-mode" type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The date and time when this object's Object Lock will expire.
+	ObjectLockRetainUntilDate *time.Time `location:"header" locationName:"x-amz-object-lock-retain-until-date" type:"timestamp" timestampFormat:"iso8601"`
+
+	// The valid values are the ReplicationStatus enum constants.
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// Provides information about object restoration operation and expiration time
+	// of the restored object copy.
+	Restore *string `location:"header" locationName:"x-amz-restore" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// Version of the object.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+
+	metadataHeadObjectOutput `json:"-" xml:"-"`
+}
+
+type metadataHeadObjectOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s HeadObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s HeadObjectOutput) GoString() string {
+	return s.String()
+}
+
+// GetAcceptRanges returns the value of AcceptRanges, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetAcceptRanges() string {
+	if s == nil || s.AcceptRanges == nil {
+		return ""
+	}
+	return *s.AcceptRanges
+}
+
+// SetAcceptRanges sets the value of AcceptRanges.
+func (s *HeadObjectOutput) SetAcceptRanges(v string) *HeadObjectOutput {
+	s.AcceptRanges = &v
+	return s
+}
+
+// GetCacheControl returns the value of CacheControl, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetCacheControl() string {
+	if s == nil || s.CacheControl == nil {
+		return ""
+	}
+	return *s.CacheControl
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *HeadObjectOutput) SetCacheControl(v string) *HeadObjectOutput {
+	s.CacheControl = &v
+	return s
+}
+
+// GetContentDisposition returns the value of ContentDisposition, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetContentDisposition() string {
+	if s == nil || s.ContentDisposition == nil {
+		return ""
+	}
+	return *s.ContentDisposition
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *HeadObjectOutput) SetContentDisposition(v string) *HeadObjectOutput {
+	s.ContentDisposition = &v
+	return s
+}
+
+// GetContentEncoding returns the value of ContentEncoding, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetContentEncoding() string {
+	if s == nil || s.ContentEncoding == nil {
+		return ""
+	}
+	return *s.ContentEncoding
+}
+
+// SetContentEncoding sets the value of ContentEncoding.
+func (s *HeadObjectOutput) SetContentEncoding(v string) *HeadObjectOutput {
+	s.ContentEncoding = &v
+	return s
+}
+
+// GetContentLanguage returns the value of ContentLanguage, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetContentLanguage() string {
+	if s == nil || s.ContentLanguage == nil {
+		return ""
+	}
+	return *s.ContentLanguage
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *HeadObjectOutput) SetContentLanguage(v string) *HeadObjectOutput {
+	s.ContentLanguage = &v
+	return s
+}
+
+// GetContentLength returns the value of ContentLength, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetContentLength() int64 {
+	if s == nil || s.ContentLength == nil {
+		return 0
+	}
+	return *s.ContentLength
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *HeadObjectOutput) SetContentLength(v int64) *HeadObjectOutput {
+	s.ContentLength = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *HeadObjectOutput) SetContentType(v string) *HeadObjectOutput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDeleteMarker returns the value of DeleteMarker, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetDeleteMarker() bool {
+	if s == nil || s.DeleteMarker == nil {
+		return false
+	}
+	return *s.DeleteMarker
+}
+
+// SetDeleteMarker sets the value of DeleteMarker.
+func (s *HeadObjectOutput) SetDeleteMarker(v bool) *HeadObjectOutput {
+	s.DeleteMarker = &v
+	return s
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *HeadObjectOutput) SetETag(v string) *HeadObjectOutput {
+	s.ETag = &v
+	return s
+}
+
+// GetExpiration returns the value of Expiration, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetExpiration() string {
+	if s == nil || s.Expiration == nil {
+		return ""
+	}
+	return *s.Expiration
+}
+
+// SetExpiration sets the value of Expiration.
+func (s *HeadObjectOutput) SetExpiration(v string) *HeadObjectOutput {
+	s.Expiration = &v
+	return s
+}
+
+// GetExpires returns the value of Expires, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetExpires() time.Time {
+	if s == nil || s.Expires == nil {
+		return time.Time{}
+	}
+	return *s.Expires
+}
+
+// SetExpires sets the value of Expires.
+func (s *HeadObjectOutput) SetExpires(v time.Time) *HeadObjectOutput {
+	s.Expires = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *HeadObjectOutput) SetLastModified(v time.Time) *HeadObjectOutput {
+	s.LastModified = &v
+	return s
+}
+
+// GetMetadata returns the value of Metadata, or nil if it is not set.
+func (s *HeadObjectOutput) GetMetadata() map[string]*string {
+	if s == nil {
+		return nil
+	}
+	return s.Metadata
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *HeadObjectOutput) SetMetadata(v map[string]*string) *HeadObjectOutput {
+	s.Metadata = v
+	return s
+}
+
+// GetMissingMeta returns the value of MissingMeta, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetMissingMeta() int64 {
+	if s == nil || s.MissingMeta == nil {
+		return 0
+	}
+	return *s.MissingMeta
+}
+
+// SetMissingMeta sets the value of MissingMeta.
+func (s *HeadObjectOutput) SetMissingMeta(v int64) *HeadObjectOutput {
+	s.MissingMeta = &v
+	return s
+}
+
+// GetObjectLockLegalHoldStatus returns the value of ObjectLockLegalHoldStatus, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetObjectLockLegalHoldStatus() string {
+	if s == nil || s.ObjectLockLegalHoldStatus == nil {
+		return ""
+	}
+	return *s.ObjectLockLegalHoldStatus
+}
+
+// SetObjectLockLegalHoldStatus sets the value of ObjectLockLegalHoldStatus.
+func (s *HeadObjectOutput) SetObjectLockLegalHoldStatus(v string) *HeadObjectOutput {
+	s.ObjectLockLegalHoldStatus = &v
+	return s
+}
+
+// GetObjectLockMode returns the value of ObjectLockMode, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetObjectLockMode() string {
+	if s == nil || s.ObjectLockMode == nil {
+		return ""
+	}
+	return *s.ObjectLockMode
+}
+
+// SetObjectLockMode sets the value of ObjectLockMode.
+func (s *HeadObjectOutput) SetObjectLockMode(v string) *HeadObjectOutput {
+	s.ObjectLockMode = &v
+	return s
+}
+
+// GetObjectLockRetainUntilDate returns the value of ObjectLockRetainUntilDate, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetObjectLockRetainUntilDate() time.Time {
+	if s == nil || s.ObjectLockRetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.ObjectLockRetainUntilDate
+}
+
+// SetObjectLockRetainUntilDate sets the value of ObjectLockRetainUntilDate.
+func (s *HeadObjectOutput) SetObjectLockRetainUntilDate(v time.Time) *HeadObjectOutput {
+	s.ObjectLockRetainUntilDate = &v
+	return s
+}
+
+// GetReplicationStatus returns the value of ReplicationStatus, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetReplicationStatus() string {
+	if s == nil || s.ReplicationStatus == nil {
+		return ""
+	}
+	return *s.ReplicationStatus
+}
+
+// SetReplicationStatus sets the value of ReplicationStatus.
+func (s *HeadObjectOutput) SetReplicationStatus(v string) *HeadObjectOutput {
+	s.ReplicationStatus = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *HeadObjectOutput) SetRequestCharged(v string) *HeadObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetRestore returns the value of Restore, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetRestore() string {
+	if s == nil || s.Restore == nil {
+		return ""
+	}
+	return *s.Restore
+}
+
+// SetRestore sets the value of Restore.
+func (s *HeadObjectOutput) SetRestore(v string) *HeadObjectOutput {
+	s.Restore = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *HeadObjectOutput) SetSSECustomerAlgorithm(v string) *HeadObjectOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *HeadObjectOutput) SetSSECustomerKeyMD5(v string) *HeadObjectOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *HeadObjectOutput) SetSSEKMSKeyID(v string) *HeadObjectOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *HeadObjectOutput) SetServerSideEncryption(v string) *HeadObjectOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *HeadObjectOutput) SetVersionID(v string) *HeadObjectOutput {
+	s.VersionID = &v
+	return s
+}
+
+// GetWebsiteRedirectLocation returns the value of WebsiteRedirectLocation, or the zero value if it is not set.
+func (s *HeadObjectOutput) GetWebsiteRedirectLocation() string {
+	if s == nil || s.WebsiteRedirectLocation == nil {
+		return ""
+	}
+	return *s.WebsiteRedirectLocation
+}
+
+// SetWebsiteRedirectLocation sets the value of WebsiteRedirectLocation.
+func (s *HeadObjectOutput) SetWebsiteRedirectLocation(v string) *HeadObjectOutput {
+	s.WebsiteRedirectLocation = &v
+	return s
+}
+
+type IndexDocument struct {
+	// A suffix that is appended to a request that is for a directory on the website
+	// endpoint (e.g. if the suffix is index.html and you make a request to samplebucket/images/
+	// the data that is returned will be for the object with the key name images/index.html)
+	// The suffix must not be empty and must not include a slash character.
+	Suffix *string `type:"string" required:"true"`
+
+	metadataIndexDocument `json:"-" xml:"-"`
+}
+
+type metadataIndexDocument struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s IndexDocument) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s IndexDocument) GoString() string {
+	return s.String()
+}
+
+// GetSuffix returns the value of Suffix, or the zero value if it is not set.
+func (s *IndexDocument) GetSuffix() string {
+	if s == nil || s.Suffix == nil {
+		return ""
+	}
+	return *s.Suffix
+}
+
+// SetSuffix sets the value of Suffix.
+func (s *IndexDocument) SetSuffix(v string) *IndexDocument {
+	s.Suffix = &v
+	return s
+}
+
+type Initiator struct {
+	// Name of the Principal.
+	DisplayName *string `type:"string"`
+
+	// If the principal is an AWS account, it provides the Canonical User ID. If
+	// the principal is an IAM User, it provides a user ARN value.
+	ID *string `type:"string"`
+
+	metadataInitiator `json:"-" xml:"-"`
+}
+
+type metadataInitiator struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Initiator) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Initiator) GoString() string {
+	return s.String()
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value if it is not set.
+func (s *Initiator) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *Initiator) SetDisplayName(v string) *Initiator {
+	s.DisplayName = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *Initiator) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *Initiator) SetID(v string) *Initiator {
+	s.ID = &v
+	return s
+}
+
+type InputSerialization struct {
+	// Describes the serialization of a CSV-encoded object.
+	CSV *CSVInput `type:"structure"`
+
+	// Specifies object's compression format. Valid values are NONE, GZIP and BZIP2.
+	//
+	// The valid values are the CompressionType enum constants.
+	CompressionType *string `type:"string" enum:"NONE,GZIP,BZIP2"`
+
+	// Specifies JSON as object's input serialization format.
+	JSON *JSONInput `type:"structure"`
+
+	// Specifies Parquet as object's input serialization format.
+	Parquet *ParquetInput `type:"structure"`
+
+	metadataInputSerialization `json:"-" xml:"-"`
+}
+
+type metadataInputSerialization struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InputSerialization) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InputSerialization) GoString() string {
+	return s.String()
+}
+
+// GetCSV returns the value of CSV, or nil if it is not set.
+func (s *InputSerialization) GetCSV() *CSVInput {
+	if s == nil {
+		return nil
+	}
+	return s.CSV
+}
+
+// SetCSV sets the value of CSV.
+func (s *InputSerialization) SetCSV(v *CSVInput) *InputSerialization {
+	s.CSV = v
+	return s
+}
+
+// GetCompressionType returns the value of CompressionType, or the zero value if it is not set.
+func (s *InputSerialization) GetCompressionType() string {
+	if s == nil || s.CompressionType == nil {
+		return ""
+	}
+	return *s.CompressionType
+}
+
+// SetCompressionType sets the value of CompressionType.
+func (s *InputSerialization) SetCompressionType(v string) *InputSerialization {
+	s.CompressionType = &v
+	return s
+}
+
+// GetJSON returns the value of JSON, or nil if it is not set.
+func (s *InputSerialization) GetJSON() *JSONInput {
+	if s == nil {
+		return nil
+	}
+	return s.JSON
+}
+
+// SetJSON sets the value of JSON.
+func (s *InputSerialization) SetJSON(v *JSONInput) *InputSerialization {
+	s.JSON = v
+	return s
+}
+
+// GetParquet returns the value of Parquet, or nil if it is not set.
+func (s *InputSerialization) GetParquet() *ParquetInput {
+	if s == nil {
+		return nil
+	}
+	return s.Parquet
+}
+
+// SetParquet sets the value of Parquet.
+func (s *InputSerialization) SetParquet(v *ParquetInput) *InputSerialization {
+	s.Parquet = v
+	return s
+}
+
+type InventoryConfiguration struct {
+	// Contains information about where to publish the inventory results.
+	Destination *InventoryDestination `type:"structure" required:"true"`
+
+	// Specifies an inventory filter. The inventory only includes objects that meet
+	// the filter's criteria.
+	Filter *InventoryFilter `type:"structure"`
+
+	// The ID used to identify the inventory configuration.
+	ID *string `locationName:"Id" type:"string" required:"true"`
+
+	// Specifies which object version(s) to included in the inventory results. Valid
+	// values are All and Current.
+	//
+	// The valid values are the InventoryIncludedObjectVersions enum constants.
+	IncludedObjectVersions *string `type:"string" enum:"All,Current" required:"true"`
+
+	// Specifies whether the inventory is enabled or disabled.
+	IsEnabled *bool `type:"boolean" required:"true"`
+
+	// Contains the optional fields that are included in the inventory results.
+	//
+	// The valid values of the elements are the InventoryOptionalField enum constants.
+	OptionalFields []*string `locationNameList:"Field" type:"list" enum:"Size,LastModifiedDate,StorageClass,ETag,IsMultipartUploaded,ReplicationStatus,EncryptionStatus,ObjectLockRetainUntilDate,ObjectLockMode,ObjectLockLegalHoldStatus"`
+
+	// Specifies the schedule for generating inventory results.
+	Schedule *InventorySchedule `type:"structure" required:"true"`
+
+	metadataInventoryConfiguration `json:"-" xml:"-"`
+}
+
+type metadataInventoryConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventoryConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventoryConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetDestination returns the value of Destination, or nil if it is not set.
+func (s *InventoryConfiguration) GetDestination() *InventoryDestination {
+	if s == nil {
+		return nil
+	}
+	return s.Destination
+}
+
+// SetDestination sets the value of Destination.
+func (s *InventoryConfiguration) SetDestination(v *InventoryDestination) *InventoryConfiguration {
+	s.Destination = v
+	return s
+}
+
+// GetFilter returns the value of Filter, or nil if it is not set.
+func (s *InventoryConfiguration) GetFilter() *InventoryFilter {
+	if s == nil {
+		return nil
+	}
+	return s.Filter
+}
+
+// SetFilter sets the value of Filter.
+func (s *InventoryConfiguration) SetFilter(v *InventoryFilter) *InventoryConfiguration {
+	s.Filter = v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *InventoryConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *InventoryConfiguration) SetID(v string) *InventoryConfiguration {
+	s.ID = &v
+	return s
+}
+
+// GetIncludedObjectVersions returns the value of IncludedObjectVersions, or the zero value if it is not set.
+func (s *InventoryConfiguration) GetIncludedObjectVersions() string {
+	if s == nil || s.IncludedObjectVersions == nil {
+		return ""
+	}
+	return *s.IncludedObjectVersions
+}
+
+// SetIncludedObjectVersions sets the value of IncludedObjectVersions.
+func (s *InventoryConfiguration) SetIncludedObjectVersions(v string) *InventoryConfiguration {
+	s.IncludedObjectVersions = &v
+	return s
+}
+
+// GetIsEnabled returns the value of IsEnabled, or the zero value if it is not set.
+func (s *InventoryConfiguration) GetIsEnabled() bool {
+	if s == nil || s.IsEnabled == nil {
+		return false
+	}
+	return *s.IsEnabled
+}
+
+// SetIsEnabled sets the value of IsEnabled.
+func (s *InventoryConfiguration) SetIsEnabled(v bool) *InventoryConfiguration {
+	s.IsEnabled = &v
+	return s
+}
+
+// GetOptionalFields returns the value of OptionalFields, or nil if it is not set.
+func (s *InventoryConfiguration) GetOptionalFields() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.OptionalFields
+}
+
+// SetOptionalFields sets the value of OptionalFields.
+func (s *InventoryConfiguration) SetOptionalFields(v []*string) *InventoryConfiguration {
+	s.OptionalFields = v
+	return s
+}
+
+// GetSchedule returns the value of Schedule, or nil if it is not set.
+func (s *InventoryConfiguration) GetSchedule() *InventorySchedule {
+	if s == nil {
+		return nil
+	}
+	return s.Schedule
+}
+
+// SetSchedule sets the value of Schedule.
+func (s *InventoryConfiguration) SetSchedule(v *InventorySchedule) *InventoryConfiguration {
+	s.Schedule = v
+	return s
+}
+
+type InventoryDestination struct {
+	// Contains the bucket name, file format, bucket owner (optional), and prefix
+	// (optional) where inventory results are published.
+	S3BucketDestination *InventoryS3BucketDestination `type:"structure" required:"true"`
+
+	metadataInventoryDestination `json:"-" xml:"-"`
+}
+
+type metadataInventoryDestination struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventoryDestination) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventoryDestination) GoString() string {
+	return s.String()
+}
+
+// GetS3BucketDestination returns the value of S3BucketDestination, or nil if it is not set.
+func (s *InventoryDestination) GetS3BucketDestination() *InventoryS3BucketDestination {
+	if s == nil {
+		return nil
+	}
+	return s.S3BucketDestination
+}
+
+// SetS3BucketDestination sets the value of S3BucketDestination.
+func (s *InventoryDestination) SetS3BucketDestination(v *InventoryS3BucketDestination) *InventoryDestination {
+	s.S3BucketDestination = v
+	return s
+}
+
+type InventoryEncryption struct {
+	// Specifies the use of SSE-KMS to encrypt delivered Inventory reports.
+	SSEKMS *SSEKMS `locationName:"SSE-KMS" type:"structure"`
+
+	// Specifies the use of SSE-S3 to encrypt delivered Inventory reports.
+	SSES3 *SSES3 `locationName:"SSE-S3" type:"structure"`
+
+	metadataInventoryEncryption `json:"-" xml:"-"`
+}
+
+type metadataInventoryEncryption struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventoryEncryption) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventoryEncryption) GoString() string {
+	return s.String()
+}
+
+// GetSSEKMS returns the value of SSEKMS, or nil if it is not set.
+func (s *InventoryEncryption) GetSSEKMS() *SSEKMS {
+	if s == nil {
+		return nil
+	}
+	return s.SSEKMS
+}
+
+// SetSSEKMS sets the value of SSEKMS.
+func (s *InventoryEncryption) SetSSEKMS(v *SSEKMS) *InventoryEncryption {
+	s.SSEKMS = v
+	return s
+}
+
+// GetSSES3 returns the value of SSES3, or nil if it is not set.
+func (s *InventoryEncryption) GetSSES3() *SSES3 {
+	if s == nil {
+		return nil
+	}
+	return s.SSES3
+}
+
+// SetSSES3 sets the value of SSES3.
+func (s *InventoryEncryption) SetSSES3(v *SSES3) *InventoryEncryption {
+	s.SSES3 = v
+	return s
+}
+
+type InventoryFilter struct {
+	// The prefix that an object must have to be included in the inventory results.
+	Prefix *string `type:"string" required:"true"`
+
+	metadataInventoryFilter `json:"-" xml:"-"`
+}
+
+type metadataInventoryFilter struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventoryFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventoryFilter) GoString() string {
+	return s.String()
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *InventoryFilter) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *InventoryFilter) SetPrefix(v string) *InventoryFilter {
+	s.Prefix = &v
+	return s
+}
+
+type InventoryS3BucketDestination struct {
+	// The ID of the account that owns the destination bucket.
+	AccountID *string `locationName:"AccountId" type:"string"`
+
+	// The Amazon resource name (ARN) of the bucket where inventory results will
+	// be published.
+	Bucket *string `type:"string" required:"true"`
+
+	// Contains the type of server-side encryption used to encrypt the inventory
+	// results.
+	Encryption *InventoryEncryption `type:"structure"`
+
+	// Specifies the output format of the inventory results. Valid values are CSV,
+	// ORC and Parquet.
+	//
+	// The valid values are the InventoryFormat enum constants.
+	Format *string `type:"string" enum:"CSV,ORC,Parquet" required:"true"`
+
+	// The prefix that is prepended to all inventory results.
+	Prefix *string `type:"string"`
+
+	metadataInventoryS3BucketDestination `json:"-" xml:"-"`
+}
+
+type metadataInventoryS3BucketDestination struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventoryS3BucketDestination) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventoryS3BucketDestination) GoString() string {
+	return s.String()
+}
+
+// GetAccountID returns the value of AccountID, or the zero value if it is not set.
+func (s *InventoryS3BucketDestination) GetAccountID() string {
+	if s == nil || s.AccountID == nil {
+		return ""
+	}
+	return *s.AccountID
+}
+
+// SetAccountID sets the value of AccountID.
+func (s *InventoryS3BucketDestination) SetAccountID(v string) *InventoryS3BucketDestination {
+	s.AccountID = &v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *InventoryS3BucketDestination) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *InventoryS3BucketDestination) SetBucket(v string) *InventoryS3BucketDestination {
+	s.Bucket = &v
+	return s
+}
+
+// GetEncryption returns the value of Encryption, or nil if it is not set.
+func (s *InventoryS3BucketDestination) GetEncryption() *InventoryEncryption {
+	if s == nil {
+		return nil
+	}
+	return s.Encryption
+}
+
+// SetEncryption sets the value of Encryption.
+func (s *InventoryS3BucketDestination) SetEncryption(v *InventoryEncryption) *InventoryS3BucketDestination {
+	s.Encryption = v
+	return s
+}
+
+// GetFormat returns the value of Format, or the zero value if it is not set.
+func (s *InventoryS3BucketDestination) GetFormat() string {
+	if s == nil || s.Format == nil {
+		return ""
+	}
+	return *s.Format
+}
+
+// SetFormat sets the value of Format.
+func (s *InventoryS3BucketDestination) SetFormat(v string) *InventoryS3BucketDestination {
+	s.Format = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *InventoryS3BucketDestination) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *InventoryS3BucketDestination) SetPrefix(v string) *InventoryS3BucketDestination {
+	s.Prefix = &v
+	return s
+}
+
+type InventorySchedule struct {
+	// Specifies how frequently inventory results are produced. Valid values are
+	// Daily and Weekly.
+	//
+	// The valid values are the InventoryFrequency enum constants.
+	Frequency *string `type:"string" enum:"Daily,Weekly" required:"true"`
+
+	metadataInventorySchedule `json:"-" xml:"-"`
+}
+
+type metadataInventorySchedule struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s InventorySchedule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s InventorySchedule) GoString() string {
+	return s.String()
+}
+
+// GetFrequency returns the value of Frequency, or the zero value if it is not set.
+func (s *InventorySchedule) GetFrequency() string {
+	if s == nil || s.Frequency == nil {
+		return ""
+	}
+	return *s.Frequency
+}
+
+// SetFrequency sets the value of Frequency.
+func (s *InventorySchedule) SetFrequency(v string) *InventorySchedule {
+	s.Frequency = &v
+	return s
+}
+
+type JSONInput struct {
+	// The type of JSON. Valid values are DOCUMENT and LINES.
+	//
+	// The valid values are the JSONType enum constants.
+	Type *string `type:"string" enum:"DOCUMENT,LINES"`
+
+	metadataJSONInput `json:"-" xml:"-"`
+}
+
+type metadataJSONInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s JSONInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s JSONInput) GoString() string {
+	return s.String()
+}
+
+// GetType returns the value of Type, or the zero value if it is not set.
+func (s *JSONInput) GetType() string {
+	if s == nil || s.Type == nil {
+		return ""
+	}
+	return *s.Type
+}
+
+// SetType sets the value of Type.
+func (s *JSONInput) SetType(v string) *JSONInput {
+	s.Type = &v
+	return s
+}
+
+type JSONOutput struct {
+	// The value used to separate individual records in the output.
+	RecordDelimiter *string `type:"string"`
+
+	metadataJSONOutput `json:"-" xml:"-"`
+}
+
+type metadataJSONOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s JSONOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s JSONOutput) GoString() string {
+	return s.String()
+}
+
+// GetRecordDelimiter returns the value of RecordDelimiter, or the zero value if it is not set.
+func (s *JSONOutput) GetRecordDelimiter() string {
+	if s == nil || s.RecordDelimiter == nil {
+		return ""
+	}
+	return *s.RecordDelimiter
+}
+
+// SetRecordDelimiter sets the value of RecordDelimiter.
+func (s *JSONOutput) SetRecordDelimiter(v string) *JSONOutput {
+	s.RecordDelimiter = &v
+	return s
+}
+
+// Container for specifying the AWS Lambda notification configuration.
+type LambdaFunctionConfiguration struct {
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true" required:"true"`
+
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
+
+	// Lambda cloud function ARN that Amazon S3 can invoke when it detects events
+	// of the specified type.
+	LambdaFunctionARN *string `locationName:"CloudFunction" type:"string" required:"true"`
+
+	metadataLambdaFunctionConfiguration `json:"-" xml:"-"`
+}
+
+type metadataLambdaFunctionConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LambdaFunctionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LambdaFunctionConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *LambdaFunctionConfiguration) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
+}
+
+// SetEvents sets the value of Events.
+func (s *LambdaFunctionConfiguration) SetEvents(v []*string) *LambdaFunctionConfiguration {
+	s.Events = v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *LambdaFunctionConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *LambdaFunctionConfiguration) SetID(v string) *LambdaFunctionConfiguration {
+	s.ID = &v
+	return s
+}
+
+// GetLambdaFunctionARN returns the value of LambdaFunctionARN, or the zero value if it is not set.
+func (s *LambdaFunctionConfiguration) GetLambdaFunctionARN() string {
+	if s == nil || s.LambdaFunctionARN == nil {
+		return ""
+	}
+	return *s.LambdaFunctionARN
+}
+
+// SetLambdaFunctionARN sets the value of LambdaFunctionARN.
+func (s *LambdaFunctionConfiguration) SetLambdaFunctionARN(v string) *LambdaFunctionConfiguration {
+	s.LambdaFunctionARN = &v
+	return s
+}
+
+type LifecycleConfiguration struct {
+	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+
+	metadataLifecycleConfiguration `json:"-" xml:"-"`
+}
+
+type metadataLifecycleConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LifecycleConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LifecycleConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *LifecycleConfiguration) GetRules() []*LifecycleRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *LifecycleConfiguration) SetRules(v []*LifecycleRule) *LifecycleConfiguration {
+	s.Rules = v
+	return s
+}
+
+type LifecycleExpiration struct {
+	// Indicates at what date the object is to be moved or deleted. Should be in
+	// GMT ISO 8601 Format.
+	Date *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	// Indicates the lifetime, in days, of the objects that are subject to the rule.
+	// The value must be a non-zero positive integer.
+	Days *int64 `type:"integer"`
+
+	metadataLifecycleExpiration `json:"-" xml:"-"`
+}
+
+type metadataLifecycleExpiration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LifecycleExpiration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LifecycleExpiration) GoString() string {
+	return s.String()
+}
+
+// GetDate returns the value of Date, or the zero value if it is not set.
+func (s *LifecycleExpiration) GetDate() time.Time {
+	if s == nil || s.Date == nil {
+		return time.Time{}
+	}
+	return *s.Date
+}
+
+// SetDate sets the value of Date.
+func (s *LifecycleExpiration) SetDate(v time.Time) *LifecycleExpiration {
+	s.Date = &v
+	return s
+}
+
+// GetDays returns the value of Days, or the zero value if it is not set.
+func (s *LifecycleExpiration) GetDays() int64 {
+	if s == nil || s.Days == nil {
+		return 0
+	}
+	return *s.Days
+}
+
+// SetDays sets the value of Days.
+func (s *LifecycleExpiration) SetDays(v int64) *LifecycleExpiration {
+	s.Days = &v
+	return s
+}
+
+// The Filter is used to identify objects that a Lifecycle Rule applies to.
+// A Filter must have exactly one of Prefix, Tag, or And specified.
+type LifecycleFilter struct {
+	// This is used in a Lifecycle Rule Filter to apply a logical AND to two or
+	// more predicates. The Lifecycle Rule will apply to any object matching all
+	// of the predicates configured inside the And operator.
+	And *LifecycleRuleAndOperator `type:"structure"`
+
+	// Prefix identifying one or more objects to which the rule applies.
+	Prefix *string `type:"string"`
+
+	// This tag must exist in the object's tag set in order for the rule to apply.
+	Tag *Tag `type:"structure"`
+
+	metadataLifecycleFilter `json:"-" xml:"-"`
+}
+
+type metadataLifecycleFilter struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LifecycleFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LifecycleFilter) GoString() string {
+	return s.String()
+}
+
+// GetAnd returns the value of And, or nil if it is not set.
+func (s *LifecycleFilter) GetAnd() *LifecycleRuleAndOperator {
+	if s == nil {
+		return nil
+	}
+	return s.And
+}
+
+// SetAnd sets the value of And.
+func (s *LifecycleFilter) SetAnd(v *LifecycleRuleAndOperator) *LifecycleFilter {
+	s.And = v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *LifecycleFilter) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *LifecycleFilter) SetPrefix(v string) *LifecycleFilter {
+	s.Prefix = &v
+	return s
+}
+
+// GetTag returns the value of Tag, or nil if it is not set.
+func (s *LifecycleFilter) GetTag() *Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tag
+}
+
+// SetTag sets the value of Tag.
+func (s *LifecycleFilter) SetTag(v *Tag) *LifecycleFilter {
+	s.Tag = v
+	return s
+}
+
+type LifecycleRule struct {
+	// Specifies the days since the initiation of an Incomplete Multipart Upload
+	// that Lifecycle will wait before permanently removing all parts of the upload.
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `type:"structure"`
+
+	Expiration *LifecycleExpiration `type:"structure"`
+
+	// The Filter is used to identify objects that a Lifecycle Rule applies to.
+	// A Filter must have exactly one of Prefix, Tag, or And specified.
+	Filter *LifecycleFilter `type:"structure"`
+
+	// Unique identifier for the rule. The value cannot be longer than 255 characters.
+	ID *string `type:"string"`
+
+	// Specifies when noncurrent object versions expire. Upon expiration, Amazon
+	// S3 permanently deletes the noncurrent object versions. You set this lifecycle
+	// configuration action on a bucket that has versioning enabled (or suspended)
+	// to request that Amazon S3 delete noncurrent object versions at a specific
+	// period in the object's lifetime.
+	NoncurrentVersionExpiration *NoncurrentVersionExpiration `type:"structure"`
+
+	// Container for the transition rule that describes when noncurrent objects
+	// transition to the GLACIER storage class. If your bucket is versioning-enabled
+	// (or versioning is suspended), you can set this action to request that Amazon
+	// S3 transition noncurrent object versions to the GLACIER storage class at
+	// a specific period in the object's lifetime.
+	NoncurrentVersionTransition *NoncurrentVersionTransition `type:"structure"`
+
+	// Prefix identifying one or more objects to which the rule applies. This is
+	// deprecated; use Filter instead.
+	Prefix *string `type:"string"`
+
+	// If 'Enabled', the rule is currently being applied. If 'Disabled', the rule
+	// is not currently being applied.
+	//
+	// The valid values are the ExpirationStatus enum constants.
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
+
+	Transition *Transition `type:"structure"`
+
+	metadataLifecycleRule `json:"-" xml:"-"`
+}
+
+type metadataLifecycleRule struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LifecycleRule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LifecycleRule) GoString() string {
+	return s.String()
+}
+
+// GetAbortIncompleteMultipartUpload returns the value of AbortIncompleteMultipartUpload, or nil if it is not set.
+func (s *LifecycleRule) GetAbortIncompleteMultipartUpload() *AbortIncompleteMultipartUpload {
+	if s == nil {
+		return nil
+	}
+	return s.AbortIncompleteMultipartUpload
+}
+
+// SetAbortIncompleteMultipartUpload sets the value of AbortIncompleteMultipartUpload.
+func (s *LifecycleRule) SetAbortIncompleteMultipartUpload(v *AbortIncompleteMultipartUpload) *LifecycleRule {
+	s.AbortIncompleteMultipartUpload = v
+	return s
+}
+
+// GetExpiration returns the value of Expiration, or nil if it is not set.
+func (s *LifecycleRule) GetExpiration() *LifecycleExpiration {
+	if s == nil {
+		return nil
+	}
+	return s.Expiration
+}
+
+// SetExpiration sets the value of Expiration.
+func (s *LifecycleRule) SetExpiration(v *LifecycleExpiration) *LifecycleRule {
+	s.Expiration = v
+	return s
+}
+
+// GetFilter returns the value of Filter, or nil if it is not set.
+func (s *LifecycleRule) GetFilter() *LifecycleFilter {
+	if s == nil {
+		return nil
+	}
+	return s.Filter
+}
+
+// SetFilter sets the value of Filter.
+func (s *LifecycleRule) SetFilter(v *LifecycleFilter) *LifecycleRule {
+	s.Filter = v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *LifecycleRule) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *LifecycleRule) SetID(v string) *LifecycleRule {
+	s.ID = &v
+	return s
+}
+
+// GetNoncurrentVersionExpiration returns the value of NoncurrentVersionExpiration, or nil if it is not set.
+func (s *LifecycleRule) GetNoncurrentVersionExpiration() *NoncurrentVersionExpiration {
+	if s == nil {
+		return nil
+	}
+	return s.NoncurrentVersionExpiration
+}
+
+// SetNoncurrentVersionExpiration sets the value of NoncurrentVersionExpiration.
+func (s *LifecycleRule) SetNoncurrentVersionExpiration(v *NoncurrentVersionExpiration) *LifecycleRule {
+	s.NoncurrentVersionExpiration = v
+	return s
+}
+
+// GetNoncurrentVersionTransition returns the value of NoncurrentVersionTransition, or nil if it is not set.
+func (s *LifecycleRule) GetNoncurrentVersionTransition() *NoncurrentVersionTransition {
+	if s == nil {
+		return nil
+	}
+	return s.NoncurrentVersionTransition
+}
+
+// SetNoncurrentVersionTransition sets the value of NoncurrentVersionTransition.
+func (s *LifecycleRule) SetNoncurrentVersionTransition(v *NoncurrentVersionTransition) *LifecycleRule {
+	s.NoncurrentVersionTransition = v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *LifecycleRule) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *LifecycleRule) SetPrefix(v string) *LifecycleRule {
+	s.Prefix = &v
+	return s
+}
+
+// GetStatus returns the value of Status, or the zero value if it is not set.
+func (s *LifecycleRule) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *LifecycleRule) SetStatus(v string) *LifecycleRule {
+	s.Status = &v
+	return s
+}
+
+// GetTransition returns the value of Transition, or nil if it is not set.
+func (s *LifecycleRule) GetTransition() *Transition {
+	if s == nil {
+		return nil
+	}
+	return s.Transition
+}
+
+// SetTransition sets the value of Transition.
+func (s *LifecycleRule) SetTransition(v *Transition) *LifecycleRule {
+	s.Transition = v
+	return s
+}
+
+type LifecycleRuleAndOperator struct {
+	Prefix *string `type:"string"`
+
+	// All of these tags must exist in the object's tag set in order for the rule
+	// to apply.
+	Tags []*Tag `locationName:"Tag" locationNameList:"Tag" type:"list" flattened:"true"`
+
+	metadataLifecycleRuleAndOperator `json:"-" xml:"-"`
+}
+
+type metadataLifecycleRuleAndOperator struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LifecycleRuleAndOperator) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LifecycleRuleAndOperator) GoString() string {
+	return s.String()
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *LifecycleRuleAndOperator) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *LifecycleRuleAndOperator) SetPrefix(v string) *LifecycleRuleAndOperator {
+	s.Prefix = &v
+	return s
+}
+
+// GetTags returns the value of Tags, or nil if it is not set.
+func (s *LifecycleRuleAndOperator) GetTags() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *LifecycleRuleAndOperator) SetTags(v []*Tag) *LifecycleRuleAndOperator {
+	s.Tags = v
+	return s
+}
+
+type ListBucketAnalyticsConfigurationsInput struct {
+	// The name of the bucket from which an analytics configurations are retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ContinuationToken that represents a placeholder from where this request
+	// should begin.
+	ContinuationToken *string `location:"querystring" locationName:"continuation-token" type:"string"`
+
+	metadataListBucketAnalyticsConfigurationsInput `json:"-" xml:"-"`
+}
+
+type metadataListBucketAnalyticsConfigurationsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketAnalyticsConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketAnalyticsConfigurationsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListBucketAnalyticsConfigurationsInput) SetBucket(v string) *ListBucketAnalyticsConfigurationsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListBucketAnalyticsConfigurationsInput) SetContentType(v string) *ListBucketAnalyticsConfigurationsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsInput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketAnalyticsConfigurationsInput) SetContinuationToken(v string) *ListBucketAnalyticsConfigurationsInput {
+	s.ContinuationToken = &v
+	return s
+}
+
+type ListBucketAnalyticsConfigurationsOutput struct {
+	// The list of an analytics configurations for a bucket.
+	AnalyticsConfigurationList []*AnalyticsConfiguration `locationName:"AnalyticsConfiguration" type:"list" flattened:"true"`
+
+	// The ContinuationToken that represents where this request began.
+	ContinuationToken *string `type:"string"`
+
+	// Indicates whether the returned list of an analytics configurations is complete.
+	// A value of true indicates that the list is not complete and the NextContinuationToken
+	// will be provided for a subsequent request.
+	IsTruncated *bool `type:"boolean"`
+
+	// The marker used to continue this an analytics configuration listing.
+	NextContinuationToken *string `type:"string"`
+
+	metadataListBucketAnalyticsConfigurationsOutput `json:"-" xml:"-"`
+}
+
+type metadataListBucketAnalyticsConfigurationsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketAnalyticsConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketAnalyticsConfigurationsOutput) GoString() string {
+	return s.String()
+}
+
+// GetAnalyticsConfigurationList returns the value of AnalyticsConfigurationList, or nil if it is not set.
+func (s *ListBucketAnalyticsConfigurationsOutput) GetAnalyticsConfigurationList() []*AnalyticsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.AnalyticsConfigurationList
+}
+
+// SetAnalyticsConfigurationList sets the value of AnalyticsConfigurationList.
+func (s *ListBucketAnalyticsConfigurationsOutput) SetAnalyticsConfigurationList(v []*AnalyticsConfiguration) *ListBucketAnalyticsConfigurationsOutput {
+	s.AnalyticsConfigurationList = v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsOutput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketAnalyticsConfigurationsOutput) SetContinuationToken(v string) *ListBucketAnalyticsConfigurationsOutput {
+	s.ContinuationToken = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListBucketAnalyticsConfigurationsOutput) SetIsTruncated(v bool) *ListBucketAnalyticsConfigurationsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetNextContinuationToken returns the value of NextContinuationToken, or the zero value if it is not set.
+func (s *ListBucketAnalyticsConfigurationsOutput) GetNextContinuationToken() string {
+	if s == nil || s.NextContinuationToken == nil {
+		return ""
+	}
+	return *s.NextContinuationToken
+}
+
+// SetNextContinuationToken sets the value of NextContinuationToken.
+func (s *ListBucketAnalyticsConfigurationsOutput) SetNextContinuationToken(v string) *ListBucketAnalyticsConfigurationsOutput {
+	s.NextContinuationToken = &v
+	return s
+}
+
+type ListBucketInventoryConfigurationsInput struct {
+	// The name of the bucket from which an inventory configurations are retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ContinuationToken that represents a placeholder from where this request
+	// should begin.
+	ContinuationToken *string `location:"querystring" locationName:"continuation-token" type:"string"`
+
+	metadataListBucketInventoryConfigurationsInput `json:"-" xml:"-"`
+}
+
+type metadataListBucketInventoryConfigurationsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketInventoryConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketInventoryConfigurationsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListBucketInventoryConfigurationsInput) SetBucket(v string) *ListBucketInventoryConfigurationsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListBucketInventoryConfigurationsInput) SetContentType(v string) *ListBucketInventoryConfigurationsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsInput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketInventoryConfigurationsInput) SetContinuationToken(v string) *ListBucketInventoryConfigurationsInput {
+	s.ContinuationToken = &v
+	return s
+}
+
+type ListBucketInventoryConfigurationsOutput struct {
+	// The ContinuationToken that represents where this request began.
+	ContinuationToken *string `type:"string"`
+
+	// The list of an inventory configurations for a bucket.
+	InventoryConfigurationList []*InventoryConfiguration `locationName:"InventoryConfiguration" type:"list" flattened:"true"`
+
+	// Indicates whether the returned list of an inventory configurations is complete.
+	// A value of true indicates that the list is not complete and the NextContinuationToken
+	// will be provided for a subsequent request.
+	IsTruncated *bool `type:"boolean"`
+
+	// The marker used to continue this an inventory configuration listing.
+	NextContinuationToken *string `type:"string"`
+
+	metadataListBucketInventoryConfigurationsOutput `json:"-" xml:"-"`
+}
+
+type metadataListBucketInventoryConfigurationsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketInventoryConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketInventoryConfigurationsOutput) GoString() string {
+	return s.String()
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsOutput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketInventoryConfigurationsOutput) SetContinuationToken(v string) *ListBucketInventoryConfigurationsOutput {
+	s.ContinuationToken = &v
+	return s
+}
+
+// GetInventoryConfigurationList returns the value of InventoryConfigurationList, or nil if it is not set.
+func (s *ListBucketInventoryConfigurationsOutput) GetInventoryConfigurationList() []*InventoryConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.InventoryConfigurationList
+}
+
+// SetInventoryConfigurationList sets the value of InventoryConfigurationList.
+func (s *ListBucketInventoryConfigurationsOutput) SetInventoryConfigurationList(v []*InventoryConfiguration) *ListBucketInventoryConfigurationsOutput {
+	s.InventoryConfigurationList = v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListBucketInventoryConfigurationsOutput) SetIsTruncated(v bool) *ListBucketInventoryConfigurationsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetNextContinuationToken returns the value of NextContinuationToken, or the zero value if it is not set.
+func (s *ListBucketInventoryConfigurationsOutput) GetNextContinuationToken() string {
+	if s == nil || s.NextContinuationToken == nil {
+		return ""
+	}
+	return *s.NextContinuationToken
+}
+
+// SetNextContinuationToken sets the value of NextContinuationToken.
+func (s *ListBucketInventoryConfigurationsOutput) SetNextContinuationToken(v string) *ListBucketInventoryConfigurationsOutput {
+	s.NextContinuationToken = &v
+	return s
+}
+
+type ListBucketMetricsConfigurationsInput struct {
+	// The name of the bucket from which a metrics configurations are retrieved.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ContinuationToken that represents a placeholder from where this request
+	// should begin.
+	ContinuationToken *string `location:"querystring" locationName:"continuation-token" type:"string"`
+
+	metadataListBucketMetricsConfigurationsInput `json:"-" xml:"-"`
+}
+
+type metadataListBucketMetricsConfigurationsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketMetricsConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketMetricsConfigurationsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListBucketMetricsConfigurationsInput) SetBucket(v string) *ListBucketMetricsConfigurationsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListBucketMetricsConfigurationsInput) SetContentType(v string) *ListBucketMetricsConfigurationsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsInput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketMetricsConfigurationsInput) SetContinuationToken(v string) *ListBucketMetricsConfigurationsInput {
+	s.ContinuationToken = &v
+	return s
+}
+
+type ListBucketMetricsConfigurationsOutput struct {
+	// The ContinuationToken that represents where this request began.
+	ContinuationToken *string `type:"string"`
+
+	// Indicates whether the returned list of a metrics configurations is complete.
+	// A value of true indicates that the list is not complete and the NextContinuationToken
+	// will be provided for a subsequent request.
+	IsTruncated *bool `type:"boolean"`
+
+	// The list of a metrics configurations for a bucket.
+	MetricsConfigurationList []*MetricsConfiguration `locationName:"MetricsConfiguration" type:"list" flattened:"true"`
+
+	// The marker used to continue this a metrics configuration listing.
+	NextContinuationToken *string `type:"string"`
+
+	metadataListBucketMetricsConfigurationsOutput `json:"-" xml:"-"`
+}
+
+type metadataListBucketMetricsConfigurationsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketMetricsConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketMetricsConfigurationsOutput) GoString() string {
+	return s.String()
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsOutput) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListBucketMetricsConfigurationsOutput) SetContinuationToken(v string) *ListBucketMetricsConfigurationsOutput {
+	s.ContinuationToken = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListBucketMetricsConfigurationsOutput) SetIsTruncated(v bool) *ListBucketMetricsConfigurationsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetMetricsConfigurationList returns the value of MetricsConfigurationList, or nil if it is not set.
+func (s *ListBucketMetricsConfigurationsOutput) GetMetricsConfigurationList() []*MetricsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.MetricsConfigurationList
+}
+
+// SetMetricsConfigurationList sets the value of MetricsConfigurationList.
+func (s *ListBucketMetricsConfigurationsOutput) SetMetricsConfigurationList(v []*MetricsConfiguration) *ListBucketMetricsConfigurationsOutput {
+	s.MetricsConfigurationList = v
+	return s
+}
+
+// GetNextContinuationToken returns the value of NextContinuationToken, or the zero value if it is not set.
+func (s *ListBucketMetricsConfigurationsOutput) GetNextContinuationToken() string {
+	if s == nil || s.NextContinuationToken == nil {
+		return ""
+	}
+	return *s.NextContinuationToken
+}
+
+// SetNextContinuationToken sets the value of NextContinuationToken.
+func (s *ListBucketMetricsConfigurationsOutput) SetNextContinuationToken(v string) *ListBucketMetricsConfigurationsOutput {
+	s.NextContinuationToken = &v
+	return s
+}
+
+type ListBucketsInput struct {
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataListBucketsInput `json:"-" xml:"-"`
+}
+
+type metadataListBucketsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketsInput) GoString() string {
+	return s.String()
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListBucketsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListBucketsInput) SetContentType(v string) *ListBucketsInput {
+	s.ContentType = &v
+	return s
+}
+
+type ListBucketsOutput struct {
+	Buckets []*Bucket `locationNameList:"Bucket" type:"list"`
+
+	Owner *Owner `type:"structure"`
+
+	metadataListBucketsOutput `json:"-" xml:"-"`
+}
+
+type metadataListBucketsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListBucketsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListBucketsOutput) GoString() string {
+	return s.String()
+}
+
+// GetBuckets returns the value of Buckets, or nil if it is not set.
+func (s *ListBucketsOutput) GetBuckets() []*Bucket {
+	if s == nil {
+		return nil
+	}
+	return s.Buckets
+}
+
+// SetBuckets sets the value of Buckets.
+func (s *ListBucketsOutput) SetBuckets(v []*Bucket) *ListBucketsOutput {
+	s.Buckets = v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *ListBucketsOutput) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *ListBucketsOutput) SetOwner(v *Owner) *ListBucketsOutput {
+	s.Owner = v
+	return s
+}
+
+type ListMultipartUploadsInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Character you use to group keys.
+	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+
+	// Requests Amazon S3 to encode the object keys in the response and specifies
+	// the encoding method to use. An object key may contain any Unicode character;
+	// however, XML 1.0 parser cannot parse some characters, such as characters
+	// with an ASCII value from 0 to 10. For characters that are not supported in
+	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
+	// keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
+
+	// Together with upload-id-marker, this parameter specifies the multipart upload
+	// after which listing should begin.
+	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
+
+	// Sets the maximum number of multipart uploads, from 1 to 1,000, to return
+	// in the response body. 1,000 is the maximum number of uploads that can be
+	// returned in a response.
+	MaxUploads *int64 `location:"querystring" locationName:"max-uploads" type:"integer"`
+
+	// Lists in-progress uploads only for those keys that begin with the specified
+	// prefix.
+	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+
+	// Together with key-marker, specifies the multipart upload after which listing
+	// should begin. If key-marker is not specified, the upload-id-marker parameter
+	// is ignored.
+	UploadIDMarker *string `location:"querystring" locationName:"upload-id-marker" type:"string"`
+
+	metadataListMultipartUploadsInput `json:"-" xml:"-"`
+}
+
+type metadataListMultipartUploadsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListMultipartUploadsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListMultipartUploadsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListMultipartUploadsInput) SetBucket(v string) *ListMultipartUploadsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListMultipartUploadsInput) SetContentType(v string) *ListMultipartUploadsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListMultipartUploadsInput) SetDelimiter(v string) *ListMultipartUploadsInput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListMultipartUploadsInput) SetEncodingType(v string) *ListMultipartUploadsInput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetKeyMarker returns the value of KeyMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetKeyMarker() string {
+	if s == nil || s.KeyMarker == nil {
+		return ""
+	}
+	return *s.KeyMarker
+}
+
+// SetKeyMarker sets the value of KeyMarker.
+func (s *ListMultipartUploadsInput) SetKeyMarker(v string) *ListMultipartUploadsInput {
+	s.KeyMarker = &v
+	return s
+}
+
+// GetMaxUploads returns the value of MaxUploads, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetMaxUploads() int64 {
+	if s == nil || s.MaxUploads == nil {
+		return 0
+	}
+	return *s.MaxUploads
+}
+
+// SetMaxUploads sets the value of MaxUploads.
+func (s *ListMultipartUploadsInput) SetMaxUploads(v int64) *ListMultipartUploadsInput {
+	s.MaxUploads = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListMultipartUploadsInput) SetPrefix(v string) *ListMultipartUploadsInput {
+	s.Prefix = &v
+	return s
+}
+
+// GetUploadIDMarker returns the value of UploadIDMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsInput) GetUploadIDMarker() string {
+	if s == nil || s.UploadIDMarker == nil {
+		return ""
+	}
+	return *s.UploadIDMarker
+}
+
+// SetUploadIDMarker sets the value of UploadIDMarker.
+func (s *ListMultipartUploadsInput) SetUploadIDMarker(v string) *ListMultipartUploadsInput {
+	s.UploadIDMarker = &v
+	return s
+}
+
+type ListMultipartUploadsOutput struct {
+	// Name of the bucket to which the multipart upload was initiated.
+	Bucket *string `type:"string"`
+
+	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+
+	Delimiter *string `type:"string"`
+
+	// Encoding type used by Amazon S3 to encode object keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `type:"string" enum:"url"`
+
+	// Indicates whether the returned list of multipart uploads is truncated. A
+	// value of true indicates that the list was truncated. The list can be truncated
+	// if the number of multipart uploads exceeds the limit allowed or specified
+	// by max uploads.
+	IsTruncated *bool `type:"boolean"`
+
+	// The key at or after which the listing began.
+	KeyMarker *string `type:"string"`
+
+	// Maximum number of multipart uploads that could have been included in the
+	// response.
+	MaxUploads *int64 `type:"integer"`
+
+	// When a list is truncated, this element specifies the value that should be
+	// used for the key-marker request parameter in a subsequent request.
+	NextKeyMarker *string `type:"string"`
+
+	// When a list is truncated, this element specifies the value that should be
+	// used for the upload-id-marker request parameter in a subsequent request.
+	NextUploadIDMarker *string `locationName:"NextUploadIdMarker" type:"string"`
+
+	// When a prefix is provided in the request, this field contains the specified
+	// prefix. The result contains only keys starting with the specified prefix.
+	Prefix *string `type:"string"`
+
+	// Upload ID after which listing began.
+	UploadIDMarker *string `locationName:"UploadIdMarker" type:"string"`
+
+	Uploads []*MultipartUpload `locationName:"Upload" type:"list" flattened:"true"`
+
+	metadataListMultipartUploadsOutput `json:"-" xml:"-"`
+}
+
+type metadataListMultipartUploadsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListMultipartUploadsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListMultipartUploadsOutput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListMultipartUploadsOutput) SetBucket(v string) *ListMultipartUploadsOutput {
+	s.Bucket = &v
+	return s
+}
+
+// GetCommonPrefixes returns the value of CommonPrefixes, or nil if it is not set.
+func (s *ListMultipartUploadsOutput) GetCommonPrefixes() []*CommonPrefix {
+	if s == nil {
+		return nil
+	}
+	return s.CommonPrefixes
+}
+
+// SetCommonPrefixes sets the value of CommonPrefixes.
+func (s *ListMultipartUploadsOutput) SetCommonPrefixes(v []*CommonPrefix) *ListMultipartUploadsOutput {
+	s.CommonPrefixes = v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListMultipartUploadsOutput) SetDelimiter(v string) *ListMultipartUploadsOutput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListMultipartUploadsOutput) SetEncodingType(v string) *ListMultipartUploadsOutput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListMultipartUploadsOutput) SetIsTruncated(v bool) *ListMultipartUploadsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetKeyMarker returns the value of KeyMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetKeyMarker() string {
+	if s == nil || s.KeyMarker == nil {
+		return ""
+	}
+	return *s.KeyMarker
+}
+
+// SetKeyMarker sets the value of KeyMarker.
+func (s *ListMultipartUploadsOutput) SetKeyMarker(v string) *ListMultipartUploadsOutput {
+	s.KeyMarker = &v
+	return s
+}
+
+// GetMaxUploads returns the value of MaxUploads, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetMaxUploads() int64 {
+	if s == nil || s.MaxUploads == nil {
+		return 0
+	}
+	return *s.MaxUploads
+}
+
+// SetMaxUploads sets the value of MaxUploads.
+func (s *ListMultipartUploadsOutput) SetMaxUploads(v int64) *ListMultipartUploadsOutput {
+	s.MaxUploads = &v
+	return s
+}
+
+// GetNextKeyMarker returns the value of NextKeyMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetNextKeyMarker() string {
+	if s == nil || s.NextKeyMarker == nil {
+		return ""
+	}
+	return *s.NextKeyMarker
+}
+
+// SetNextKeyMarker sets the value of NextKeyMarker.
+func (s *ListMultipartUploadsOutput) SetNextKeyMarker(v string) *ListMultipartUploadsOutput {
+	s.NextKeyMarker = &v
+	return s
+}
+
+// GetNextUploadIDMarker returns the value of NextUploadIDMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetNextUploadIDMarker() string {
+	if s == nil || s.NextUploadIDMarker == nil {
+		return ""
+	}
+	return *s.NextUploadIDMarker
+}
+
+// SetNextUploadIDMarker sets the value of NextUploadIDMarker.
+func (s *ListMultipartUploadsOutput) SetNextUploadIDMarker(v string) *ListMultipartUploadsOutput {
+	s.NextUploadIDMarker = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListMultipartUploadsOutput) SetPrefix(v string) *ListMultipartUploadsOutput {
+	s.Prefix = &v
+	return s
+}
+
+// GetUploadIDMarker returns the value of UploadIDMarker, or the zero value if it is not set.
+func (s *ListMultipartUploadsOutput) GetUploadIDMarker() string {
+	if s == nil || s.UploadIDMarker == nil {
+		return ""
+	}
+	return *s.UploadIDMarker
+}
+
+// SetUploadIDMarker sets the value of UploadIDMarker.
+func (s *ListMultipartUploadsOutput) SetUploadIDMarker(v string) *ListMultipartUploadsOutput {
+	s.UploadIDMarker = &v
+	return s
+}
+
+// GetUploads returns the value of Uploads, or nil if it is not set.
+func (s *ListMultipartUploadsOutput) GetUploads() []*MultipartUpload {
+	if s == nil {
+		return nil
+	}
+	return s.Uploads
+}
+
+// SetUploads sets the value of Uploads.
+func (s *ListMultipartUploadsOutput) SetUploads(v []*MultipartUpload) *ListMultipartUploadsOutput {
+	s.Uploads = v
+	return s
+}
+
+type ListObjectVersionsInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// A delimiter is a character you use to group keys.
+	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+
+	// Requests Amazon S3 to encode the object keys in the response and specifies
+	// the encoding method to use. An object key may contain any Unicode character;
+	// however, XML 1.0 parser cannot parse some characters, such as characters
+	// with an ASCII value from 0 to 10. For characters that are not supported in
+	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
+	// keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
+
+	// Specifies the key to start with when listing objects in a bucket.
+	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
+
+	// Sets the maximum number of keys returned in the response. The response might
+	// contain fewer keys but will never contain more.
+	MaxKeys *int64 `location:"querystring" locationName:"max-keys" type:"integer"`
+
+	// Limits the response to keys that begin with the specified prefix.
+	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+
+	// Specifies the object version you want to start listing from.
+	VersionIDMarker *string `location:"querystring" locationName:"version-id-marker" type:"string"`
+
+	metadataListObjectVersionsInput `json:"-" xml:"-"`
+}
+
+type metadataListObjectVersionsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectVersionsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListObjectVersionsInput) SetBucket(v string) *ListObjectVersionsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListObjectVersionsInput) SetContentType(v string) *ListObjectVersionsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectVersionsInput) SetDelimiter(v string) *ListObjectVersionsInput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectVersionsInput) SetEncodingType(v string) *ListObjectVersionsInput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetKeyMarker returns the value of KeyMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetKeyMarker() string {
+	if s == nil || s.KeyMarker == nil {
+		return ""
+	}
+	return *s.KeyMarker
+}
+
+// SetKeyMarker sets the value of KeyMarker.
+func (s *ListObjectVersionsInput) SetKeyMarker(v string) *ListObjectVersionsInput {
+	s.KeyMarker = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectVersionsInput) SetMaxKeys(v int64) *ListObjectVersionsInput {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectVersionsInput) SetPrefix(v string) *ListObjectVersionsInput {
+	s.Prefix = &v
+	return s
+}
+
+// GetVersionIDMarker returns the value of VersionIDMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsInput) GetVersionIDMarker() string {
+	if s == nil || s.VersionIDMarker == nil {
+		return ""
+	}
+	return *s.VersionIDMarker
+}
+
+// SetVersionIDMarker sets the value of VersionIDMarker.
+func (s *ListObjectVersionsInput) SetVersionIDMarker(v string) *ListObjectVersionsInput {
+	s.VersionIDMarker = &v
+	return s
+}
+
+type ListObjectVersionsOutput struct {
+	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+
+	DeleteMarkers []*DeleteMarkerEntry `locationName:"DeleteMarker" type:"list" flattened:"true"`
+
+	Delimiter *string `type:"string"`
+
+	// Encoding type used by Amazon S3 to encode object keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `type:"string" enum:"url"`
+
+	// A flag that indicates whether or not Amazon S3 returned all of the results
+	// that satisfied the search criteria. If your results were truncated, you can
+	// make a follow-up paginated request using the NextKeyMarker and NextVersionIdMarker
+	// response parameters as a starting place in another request to return the
+	// rest of the results.
+	IsTruncated *bool `type:"boolean"`
+
+	// Marks the last Key returned in a truncated response.
+	KeyMarker *string `type:"string"`
+
+	MaxKeys *int64 `type:"integer"`
+
+	Name *string `type:"string"`
+
+	// Use this value for the key marker request parameter in a subsequent request.
+	NextKeyMarker *string `type:"string"`
+
+	// Use this value for the next version id marker parameter in a subsequent request.
+	NextVersionIDMarker *string `locationName:"NextVersionIdMarker" type:"string"`
+
+	Prefix *string `type:"string"`
+
+	VersionIDMarker *string `locationName:"VersionIdMarker" type:"string"`
+
+	Versions []*ObjectVersion `locationName:"Version" type:"list" flattened:"true"`
+
+	metadataListObjectVersionsOutput `json:"-" xml:"-"`
+}
+
+type metadataListObjectVersionsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectVersionsOutput) GoString() string {
+	return s.String()
+}
+
+// GetCommonPrefixes returns the value of CommonPrefixes, or nil if it is not set.
+func (s *ListObjectVersionsOutput) GetCommonPrefixes() []*CommonPrefix {
+	if s == nil {
+		return nil
+	}
+	return s.CommonPrefixes
+}
+
+// SetCommonPrefixes sets the value of CommonPrefixes.
+func (s *ListObjectVersionsOutput) SetCommonPrefixes(v []*CommonPrefix) *ListObjectVersionsOutput {
+	s.CommonPrefixes = v
+	return s
+}
+
+// GetDeleteMarkers returns the value of DeleteMarkers, or nil if it is not set.
+func (s *ListObjectVersionsOutput) GetDeleteMarkers() []*DeleteMarkerEntry {
+	if s == nil {
+		return nil
+	}
+	return s.DeleteMarkers
+}
+
+// SetDeleteMarkers sets the value of DeleteMarkers.
+func (s *ListObjectVersionsOutput) SetDeleteMarkers(v []*DeleteMarkerEntry) *ListObjectVersionsOutput {
+	s.DeleteMarkers = v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectVersionsOutput) SetDelimiter(v string) *ListObjectVersionsOutput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectVersionsOutput) SetEncodingType(v string) *ListObjectVersionsOutput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListObjectVersionsOutput) SetIsTruncated(v bool) *ListObjectVersionsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetKeyMarker returns the value of KeyMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetKeyMarker() string {
+	if s == nil || s.KeyMarker == nil {
+		return ""
+	}
+	return *s.KeyMarker
+}
+
+// SetKeyMarker sets the value of KeyMarker.
+func (s *ListObjectVersionsOutput) SetKeyMarker(v string) *ListObjectVersionsOutput {
+	s.KeyMarker = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectVersionsOutput) SetMaxKeys(v int64) *ListObjectVersionsOutput {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetName returns the value of Name, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// SetName sets the value of Name.
+func (s *ListObjectVersionsOutput) SetName(v string) *ListObjectVersionsOutput {
+	s.Name = &v
+	return s
+}
+
+// GetNextKeyMarker returns the value of NextKeyMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetNextKeyMarker() string {
+	if s == nil || s.NextKeyMarker == nil {
+		return ""
+	}
+	return *s.NextKeyMarker
+}
+
+// SetNextKeyMarker sets the value of NextKeyMarker.
+func (s *ListObjectVersionsOutput) SetNextKeyMarker(v string) *ListObjectVersionsOutput {
+	s.NextKeyMarker = &v
+	return s
+}
+
+// GetNextVersionIDMarker returns the value of NextVersionIDMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetNextVersionIDMarker() string {
+	if s == nil || s.NextVersionIDMarker == nil {
+		return ""
+	}
+	return *s.NextVersionIDMarker
+}
+
+// SetNextVersionIDMarker sets the value of NextVersionIDMarker.
+func (s *ListObjectVersionsOutput) SetNextVersionIDMarker(v string) *ListObjectVersionsOutput {
+	s.NextVersionIDMarker = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectVersionsOutput) SetPrefix(v string) *ListObjectVersionsOutput {
+	s.Prefix = &v
+	return s
+}
+
+// GetVersionIDMarker returns the value of VersionIDMarker, or the zero value if it is not set.
+func (s *ListObjectVersionsOutput) GetVersionIDMarker() string {
+	if s == nil || s.VersionIDMarker == nil {
+		return ""
+	}
+	return *s.VersionIDMarker
+}
+
+// SetVersionIDMarker sets the value of VersionIDMarker.
+func (s *ListObjectVersionsOutput) SetVersionIDMarker(v string) *ListObjectVersionsOutput {
+	s.VersionIDMarker = &v
+	return s
+}
+
+// GetVersions returns the value of Versions, or nil if it is not set.
+func (s *ListObjectVersionsOutput) GetVersions() []*ObjectVersion {
+	if s == nil {
+		return nil
+	}
+	return s.Versions
+}
+
+// SetVersions sets the value of Versions.
+func (s *ListObjectVersionsOutput) SetVersions(v []*ObjectVersion) *ListObjectVersionsOutput {
+	s.Versions = v
+	return s
+}
+
+type ListObjectsInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// A delimiter is a character you use to group keys.
+	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+
+	// Requests Amazon S3 to encode the object keys in the response and specifies
+	// the encoding method to use. An object key may contain any Unicode character;
+	// however, XML 1.0 parser cannot parse some characters, such as characters
+	// with an ASCII value from 0 to 10. For characters that are not supported in
+	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
+	// keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// Specifies the key to start with when listing objects in a bucket.
+	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+
+	// Sets the maximum number of keys returned in the response. The response might
+	// contain fewer keys but will never contain more.
+	MaxKeys *int64 `location:"querystring" locationName:"max-keys" type:"integer"`
+
+	// Limits the response to keys that begin with the specified prefix.
+	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	metadataListObjectsInput `json:"-" xml:"-"`
+}
+
+type metadataListObjectsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListObjectsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListObjectsInput) SetBucket(v string) *ListObjectsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListObjectsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListObjectsInput) SetContentType(v string) *ListObjectsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectsInput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectsInput) SetDelimiter(v string) *ListObjectsInput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectsInput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectsInput) SetEncodingType(v string) *ListObjectsInput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *ListObjectsInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *ListObjectsInput) SetExpectedBucketOwner(v string) *ListObjectsInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetMarker returns the value of Marker, or the zero value if it is not set.
+func (s *ListObjectsInput) GetMarker() string {
+	if s == nil || s.Marker == nil {
+		return ""
+	}
+	return *s.Marker
+}
+
+// SetMarker sets the value of Marker.
+func (s *ListObjectsInput) SetMarker(v string) *ListObjectsInput {
+	s.Marker = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectsInput) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectsInput) SetMaxKeys(v int64) *ListObjectsInput {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectsInput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectsInput) SetPrefix(v string) *ListObjectsInput {
+	s.Prefix = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *ListObjectsInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *ListObjectsInput) SetRequestPayer(v string) *ListObjectsInput {
+	s.RequestPayer = &v
+	return s
+}
+
+type ListObjectsOutput struct {
+	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+
+	Contents []*Object `type:"list" flattened:"true"`
+
+	Delimiter *string `type:"string"`
+
+	// Encoding type used by Amazon S3 to encode object keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `type:"string" enum:"url"`
+
+	// A flag that indicates whether or not Amazon S3 returned all of the results
+	// that satisfied the search criteria.
+	IsTruncated *bool `type:"boolean"`
+
+	Marker *string `type:"string"`
+
+	MaxKeys *int64 `type:"integer"`
+
+	Name *string `type:"string"`
+
+	// When response is truncated (the IsTruncated element value in the response
+	// is true), you can use the key name in this field as marker in the subsequent
+	// request to get next set of objects. Amazon S3 lists objects in alphabetical
+	// order Note: This element is returned only if you have delimiter request parameter
+	// specified. If response does not include the NextMaker and it is truncated,
+	// you can use the value of the last Key in the response as the marker in the
+	// subsequent request to get the next set of object keys.
+	NextMarker *string `type:"string"`
+
+	Prefix *string `type:"string"`
+
+	metadataListObjectsOutput `json:"-" xml:"-"`
+}
+
+type metadataListObjectsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectsOutput) GoString() string {
+	return s.String()
+}
+
+// GetCommonPrefixes returns the value of CommonPrefixes, or nil if it is not set.
+func (s *ListObjectsOutput) GetCommonPrefixes() []*CommonPrefix {
+	if s == nil {
+		return nil
+	}
+	return s.CommonPrefixes
+}
+
+// SetCommonPrefixes sets the value of CommonPrefixes.
+func (s *ListObjectsOutput) SetCommonPrefixes(v []*CommonPrefix) *ListObjectsOutput {
+	s.CommonPrefixes = v
+	return s
+}
+
+// GetContents returns the value of Contents, or nil if it is not set.
+func (s *ListObjectsOutput) GetContents() []*Object {
+	if s == nil {
+		return nil
+	}
+	return s.Contents
+}
+
+// SetContents sets the value of Contents.
+func (s *ListObjectsOutput) SetContents(v []*Object) *ListObjectsOutput {
+	s.Contents = v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectsOutput) SetDelimiter(v string) *ListObjectsOutput {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectsOutput) SetEncodingType(v string) *ListObjectsOutput {
+	s.EncodingType = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListObjectsOutput) SetIsTruncated(v bool) *ListObjectsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetMarker returns the value of Marker, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetMarker() string {
+	if s == nil || s.Marker == nil {
+		return ""
+	}
+	return *s.Marker
+}
+
+// SetMarker sets the value of Marker.
+func (s *ListObjectsOutput) SetMarker(v string) *ListObjectsOutput {
+	s.Marker = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectsOutput) SetMaxKeys(v int64) *ListObjectsOutput {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetName returns the value of Name, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// SetName sets the value of Name.
+func (s *ListObjectsOutput) SetName(v string) *ListObjectsOutput {
+	s.Name = &v
+	return s
+}
+
+// GetNextMarker returns the value of NextMarker, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetNextMarker() string {
+	if s == nil || s.NextMarker == nil {
+		return ""
+	}
+	return *s.NextMarker
+}
+
+// SetNextMarker sets the value of NextMarker.
+func (s *ListObjectsOutput) SetNextMarker(v string) *ListObjectsOutput {
+	s.NextMarker = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectsOutput) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectsOutput) SetPrefix(v string) *ListObjectsOutput {
+	s.Prefix = &v
+	return s
+}
+
+type ListObjectsV2Input struct {
+	// Name of the bucket to list.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// ContinuationToken indicates Amazon S3 that the list is being continued on
+	// this bucket with a token. ContinuationToken is obfuscated and is not a real
+	// key
+	ContinuationToken *string `location:"querystring" locationName:"continuation-token" type:"string"`
+
+	// A delimiter is a character you use to group keys.
+	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+
+	// Encoding type used by Amazon S3 to encode object keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// The owner field is not present in listV2 by default, if you want to return
+	// owner field with each key in the result then set the fetch owner field to
+	// true
+	FetchOwner *bool `location:"querystring" locationName:"fetch-owner" type:"boolean"`
+
+	// Sets the maximum number of keys returned in the response. The response might
+	// contain fewer keys but will never contain more.
+	MaxKeys *int64 `location:"querystring" locationName:"max-keys" type:"integer"`
+
+	// Limits the response to keys that begin with the specified prefix.
+	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// StartAfter is where you want Amazon S3 to start listing from. Amazon S3 starts
+	// listing after this specified key. StartAfter can be any key in the bucket
+	StartAfter *string `location:"querystring" locationName:"start-after" type:"string"`
+
+	metadataListObjectsV2Input `json:"-" xml:"-"`
+}
+
+type metadataListObjectsV2Input struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectsV2Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectsV2Input) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListObjectsV2Input) SetBucket(v string) *ListObjectsV2Input {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListObjectsV2Input) SetContentType(v string) *ListObjectsV2Input {
+	s.ContentType = &v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListObjectsV2Input) SetContinuationToken(v string) *ListObjectsV2Input {
+	s.ContinuationToken = &v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectsV2Input) SetDelimiter(v string) *ListObjectsV2Input {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectsV2Input) SetEncodingType(v string) *ListObjectsV2Input {
+	s.EncodingType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *ListObjectsV2Input) SetExpectedBucketOwner(v string) *ListObjectsV2Input {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetFetchOwner returns the value of FetchOwner, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetFetchOwner() bool {
+	if s == nil || s.FetchOwner == nil {
+		return false
+	}
+	return *s.FetchOwner
+}
+
+// SetFetchOwner sets the value of FetchOwner.
+func (s *ListObjectsV2Input) SetFetchOwner(v bool) *ListObjectsV2Input {
+	s.FetchOwner = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectsV2Input) SetMaxKeys(v int64) *ListObjectsV2Input {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectsV2Input) SetPrefix(v string) *ListObjectsV2Input {
+	s.Prefix = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *ListObjectsV2Input) SetRequestPayer(v string) *ListObjectsV2Input {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetStartAfter returns the value of StartAfter, or the zero value if it is not set.
+func (s *ListObjectsV2Input) GetStartAfter() string {
+	if s == nil || s.StartAfter == nil {
+		return ""
+	}
+	return *s.StartAfter
+}
+
+// SetStartAfter sets the value of StartAfter.
+func (s *ListObjectsV2Input) SetStartAfter(v string) *ListObjectsV2Input {
+	s.StartAfter = &v
+	return s
+}
+
+type ListObjectsV2Output struct {
+	// CommonPrefixes contains all (if there are any) keys between Prefix and the
+	// next occurrence of the string specified by delimiter
+	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+
+	// Metadata about each object returned.
+	Contents []*Object `type:"list" flattened:"true"`
+
+	// ContinuationToken indicates Amazon S3 that the list is being continued on
+	// this bucket with a token. ContinuationToken is obfuscated and is not a real
+	// key
+	ContinuationToken *string `type:"string"`
+
+	// A delimiter is a character you use to group keys.
+	Delimiter *string `type:"string"`
+
+	// Encoding type used by Amazon S3 to encode object keys in the response.
+	//
+	// The valid values are the EncodingType enum constants.
+	EncodingType *string `type:"string" enum:"url"`
+
+	// A flag that indicates whether or not Amazon S3 returned all of the results
+	// that satisfied the search criteria.
+	IsTruncated *bool `type:"boolean"`
+
+	// KeyCount is the number of keys returned with this request. KeyCount will
+	// always be less than equals to MaxKeys field. Say you ask for 50 keys, your
+	// result will include less than equals 50 keys
+	KeyCount *int64 `type:"integer"`
+
+	// Sets the maximum number of keys returned in the response. The response might
+	// contain fewer keys but will never contain more.
+	MaxKeys *int64 `type:"integer"`
+
+	// Name of the bucket to list.
+	Name *string `type:"string"`
+
+	// NextContinuationToken is sent when isTruncated is true which means there
+	// are more keys in the bucket that can be listed. The next list requests to
+	// Amazon S3 can be continued with this NextContinuationToken. NextContinuationToken
+	// is obfuscated and is not a real key
+	NextContinuationToken *string `type:"string"`
+
+	// Limits the response to keys that begin with the specified prefix.
+	Prefix *string `type:"string"`
+
+	// StartAfter is where you want Amazon S3 to start listing from. Amazon S3 starts
+	// listing after this specified key. StartAfter can be any key in the bucket
+	StartAfter *string `type:"string"`
+
+	metadataListObjectsV2Output `json:"-" xml:"-"`
+}
+
+type metadataListObjectsV2Output struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListObjectsV2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListObjectsV2Output) GoString() string {
+	return s.String()
+}
+
+// GetCommonPrefixes returns the value of CommonPrefixes, or nil if it is not set.
+func (s *ListObjectsV2Output) GetCommonPrefixes() []*CommonPrefix {
+	if s == nil {
+		return nil
+	}
+	return s.CommonPrefixes
+}
+
+// SetCommonPrefixes sets the value of CommonPrefixes.
+func (s *ListObjectsV2Output) SetCommonPrefixes(v []*CommonPrefix) *ListObjectsV2Output {
+	s.CommonPrefixes = v
+	return s
+}
+
+// GetContents returns the value of Contents, or nil if it is not set.
+func (s *ListObjectsV2Output) GetContents() []*Object {
+	if s == nil {
+		return nil
+	}
+	return s.Contents
+}
+
+// SetContents sets the value of Contents.
+func (s *ListObjectsV2Output) SetContents(v []*Object) *ListObjectsV2Output {
+	s.Contents = v
+	return s
+}
+
+// GetContinuationToken returns the value of ContinuationToken, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetContinuationToken() string {
+	if s == nil || s.ContinuationToken == nil {
+		return ""
+	}
+	return *s.ContinuationToken
+}
+
+// SetContinuationToken sets the value of ContinuationToken.
+func (s *ListObjectsV2Output) SetContinuationToken(v string) *ListObjectsV2Output {
+	s.ContinuationToken = &v
+	return s
+}
+
+// GetDelimiter returns the value of Delimiter, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetDelimiter() string {
+	if s == nil || s.Delimiter == nil {
+		return ""
+	}
+	return *s.Delimiter
+}
+
+// SetDelimiter sets the value of Delimiter.
+func (s *ListObjectsV2Output) SetDelimiter(v string) *ListObjectsV2Output {
+	s.Delimiter = &v
+	return s
+}
+
+// GetEncodingType returns the value of EncodingType, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetEncodingType() string {
+	if s == nil || s.EncodingType == nil {
+		return ""
+	}
+	return *s.EncodingType
+}
+
+// SetEncodingType sets the value of EncodingType.
+func (s *ListObjectsV2Output) SetEncodingType(v string) *ListObjectsV2Output {
+	s.EncodingType = &v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListObjectsV2Output) SetIsTruncated(v bool) *ListObjectsV2Output {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetKeyCount returns the value of KeyCount, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetKeyCount() int64 {
+	if s == nil || s.KeyCount == nil {
+		return 0
+	}
+	return *s.KeyCount
+}
+
+// SetKeyCount sets the value of KeyCount.
+func (s *ListObjectsV2Output) SetKeyCount(v int64) *ListObjectsV2Output {
+	s.KeyCount = &v
+	return s
+}
+
+// GetMaxKeys returns the value of MaxKeys, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetMaxKeys() int64 {
+	if s == nil || s.MaxKeys == nil {
+		return 0
+	}
+	return *s.MaxKeys
+}
+
+// SetMaxKeys sets the value of MaxKeys.
+func (s *ListObjectsV2Output) SetMaxKeys(v int64) *ListObjectsV2Output {
+	s.MaxKeys = &v
+	return s
+}
+
+// GetName returns the value of Name, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// SetName sets the value of Name.
+func (s *ListObjectsV2Output) SetName(v string) *ListObjectsV2Output {
+	s.Name = &v
+	return s
+}
+
+// GetNextContinuationToken returns the value of NextContinuationToken, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetNextContinuationToken() string {
+	if s == nil || s.NextContinuationToken == nil {
+		return ""
+	}
+	return *s.NextContinuationToken
+}
+
+// SetNextContinuationToken sets the value of NextContinuationToken.
+func (s *ListObjectsV2Output) SetNextContinuationToken(v string) *ListObjectsV2Output {
+	s.NextContinuationToken = &v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *ListObjectsV2Output) SetPrefix(v string) *ListObjectsV2Output {
+	s.Prefix = &v
+	return s
+}
+
+// GetStartAfter returns the value of StartAfter, or the zero value if it is not set.
+func (s *ListObjectsV2Output) GetStartAfter() string {
+	if s == nil || s.StartAfter == nil {
+		return ""
+	}
+	return *s.StartAfter
+}
+
+// SetStartAfter sets the value of StartAfter.
+func (s *ListObjectsV2Output) SetStartAfter(v string) *ListObjectsV2Output {
+	s.StartAfter = &v
+	return s
+}
+
+type ListPartsInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Sets the maximum number of parts to return.
+	MaxParts *int64 `location:"querystring" locationName:"max-parts" type:"integer"`
+
+	// Specifies the part after which listing should begin. Only parts with higher
+	// part numbers will be listed.
+	PartNumberMarker *int64 `location:"querystring" locationName:"part-number-marker" type:"integer"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// Upload ID identifying the multipart upload whose parts are being listed.
+	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+
+	metadataListPartsInput `json:"-" xml:"-"`
+}
+
+type metadataListPartsInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListPartsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListPartsInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListPartsInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListPartsInput) SetBucket(v string) *ListPartsInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *ListPartsInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *ListPartsInput) SetContentType(v string) *ListPartsInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *ListPartsInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *ListPartsInput) SetKey(v string) *ListPartsInput {
+	s.Key = &v
+	return s
+}
+
+// GetMaxParts returns the value of MaxParts, or the zero value if it is not set.
+func (s *ListPartsInput) GetMaxParts() int64 {
+	if s == nil || s.MaxParts == nil {
+		return 0
+	}
+	return *s.MaxParts
+}
+
+// SetMaxParts sets the value of MaxParts.
+func (s *ListPartsInput) SetMaxParts(v int64) *ListPartsInput {
+	s.MaxParts = &v
+	return s
+}
+
+// GetPartNumberMarker returns the value of PartNumberMarker, or the zero value if it is not set.
+func (s *ListPartsInput) GetPartNumberMarker() int64 {
+	if s == nil || s.PartNumberMarker == nil {
+		return 0
+	}
+	return *s.PartNumberMarker
+}
+
+// SetPartNumberMarker sets the value of PartNumberMarker.
+func (s *ListPartsInput) SetPartNumberMarker(v int64) *ListPartsInput {
+	s.PartNumberMarker = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *ListPartsInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *ListPartsInput) SetRequestPayer(v string) *ListPartsInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *ListPartsInput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
+}
+
+// SetUploadID sets the value of UploadID.
+func (s *ListPartsInput) SetUploadID(v string) *ListPartsInput {
+	s.UploadID = &v
+	return s
+}
+
+type ListPartsOutput struct {
+	// Name of the bucket to which the multipart upload was initiated.
+	Bucket *string `type:"string"`
+
+	// Identifies who initiated the multipart upload.
+	Initiator *Initiator `type:"structure"`
+
+	// Indicates whether the returned list of parts is truncated.
+	IsTruncated *bool `type:"boolean"`
+
+	// Object key for which the multipart upload was initiated.
+	Key *string `type:"string"`
+
+	// Maximum number of parts that were allowed in the response.
+	MaxParts *int64 `type:"integer"`
+
+	// When a list is truncated, this element specifies the last part in the list,
+	// as well as the value to use for the part-number-marker request parameter
+	// in a subsequent request.
+	NextPartNumberMarker *int64 `type:"integer"`
+
+	Owner *Owner `type:"structure"`
+
+	// Part number after which listing begins.
+	PartNumberMarker *int64 `type:"integer"`
+
+	Parts []*Part `locationName:"Part" type:"list" flattened:"true"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// The class of storage used to store the object.
+	//
+	// The valid values are the StorageClass enum constants.
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,GLACIER,DEEP_ARCHIVE"`
+
+	// Upload ID identifying the multipart upload whose parts are being listed.
+	UploadID *string `locationName:"UploadId" type:"string"`
+
+	metadataListPartsOutput `json:"-" xml:"-"`
+}
+
+type metadataListPartsOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ListPartsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ListPartsOutput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *ListPartsOutput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *ListPartsOutput) SetBucket(v string) *ListPartsOutput {
+	s.Bucket = &v
+	return s
+}
+
+// GetInitiator returns the value of Initiator, or nil if it is not set.
+func (s *ListPartsOutput) GetInitiator() *Initiator {
+	if s == nil {
+		return nil
+	}
+	return s.Initiator
+}
+
+// SetInitiator sets the value of Initiator.
+func (s *ListPartsOutput) SetInitiator(v *Initiator) *ListPartsOutput {
+	s.Initiator = v
+	return s
+}
+
+// GetIsTruncated returns the value of IsTruncated, or the zero value if it is not set.
+func (s *ListPartsOutput) GetIsTruncated() bool {
+	if s == nil || s.IsTruncated == nil {
+		return false
+	}
+	return *s.IsTruncated
+}
+
+// SetIsTruncated sets the value of IsTruncated.
+func (s *ListPartsOutput) SetIsTruncated(v bool) *ListPartsOutput {
+	s.IsTruncated = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *ListPartsOutput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *ListPartsOutput) SetKey(v string) *ListPartsOutput {
+	s.Key = &v
+	return s
+}
+
+// GetMaxParts returns the value of MaxParts, or the zero value if it is not set.
+func (s *ListPartsOutput) GetMaxParts() int64 {
+	if s == nil || s.MaxParts == nil {
+		return 0
+	}
+	return *s.MaxParts
+}
+
+// SetMaxParts sets the value of MaxParts.
+func (s *ListPartsOutput) SetMaxParts(v int64) *ListPartsOutput {
+	s.MaxParts = &v
+	return s
+}
+
+// GetNextPartNumberMarker returns the value of NextPartNumberMarker, or the zero value if it is not set.
+func (s *ListPartsOutput) GetNextPartNumberMarker() int64 {
+	if s == nil || s.NextPartNumberMarker == nil {
+		return 0
+	}
+	return *s.NextPartNumberMarker
+}
+
+// SetNextPartNumberMarker sets the value of NextPartNumberMarker.
+func (s *ListPartsOutput) SetNextPartNumberMarker(v int64) *ListPartsOutput {
+	s.NextPartNumberMarker = &v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *ListPartsOutput) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *ListPartsOutput) SetOwner(v *Owner) *ListPartsOutput {
+	s.Owner = v
+	return s
+}
+
+// GetPartNumberMarker returns the value of PartNumberMarker, or the zero value if it is not set.
+func (s *ListPartsOutput) GetPartNumberMarker() int64 {
+	if s == nil || s.PartNumberMarker == nil {
+		return 0
+	}
+	return *s.PartNumberMarker
+}
+
+// SetPartNumberMarker sets the value of PartNumberMarker.
+func (s *ListPartsOutput) SetPartNumberMarker(v int64) *ListPartsOutput {
+	s.PartNumberMarker = &v
+	return s
+}
+
+// GetParts returns the value of Parts, or nil if it is not set.
+func (s *ListPartsOutput) GetParts() []*Part {
+	if s == nil {
+		return nil
+	}
+	return s.Parts
+}
+
+// SetParts sets the value of Parts.
+func (s *ListPartsOutput) SetParts(v []*Part) *ListPartsOutput {
+	s.Parts = v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *ListPartsOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *ListPartsOutput) SetRequestCharged(v string) *ListPartsOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *ListPartsOutput) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *ListPartsOutput) SetStorageClass(v string) *ListPartsOutput {
+	s.StorageClass = &v
+	return s
+}
+
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *ListPartsOutput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
+}
+
+// SetUploadID sets the value of UploadID.
+func (s *ListPartsOutput) SetUploadID(v string) *ListPartsOutput {
+	s.UploadID = &v
+	return s
+}
+
+type LoggingEnabled struct {
+	// Specifies the bucket where you want Amazon S3 to store server access logs.
+	// You can have your logs delivered to any bucket that you own, including the
+	// same bucket that is being logged. You can also configure multiple buckets
+	// to deliver their logs to the same target bucket. In this case you should
+	// choose a different TargetPrefix for each source bucket so that the delivered
+	// log files can be distinguished by key.
+	TargetBucket *string `type:"string"`
+
+	TargetGrants []*TargetGrant `locationNameList:"Grant" type:"list"`
+
+	// This element lets you specify a prefix for the keys that the log files will
+	// be stored under.
+	TargetPrefix *string `type:"string"`
+
+	metadataLoggingEnabled `json:"-" xml:"-"`
+}
+
+type metadataLoggingEnabled struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s LoggingEnabled) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s LoggingEnabled) GoString() string {
+	return s.String()
+}
+
+// GetTargetBucket returns the value of TargetBucket, or the zero value if it is not set.
+func (s *LoggingEnabled) GetTargetBucket() string {
+	if s == nil || s.TargetBucket == nil {
+		return ""
+	}
+	return *s.TargetBucket
+}
+
+// SetTargetBucket sets the value of TargetBucket.
+func (s *LoggingEnabled) SetTargetBucket(v string) *LoggingEnabled {
+	s.TargetBucket = &v
+	return s
+}
+
+// GetTargetGrants returns the value of TargetGrants, or nil if it is not set.
+func (s *LoggingEnabled) GetTargetGrants() []*TargetGrant {
+	if s == nil {
+		return nil
+	}
+	return s.TargetGrants
+}
+
+// SetTargetGrants sets the value of TargetGrants.
+func (s *LoggingEnabled) SetTargetGrants(v []*TargetGrant) *LoggingEnabled {
+	s.TargetGrants = v
+	return s
+}
+
+// GetTargetPrefix returns the value of TargetPrefix, or the zero value if it is not set.
+func (s *LoggingEnabled) GetTargetPrefix() string {
+	if s == nil || s.TargetPrefix == nil {
+		return ""
+	}
+	return *s.TargetPrefix
+}
+
+// SetTargetPrefix sets the value of TargetPrefix.
+func (s *LoggingEnabled) SetTargetPrefix(v string) *LoggingEnabled {
+	s.TargetPrefix = &v
+	return s
+}
+
+type MetricsAndOperator struct {
+	// The prefix used when evaluating an AND predicate.
+	Prefix *string `type:"string"`
+
+	// The list of tags used when evaluating an AND predicate.
+	Tags []*Tag `locationName:"Tag" locationNameList:"Tag" type:"list" flattened:"true"`
+
+	metadataMetricsAndOperator `json:"-" xml:"-"`
+}
+
+type metadataMetricsAndOperator struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s MetricsAndOperator) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s MetricsAndOperator) GoString() string {
+	return s.String()
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *MetricsAndOperator) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *MetricsAndOperator) SetPrefix(v string) *MetricsAndOperator {
+	s.Prefix = &v
+	return s
+}
+
+// GetTags returns the value of Tags, or nil if it is not set.
+func (s *MetricsAndOperator) GetTags() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *MetricsAndOperator) SetTags(v []*Tag) *MetricsAndOperator {
+	s.Tags = v
+	return s
+}
+
+type MetricsConfiguration struct {
+	// Specifies a metrics configuration filter. The metrics configuration will
+	// only include objects that meet the filter's criteria. A filter must be a
+	// prefix, a tag, or a conjunction (MetricsAndOperator).
+	Filter *MetricsFilter `type:"structure"`
+
+	// The ID used to identify the metrics configuration.
+	ID *string `locationName:"Id" type:"string" required:"true"`
+
+	metadataMetricsConfiguration `json:"-" xml:"-"`
+}
+
+type metadataMetricsConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s MetricsConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s MetricsConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetFilter returns the value of Filter, or nil if it is not set.
+func (s *MetricsConfiguration) GetFilter() *MetricsFilter {
+	if s == nil {
+		return nil
+	}
+	return s.Filter
+}
+
+// SetFilter sets the value of Filter.
+func (s *MetricsConfiguration) SetFilter(v *MetricsFilter) *MetricsConfiguration {
+	s.Filter = v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *MetricsConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *MetricsConfiguration) SetID(v string) *MetricsConfiguration {
+	s.ID = &v
+	return s
+}
+
+type MetricsFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating a
+	// metrics filter. The operator must have at least two predicates, and an object
+	// must match all of the predicates in order for the filter to apply.
+	And *MetricsAndOperator `type:"structure"`
+
+	// The prefix used when evaluating a metrics filter.
+	Prefix *string `type:"string"`
+
+	// The tag used when evaluating a metrics filter.
+	Tag *Tag `type:"structure"`
+
+	metadataMetricsFilter `json:"-" xml:"-"`
+}
+
+type metadataMetricsFilter struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s MetricsFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s MetricsFilter) GoString() string {
+	return s.String()
+}
+
+// GetAnd returns the value of And, or nil if it is not set.
+func (s *MetricsFilter) GetAnd() *MetricsAndOperator {
+	if s == nil {
+		return nil
+	}
+	return s.And
+}
+
+// SetAnd sets the value of And.
+func (s *MetricsFilter) SetAnd(v *MetricsAndOperator) *MetricsFilter {
+	s.And = v
+	return s
+}
+
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *MetricsFilter) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *MetricsFilter) SetPrefix(v string) *MetricsFilter {
+	s.Prefix = &v
+	return s
+}
+
+// GetTag returns the value of Tag, or nil if it is not set.
+func (s *MetricsFilter) GetTag() *Tag {
+	if s == nil {
+		return nil
+	}
+	return s.Tag
+}
+
+// SetTag sets the value of Tag.
+func (s *MetricsFilter) SetTag(v *Tag) *MetricsFilter {
+	s.Tag = v
+	return s
+}
+
+type MultipartUpload struct {
+	// Date and time at which the multipart upload was initiated.
+	Initiated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	// Identifies who initiated the multipart upload.
+	Initiator *Initiator `type:"structure"`
+
+	// Key of the object for which the multipart upload was initiated.
+	Key *string `type:"string"`
+
+	Owner *Owner `type:"structure"`
+
+	// The class of storage used to store the object.
+	//
+	// The valid values are the StorageClass enum constants.
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,GLACIER,DEEP_ARCHIVE"`
+
+	// Upload ID that identifies the multipart upload.
+	UploadID *string `locationName:"UploadId" type:"string"`
+
+	metadataMultipartUpload `json:"-" xml:"-"`
+}
+
+type metadataMultipartUpload struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s MultipartUpload) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s MultipartUpload) GoString() string {
+	return s.String()
+}
+
+// GetInitiated returns the value of Initiated, or the zero value if it is not set.
+func (s *MultipartUpload) GetInitiated() time.Time {
+	if s == nil || s.Initiated == nil {
+		return time.Time{}
+	}
+	return *s.Initiated
+}
+
+// SetInitiated sets the value of Initiated.
+func (s *MultipartUpload) SetInitiated(v time.Time) *MultipartUpload {
+	s.Initiated = &v
+	return s
+}
+
+// GetInitiator returns the value of Initiator, or nil if it is not set.
+func (s *MultipartUpload) GetInitiator() *Initiator {
+	if s == nil {
+		return nil
+	}
+	return s.Initiator
+}
+
+// SetInitiator sets the value of Initiator.
+func (s *MultipartUpload) SetInitiator(v *Initiator) *MultipartUpload {
+	s.Initiator = v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *MultipartUpload) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *MultipartUpload) SetKey(v string) *MultipartUpload {
+	s.Key = &v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *MultipartUpload) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *MultipartUpload) SetOwner(v *Owner) *MultipartUpload {
+	s.Owner = v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *MultipartUpload) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *MultipartUpload) SetStorageClass(v string) *MultipartUpload {
+	s.StorageClass = &v
+	return s
+}
+
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *MultipartUpload) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
+}
+
+// SetUploadID sets the value of UploadID.
+func (s *MultipartUpload) SetUploadID(v string) *MultipartUpload {
+	s.UploadID = &v
+	return s
+}
+
+// Specifies when noncurrent object versions expire. Upon expiration, Amazon
+// S3 permanently deletes the noncurrent object versions. You set this lifecycle
+// configuration action on a bucket that has versioning enabled (or suspended)
+// to request that Amazon S3 delete noncurrent object versions at a specific
+// period in the object's lifetime.
+type NoncurrentVersionExpiration struct {
+	// Specifies the number of days an object is noncurrent before Amazon S3 can
+	// perform the associated action. For information about the noncurrent days
+	// calculations, see How Amazon S3 Calculates When an Object Became Noncurrent
+	// (/AmazonS3/latest/dev/s3-access-control.html) in the Amazon Simple Storage
+	// Service Developer Guide.
+	NoncurrentDays *int64 `type:"integer"`
+
+	metadataNoncurrentVersionExpiration `json:"-" xml:"-"`
+}
+
+type metadataNoncurrentVersionExpiration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s NoncurrentVersionExpiration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s NoncurrentVersionExpiration) GoString() string {
+	return s.String()
+}
+
+// GetNoncurrentDays returns the value of NoncurrentDays, or the zero value if it is not set.
+func (s *NoncurrentVersionExpiration) GetNoncurrentDays() int64 {
+	if s == nil || s.NoncurrentDays == nil {
+		return 0
+	}
+	return *s.NoncurrentDays
+}
+
+// SetNoncurrentDays sets the value of NoncurrentDays.
+func (s *NoncurrentVersionExpiration) SetNoncurrentDays(v int64) *NoncurrentVersionExpiration {
+	s.NoncurrentDays = &v
+	return s
+}
+
+// Container for the transition rule that describes when noncurrent objects
+// transition to the GLACIER storage class. If your bucket is versioning-enabled
+// (or versioning is suspended), you can set this action to request that Amazon
+// S3 transition noncurrent object versions to the GLACIER storage class at
+// a specific period in the object's lifetime.
+type NoncurrentVersionTransition struct {
+	// Specifies the number of days an object is noncurrent before Amazon S3 can
+	// perform the associated action. For information about the noncurrent days
+	// calculations, see How Amazon S3 Calculates When an Object Became Noncurrent
+	// (/AmazonS3/latest/dev/s3-access-control.html) in the Amazon Simple Storage
+	// Service Developer Guide.
+	NoncurrentDays *int64 `type:"integer"`
+
+	// The class of storage used to store the object.
+	//
+	// The valid values are the TransitionStorageClass enum constants.
+	StorageClass *string `type:"string" enum:"GLACIER,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,DEEP_ARCHIVE"`
+
+	metadataNoncurrentVersionTransition `json:"-" xml:"-"`
+}
+
+type metadataNoncurrentVersionTransition struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s NoncurrentVersionTransition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s NoncurrentVersionTransition) GoString() string {
+	return s.String()
+}
+
+// GetNoncurrentDays returns the value of NoncurrentDays, or the zero value if it is not set.
+func (s *NoncurrentVersionTransition) GetNoncurrentDays() int64 {
+	if s == nil || s.NoncurrentDays == nil {
+		return 0
+	}
+	return *s.NoncurrentDays
+}
+
+// SetNoncurrentDays sets the value of NoncurrentDays.
+func (s *NoncurrentVersionTransition) SetNoncurrentDays(v int64) *NoncurrentVersionTransition {
+	s.NoncurrentDays = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *NoncurrentVersionTransition) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *NoncurrentVersionTransition) SetStorageClass(v string) *NoncurrentVersionTransition {
+	s.StorageClass = &v
+	return s
+}
+
+// Container for specifying the notification configuration of the bucket. If
+// this element is empty, notifications are turned off on the bucket.
+type NotificationConfiguration struct {
+	LambdaFunctionConfigurations []*LambdaFunctionConfiguration `locationName:"CloudFunctionConfiguration" type:"list" flattened:"true"`
+
+	QueueConfigurations []*QueueConfiguration `locationName:"QueueConfiguration" type:"list" flattened:"true"`
+
+	TopicConfigurations []*TopicConfiguration `locationName:"TopicConfiguration" type:"list" flattened:"true"`
+
+	metadataNotificationConfiguration `json:"-" xml:"-"`
+}
+
+type metadataNotificationConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s NotificationConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetLambdaFunctionConfigurations returns the value of LambdaFunctionConfigurations, or nil if it is not set.
+func (s *NotificationConfiguration) GetLambdaFunctionConfigurations() []*LambdaFunctionConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.LambdaFunctionConfigurations
+}
+
+// SetLambdaFunctionConfigurations sets the value of LambdaFunctionConfigurations.
+func (s *NotificationConfiguration) SetLambdaFunctionConfigurations(v []*LambdaFunctionConfiguration) *NotificationConfiguration {
+	s.LambdaFunctionConfigurations = v
+	return s
+}
+
+// GetQueueConfigurations returns the value of QueueConfigurations, or nil if it is not set.
+func (s *NotificationConfiguration) GetQueueConfigurations() []*QueueConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.QueueConfigurations
+}
+
+// SetQueueConfigurations sets the value of QueueConfigurations.
+func (s *NotificationConfiguration) SetQueueConfigurations(v []*QueueConfiguration) *NotificationConfiguration {
+	s.QueueConfigurations = v
+	return s
+}
+
+// GetTopicConfigurations returns the value of TopicConfigurations, or nil if it is not set.
+func (s *NotificationConfiguration) GetTopicConfigurations() []*TopicConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.TopicConfigurations
+}
+
+// SetTopicConfigurations sets the value of TopicConfigurations.
+func (s *NotificationConfiguration) SetTopicConfigurations(v []*TopicConfiguration) *NotificationConfiguration {
+	s.TopicConfigurations = v
+	return s
+}
+
+type NotificationConfigurationDeprecated struct {
+	CloudFunctionConfiguration *CloudFunctionConfiguration `type:"structure"`
+
+	QueueConfiguration *QueueConfigurationDeprecated `type:"structure"`
+
+	TopicConfiguration *TopicConfigurationDeprecated `type:"structure"`
+
+	metadataNotificationConfigurationDeprecated `json:"-" xml:"-"`
+}
+
+type metadataNotificationConfigurationDeprecated struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s NotificationConfigurationDeprecated) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s NotificationConfigurationDeprecated) GoString() string {
+	return s.String()
+}
+
+// GetCloudFunctionConfiguration returns the value of CloudFunctionConfiguration, or nil if it is not set.
+func (s *NotificationConfigurationDeprecated) GetCloudFunctionConfiguration() *CloudFunctionConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.CloudFunctionConfiguration
+}
+
+// SetCloudFunctionConfiguration sets the value of CloudFunctionConfiguration.
+func (s *NotificationConfigurationDeprecated) SetCloudFunctionConfiguration(v *CloudFunctionConfiguration) *NotificationConfigurationDeprecated {
+	s.CloudFunctionConfiguration = v
+	return s
+}
+
+// GetQueueConfiguration returns the value of QueueConfiguration, or nil if it is not set.
+func (s *NotificationConfigurationDeprecated) GetQueueConfiguration() *QueueConfigurationDeprecated {
+	if s == nil {
+		return nil
+	}
+	return s.QueueConfiguration
+}
+
+// SetQueueConfiguration sets the value of QueueConfiguration.
+func (s *NotificationConfigurationDeprecated) SetQueueConfiguration(v *QueueConfigurationDeprecated) *NotificationConfigurationDeprecated {
+	s.QueueConfiguration = v
+	return s
+}
+
+// GetTopicConfiguration returns the value of TopicConfiguration, or nil if it is not set.
+func (s *NotificationConfigurationDeprecated) GetTopicConfiguration() *TopicConfigurationDeprecated {
+	if s == nil {
+		return nil
+	}
+	return s.TopicConfiguration
+}
+
+// SetTopicConfiguration sets the value of TopicConfiguration.
+func (s *NotificationConfigurationDeprecated) SetTopicConfiguration(v *TopicConfigurationDeprecated) *NotificationConfigurationDeprecated {
+	s.TopicConfiguration = v
+	return s
+}
+
+type Object struct {
+	ETag *string `type:"string"`
+
+	Key *string `type:"string"`
+
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	Owner *Owner `type:"structure"`
+
+	Size *int64 `type:"integer"`
+
+	// The class of storage used to store the object.
+	//
+	// The valid values are the ObjectStorageClass enum constants.
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,GLACIER,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,DEEP_ARCHIVE"`
+
+	metadataObject `json:"-" xml:"-"`
+}
+
+type metadataObject struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Object) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Object) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *Object) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *Object) SetETag(v string) *Object {
+	s.ETag = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *Object) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *Object) SetKey(v string) *Object {
+	s.Key = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *Object) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *Object) SetLastModified(v time.Time) *Object {
+	s.LastModified = &v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *Object) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *Object) SetOwner(v *Owner) *Object {
+	s.Owner = v
+	return s
+}
+
+// GetSize returns the value of Size, or the zero value if it is not set.
+func (s *Object) GetSize() int64 {
+	if s == nil || s.Size == nil {
+		return 0
+	}
+	return *s.Size
+}
+
+// SetSize sets the value of Size.
+func (s *Object) SetSize(v int64) *Object {
+	s.Size = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *Object) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *Object) SetStorageClass(v string) *Object {
+	s.StorageClass = &v
+	return s
+}
+
+type ObjectIdentifier struct {
+	// Key name of the object to delete.
+	Key *string `type:"string" required:"true"`
+
+	// VersionId for the specific version of the object to delete.
+	VersionID *string `locationName:"VersionId" type:"string"`
+
+	metadataObjectIdentifier `json:"-" xml:"-"`
+}
+
+type metadataObjectIdentifier struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectIdentifier) GoString() string {
+	return s.String()
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *ObjectIdentifier) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *ObjectIdentifier) SetKey(v string) *ObjectIdentifier {
+	s.Key = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *ObjectIdentifier) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *ObjectIdentifier) SetVersionID(v string) *ObjectIdentifier {
+	s.VersionID = &v
+	return s
+}
+
+type ObjectLockConfiguration struct {
+	// Indicates whether this bucket has an Object Lock configuration enabled.
+	//
+	// The valid values are the ObjectLockEnabled enum constants.
+	ObjectLockEnabled *string `type:"string" enum:"Enabled"`
+
+	// The Object Lock rule in place for the specified object.
+	Rule *ObjectLockRule `type:"structure"`
+
+	metadataObjectLockConfiguration `json:"-" xml:"-"`
+}
+
+type metadataObjectLockConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectLockConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectLockConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetObjectLockEnabled returns the value of ObjectLockEnabled, or the zero value if it is not set.
+func (s *ObjectLockConfiguration) GetObjectLockEnabled() string {
+	if s == nil || s.ObjectLockEnabled == nil {
+		return ""
+	}
+	return *s.ObjectLockEnabled
+}
+
+// SetObjectLockEnabled sets the value of ObjectLockEnabled.
+func (s *ObjectLockConfiguration) SetObjectLockEnabled(v string) *ObjectLockConfiguration {
+	s.ObjectLockEnabled = &v
+	return s
+}
+
+// GetRule returns the value of Rule, or nil if it is not set.
+func (s *ObjectLockConfiguration) GetRule() *ObjectLockRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rule
+}
+
+// SetRule sets the value of Rule.
+func (s *ObjectLockConfiguration) SetRule(v *ObjectLockRule) *ObjectLockConfiguration {
+	s.Rule = v
+	return s
+}
+
+type ObjectLockLegalHold struct {
+	// Indicates whether the specified object has a Legal Hold in place. Valid values
+	// are ON and OFF.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	Status *string `type:"string" enum:"ON,OFF"`
+
+	metadataObjectLockLegalHold `json:"-" xml:"-"`
+}
+
+type metadataObjectLockLegalHold struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectLockLegalHold) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectLockLegalHold) GoString() string {
+	return s.String()
+}
+
+// GetStatus returns the value of Status, or the zero value if it is not set.
+func (s *ObjectLockLegalHold) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *ObjectLockLegalHold) SetStatus(v string) *ObjectLockLegalHold {
+	s.Status = &v
+	return s
+}
+
+type ObjectLockRetention struct {
+	// Indicates the Retention mode for the specified object. Valid values are GOVERNANCE
+	// and COMPLIANCE.
+	//
+	// The valid values are the ObjectLockRetentionMode enum constants.
+	Mode *string `type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The date on which this Object Lock Retention will expire.
+	RetainUntilDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	metadataObjectLockRetention `json:"-" xml:"-"`
+}
+
+type metadataObjectLockRetention struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectLockRetention) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectLockRetention) GoString() string {
+	return s.String()
+}
+
+// GetMode returns the value of Mode, or the zero value if it is not set.
+func (s *ObjectLockRetention) GetMode() string {
+	if s == nil || s.Mode == nil {
+		return ""
+	}
+	return *s.Mode
+}
+
+// SetMode sets the value of Mode.
+func (s *ObjectLockRetention) SetMode(v string) *ObjectLockRetention {
+	s.Mode = &v
+	return s
+}
+
+// GetRetainUntilDate returns the value of RetainUntilDate, or the zero value if it is not set.
+func (s *ObjectLockRetention) GetRetainUntilDate() time.Time {
+	if s == nil || s.RetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.RetainUntilDate
+}
+
+// SetRetainUntilDate sets the value of RetainUntilDate.
+func (s *ObjectLockRetention) SetRetainUntilDate(v time.Time) *ObjectLockRetention {
+	s.RetainUntilDate = &v
+	return s
+}
+
+type ObjectLockRule struct {
+	// The default retention period that you want to apply to new objects placed
+	// in the specified bucket.
+	DefaultRetention *DefaultRetention `type:"structure"`
+
+	metadataObjectLockRule `json:"-" xml:"-"`
+}
+
+type metadataObjectLockRule struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectLockRule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectLockRule) GoString() string {
+	return s.String()
+}
+
+// GetDefaultRetention returns the value of DefaultRetention, or nil if it is not set.
+func (s *ObjectLockRule) GetDefaultRetention() *DefaultRetention {
+	if s == nil {
+		return nil
+	}
+	return s.DefaultRetention
+}
+
+// SetDefaultRetention sets the value of DefaultRetention.
+func (s *ObjectLockRule) SetDefaultRetention(v *DefaultRetention) *ObjectLockRule {
+	s.DefaultRetention = v
+	return s
+}
+
+type ObjectVersion struct {
+	ETag *string `type:"string"`
+
+	// Specifies whether the object is (true) or is not (false) the latest version
+	// of an object.
+	IsLatest *bool `type:"boolean"`
+
+	// The object key.
+	Key *string `type:"string"`
+
+	// Date and time the object was last modified.
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	Owner *Owner `type:"structure"`
+
+	// Size in bytes of the object.
+	Size *int64 `type:"integer"`
+
+	// The class of storage used to store the object.
+	//
+	// The valid values are the ObjectVersionStorageClass enum constants.
+	StorageClass *string `type:"string" enum:"STANDARD"`
+
+	// Version ID of an object.
+	VersionID *string `locationName:"VersionId" type:"string"`
+
+	metadataObjectVersion `json:"-" xml:"-"`
+}
+
+type metadataObjectVersion struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ObjectVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ObjectVersion) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *ObjectVersion) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *ObjectVersion) SetETag(v string) *ObjectVersion {
+	s.ETag = &v
+	return s
+}
+
+// GetIsLatest returns the value of IsLatest, or the zero value if it is not set.
+func (s *ObjectVersion) GetIsLatest() bool {
+	if s == nil || s.IsLatest == nil {
+		return false
+	}
+	return *s.IsLatest
+}
+
+// SetIsLatest sets the value of IsLatest.
+func (s *ObjectVersion) SetIsLatest(v bool) *ObjectVersion {
+	s.IsLatest = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *ObjectVersion) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *ObjectVersion) SetKey(v string) *ObjectVersion {
+	s.Key = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *ObjectVersion) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *ObjectVersion) SetLastModified(v time.Time) *ObjectVersion {
+	s.LastModified = &v
+	return s
+}
+
+// GetOwner returns the value of Owner, or nil if it is not set.
+func (s *ObjectVersion) GetOwner() *Owner {
+	if s == nil {
+		return nil
+	}
+	return s.Owner
+}
+
+// SetOwner sets the value of Owner.
+func (s *ObjectVersion) SetOwner(v *Owner) *ObjectVersion {
+	s.Owner = v
+	return s
+}
+
+// GetSize returns the value of Size, or the zero value if it is not set.
+func (s *ObjectVersion) GetSize() int64 {
+	if s == nil || s.Size == nil {
+		return 0
+	}
+	return *s.Size
+}
+
+// SetSize sets the value of Size.
+func (s *ObjectVersion) SetSize(v int64) *ObjectVersion {
+	s.Size = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *ObjectVersion) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *ObjectVersion) SetStorageClass(v string) *ObjectVersion {
+	s.StorageClass = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *ObjectVersion) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *ObjectVersion) SetVersionID(v string) *ObjectVersion {
+	s.VersionID = &v
+	return s
+}
+
+type OutputSerialization struct {
+	// Describes the serialization of CSV-encoded Select results.
+	CSV *CSVOutput `type:"structure"`
+
+	// Specifies JSON as request's output serialization format.
+	JSON *JSONOutput `type:"structure"`
+
+	metadataOutputSerialization `json:"-" xml:"-"`
+}
+
+type metadataOutputSerialization struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s OutputSerialization) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s OutputSerialization) GoString() string {
+	return s.String()
+}
+
+// GetCSV returns the value of CSV, or nil if it is not set.
+func (s *OutputSerialization) GetCSV() *CSVOutput {
+	if s == nil {
+		return nil
+	}
+	return s.CSV
+}
+
+// SetCSV sets the value of CSV.
+func (s *OutputSerialization) SetCSV(v *CSVOutput) *OutputSerialization {
+	s.CSV = v
+	return s
+}
+
+// GetJSON returns the value of JSON, or nil if it is not set.
+func (s *OutputSerialization) GetJSON() *JSONOutput {
+	if s == nil {
+		return nil
+	}
+	return s.JSON
+}
+
+// SetJSON sets the value of JSON.
+func (s *OutputSerialization) SetJSON(v *JSONOutput) *OutputSerialization {
+	s.JSON = v
+	return s
+}
+
+type Owner struct {
+	DisplayName *string `type:"string"`
+
+	ID *string `type:"string"`
+
+	metadataOwner `json:"-" xml:"-"`
+}
+
+type metadataOwner struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Owner) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Owner) GoString() string {
+	return s.String()
+}
+
+// GetDisplayName returns the value of DisplayName, or the zero value if it is not set.
+func (s *Owner) GetDisplayName() string {
+	if s == nil || s.DisplayName == nil {
+		return ""
+	}
+	return *s.DisplayName
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *Owner) SetDisplayName(v string) *Owner {
+	s.DisplayName = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *Owner) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *Owner) SetID(v string) *Owner {
+	s.ID = &v
+	return s
+}
+
+type ParquetInput struct {
+	metadataParquetInput `json:"-" xml:"-"`
+}
+
+type metadataParquetInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ParquetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ParquetInput) GoString() string {
+	return s.String()
+}
+
+type Part struct {
+	// Entity tag returned when the part was uploaded.
+	ETag *string `type:"string"`
+
+	// Date and time at which the part was uploaded.
+	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+
+	// Part number identifying the part.
+	PartNumber *int64 `type:"integer"`
+
+	// Size of the uploaded part data.
+	Size *int64 `type:"integer"`
+
+	metadataPart `json:"-" xml:"-"`
+}
+
+type metadataPart struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Part) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Part) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *Part) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *Part) SetETag(v string) *Part {
+	s.ETag = &v
+	return s
+}
+
+// GetLastModified returns the value of LastModified, or the zero value if it is not set.
+func (s *Part) GetLastModified() time.Time {
+	if s == nil || s.LastModified == nil {
+		return time.Time{}
+	}
+	return *s.LastModified
+}
+
+// SetLastModified sets the value of LastModified.
+func (s *Part) SetLastModified(v time.Time) *Part {
+	s.LastModified = &v
+	return s
+}
+
+// GetPartNumber returns the value of PartNumber, or the zero value if it is not set.
+func (s *Part) GetPartNumber() int64 {
+	if s == nil || s.PartNumber == nil {
+		return 0
+	}
+	return *s.PartNumber
+}
+
+// SetPartNumber sets the value of PartNumber.
+func (s *Part) SetPartNumber(v int64) *Part {
+	s.PartNumber = &v
+	return s
+}
+
+// GetSize returns the value of Size, or the zero value if it is not set.
+func (s *Part) GetSize() int64 {
+	if s == nil || s.Size == nil {
+		return 0
+	}
+	return *s.Size
+}
+
+// SetSize sets the value of Size.
+func (s *Part) SetSize(v int64) *Part {
+	s.Size = &v
+	return s
+}
+
+type Progress struct {
+	// The current number of uncompressed object bytes processed.
+	BytesProcessed *int64 `type:"long"`
+
+	// The current number of bytes of records payload data returned.
+	BytesReturned *int64 `type:"long"`
+
+	// The current number of object bytes scanned.
+	BytesScanned *int64 `type:"long"`
+
+	metadataProgress `json:"-" xml:"-"`
+}
+
+type metadataProgress struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s Progress) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s Progress) GoString() string {
+	return s.String()
+}
+
+// GetBytesProcessed returns the value of BytesProcessed, or the zero value if it is not set.
+func (s *Progress) GetBytesProcessed() int64 {
+	if s == nil || s.BytesProcessed == nil {
+		return 0
+	}
+	return *s.BytesProcessed
+}
+
+// SetBytesProcessed sets the value of BytesProcessed.
+func (s *Progress) SetBytesProcessed(v int64) *Progress {
+	s.BytesProcessed = &v
+	return s
+}
+
+// GetBytesReturned returns the value of BytesReturned, or the zero value if it is not set.
+func (s *Progress) GetBytesReturned() int64 {
+	if s == nil || s.BytesReturned == nil {
+		return 0
+	}
+	return *s.BytesReturned
+}
+
+// SetBytesReturned sets the value of BytesReturned.
+func (s *Progress) SetBytesReturned(v int64) *Progress {
+	s.BytesReturned = &v
+	return s
+}
+
+// GetBytesScanned returns the value of BytesScanned, or the zero value if it is not set.
+func (s *Progress) GetBytesScanned() int64 {
+	if s == nil || s.BytesScanned == nil {
+		return 0
+	}
+	return *s.BytesScanned
+}
+
+// SetBytesScanned sets the value of BytesScanned.
+func (s *Progress) SetBytesScanned(v int64) *Progress {
+	s.BytesScanned = &v
+	return s
+}
+
+type ProgressEvent struct {
+	// The Progress event details.
+	Details *Progress `type:"structure"`
+
+	metadataProgressEvent `json:"-" xml:"-"`
+}
+
+type metadataProgressEvent struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ProgressEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ProgressEvent) GoString() string {
+	return s.String()
+}
+
+// GetDetails returns the value of Details, or nil if it is not set.
+func (s *ProgressEvent) GetDetails() *Progress {
+	if s == nil {
+		return nil
+	}
+	return s.Details
+}
+
+// SetDetails sets the value of Details.
+func (s *ProgressEvent) SetDetails(v *Progress) *ProgressEvent {
+	s.Details = v
+	return s
+}
+
+type PublicAccessBlockConfiguration struct {
+	// Specifies whether Amazon S3 should block public access control lists (ACLs)
+	// for this bucket and objects in this bucket.
+	BlockPublicACLs *bool `locationName:"BlockPublicAcls" type:"boolean"`
+
+	// Specifies whether Amazon S3 should block public bucket policies for this
+	// bucket.
+	BlockPublicPolicy *bool `locationName:"BlockPublicPolicy" type:"boolean"`
+
+	// Specifies whether Amazon S3 should ignore public ACLs for this bucket and
+	// objects in this bucket.
+	IgnorePublicACLs *bool `locationName:"IgnorePublicAcls" type:"boolean"`
+
+	// Specifies whether Amazon S3 should restrict public bucket policies for this
+	// bucket.
+	RestrictPublicBuckets *bool `locationName:"RestrictPublicBuckets" type:"boolean"`
+
+	metadataPublicAccessBlockConfiguration `json:"-" xml:"-"`
+}
+
+type metadataPublicAccessBlockConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PublicAccessBlockConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PublicAccessBlockConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetBlockPublicACLs returns the value of BlockPublicACLs, or the zero value if it is not set.
+func (s *PublicAccessBlockConfiguration) GetBlockPublicACLs() bool {
+	if s == nil || s.BlockPublicACLs == nil {
+		return false
+	}
+	return *s.BlockPublicACLs
+}
+
+// SetBlockPublicACLs sets the value of BlockPublicACLs.
+func (s *PublicAccessBlockConfiguration) SetBlockPublicACLs(v bool) *PublicAccessBlockConfiguration {
+	s.BlockPublicACLs = &v
+	return s
+}
+
+// GetBlockPublicPolicy returns the value of BlockPublicPolicy, or the zero value if it is not set.
+func (s *PublicAccessBlockConfiguration) GetBlockPublicPolicy() bool {
+	if s == nil || s.BlockPublicPolicy == nil {
+		return false
+	}
+	return *s.BlockPublicPolicy
+}
+
+// SetBlockPublicPolicy sets the value of BlockPublicPolicy.
+func (s *PublicAccessBlockConfiguration) SetBlockPublicPolicy(v bool) *PublicAccessBlockConfiguration {
+	s.BlockPublicPolicy = &v
+	return s
+}
+
+// GetIgnorePublicACLs returns the value of IgnorePublicACLs, or the zero value if it is not set.
+func (s *PublicAccessBlockConfiguration) GetIgnorePublicACLs() bool {
+	if s == nil || s.IgnorePublicACLs == nil {
+		return false
+	}
+	return *s.IgnorePublicACLs
+}
+
+// SetIgnorePublicACLs sets the value of IgnorePublicACLs.
+func (s *PublicAccessBlockConfiguration) SetIgnorePublicACLs(v bool) *PublicAccessBlockConfiguration {
+	s.IgnorePublicACLs = &v
+	return s
+}
+
+// GetRestrictPublicBuckets returns the value of RestrictPublicBuckets, or the zero value if it is not set.
+func (s *PublicAccessBlockConfiguration) GetRestrictPublicBuckets() bool {
+	if s == nil || s.RestrictPublicBuckets == nil {
+		return false
+	}
+	return *s.RestrictPublicBuckets
+}
+
+// SetRestrictPublicBuckets sets the value of RestrictPublicBuckets.
+func (s *PublicAccessBlockConfiguration) SetRestrictPublicBuckets(v bool) *PublicAccessBlockConfiguration {
+	s.RestrictPublicBuckets = &v
+	return s
+}
+
+type PutBucketACLInput struct {
+	// The canned ACL to apply to the bucket.
+	//
+	// The valid values are the BucketCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
+
+	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Allows grantee the read, write, read ACP, and write ACP permissions on the
+	// bucket.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to list the objects in the bucket.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the bucket ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to create, overwrite, and delete any object in the bucket.
+	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable bucket.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	metadataPutBucketACLInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketACLInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
+}
+
+// String returns the string representation.
+func (s PutBucketACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketACLInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *PutBucketACLInput) SetACL(v string) *PutBucketACLInput {
+	s.ACL = &v
+	return s
+}
+
+// GetAccessControlPolicy returns the value of AccessControlPolicy, or nil if it is not set.
+func (s *PutBucketACLInput) GetAccessControlPolicy() *AccessControlPolicy {
+	if s == nil {
+		return nil
+	}
+	return s.AccessControlPolicy
+}
+
+// SetAccessControlPolicy sets the value of AccessControlPolicy.
+func (s *PutBucketACLInput) SetAccessControlPolicy(v *AccessControlPolicy) *PutBucketACLInput {
+	s.AccessControlPolicy = v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketACLInput) SetBucket(v string) *PutBucketACLInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketACLInput) SetContentType(v string) *PutBucketACLInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *PutBucketACLInput) SetGrantFullControl(v string) *PutBucketACLInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *PutBucketACLInput) SetGrantRead(v string) *PutBucketACLInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *PutBucketACLInput) SetGrantReadACP(v string) *PutBucketACLInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWrite returns the value of GrantWrite, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetGrantWrite() string {
+	if s == nil || s.GrantWrite == nil {
+		return ""
+	}
+	return *s.GrantWrite
+}
+
+// SetGrantWrite sets the value of GrantWrite.
+func (s *PutBucketACLInput) SetGrantWrite(v string) *PutBucketACLInput {
+	s.GrantWrite = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *PutBucketACLInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *PutBucketACLInput) SetGrantWriteACP(v string) *PutBucketACLInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+type PutBucketACLOutput struct {
+	metadataPutBucketACLOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketACLOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketACLOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketAnalyticsConfigurationInput struct {
+	// The an analytics configuration.
+	AnalyticsConfiguration *AnalyticsConfiguration `locationName:"AnalyticsConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// The name of the bucket to which the an analytics configuration is stored.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an analytics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	metadataPutBucketAnalyticsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketAnalyticsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"AnalyticsConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketAnalyticsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketAnalyticsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetAnalyticsConfiguration returns the value of AnalyticsConfiguration, or nil if it is not set.
+func (s *PutBucketAnalyticsConfigurationInput) GetAnalyticsConfiguration() *AnalyticsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.AnalyticsConfiguration
+}
+
+// SetAnalyticsConfiguration sets the value of AnalyticsConfiguration.
+func (s *PutBucketAnalyticsConfigurationInput) SetAnalyticsConfiguration(v *AnalyticsConfiguration) *PutBucketAnalyticsConfigurationInput {
+	s.AnalyticsConfiguration = v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketAnalyticsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketAnalyticsConfigurationInput) SetBucket(v string) *PutBucketAnalyticsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketAnalyticsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketAnalyticsConfigurationInput) SetContentType(v string) *PutBucketAnalyticsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *PutBucketAnalyticsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *PutBucketAnalyticsConfigurationInput) SetID(v string) *PutBucketAnalyticsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+type PutBucketAnalyticsConfigurationOutput struct {
+	metadataPutBucketAnalyticsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketAnalyticsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketAnalyticsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketAnalyticsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketCORSInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	CORSConfiguration *CORSConfiguration `locationName:"CORSConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataPutBucketCORSInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketCORSInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"CORSConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketCORSInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketCORSInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketCORSInput) SetBucket(v string) *PutBucketCORSInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetCORSConfiguration returns the value of CORSConfiguration, or nil if it is not set.
+func (s *PutBucketCORSInput) GetCORSConfiguration() *CORSConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.CORSConfiguration
+}
+
+// SetCORSConfiguration sets the value of CORSConfiguration.
+func (s *PutBucketCORSInput) SetCORSConfiguration(v *CORSConfiguration) *PutBucketCORSInput {
+	s.CORSConfiguration = v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketCORSInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketCORSInput) SetContentType(v string) *PutBucketCORSInput {
+	s.ContentType = &v
+	return s
+}
+
+type PutBucketCORSOutput struct {
+	metadataPutBucketCORSOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketCORSOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketCORSOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketEncryptionInput struct {
+	// The name of the bucket for which the server-side encryption configuration
+	// is set.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Container for server-side encryption configuration rules. Currently S3 supports
+	// one rule only.
+	ServerSideEncryptionConfiguration *ServerSideEncryptionConfiguration `locationName:"ServerSideEncryptionConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	metadataPutBucketEncryptionInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketEncryptionInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ServerSideEncryptionConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketEncryptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketEncryptionInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketEncryptionInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketEncryptionInput) SetBucket(v string) *PutBucketEncryptionInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketEncryptionInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketEncryptionInput) SetContentType(v string) *PutBucketEncryptionInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetServerSideEncryptionConfiguration returns the value of ServerSideEncryptionConfiguration, or nil if it is not set.
+func (s *PutBucketEncryptionInput) GetServerSideEncryptionConfiguration() *ServerSideEncryptionConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ServerSideEncryptionConfiguration
+}
+
+// SetServerSideEncryptionConfiguration sets the value of ServerSideEncryptionConfiguration.
+func (s *PutBucketEncryptionInput) SetServerSideEncryptionConfiguration(v *ServerSideEncryptionConfiguration) *PutBucketEncryptionInput {
+	s.ServerSideEncryptionConfiguration = v
+	return s
+}
+
+type PutBucketEncryptionOutput struct {
+	metadataPutBucketEncryptionOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketEncryptionOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketEncryptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketEncryptionOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketInventoryConfigurationInput struct {
+	// The name of the bucket to which the an inventory configuration is stored.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the an inventory configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	// The an inventory configuration.
+	InventoryConfiguration *InventoryConfiguration `locationName:"InventoryConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	metadataPutBucketInventoryConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketInventoryConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"InventoryConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketInventoryConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketInventoryConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketInventoryConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketInventoryConfigurationInput) SetBucket(v string) *PutBucketInventoryConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketInventoryConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketInventoryConfigurationInput) SetContentType(v string) *PutBucketInventoryConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *PutBucketInventoryConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *PutBucketInventoryConfigurationInput) SetID(v string) *PutBucketInventoryConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+// GetInventoryConfiguration returns the value of InventoryConfiguration, or nil if it is not set.
+func (s *PutBucketInventoryConfigurationInput) GetInventoryConfiguration() *InventoryConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.InventoryConfiguration
+}
+
+// SetInventoryConfiguration sets the value of InventoryConfiguration.
+func (s *PutBucketInventoryConfigurationInput) SetInventoryConfiguration(v *InventoryConfiguration) *PutBucketInventoryConfigurationInput {
+	s.InventoryConfiguration = v
+	return s
+}
+
+type PutBucketInventoryConfigurationOutput struct {
+	metadataPutBucketInventoryConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketInventoryConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketInventoryConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketInventoryConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketLifecycleConfigurationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	LifecycleConfiguration *BucketLifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	metadataPutBucketLifecycleConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLifecycleConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"LifecycleConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketLifecycleConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLifecycleConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketLifecycleConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketLifecycleConfigurationInput) SetBucket(v string) *PutBucketLifecycleConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketLifecycleConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketLifecycleConfigurationInput) SetContentType(v string) *PutBucketLifecycleConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetLifecycleConfiguration returns the value of LifecycleConfiguration, or nil if it is not set.
+func (s *PutBucketLifecycleConfigurationInput) GetLifecycleConfiguration() *BucketLifecycleConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.LifecycleConfiguration
+}
+
+// SetLifecycleConfiguration sets the value of LifecycleConfiguration.
+func (s *PutBucketLifecycleConfigurationInput) SetLifecycleConfiguration(v *BucketLifecycleConfiguration) *PutBucketLifecycleConfigurationInput {
+	s.LifecycleConfiguration = v
+	return s
+}
+
+type PutBucketLifecycleConfigurationOutput struct {
+	metadataPutBucketLifecycleConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLifecycleConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketLifecycleConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLifecycleConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketLifecycleInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	LifecycleConfiguration *LifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure"`
+
+	metadataPutBucketLifecycleInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLifecycleInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"LifecycleConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLifecycleInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketLifecycleInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketLifecycleInput) SetBucket(v string) *PutBucketLifecycleInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketLifecycleInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketLifecycleInput) SetContentType(v string) *PutBucketLifecycleInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetLifecycleConfiguration returns the value of LifecycleConfiguration, or nil if it is not set.
+func (s *PutBucketLifecycleInput) GetLifecycleConfiguration() *LifecycleConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.LifecycleConfiguration
+}
+
+// SetLifecycleConfiguration sets the value of LifecycleConfiguration.
+func (s *PutBucketLifecycleInput) SetLifecycleConfiguration(v *LifecycleConfiguration) *PutBucketLifecycleInput {
+	s.LifecycleConfiguration = v
+	return s
+}
+
+type PutBucketLifecycleOutput struct {
+	metadataPutBucketLifecycleOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLifecycleOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLifecycleOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketLoggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	BucketLoggingStatus *BucketLoggingStatus `locationName:"BucketLoggingStatus" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataPutBucketLoggingInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLoggingInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"BucketLoggingStatus"`
+}
+
+// String returns the string representation.
+func (s PutBucketLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLoggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketLoggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketLoggingInput) SetBucket(v string) *PutBucketLoggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetBucketLoggingStatus returns the value of BucketLoggingStatus, or nil if it is not set.
+func (s *PutBucketLoggingInput) GetBucketLoggingStatus() *BucketLoggingStatus {
+	if s == nil {
+		return nil
+	}
+	return s.BucketLoggingStatus
+}
+
+// SetBucketLoggingStatus sets the value of BucketLoggingStatus.
+func (s *PutBucketLoggingInput) SetBucketLoggingStatus(v *BucketLoggingStatus) *PutBucketLoggingInput {
+	s.BucketLoggingStatus = v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketLoggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketLoggingInput) SetContentType(v string) *PutBucketLoggingInput {
+	s.ContentType = &v
+	return s
+}
+
+type PutBucketLoggingOutput struct {
+	metadataPutBucketLoggingOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketLoggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketLoggingOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketMetricsConfigurationInput struct {
+	// The name of the bucket to which the a metrics configuration is stored.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The ID used to identify the a metrics configuration.
+	ID *string `location:"querystring" locationName:"id" type:"string" required:"true"`
+
+	// The a metrics configuration.
+	MetricsConfiguration *MetricsConfiguration `locationName:"MetricsConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	metadataPutBucketMetricsConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketMetricsConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"MetricsConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketMetricsConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketMetricsConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketMetricsConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketMetricsConfigurationInput) SetBucket(v string) *PutBucketMetricsConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketMetricsConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketMetricsConfigurationInput) SetContentType(v string) *PutBucketMetricsConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *PutBucketMetricsConfigurationInput) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *PutBucketMetricsConfigurationInput) SetID(v string) *PutBucketMetricsConfigurationInput {
+	s.ID = &v
+	return s
+}
+
+// GetMetricsConfiguration returns the value of MetricsConfiguration, or nil if it is not set.
+func (s *PutBucketMetricsConfigurationInput) GetMetricsConfiguration() *MetricsConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.MetricsConfiguration
+}
+
+// SetMetricsConfiguration sets the value of MetricsConfiguration.
+func (s *PutBucketMetricsConfigurationInput) SetMetricsConfiguration(v *MetricsConfiguration) *PutBucketMetricsConfigurationInput {
+	s.MetricsConfiguration = v
+	return s
+}
+
+type PutBucketMetricsConfigurationOutput struct {
+	metadataPutBucketMetricsConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketMetricsConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketMetricsConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketMetricsConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketNotificationConfigurationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Container for specifying the notification configuration of the bucket. If
+	// this element is empty, notifications are turned off on the bucket.
+	NotificationConfiguration *NotificationConfiguration `locationName:"NotificationConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketNotificationConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketNotificationConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"NotificationConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketNotificationConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketNotificationConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketNotificationConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketNotificationConfigurationInput) SetBucket(v string) *PutBucketNotificationConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketNotificationConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketNotificationConfigurationInput) SetContentType(v string) *PutBucketNotificationConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetNotificationConfiguration returns the value of NotificationConfiguration, or nil if it is not set.
+func (s *PutBucketNotificationConfigurationInput) GetNotificationConfiguration() *NotificationConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.NotificationConfiguration
+}
+
+// SetNotificationConfiguration sets the value of NotificationConfiguration.
+func (s *PutBucketNotificationConfigurationInput) SetNotificationConfiguration(v *NotificationConfiguration) *PutBucketNotificationConfigurationInput {
+	s.NotificationConfiguration = v
+	return s
+}
+
+type PutBucketNotificationConfigurationOutput struct {
+	metadataPutBucketNotificationConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketNotificationConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketNotificationConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketNotificationConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketNotificationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	NotificationConfiguration *NotificationConfigurationDeprecated `locationName:"NotificationConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketNotificationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketNotificationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"NotificationConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketNotificationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketNotificationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketNotificationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketNotificationInput) SetBucket(v string) *PutBucketNotificationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketNotificationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketNotificationInput) SetContentType(v string) *PutBucketNotificationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetNotificationConfiguration returns the value of NotificationConfiguration, or nil if it is not set.
+func (s *PutBucketNotificationInput) GetNotificationConfiguration() *NotificationConfigurationDeprecated {
+	if s == nil {
+		return nil
+	}
+	return s.NotificationConfiguration
+}
+
+// SetNotificationConfiguration sets the value of NotificationConfiguration.
+func (s *PutBucketNotificationInput) SetNotificationConfiguration(v *NotificationConfigurationDeprecated) *PutBucketNotificationInput {
+	s.NotificationConfiguration = v
+	return s
+}
+
+type PutBucketNotificationOutput struct {
+	metadataPutBucketNotificationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketNotificationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketNotificationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketNotificationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketPolicyInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The bucket policy as a JSON document.
+	Policy *string `type:"string" required:"true"`
+
+	metadataPutBucketPolicyInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketPolicyInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Policy"`
+}
+
+// String returns the string representation.
+func (s PutBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketPolicyInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketPolicyInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketPolicyInput) SetBucket(v string) *PutBucketPolicyInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketPolicyInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketPolicyInput) SetContentType(v string) *PutBucketPolicyInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetPolicy returns the value of Policy, or the zero value if it is not set.
+func (s *PutBucketPolicyInput) GetPolicy() string {
+	if s == nil || s.Policy == nil {
+		return ""
+	}
+	return *s.Policy
+}
+
+// SetPolicy sets the value of Policy.
+func (s *PutBucketPolicyInput) SetPolicy(v string) *PutBucketPolicyInput {
+	s.Policy = &v
+	return s
+}
+
+type PutBucketPolicyOutput struct {
+	metadataPutBucketPolicyOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketPolicyOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketPolicyOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketReplicationInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Container for replication rules. You can add as many as 1,000 rules. Total
+	// replication configuration size can be up to 2 MB.
+	ReplicationConfiguration *ReplicationConfiguration `locationName:"ReplicationConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketReplicationInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketReplicationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketReplicationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketReplicationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketReplicationInput) SetBucket(v string) *PutBucketReplicationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketReplicationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketReplicationInput) SetContentType(v string) *PutBucketReplicationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetReplicationConfiguration returns the value of ReplicationConfiguration, or nil if it is not set.
+func (s *PutBucketReplicationInput) GetReplicationConfiguration() *ReplicationConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ReplicationConfiguration
+}
+
+// SetReplicationConfiguration sets the value of ReplicationConfiguration.
+func (s *PutBucketReplicationInput) SetReplicationConfiguration(v *ReplicationConfiguration) *PutBucketReplicationInput {
+	s.ReplicationConfiguration = v
+	return s
+}
+
+type PutBucketReplicationOutput struct {
+	metadataPutBucketReplicationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketReplicationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketReplicationOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketRequestPaymentInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	RequestPaymentConfiguration *RequestPaymentConfiguration `locationName:"RequestPaymentConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketRequestPaymentInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketRequestPaymentInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"RequestPaymentConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketRequestPaymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketRequestPaymentInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketRequestPaymentInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketRequestPaymentInput) SetBucket(v string) *PutBucketRequestPaymentInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketRequestPaymentInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketRequestPaymentInput) SetContentType(v string) *PutBucketRequestPaymentInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetRequestPaymentConfiguration returns the value of RequestPaymentConfiguration, or nil if it is not set.
+func (s *PutBucketRequestPaymentInput) GetRequestPaymentConfiguration() *RequestPaymentConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.RequestPaymentConfiguration
+}
+
+// SetRequestPaymentConfiguration sets the value of RequestPaymentConfiguration.
+func (s *PutBucketRequestPaymentInput) SetRequestPaymentConfiguration(v *RequestPaymentConfiguration) *PutBucketRequestPaymentInput {
+	s.RequestPaymentConfiguration = v
+	return s
+}
+
+type PutBucketRequestPaymentOutput struct {
+	metadataPutBucketRequestPaymentOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketRequestPaymentOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketRequestPaymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketRequestPaymentOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Tagging *Tagging `locationName:"Tagging" type:"structure" required:"true"`
+
+	metadataPutBucketTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketTaggingInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Tagging"`
+}
+
+// String returns the string representation.
+func (s PutBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketTaggingInput) SetBucket(v string) *PutBucketTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketTaggingInput) SetContentType(v string) *PutBucketTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetTagging returns the value of Tagging, or nil if it is not set.
+func (s *PutBucketTaggingInput) GetTagging() *Tagging {
+	if s == nil {
+		return nil
+	}
+	return s.Tagging
+}
+
+// SetTagging sets the value of Tagging.
+func (s *PutBucketTaggingInput) SetTagging(v *Tagging) *PutBucketTaggingInput {
+	s.Tagging = v
+	return s
+}
+
+type PutBucketTaggingOutput struct {
+	metadataPutBucketTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketTaggingOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketVersioningInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The concatenation of the authentication device's serial number, a space,
+	// and the value that is displayed on your authentication device.
+	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+
+	VersioningConfiguration *VersioningConfiguration `locationName:"VersioningConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketVersioningInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketVersioningInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"VersioningConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketVersioningInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketVersioningInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketVersioningInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketVersioningInput) SetBucket(v string) *PutBucketVersioningInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketVersioningInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketVersioningInput) SetContentType(v string) *PutBucketVersioningInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetMFA returns the value of MFA, or the zero value if it is not set.
+func (s *PutBucketVersioningInput) GetMFA() string {
+	if s == nil || s.MFA == nil {
+		return ""
+	}
+	return *s.MFA
+}
+
+// SetMFA sets the value of MFA.
+func (s *PutBucketVersioningInput) SetMFA(v string) *PutBucketVersioningInput {
+	s.MFA = &v
+	return s
+}
+
+// GetVersioningConfiguration returns the value of VersioningConfiguration, or nil if it is not set.
+func (s *PutBucketVersioningInput) GetVersioningConfiguration() *VersioningConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.VersioningConfiguration
+}
+
+// SetVersioningConfiguration sets the value of VersioningConfiguration.
+func (s *PutBucketVersioningInput) SetVersioningConfiguration(v *VersioningConfiguration) *PutBucketVersioningInput {
+	s.VersioningConfiguration = v
+	return s
+}
+
+type PutBucketVersioningOutput struct {
+	metadataPutBucketVersioningOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketVersioningOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketVersioningOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketVersioningOutput) GoString() string {
+	return s.String()
+}
+
+type PutBucketWebsiteInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	WebsiteConfiguration *WebsiteConfiguration `locationName:"WebsiteConfiguration" type:"structure" required:"true"`
+
+	metadataPutBucketWebsiteInput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketWebsiteInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"WebsiteConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketWebsiteInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutBucketWebsiteInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutBucketWebsiteInput) SetBucket(v string) *PutBucketWebsiteInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutBucketWebsiteInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutBucketWebsiteInput) SetContentType(v string) *PutBucketWebsiteInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetWebsiteConfiguration returns the value of WebsiteConfiguration, or nil if it is not set.
+func (s *PutBucketWebsiteInput) GetWebsiteConfiguration() *WebsiteConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.WebsiteConfiguration
+}
+
+// SetWebsiteConfiguration sets the value of WebsiteConfiguration.
+func (s *PutBucketWebsiteInput) SetWebsiteConfiguration(v *WebsiteConfiguration) *PutBucketWebsiteInput {
+	s.WebsiteConfiguration = v
+	return s
+}
+
+type PutBucketWebsiteOutput struct {
+	metadataPutBucketWebsiteOutput `json:"-" xml:"-"`
+}
+
+type metadataPutBucketWebsiteOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutBucketWebsiteOutput) GoString() string {
+	return s.String()
+}
+
+type PutObjectACLInput struct {
+	// The canned ACL to apply to the object.
+	//
+	// The valid values are the ObjectCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,aws-exec-read,bucket-owner-read,bucket-owner-full-control"`
+
+	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// Allows grantee the read, write, read ACP, and write ACP permissions on the
+	// bucket.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to list the objects in the bucket.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the bucket ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to create, overwrite, and delete any object in the bucket.
+	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable bucket.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	metadataPutObjectACLInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectACLInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
+}
+
+// String returns the string representation.
+func (s PutObjectACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectACLInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *PutObjectACLInput) SetACL(v string) *PutObjectACLInput {
+	s.ACL = &v
+	return s
+}
+
+// GetAccessControlPolicy returns the value of AccessControlPolicy, or nil if it is not set.
+func (s *PutObjectACLInput) GetAccessControlPolicy() *AccessControlPolicy {
+	if s == nil {
+		return nil
+	}
+	return s.AccessControlPolicy
+}
+
+// SetAccessControlPolicy sets the value of AccessControlPolicy.
+func (s *PutObjectACLInput) SetAccessControlPolicy(v *AccessControlPolicy) *PutObjectACLInput {
+	s.AccessControlPolicy = v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectACLInput) SetBucket(v string) *PutObjectACLInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectACLInput) SetContentType(v string) *PutObjectACLInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *PutObjectACLInput) SetGrantFullControl(v string) *PutObjectACLInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *PutObjectACLInput) SetGrantRead(v string) *PutObjectACLInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *PutObjectACLInput) SetGrantReadACP(v string) *PutObjectACLInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWrite returns the value of GrantWrite, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetGrantWrite() string {
+	if s == nil || s.GrantWrite == nil {
+		return ""
+	}
+	return *s.GrantWrite
+}
+
+// SetGrantWrite sets the value of GrantWrite.
+func (s *PutObjectACLInput) SetGrantWrite(v string) *PutObjectACLInput {
+	s.GrantWrite = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *PutObjectACLInput) SetGrantWriteACP(v string) *PutObjectACLInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *PutObjectACLInput) SetKey(v string) *PutObjectACLInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *PutObjectACLInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *PutObjectACLInput) SetRequestPayer(v string) *PutObjectACLInput {
+	s.RequestPayer = &v
+	return s
+}
+
+type PutObjectACLOutput struct {
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataPutObjectACLOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectACLOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectACLOutput) GoString() string {
+	return s.String()
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *PutObjectACLOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *PutObjectACLOutput) SetRequestCharged(v string) *PutObjectACLOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type PutObjectInput struct {
+	// The canned ACL to apply to the object.
+	//
+	// The valid values are the ObjectCannedACL enum constants.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,aws-exec-read,bucket-owner-read,bucket-owner-full-control"`
+
+	// Object data.
+	Body io.ReadSeeker `type:"blob"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+
+	CallbackBody *string `location:"header" locationName:"x-kss-callbackbody" type:"string"`
+
+	CallbackUrl *string `location:"header" locationName:"x-kss-callbackurl" type:"string"`
+
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+
+	// Size of the body in bytes. This parameter is useful when the size of the
+	// body cannot be determined automatically.
+	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+
+	ContentMaxLength *int64 `location:"header" locationName:"x-amz-content-maxlength" type:"integer"`
+
+	// A standard MIME type describing the format of the object data.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to read the object data and its metadata.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the object ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable object.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+
+	// Specifies whether a legal hold will be applied to this object.
+	//
+	// The valid values are the ObjectLockLegalHoldStatus enum constants.
+	ObjectLockLegalHoldStatus *string `location:"header" locationName:"x-amz-object-lock-legal-hold" type:"string" enum:"ON,OFF"`
+
+	// The Object Lock mode that you want to apply to this object.
+	//
+	// The valid values are the ObjectLockMode enum constants.
+	ObjectLockMode *string `location:"header" locationName:"x-amz-object-lock-mode" type:"string" enum:"GOVERNANCE,COMPLIANCE"`
+
+	// The date and time when you want this object's Object Lock to expire.
+	ObjectLockRetainUntilDate *time.Time `location:"header" locationName:"x-amz-object-lock-retain-until-date" type:"timestamp" timestampFormat:"iso8601"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
+	// requests for an object protected by AWS KMS will fail if not made via SSL
+	// or using SigV4. Documentation on configuring any of the officially supported
+	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// The type of storage to use for the object. Defaults to 'STANDARD'.
+	//
+	// The valid values are the StorageClass enum constants.
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,GLACIER,DEEP_ARCHIVE"`
+
+	// The tag-set for the object. The tag-set must be encoded as URL Query parameters.
+	Tagging *string `location:"header" locationName:"x-amz-tagging" type:"string"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+
+	metadataPutObjectInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+// String returns the string representation.
+func (s PutObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectInput) GoString() string {
+	return s.String()
+}
+
+// GetACL returns the value of ACL, or the zero value if it is not set.
+func (s *PutObjectInput) GetACL() string {
+	if s == nil || s.ACL == nil {
+		return ""
+	}
+	return *s.ACL
+}
+
+// SetACL sets the value of ACL.
+func (s *PutObjectInput) SetACL(v string) *PutObjectInput {
+	s.ACL = &v
+	return s
+}
+
+// GetBody returns the value of Body, or nil if it is not set.
+func (s *PutObjectInput) GetBody() io.ReadSeeker {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// SetBody sets the value of Body.
+func (s *PutObjectInput) SetBody(v io.ReadSeeker) *PutObjectInput {
+	s.Body = v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectInput) SetBucket(v string) *PutObjectInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetCacheControl returns the value of CacheControl, or the zero value if it is not set.
+func (s *PutObjectInput) GetCacheControl() string {
+	if s == nil || s.CacheControl == nil {
+		return ""
+	}
+	return *s.CacheControl
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *PutObjectInput) SetCacheControl(v string) *PutObjectInput {
+	s.CacheControl = &v
+	return s
+}
+
+// GetCallbackBody returns the value of CallbackBody, or the zero value if it is not set.
+func (s *PutObjectInput) GetCallbackBody() string {
+	if s == nil || s.CallbackBody == nil {
+		return ""
+	}
+	return *s.CallbackBody
+}
+
+// SetCallbackBody sets the value of CallbackBody.
+func (s *PutObjectInput) SetCallbackBody(v string) *PutObjectInput {
+	s.CallbackBody = &v
+	return s
+}
+
+// GetCallbackUrl returns the value of CallbackUrl, or the zero value if it is not set.
+func (s *PutObjectInput) GetCallbackUrl() string {
+	if s == nil || s.CallbackUrl == nil {
+		return ""
+	}
+	return *s.CallbackUrl
+}
+
+// SetCallbackUrl sets the value of CallbackUrl.
+func (s *PutObjectInput) SetCallbackUrl(v string) *PutObjectInput {
+	s.CallbackUrl = &v
+	return s
+}
+
+// GetContentDisposition returns the value of ContentDisposition, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentDisposition() string {
+	if s == nil || s.ContentDisposition == nil {
+		return ""
+	}
+	return *s.ContentDisposition
+}
+
+// SetContentDisposition sets the value of ContentDisposition.
+func (s *PutObjectInput) SetContentDisposition(v string) *PutObjectInput {
+	s.ContentDisposition = &v
+	return s
+}
+
+// GetContentEncoding returns the value of ContentEncoding, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentEncoding() string {
+	if s == nil || s.ContentEncoding == nil {
+		return ""
+	}
+	return *s.ContentEncoding
+}
+
+// SetContentEncoding sets the value of ContentEncoding.
+func (s *PutObjectInput) SetContentEncoding(v string) *PutObjectInput {
+	s.ContentEncoding = &v
+	return s
+}
+
+// GetContentLanguage returns the value of ContentLanguage, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentLanguage() string {
+	if s == nil || s.ContentLanguage == nil {
+		return ""
+	}
+	return *s.ContentLanguage
+}
+
+// SetContentLanguage sets the value of ContentLanguage.
+func (s *PutObjectInput) SetContentLanguage(v string) *PutObjectInput {
+	s.ContentLanguage = &v
+	return s
+}
+
+// GetContentLength returns the value of ContentLength, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentLength() int64 {
+	if s == nil || s.ContentLength == nil {
+		return 0
+	}
+	return *s.ContentLength
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *PutObjectInput) SetContentLength(v int64) *PutObjectInput {
+	s.ContentLength = &v
+	return s
+}
+
+// GetContentMaxLength returns the value of ContentMaxLength, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentMaxLength() int64 {
+	if s == nil || s.ContentMaxLength == nil {
+		return 0
+	}
+	return *s.ContentMaxLength
+}
+
+// SetContentMaxLength sets the value of ContentMaxLength.
+func (s *PutObjectInput) SetContentMaxLength(v int64) *PutObjectInput {
+	s.ContentMaxLength = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectInput) SetContentType(v string) *PutObjectInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *PutObjectInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *PutObjectInput) SetExpectedBucketOwner(v string) *PutObjectInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetExpires returns the value of Expires, or the zero value if it is not set.
+func (s *PutObjectInput) GetExpires() time.Time {
+	if s == nil || s.Expires == nil {
+		return time.Time{}
+	}
+	return *s.Expires
+}
+
+// SetExpires sets the value of Expires.
+func (s *PutObjectInput) SetExpires(v time.Time) *PutObjectInput {
+	s.Expires = &v
+	return s
+}
+
+// GetGrantFullControl returns the value of GrantFullControl, or the zero value if it is not set.
+func (s *PutObjectInput) GetGrantFullControl() string {
+	if s == nil || s.GrantFullControl == nil {
+		return ""
+	}
+	return *s.GrantFullControl
+}
+
+// SetGrantFullControl sets the value of GrantFullControl.
+func (s *PutObjectInput) SetGrantFullControl(v string) *PutObjectInput {
+	s.GrantFullControl = &v
+	return s
+}
+
+// GetGrantRead returns the value of GrantRead, or the zero value if it is not set.
+func (s *PutObjectInput) GetGrantRead() string {
+	if s == nil || s.GrantRead == nil {
+		return ""
+	}
+	return *s.GrantRead
+}
+
+// SetGrantRead sets the value of GrantRead.
+func (s *PutObjectInput) SetGrantRead(v string) *PutObjectInput {
+	s.GrantRead = &v
+	return s
+}
+
+// GetGrantReadACP returns the value of GrantReadACP, or the zero value if it is not set.
+func (s *PutObjectInput) GetGrantReadACP() string {
+	if s == nil || s.GrantReadACP == nil {
+		return ""
+	}
+	return *s.GrantReadACP
+}
+
+// SetGrantReadACP sets the value of GrantReadACP.
+func (s *PutObjectInput) SetGrantReadACP(v string) *PutObjectInput {
+	s.GrantReadACP = &v
+	return s
+}
+
+// GetGrantWriteACP returns the value of GrantWriteACP, or the zero value if it is not set.
+func (s *PutObjectInput) GetGrantWriteACP() string {
+	if s == nil || s.GrantWriteACP == nil {
+		return ""
+	}
+	return *s.GrantWriteACP
+}
+
+// SetGrantWriteACP sets the value of GrantWriteACP.
+func (s *PutObjectInput) SetGrantWriteACP(v string) *PutObjectInput {
+	s.GrantWriteACP = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *PutObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *PutObjectInput) SetKey(v string) *PutObjectInput {
+	s.Key = &v
+	return s
+}
+
+// GetMetadata returns the value of Metadata, or nil if it is not set.
+func (s *PutObjectInput) GetMetadata() map[string]*string {
+	if s == nil {
+		return nil
+	}
+	return s.Metadata
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *PutObjectInput) SetMetadata(v map[string]*string) *PutObjectInput {
+	s.Metadata = v
+	return s
+}
+
+// GetObjectLockLegalHoldStatus returns the value of ObjectLockLegalHoldStatus, or the zero value if it is not set.
+func (s *PutObjectInput) GetObjectLockLegalHoldStatus() string {
+	if s == nil || s.ObjectLockLegalHoldStatus == nil {
+		return ""
+	}
+	return *s.ObjectLockLegalHoldStatus
+}
+
+// SetObjectLockLegalHoldStatus sets the value of ObjectLockLegalHoldStatus.
+func (s *PutObjectInput) SetObjectLockLegalHoldStatus(v string) *PutObjectInput {
+	s.ObjectLockLegalHoldStatus = &v
+	return s
+}
+
+// GetObjectLockMode returns the value of ObjectLockMode, or the zero value if it is not set.
+func (s *PutObjectInput) GetObjectLockMode() string {
+	if s == nil || s.ObjectLockMode == nil {
+		return ""
+	}
+	return *s.ObjectLockMode
+}
+
+// SetObjectLockMode sets the value of ObjectLockMode.
+func (s *PutObjectInput) SetObjectLockMode(v string) *PutObjectInput {
+	s.ObjectLockMode = &v
+	return s
+}
+
+// GetObjectLockRetainUntilDate returns the value of ObjectLockRetainUntilDate, or the zero value if it is not set.
+func (s *PutObjectInput) GetObjectLockRetainUntilDate() time.Time {
+	if s == nil || s.ObjectLockRetainUntilDate == nil {
+		return time.Time{}
+	}
+	return *s.ObjectLockRetainUntilDate
+}
+
+// SetObjectLockRetainUntilDate sets the value of ObjectLockRetainUntilDate.
+func (s *PutObjectInput) SetObjectLockRetainUntilDate(v time.Time) *PutObjectInput {
+	s.ObjectLockRetainUntilDate = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *PutObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *PutObjectInput) SetRequestPayer(v string) *PutObjectInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *PutObjectInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *PutObjectInput) SetSSECustomerAlgorithm(v string) *PutObjectInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *PutObjectInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *PutObjectInput) SetSSECustomerKey(v string) *PutObjectInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *PutObjectInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *PutObjectInput) SetSSECustomerKeyMD5(v string) *PutObjectInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *PutObjectInput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *PutObjectInput) SetSSEKMSKeyID(v string) *PutObjectInput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *PutObjectInput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *PutObjectInput) SetServerSideEncryption(v string) *PutObjectInput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *PutObjectInput) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
+}
+
+// SetStorageClass sets the value of StorageClass.
+func (s *PutObjectInput) SetStorageClass(v string) *PutObjectInput {
+	s.StorageClass = &v
+	return s
+}
+
+// GetTagging returns the value of Tagging, or the zero value if it is not set.
+func (s *PutObjectInput) GetTagging() string {
+	if s == nil || s.Tagging == nil {
+		return ""
+	}
+	return *s.Tagging
+}
+
+// SetTagging sets the value of Tagging.
+func (s *PutObjectInput) SetTagging(v string) *PutObjectInput {
+	s.Tagging = &v
+	return s
+}
+
+// GetWebsiteRedirectLocation returns the value of WebsiteRedirectLocation, or the zero value if it is not set.
+func (s *PutObjectInput) GetWebsiteRedirectLocation() string {
+	if s == nil || s.WebsiteRedirectLocation == nil {
+		return ""
+	}
+	return *s.WebsiteRedirectLocation
+}
+
+// SetWebsiteRedirectLocation sets the value of WebsiteRedirectLocation.
+func (s *PutObjectInput) SetWebsiteRedirectLocation(v string) *PutObjectInput {
+	s.WebsiteRedirectLocation = &v
+	return s
+}
+
+type PutObjectLegalHoldInput struct {
+	// The bucket containing the object that you want to place a Legal Hold on.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The key name for the object that you want to place a Legal Hold on.
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Container element for the Legal Hold configuration you want to apply to the
+	// specified object.
+	LegalHold *ObjectLockLegalHold `locationName:"LegalHold" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// The version ID of the object that you want to place a Legal Hold on.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataPutObjectLegalHoldInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectLegalHoldInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"LegalHold"`
+}
+
+// String returns the string representation.
+func (s PutObjectLegalHoldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectLegalHoldInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectLegalHoldInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectLegalHoldInput) SetBucket(v string) *PutObjectLegalHoldInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectLegalHoldInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectLegalHoldInput) SetContentType(v string) *PutObjectLegalHoldInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *PutObjectLegalHoldInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *PutObjectLegalHoldInput) SetKey(v string) *PutObjectLegalHoldInput {
+	s.Key = &v
+	return s
+}
+
+// GetLegalHold returns the value of LegalHold, or nil if it is not set.
+func (s *PutObjectLegalHoldInput) GetLegalHold() *ObjectLockLegalHold {
+	if s == nil {
+		return nil
+	}
+	return s.LegalHold
+}
+
+// SetLegalHold sets the value of LegalHold.
+func (s *PutObjectLegalHoldInput) SetLegalHold(v *ObjectLockLegalHold) *PutObjectLegalHoldInput {
+	s.LegalHold = v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *PutObjectLegalHoldInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *PutObjectLegalHoldInput) SetRequestPayer(v string) *PutObjectLegalHoldInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *PutObjectLegalHoldInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *PutObjectLegalHoldInput) SetVersionID(v string) *PutObjectLegalHoldInput {
+	s.VersionID = &v
+	return s
+}
+
+type PutObjectLegalHoldOutput struct {
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataPutObjectLegalHoldOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectLegalHoldOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectLegalHoldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectLegalHoldOutput) GoString() string {
+	return s.String()
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *PutObjectLegalHoldOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *PutObjectLegalHoldOutput) SetRequestCharged(v string) *PutObjectLegalHoldOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type PutObjectLockConfigurationInput struct {
+	// The bucket whose Object Lock configuration you want to create or replace.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The Object Lock configuration that you want to apply to the specified bucket.
+	ObjectLockConfiguration *ObjectLockConfiguration `locationName:"ObjectLockConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// A token to allow Object Lock to be enabled for an existing bucket.
+	Token *string `location:"header" locationName:"x-amz-bucket-object-lock-token" type:"string"`
+
+	metadataPutObjectLockConfigurationInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectLockConfigurationInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"ObjectLockConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutObjectLockConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectLockConfigurationInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectLockConfigurationInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectLockConfigurationInput) SetBucket(v string) *PutObjectLockConfigurationInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectLockConfigurationInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectLockConfigurationInput) SetContentType(v string) *PutObjectLockConfigurationInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetObjectLockConfiguration returns the value of ObjectLockConfiguration, or nil if it is not set.
+func (s *PutObjectLockConfigurationInput) GetObjectLockConfiguration() *ObjectLockConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.ObjectLockConfiguration
+}
+
+// SetObjectLockConfiguration sets the value of ObjectLockConfiguration.
+func (s *PutObjectLockConfigurationInput) SetObjectLockConfiguration(v *ObjectLockConfiguration) *PutObjectLockConfigurationInput {
+	s.ObjectLockConfiguration = v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *PutObjectLockConfigurationInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *PutObjectLockConfigurationInput) SetRequestPayer(v string) *PutObjectLockConfigurationInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetToken returns the value of Token, or the zero value if it is not set.
+func (s *PutObjectLockConfigurationInput) GetToken() string {
+	if s == nil || s.Token == nil {
+		return ""
+	}
+	return *s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *PutObjectLockConfigurationInput) SetToken(v string) *PutObjectLockConfigurationInput {
+	s.Token = &v
+	return s
+}
+
+type PutObjectLockConfigurationOutput struct {
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataPutObjectLockConfigurationOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectLockConfigurationOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectLockConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectLockConfigurationOutput) GoString() string {
+	return s.String()
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *PutObjectLockConfigurationOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *PutObjectLockConfigurationOutput) SetRequestCharged(v string) *PutObjectLockConfigurationOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type PutObjectOutput struct {
+	// Entity tag for the uploaded object.
+	ETag *string `location:"header" locationName:"ETag" type:"string"`
+
+	// If the object expiration is configured, this will contain the expiration
+	// date (expiry-date) and rule ID (rule-id). The value of rule-id is URL encoded.
+	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+
+	NewFileName *string `location:"header" locationName:"newfilename" type:"string"`
+
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
+
+	// Version of the object.
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	metadataPutObjectOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectOutput) GoString() string {
+	return s.String()
+}
+
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *PutObjectOutput) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
+
+// SetETag sets the value of ETag.
+func (s *PutObjectOutput) SetETag(v string) *PutObjectOutput {
+	s.ETag = &v
+	return s
+}
+
+// GetExpiration returns the value of Expiration, or the zero value if it is not set.
+func (s *PutObjectOutput) GetExpiration() string {
+	if s == nil || s.Expiration == nil {
+		return ""
+	}
+	return *s.Expiration
+}
+
+// SetExpiration sets the value of Expiration.
+func (s *PutObjectOutput) SetExpiration(v string) *PutObjectOutput {
+	s.Expiration = &v
+	return s
+}
+
+// GetNewFileName returns the value of NewFileName, or the zero value if it is not set.
+func (s *PutObjectOutput) GetNewFileName() string {
+	if s == nil || s.NewFileName == nil {
+		return ""
+	}
+	return *s.NewFileName
+}
+
+// SetNewFileName sets the value of NewFileName.
+func (s *PutObjectOutput) SetNewFileName(v string) *PutObjectOutput {
+	s.NewFileName = &v
+	return s
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *PutObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *PutObjectOutput) SetRequestCharged(v string) *PutObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *PutObjectOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *PutObjectOutput) SetSSECustomerAlgorithm(v string) *PutObjectOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *PutObjectOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
+
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *PutObjectOutput) SetSSECustomerKeyMD5(v string) *PutObjectOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
+
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *PutObjectOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
+
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *PutObjectOutput) SetSSEKMSKeyID(v string) *PutObjectOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
+
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *PutObjectOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
+
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *PutObjectOutput) SetServerSideEncryption(v string) *PutObjectOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *PutObjectOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *PutObjectOutput) SetVersionID(v string) *PutObjectOutput {
+	s.VersionID = &v
+	return s
+}
+
+type PutObjectRetentionInput struct {
+	// The bucket that contains the object you want to apply this Object Retention
+	// configuration to.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// Indicates whether this operation should bypass Governance-mode restrictions.
+	BypassGovernanceRetention *bool `location:"header" locationName:"x-amz-bypass-governance-retention" type:"boolean"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The key name for the object that you want to apply this Object Retention
+	// configuration to.
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
+
+	// The container element for the Object Retention configuration.
+	Retention *ObjectLockRetention `locationName:"Retention" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	// The version ID for the object that you want to apply this Object Retention
+	// configuration to.
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataPutObjectRetentionInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectRetentionInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Retention"`
+}
+
+// String returns the string representation.
+func (s PutObjectRetentionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectRetentionInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectRetentionInput) SetBucket(v string) *PutObjectRetentionInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetBypassGovernanceRetention returns the value of BypassGovernanceRetention, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetBypassGovernanceRetention() bool {
+	if s == nil || s.BypassGovernanceRetention == nil {
+		return false
+	}
+	return *s.BypassGovernanceRetention
+}
+
+// SetBypassGovernanceRetention sets the value of BypassGovernanceRetention.
+func (s *PutObjectRetentionInput) SetBypassGovernanceRetention(v bool) *PutObjectRetentionInput {
+	s.BypassGovernanceRetention = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectRetentionInput) SetContentType(v string) *PutObjectRetentionInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *PutObjectRetentionInput) SetKey(v string) *PutObjectRetentionInput {
+	s.Key = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *PutObjectRetentionInput) SetRequestPayer(v string) *PutObjectRetentionInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetRetention returns the value of Retention, or nil if it is not set.
+func (s *PutObjectRetentionInput) GetRetention() *ObjectLockRetention {
+	if s == nil {
+		return nil
+	}
+	return s.Retention
+}
+
+// SetRetention sets the value of Retention.
+func (s *PutObjectRetentionInput) SetRetention(v *ObjectLockRetention) *PutObjectRetentionInput {
+	s.Retention = v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *PutObjectRetentionInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *PutObjectRetentionInput) SetVersionID(v string) *PutObjectRetentionInput {
+	s.VersionID = &v
+	return s
+}
+
+type PutObjectRetentionOutput struct {
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
+
+	metadataPutObjectRetentionOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectRetentionOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectRetentionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectRetentionOutput) GoString() string {
+	return s.String()
+}
+
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *PutObjectRetentionOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
+}
+
+// SetRequestCharged sets the value of RequestCharged.
+func (s *PutObjectRetentionOutput) SetRequestCharged(v string) *PutObjectRetentionOutput {
+	s.RequestCharged = &v
+	return s
+}
+
+type PutObjectTaggingInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	Tagging *Tagging `locationName:"Tagging" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+
+	metadataPutObjectTaggingInput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectTaggingInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Tagging"`
+}
+
+// String returns the string representation.
+func (s PutObjectTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectTaggingInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutObjectTaggingInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutObjectTaggingInput) SetBucket(v string) *PutObjectTaggingInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutObjectTaggingInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutObjectTaggingInput) SetContentType(v string) *PutObjectTaggingInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *PutObjectTaggingInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *PutObjectTaggingInput) SetKey(v string) *PutObjectTaggingInput {
+	s.Key = &v
+	return s
+}
+
+// GetTagging returns the value of Tagging, or nil if it is not set.
+func (s *PutObjectTaggingInput) GetTagging() *Tagging {
+	if s == nil {
+		return nil
+	}
+	return s.Tagging
+}
+
+// SetTagging sets the value of Tagging.
+func (s *PutObjectTaggingInput) SetTagging(v *Tagging) *PutObjectTaggingInput {
+	s.Tagging = v
+	return s
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *PutObjectTaggingInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *PutObjectTaggingInput) SetVersionID(v string) *PutObjectTaggingInput {
+	s.VersionID = &v
+	return s
+}
+
+type PutObjectTaggingOutput struct {
+	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+
+	metadataPutObjectTaggingOutput `json:"-" xml:"-"`
+}
+
+type metadataPutObjectTaggingOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutObjectTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutObjectTaggingOutput) GoString() string {
+	return s.String()
+}
+
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *PutObjectTaggingOutput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
+
+// SetVersionID sets the value of VersionID.
+func (s *PutObjectTaggingOutput) SetVersionID(v string) *PutObjectTaggingOutput {
+	s.VersionID = &v
+	return s
+}
+
+type PutPublicAccessBlockInput struct {
+	// The name of the Amazon S3 bucket whose PublicAccessBlock configuration you
+	// want to set.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
 	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	metadataGetObjectInput `json:"-" xml:"-"`
+	// The PublicAccessBlock configuration that you want to apply to this Amazon
+	// S3 bucket.
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `locationName:"PublicAccessBlockConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+
+	metadataPutPublicAccessBlockInput `json:"-" xml:"-"`
+}
+
+type metadataPutPublicAccessBlockInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"PublicAccessBlockConfiguration"`
+}
+
+// String returns the string representation.
+func (s PutPublicAccessBlockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutPublicAccessBlockInput) GoString() string {
+	return s.String()
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *PutPublicAccessBlockInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *PutPublicAccessBlockInput) SetBucket(v string) *PutPublicAccessBlockInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *PutPublicAccessBlockInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *PutPublicAccessBlockInput) SetContentType(v string) *PutPublicAccessBlockInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetPublicAccessBlockConfiguration returns the value of PublicAccessBlockConfiguration, or nil if it is not set.
+func (s *PutPublicAccessBlockInput) GetPublicAccessBlockConfiguration() *PublicAccessBlockConfiguration {
+	if s == nil {
+		return nil
+	}
+	return s.PublicAccessBlockConfiguration
+}
+
+// SetPublicAccessBlockConfiguration sets the value of PublicAccessBlockConfiguration.
+func (s *PutPublicAccessBlockInput) SetPublicAccessBlockConfiguration(v *PublicAccessBlockConfiguration) *PutPublicAccessBlockInput {
+	s.PublicAccessBlockConfiguration = v
+	return s
+}
+
+type PutPublicAccessBlockOutput struct {
+	metadataPutPublicAccessBlockOutput `json:"-" xml:"-"`
+}
+
+type metadataPutPublicAccessBlockOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s PutPublicAccessBlockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s PutPublicAccessBlockOutput) GoString() string {
+	return s.String()
+}
+
+// Container for specifying an configuration when you want Amazon S3 to publish
+// events to an Amazon Simple Queue Service (Amazon SQS) queue.
+type QueueConfiguration struct {
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true" required:"true"`
+
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
+
+	// Amazon SQS queue ARN to which Amazon S3 will publish a message when it detects
+	// events of specified type.
+	QueueARN *string `locationName:"Queue" type:"string" required:"true"`
+
+	metadataQueueConfiguration `json:"-" xml:"-"`
+}
+
+type metadataQueueConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s QueueConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s QueueConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *QueueConfiguration) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
+}
+
+// SetEvents sets the value of Events.
+func (s *QueueConfiguration) SetEvents(v []*string) *QueueConfiguration {
+	s.Events = v
+	return s
 }
 
-type metadataGetObjectInput struct {
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *QueueConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
+
+// SetID sets the value of ID.
+func (s *QueueConfiguration) SetID(v string) *QueueConfiguration {
+	s.ID = &v
+	return s
+}
+
+// GetQueueARN returns the value of QueueARN, or the zero value if it is not set.
+func (s *QueueConfiguration) GetQueueARN() string {
+	if s == nil || s.QueueARN == nil {
+		return ""
+	}
+	return *s.QueueARN
+}
+
+// SetQueueARN sets the value of QueueARN.
+func (s *QueueConfiguration) SetQueueARN(v string) *QueueConfiguration {
+	s.QueueARN = &v
+	return s
+}
+
+type QueueConfigurationDeprecated struct {
+	// Bucket event for which to send notifications.
+	//
+	// The valid values are the Event enum constants.
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated"`
+
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true"`
+
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
+
+	Queue *string `type:"string"`
+
+	metadataQueueConfigurationDeprecated `json:"-" xml:"-"`
+}
+
+type metadataQueueConfigurationDeprecated struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetObjectOutput struct {
-	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
+// String returns the string representation.
+func (s QueueConfigurationDeprecated) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Object data.
-	Body io.ReadCloser `type:"blob"`
+// GoString returns the string representation.
+func (s QueueConfigurationDeprecated) GoString() string {
+	return s.String()
+}
 
-	// Specifies caching behavior along the request/reply chain.
-	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+// GetEvent returns the value of Event, or the zero value if it is not set.
+func (s *QueueConfigurationDeprecated) GetEvent() string {
+	if s == nil || s.Event == nil {
+		return ""
+	}
+	return *s.Event
+}
 
-	// Specifies presentational information for the object.
-	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+// SetEvent sets the value of Event.
+func (s *QueueConfigurationDeprecated) SetEvent(v string) *QueueConfigurationDeprecated {
+	s.Event = &v
+	return s
+}
 
-	// Specifies what content encodings have been applied to the object and thus
-	// what decoding mechanisms must be applied to obtain the media-type referenced
-	// by the Content-Type header field.
-	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *QueueConfigurationDeprecated) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
+}
 
-	// The language the content is in.
-	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+// SetEvents sets the value of Events.
+func (s *QueueConfigurationDeprecated) SetEvents(v []*string) *QueueConfigurationDeprecated {
+	s.Events = v
+	return s
+}
 
-	// Size of the body in bytes.
-	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *QueueConfigurationDeprecated) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
 
-	// The portion of the object returned in the response.
-	ContentRange *string `location:"header" locationName:"Content-Range" type:"string"`
+// SetID sets the value of ID.
+func (s *QueueConfigurationDeprecated) SetID(v string) *QueueConfigurationDeprecated {
+	s.ID = &v
+	return s
+}
 
-	// A standard MIME type describing the format of the object data.
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetQueue returns the value of Queue, or the zero value if it is not set.
+func (s *QueueConfigurationDeprecated) GetQueue() string {
+	if s == nil || s.Queue == nil {
+		return ""
+	}
+	return *s.Queue
+}
 
-	// Specifies whether the object retrieved was (true) or was not (false) a Delete
-	// Marker. If false, this response header does not appear in the response.
-	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
+// SetQueue sets the value of Queue.
+func (s *QueueConfigurationDeprecated) SetQueue(v string) *QueueConfigurationDeprecated {
+	s.Queue = &v
+	return s
+}
 
-	// An ETag is an opaque identifier assigned by a web server to a specific version
-	// of a resource found at a URL
-	ETag *string `location:"header" locationName:"ETag" type:"string"`
+type RecordsEvent struct {
+	// The byte array of partial, one or more result records.
+	Payload []byte `type:"blob"`
 
-	// If the object expiration is configured (see PUT Bucket lifecycle), the response
-	// includes this header. It includes the expiry-date and rule-id key value pairs
-	// providing object expiration information. The value of the rule-id is URL
-	// encoded.
-	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+	metadataRecordsEvent `json:"-" xml:"-"`
+}
 
-	// The date and time at which the object is no longer cacheable.
-	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+type metadataRecordsEvent struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Last modified date of the object
-	LastModified *time.Time `location:"header" locationName:"Last-Modified" type:"timestamp" timestampFormat:"rfc822"`
+// String returns the string representation.
+func (s RecordsEvent) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// A map of metadata to store with the object in S3.
-	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+// GoString returns the string representation.
+func (s RecordsEvent) GoString() string {
+	return s.String()
+}
 
-	// This is set to the number of metadata entries not returned in x-amz-meta
-	// headers. This can happen if you create metadata using an API like SOAP that
-	// supports more flexible metadata than the REST API. For example, using SOAP,
-	// you can create metadata whose values are not legal HTTP headers.
-	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
+// GetPayload returns the value of Payload, or nil if it is not set.
+func (s *RecordsEvent) GetPayload() []byte {
+	if s == nil {
+		return nil
+	}
+	return s.Payload
+}
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+// SetPayload sets the value of Payload.
+func (s *RecordsEvent) SetPayload(v []byte) *RecordsEvent {
+	s.Payload = v
+	return s
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+type Redirect struct {
+	// The HTTP redirect code to use on the response. Not required if one of the
+	// siblings is present.
+	HTTPRedirectCode *string `locationName:"HttpRedirectCode" type:"string"`
 
-	// Provides information about object restoration operation and expiration time
-	// of the restored object copy.
-	Restore *string `location:"header" locationName:"x-amz-restore" type:"string"`
+	// The host name to use in the redirect request.
+	HostName *string `type:"string"`
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+	// Protocol to use (http, https) when redirecting requests. The default is the
+	// protocol that is used in the original request.
+	//
+	// The valid values are the Protocol enum constants.
+	Protocol *string `type:"string" enum:"http,https"`
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+	// The object key prefix to use in the redirect request. For example, to redirect
+	// requests for all pages with prefix docs/ (objects in the docs/ folder) to
+	// documents/, you can set a condition block with KeyPrefixEquals set to docs/
+	// and in the Redirect set ReplaceKeyPrefixWith to /documents. Not required
+	// if one of the siblings is present. Can be present only if ReplaceKeyWith
+	// is not provided.
+	ReplaceKeyPrefixWith *string `type:"string"`
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	// The specific object key to use in the redirect request. For example, redirect
+	// request to error.html. Not required if one of the sibling is present. Can
+	// be present only if ReplaceKeyPrefixWith is not provided.
+	ReplaceKeyWith *string `type:"string"`
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	metadataRedirect `json:"-" xml:"-"`
+}
 
-	// Version of the object.
-	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+type metadataRedirect struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// If the bucket is configured as a website, redirects requests for this object
-	// to another object in the same bucket or to an external URL. Amazon S3 stores
-	// the value of this header in the object metadata.
-	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+// String returns the string representation.
+func (s Redirect) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataGetObjectOutput `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s Redirect) GoString() string {
+	return s.String()
 }
 
-type metadataGetObjectOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Body"`
+// GetHTTPRedirectCode returns the value of HTTPRedirectCode, or the zero value if it is not set.
+func (s *Redirect) GetHTTPRedirectCode() string {
+	if s == nil || s.HTTPRedirectCode == nil {
+		return ""
+	}
+	return *s.HTTPRedirectCode
 }
 
-type GetObjectTorrentInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetHTTPRedirectCode sets the value of HTTPRedirectCode.
+func (s *Redirect) SetHTTPRedirectCode(v string) *Redirect {
+	s.HTTPRedirectCode = &v
+	return s
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// GetHostName returns the value of HostName, or the zero value if it is not set.
+func (s *Redirect) GetHostName() string {
+	if s == nil || s.HostName == nil {
+		return ""
+	}
+	return *s.HostName
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// SetHostName sets the value of HostName.
+func (s *Redirect) SetHostName(v string) *Redirect {
+	s.HostName = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetProtocol returns the value of Protocol, or the zero value if it is not set.
+func (s *Redirect) GetProtocol() string {
+	if s == nil || s.Protocol == nil {
+		return ""
+	}
+	return *s.Protocol
+}
 
-	metadataGetObjectTorrentInput `json:"-" xml:"-"`
+// SetProtocol sets the value of Protocol.
+func (s *Redirect) SetProtocol(v string) *Redirect {
+	s.Protocol = &v
+	return s
 }
 
-type metadataGetObjectTorrentInput struct {
+// GetReplaceKeyPrefixWith returns the value of ReplaceKeyPrefixWith, or the zero value if it is not set.
+func (s *Redirect) GetReplaceKeyPrefixWith() string {
+	if s == nil || s.ReplaceKeyPrefixWith == nil {
+		return ""
+	}
+	return *s.ReplaceKeyPrefixWith
+}
+
+// SetReplaceKeyPrefixWith sets the value of ReplaceKeyPrefixWith.
+func (s *Redirect) SetReplaceKeyPrefixWith(v string) *Redirect {
+	s.ReplaceKeyPrefixWith = &v
+	return s
+}
+
+// GetReplaceKeyWith returns the value of ReplaceKeyWith, or the zero value if it is not set.
+func (s *Redirect) GetReplaceKeyWith() string {
+	if s == nil || s.ReplaceKeyWith == nil {
+		return ""
+	}
+	return *s.ReplaceKeyWith
+}
+
+// SetReplaceKeyWith sets the value of ReplaceKeyWith.
+func (s *Redirect) SetReplaceKeyWith(v string) *Redirect {
+	s.ReplaceKeyWith = &v
+	return s
+}
+
+type RedirectAllRequestsTo struct {
+	// Name of the host where requests will be redirected.
+	HostName *string `type:"string" required:"true"`
+
+	// Protocol to use (http, https) when redirecting requests. The default is the
+	// protocol that is used in the original request.
+	//
+	// The valid values are the Protocol enum constants.
+	Protocol *string `type:"string" enum:"http,https"`
+
+	metadataRedirectAllRequestsTo `json:"-" xml:"-"`
+}
+
+type metadataRedirectAllRequestsTo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetObjectTorrentOutput struct {
-	Body io.ReadCloser `type:"blob"`
+// String returns the string representation.
+func (s RedirectAllRequestsTo) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// GoString returns the string representation.
+func (s RedirectAllRequestsTo) GoString() string {
+	return s.String()
+}
+
+// GetHostName returns the value of HostName, or the zero value if it is not set.
+func (s *RedirectAllRequestsTo) GetHostName() string {
+	if s == nil || s.HostName == nil {
+		return ""
+	}
+	return *s.HostName
+}
+
+// SetHostName sets the value of HostName.
+func (s *RedirectAllRequestsTo) SetHostName(v string) *RedirectAllRequestsTo {
+	s.HostName = &v
+	return s
+}
+
+// GetProtocol returns the value of Protocol, or the zero value if it is not set.
+func (s *RedirectAllRequestsTo) GetProtocol() string {
+	if s == nil || s.Protocol == nil {
+		return ""
+	}
+	return *s.Protocol
+}
+
+// SetProtocol sets the value of Protocol.
+func (s *RedirectAllRequestsTo) SetProtocol(v string) *RedirectAllRequestsTo {
+	s.Protocol = &v
+	return s
+}
+
+// Container for replication rules. You can add as many as 1,000 rules. Total
+// replication configuration size can be up to 2 MB.
+type ReplicationConfiguration struct {
+	// Amazon Resource Name (ARN) of an IAM role for Amazon S3 to assume when replicating
+	// the objects.
+	Role *string `type:"string" required:"true"`
+
+	// Container for information about a particular replication rule. Replication
+	// configuration must have at least one rule and can contain up to 1,000 rules.
+	Rules []*ReplicationRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+
+	metadataReplicationConfiguration `json:"-" xml:"-"`
+}
+
+type metadataReplicationConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ReplicationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ReplicationConfiguration) GoString() string {
+	return s.String()
+}
+
+// GetRole returns the value of Role, or the zero value if it is not set.
+func (s *ReplicationConfiguration) GetRole() string {
+	if s == nil || s.Role == nil {
+		return ""
+	}
+	return *s.Role
+}
+
+// SetRole sets the value of Role.
+func (s *ReplicationConfiguration) SetRole(v string) *ReplicationConfiguration {
+	s.Role = &v
+	return s
+}
+
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *ReplicationConfiguration) GetRules() []*ReplicationRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// SetRules sets the value of Rules.
+func (s *ReplicationConfiguration) SetRules(v []*ReplicationRule) *ReplicationConfiguration {
+	s.Rules = v
+	return s
+}
+
+type ReplicationRule struct {
+	Destination *Destination `type:"structure" required:"true"`
+
+	// Unique identifier for the rule. The value cannot be longer than 255 characters.
+	ID *string `type:"string"`
+
+	// Object keyname prefix identifying one or more objects to which the rule applies.
+	// Maximum prefix length can be up to 1,024 characters. Overlapping prefixes
+	// are not supported.
+	Prefix *string `type:"string" required:"true"`
+
+	// The rule is ignored if status is not Enabled.
+	//
+	// The valid values are the ReplicationRuleStatus enum constants.
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
+
+	metadataReplicationRule `json:"-" xml:"-"`
+}
+
+type metadataReplicationRule struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation.
+func (s ReplicationRule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s ReplicationRule) GoString() string {
+	return s.String()
+}
+
+// GetDestination returns the value of Destination, or nil if it is not set.
+func (s *ReplicationRule) GetDestination() *Destination {
+	if s == nil {
+		return nil
+	}
+	return s.Destination
+}
 
-	metadataGetObjectTorrentOutput `json:"-" xml:"-"`
+// SetDestination sets the value of Destination.
+func (s *ReplicationRule) SetDestination(v *Destination) *ReplicationRule {
+	s.Destination = v
+	return s
 }
 
-type metadataGetObjectTorrentOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Body"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *ReplicationRule) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
 }
 
-type Grant struct {
-	Grantee *Grantee `type:"structure"`
+// SetID sets the value of ID.
+func (s *ReplicationRule) SetID(v string) *ReplicationRule {
+	s.ID = &v
+	return s
+}
 
-	// Specifies the permission given to the grantee.
-	Permission *string `type:"string"`
+// GetPrefix returns the value of Prefix, or the zero value if it is not set.
+func (s *ReplicationRule) GetPrefix() string {
+	if s == nil || s.Prefix == nil {
+		return ""
+	}
+	return *s.Prefix
+}
 
-	metadataGrant `json:"-" xml:"-"`
+// SetPrefix sets the value of Prefix.
+func (s *ReplicationRule) SetPrefix(v string) *ReplicationRule {
+	s.Prefix = &v
+	return s
 }
 
-type metadataGrant struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetStatus returns the value of Status, or the zero value if it is not set.
+func (s *ReplicationRule) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
 }
 
-type Grantee struct {
-	// Screen name of the grantee.
-	DisplayName *string `type:"string"`
+// SetStatus sets the value of Status.
+func (s *ReplicationRule) SetStatus(v string) *ReplicationRule {
+	s.Status = &v
+	return s
+}
 
-	// Email address of the grantee.
-	EmailAddress *string `type:"string"`
+type RequestPaymentConfiguration struct {
+	// Specifies who pays for the download and request fees.
+	//
+	// The valid values are the Payer enum constants.
+	Payer *string `type:"string" enum:"Requester,BucketOwner" required:"true"`
 
-	// The canonical user ID of the grantee.
-	ID *string `type:"string"`
+	metadataRequestPaymentConfiguration `json:"-" xml:"-"`
+}
 
-	// Type of grantee
-	Type *string `locationName:"xsi:type" type:"string" xmlAttribute:"true" required:"true"`
+type metadataRequestPaymentConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// URI of the grantee group.
-	URI *string `type:"string"`
+// String returns the string representation.
+func (s RequestPaymentConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataGrantee `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s RequestPaymentConfiguration) GoString() string {
+	return s.String()
 }
 
-type metadataGrantee struct {
-	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
+// GetPayer returns the value of Payer, or the zero value if it is not set.
+func (s *RequestPaymentConfiguration) GetPayer() string {
+	if s == nil || s.Payer == nil {
+		return ""
+	}
+	return *s.Payer
 }
 
-type HeadBucketInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetPayer sets the value of Payer.
+func (s *RequestPaymentConfiguration) SetPayer(v string) *RequestPaymentConfiguration {
+	s.Payer = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+type RequestProgress struct {
+	// Specifies whether periodic QueryProgress frames should be sent.
+	Enabled *bool `type:"boolean"`
 
-	metadataHeadBucketInput `json:"-" xml:"-"`
+	metadataRequestProgress `json:"-" xml:"-"`
 }
 
-type metadataHeadBucketInput struct {
+type metadataRequestProgress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type HeadBucketOutput struct {
-	metadataHeadBucketOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s RequestProgress) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataHeadBucketOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s RequestProgress) GoString() string {
+	return s.String()
 }
 
-type HeadObjectInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	// Return the object only if its entity tag (ETag) is the same as the one specified,
-	// otherwise return a 412 (precondition failed).
-	IfMatch *string `location:"header" locationName:"If-Match" type:"string"`
+// GetEnabled returns the value of Enabled, or the zero value if it is not set.
+func (s *RequestProgress) GetEnabled() bool {
+	if s == nil || s.Enabled == nil {
+		return false
+	}
+	return *s.Enabled
+}
 
-	// Return the object only if it has been modified since the specified time,
-	// otherwise return a 304 (not modified).
-	IfModifiedSince *time.Time `location:"header" locationName:"If-Modified-Since" type:"timestamp" timestampFormat:"rfc822"`
+// SetEnabled sets the value of Enabled.
+func (s *RequestProgress) SetEnabled(v bool) *RequestProgress {
+	s.Enabled = &v
+	return s
+}
 
-	// Return the object only if its entity tag (ETag) is different from the one
-	// specified, otherwise return a 304 (not modified).
-	IfNoneMatch *string `location:"header" locationName:"If-None-Match" type:"string"`
+type RestoreObjectInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	// Return the object only if it has not been modified since the specified time,
-	// otherwise return a 412 (precondition failed).
-	IfUnmodifiedSince *time.Time `location:"header" locationName:"If-Unmodified-Since" type:"timestamp" timestampFormat:"rfc822"`
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
 	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	// Downloads the specified range bytes of an object. For more information about
-	// the HTTP Range header, go to http://www.w3.org/Protocols/rfc2616/rfc2616-sec14.html#sec14.35.
-	Range *string `location:"header" locationName:"Range" type:"string"`
-
 	// Confirms that the requester knows that she or he will be charged for the
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
-
-	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
-	// aws:kms).
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
-
-	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
-	// data. This value is used to store the object and then it is discarded; Amazon
-	// does not store the encryption key. The key must be appropriate for use with
-	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+	RestoreRequest *RestoreRequest `locationName:"RestoreRequest" type:"structure"`
 
-	// VersionId used to reference a specific version of the object.
 	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
-
-	metadataHeadObjectInput `json:"-" xml:"-"`
+	metadataRestoreObjectInput `json:"-" xml:"-"`
 }
 
-type metadataHeadObjectInput struct {
-	SDKShapeTraits bool `type:"structure"`
+type metadataRestoreObjectInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"RestoreRequest"`
 }
 
-type HeadObjectOutput struct {
-	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
-
-	// Specifies caching behavior along the request/reply chain.
-	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+// String returns the string representation.
+func (s RestoreObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Specifies presentational information for the object.
-	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+// GoString returns the string representation.
+func (s RestoreObjectInput) GoString() string {
+	return s.String()
+}
 
-	// Specifies what content encodings have been applied to the object and thus
-	// what decoding mechanisms must be applied to obtain the media-type referenced
-	// by the Content-Type header field.
-	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *RestoreObjectInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
 
-	// The language the content is in.
-	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+// SetBucket sets the value of Bucket.
+func (s *RestoreObjectInput) SetBucket(v string) *RestoreObjectInput {
+	s.Bucket = &v
+	return s
+}
 
-	// Size of the body in bytes.
-	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *RestoreObjectInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
 
-	// A standard MIME type describing the format of the object data.
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetContentType sets the value of ContentType.
+func (s *RestoreObjectInput) SetContentType(v string) *RestoreObjectInput {
+	s.ContentType = &v
+	return s
+}
 
-	// Specifies whether the object retrieved was (true) or was not (false) a Delete
-	// Marker. If false, this response header does not appear in the response.
-	DeleteMarker *bool `location:"header" locationName:"x-amz-delete-marker" type:"boolean"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *RestoreObjectInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
 
-	// An ETag is an opaque identifier assigned by a web server to a specific version
-	// of a resource found at a URL
-	ETag *string `location:"header" locationName:"ETag" type:"string"`
+// SetKey sets the value of Key.
+func (s *RestoreObjectInput) SetKey(v string) *RestoreObjectInput {
+	s.Key = &v
+	return s
+}
 
-	// If the object expiration is configured (see PUT Bucket lifecycle), the response
-	// includes this header. It includes the expiry-date and rule-id key value pairs
-	// providing object expiration information. The value of the rule-id is URL
-	// encoded.
-	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *RestoreObjectInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
 
-	// The date and time at which the object is no longer cacheable.
-	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+// SetRequestPayer sets the value of RequestPayer.
+func (s *RestoreObjectInput) SetRequestPayer(v string) *RestoreObjectInput {
+	s.RequestPayer = &v
+	return s
+}
 
-	// Last modified date of the object
-	LastModified *time.Time `location:"header" locationName:"Last-Modified" type:"timestamp" timestampFormat:"rfc822"`
+// GetRestoreRequest returns the value of RestoreRequest, or nil if it is not set.
+func (s *RestoreObjectInput) GetRestoreRequest() *RestoreRequest {
+	if s == nil {
+		return nil
+	}
+	return s.RestoreRequest
+}
 
-	// A map of metadata to store with the object in S3.
-	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+// SetRestoreRequest sets the value of RestoreRequest.
+func (s *RestoreObjectInput) SetRestoreRequest(v *RestoreRequest) *RestoreObjectInput {
+	s.RestoreRequest = v
+	return s
+}
 
-	// This is set to the number of metadata entries not returned in x-amz-meta
-	// headers. This can happen if you create metadata using an API like SOAP that
-	// supports more flexible metadata than the REST API. For example, using SOAP,
-	// you can create metadata whose values are not legal HTTP headers.
-	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
+// GetVersionID returns the value of VersionID, or the zero value if it is not set.
+func (s *RestoreObjectInput) GetVersionID() string {
+	if s == nil || s.VersionID == nil {
+		return ""
+	}
+	return *s.VersionID
+}
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+// SetVersionID sets the value of VersionID.
+func (s *RestoreObjectInput) SetVersionID(v string) *RestoreObjectInput {
+	s.VersionID = &v
+	return s
+}
 
+type RestoreObjectOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
-
-	// Provides information about object restoration operation and expiration time
-	// of the restored object copy.
-	Restore *string `location:"header" locationName:"x-amz-restore" type:"string"`
-
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
-
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
-
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
-
-	// Version of the object.
-	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
-
-	// If the bucket is configured as a website, redirects requests for this object
-	// to another object in the same bucket or to an external URL. Amazon S3 stores
-	// the value of this header in the object metadata.
-	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
-
-	metadataHeadObjectOutput `json:"-" xml:"-"`
+	metadataRestoreObjectOutput `json:"-" xml:"-"`
 }
 
-type metadataHeadObjectOutput struct {
+type metadataRestoreObjectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type IndexDocument struct {
-	// A suffix that is appended to a request that is for a directory on the website
-	// endpoint (e.g. if the suffix is index.html and you make a request to samplebucket/images/
-	// the data that is returned will be for the object with the key name images/index.html)
-	// The suffix must not be empty and must not include a slash character.
-	Suffix *string `type:"string" required:"true"`
+// String returns the string representation.
+func (s RestoreObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataIndexDocument `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s RestoreObjectOutput) GoString() string {
+	return s.String()
 }
 
-type metadataIndexDocument struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *RestoreObjectOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
 }
 
-type Initiator struct {
-	// Name of the Principal.
-	DisplayName *string `type:"string"`
+// SetRequestCharged sets the value of RequestCharged.
+func (s *RestoreObjectOutput) SetRequestCharged(v string) *RestoreObjectOutput {
+	s.RequestCharged = &v
+	return s
+}
 
-	// If the principal is an AWS account, it provides the Canonical User ID. If
-	// the principal is an IAM User, it provides a user ARN value.
-	ID *string `type:"string"`
+type RestoreRequest struct {
+	// Lifetime of the active copy in days
+	Days *int64 `type:"integer" required:"true"`
 
-	metadataInitiator `json:"-" xml:"-"`
+	metadataRestoreRequest `json:"-" xml:"-"`
 }
 
-type metadataInitiator struct {
+type metadataRestoreRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Container for specifying the AWS Lambda notification configuration.
-type LambdaFunctionConfiguration struct {
-	Events []*string `locationName:"Event" type:"list" flattened:"true" required:"true"`
-
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+// String returns the string representation.
+func (s RestoreRequest) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Lambda cloud function ARN that Amazon S3 can invoke when it detects events
-	// of the specified type.
-	LambdaFunctionARN *string `locationName:"CloudFunction" type:"string" required:"true"`
+// GoString returns the string representation.
+func (s RestoreRequest) GoString() string {
+	return s.String()
+}
 
-	metadataLambdaFunctionConfiguration `json:"-" xml:"-"`
+// GetDays returns the value of Days, or the zero value if it is not set.
+func (s *RestoreRequest) GetDays() int64 {
+	if s == nil || s.Days == nil {
+		return 0
+	}
+	return *s.Days
 }
 
-type metadataLambdaFunctionConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetDays sets the value of Days.
+func (s *RestoreRequest) SetDays(v int64) *RestoreRequest {
+	s.Days = &v
+	return s
 }
 
-type LifecycleConfiguration struct {
-	Rules []*LifecycleRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+type RoutingRule struct {
+	// A container for describing a condition that must be met for the specified
+	// redirect to apply. For example, 1. If request is for pages in the /docs folder,
+	// redirect to the /documents folder. 2. If request results in HTTP error 4xx,
+	// redirect request to another host where you might process the error.
+	Condition *Condition `type:"structure"`
 
-	metadataLifecycleConfiguration `json:"-" xml:"-"`
+	// Container for redirect information. You can redirect requests to another
+	// host, to another page, or with another protocol. In the event of an error,
+	// you can can specify a different error code to return.
+	Redirect *Redirect `type:"structure" required:"true"`
+
+	metadataRoutingRule `json:"-" xml:"-"`
 }
 
-type metadataLifecycleConfiguration struct {
+type metadataRoutingRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type LifecycleExpiration struct {
-	// Indicates at what date the object is to be moved or deleted. Should be in
-	// GMT ISO 8601 Format.
-	Date *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+// String returns the string representation.
+func (s RoutingRule) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Indicates the lifetime, in days, of the objects that are subject to the rule.
-	// The value must be a non-zero positive integer.
-	Days *int64 `type:"integer"`
+// GoString returns the string representation.
+func (s RoutingRule) GoString() string {
+	return s.String()
+}
 
-	metadataLifecycleExpiration `json:"-" xml:"-"`
+// GetCondition returns the value of Condition, or nil if it is not set.
+func (s *RoutingRule) GetCondition() *Condition {
+	if s == nil {
+		return nil
+	}
+	return s.Condition
 }
 
-type LifecycleFilter struct {
-	Prefix *string `type:"string" required:"true"`
+// SetCondition sets the value of Condition.
+func (s *RoutingRule) SetCondition(v *Condition) *RoutingRule {
+	s.Condition = v
+	return s
 }
 
-type metadataLifecycleExpiration struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRedirect returns the value of Redirect, or nil if it is not set.
+func (s *RoutingRule) GetRedirect() *Redirect {
+	if s == nil {
+		return nil
+	}
+	return s.Redirect
 }
 
-type LifecycleRule struct {
-	Expiration *LifecycleExpiration `type:"structure"`
+// SetRedirect sets the value of Redirect.
+func (s *RoutingRule) SetRedirect(v *Redirect) *RoutingRule {
+	s.Redirect = v
+	return s
+}
 
-	// Unique identifier for the rule. The value cannot be longer than 255 characters.
-	ID *string `type:"string"`
+type SSEKMS struct {
+	// Specifies the ID of the AWS Key Management Service (KMS) master encryption
+	// key to use for encrypting Inventory reports.
+	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
 
-	// Specifies when noncurrent object versions expire. Upon expiration, Amazon
-	// S3 permanently deletes the noncurrent object versions. You set this lifecycle
-	// configuration action on a bucket that has versioning enabled (or suspended)
-	// to request that Amazon S3 delete noncurrent object versions at a specific
-	// period in the object's lifetime.
-	NoncurrentVersionExpiration *NoncurrentVersionExpiration `type:"structure"`
+	metadataSSEKMS `json:"-" xml:"-"`
+}
 
-	// Container for the transition rule that describes when noncurrent objects
-	// transition to the GLACIER storage class. If your bucket is versioning-enabled
-	// (or versioning is suspended), you can set this action to request that Amazon
-	// S3 transition noncurrent object versions to the GLACIER storage class at
-	// a specific period in the object's lifetime.
-	NoncurrentVersionTransition *NoncurrentVersionTransition `type:"structure"`
+type metadataSSEKMS struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Prefix identifying one or more objects to which the rule applies.
-	Filter *LifecycleFilter `type:"structure"`
+// String returns the string representation.
+func (s SSEKMS) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// If 'Enabled', the rule is currently being applied. If 'Disabled', the rule
-	// is not currently being applied.
-	Status *string `type:"string" required:"true"`
+// GoString returns the string representation.
+func (s SSEKMS) GoString() string {
+	return s.String()
+}
 
-	Transition *Transition `type:"structure"`
+// GetKeyID returns the value of KeyID, or the zero value if it is not set.
+func (s *SSEKMS) GetKeyID() string {
+	if s == nil || s.KeyID == nil {
+		return ""
+	}
+	return *s.KeyID
+}
 
-	metadataLifecycleRule `json:"-" xml:"-"`
+// SetKeyID sets the value of KeyID.
+func (s *SSEKMS) SetKeyID(v string) *SSEKMS {
+	s.KeyID = &v
+	return s
 }
 
-type metadataLifecycleRule struct {
+type SSES3 struct {
+	metadataSSES3 `json:"-" xml:"-"`
+}
+
+type metadataSSES3 struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ListBucketsInput struct {
-	ContentType              *string `location:"header" locationName:"Content-Type" type:"string"`
-	metadataListBucketsInput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s SSES3) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataListBucketsInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s SSES3) GoString() string {
+	return s.String()
 }
 
-type ListBucketsOutput struct {
-	Buckets []*Bucket `locationNameList:"Bucket" type:"list"`
+type ScanRange struct {
+	// Specifies the end of the byte range. This parameter is optional.
+	End *int64 `type:"long"`
 
-	Owner *Owner `type:"structure"`
+	// Specifies the start of the byte range. This parameter is optional.
+	Start *int64 `type:"long"`
 
-	metadataListBucketsOutput `json:"-" xml:"-"`
+	metadataScanRange `json:"-" xml:"-"`
 }
 
-type metadataListBucketsOutput struct {
+type metadataScanRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ListMultipartUploadsInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// String returns the string representation.
+func (s ScanRange) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Character you use to group keys.
-	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+// GoString returns the string representation.
+func (s ScanRange) GoString() string {
+	return s.String()
+}
 
-	// Requests Amazon S3 to encode the object keys in the response and specifies
-	// the encoding method to use. An object key may contain any Unicode character;
-	// however, XML 1.0 parser cannot parse some characters, such as characters
-	// with an ASCII value from 0 to 10. For characters that are not supported in
-	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
-	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+// GetEnd returns the value of End, or the zero value if it is not set.
+func (s *ScanRange) GetEnd() int64 {
+	if s == nil || s.End == nil {
+		return 0
+	}
+	return *s.End
+}
 
-	// Together with upload-id-marker, this parameter specifies the multipart upload
-	// after which listing should begin.
-	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
+// SetEnd sets the value of End.
+func (s *ScanRange) SetEnd(v int64) *ScanRange {
+	s.End = &v
+	return s
+}
 
-	// Sets the maximum number of multipart uploads, from 1 to 1,000, to return
-	// in the response body. 1,000 is the maximum number of uploads that can be
-	// returned in a response.
-	MaxUploads *int64 `location:"querystring" locationName:"max-uploads" type:"integer"`
+// GetStart returns the value of Start, or the zero value if it is not set.
+func (s *ScanRange) GetStart() int64 {
+	if s == nil || s.Start == nil {
+		return 0
+	}
+	return *s.Start
+}
 
-	// Lists in-progress uploads only for those keys that begin with the specified
-	// prefix.
-	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+// SetStart sets the value of Start.
+func (s *ScanRange) SetStart(v int64) *ScanRange {
+	s.Start = &v
+	return s
+}
 
-	// Together with key-marker, specifies the multipart upload after which listing
-	// should begin. If key-marker is not specified, the upload-id-marker parameter
-	// is ignored.
-	UploadIDMarker *string `location:"querystring" locationName:"upload-id-marker" type:"string"`
+type SelectObjectContentInput struct {
+	// The S3 bucket.
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
 	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	metadataListMultipartUploadsInput `json:"-" xml:"-"`
-}
+	// The expression that is used to query the object.
+	Expression *string `type:"string" required:"true"`
 
-type metadataListMultipartUploadsInput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// The type of the provided expression (for example, SQL).
+	//
+	// The valid values are the ExpressionType enum constants.
+	ExpressionType *string `type:"string" enum:"SQL" required:"true"`
 
-type ListMultipartUploadsOutput struct {
-	// Name of the bucket to which the multipart upload was initiated.
-	Bucket *string `type:"string"`
+	// Describes the format of the data in the object that is being queried.
+	InputSerialization *InputSerialization `type:"structure" required:"true"`
 
-	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+	// The object key.
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	Delimiter *string `type:"string"`
+	// Describes the format of the data that you want Amazon S3 to return in response.
+	OutputSerialization *OutputSerialization `type:"structure" required:"true"`
 
-	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	// Specifies if periodic request progress information should be enabled.
+	RequestProgress *RequestProgress `type:"structure"`
 
-	// Indicates whether the returned list of multipart uploads is truncated. A
-	// value of true indicates that the list was truncated. The list can be truncated
-	// if the number of multipart uploads exceeds the limit allowed or specified
-	// by max uploads.
-	IsTruncated *bool `type:"boolean"`
+	// The SSE Algorithm used to encrypt the object.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
 
-	// The key at or after which the listing began.
-	KeyMarker *string `type:"string"`
+	// The SSE Customer Key.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
 
-	// Maximum number of multipart uploads that could have been included in the
-	// response.
-	MaxUploads *int64 `type:"integer"`
+	// The SSE Customer Key MD5.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
 
-	// When a list is truncated, this element specifies the value that should be
-	// used for the key-marker request parameter in a subsequent request.
-	NextKeyMarker *string `type:"string"`
+	// Specifies the byte range of the object to get the records from. A record
+	// is processed when its first byte is contained by the range.
+	ScanRange *ScanRange `type:"structure"`
 
-	// When a list is truncated, this element specifies the value that should be
-	// used for the upload-id-marker request parameter in a subsequent request.
-	NextUploadIDMarker *string `locationName:"NextUploadIdMarker" type:"string"`
+	metadataSelectObjectContentInput `json:"-" xml:"-"`
+}
 
-	// When a prefix is provided in the request, this field contains the specified
-	// prefix. The result contains only keys starting with the specified prefix.
-	Prefix *string `type:"string"`
+type metadataSelectObjectContentInput struct {
+	SDKShapeTraits bool `locationName:"SelectObjectContentRequest" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
 
-	// Upload ID after which listing began.
-	UploadIDMarker *string `locationName:"UploadIdMarker" type:"string"`
+// String returns the string representation.
+func (s SelectObjectContentInput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	Uploads []*MultipartUpload `locationName:"Upload" type:"list" flattened:"true"`
+// GoString returns the string representation.
+func (s SelectObjectContentInput) GoString() string {
+	return s.String()
+}
 
-	metadataListMultipartUploadsOutput `json:"-" xml:"-"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
 }
 
-type metadataListMultipartUploadsOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetBucket sets the value of Bucket.
+func (s *SelectObjectContentInput) SetBucket(v string) *SelectObjectContentInput {
+	s.Bucket = &v
+	return s
 }
 
-type ListObjectVersionsInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
 
-	// A delimiter is a character you use to group keys.
-	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+// SetContentType sets the value of ContentType.
+func (s *SelectObjectContentInput) SetContentType(v string) *SelectObjectContentInput {
+	s.ContentType = &v
+	return s
+}
 
-	// Requests Amazon S3 to encode the object keys in the response and specifies
-	// the encoding method to use. An object key may contain any Unicode character;
-	// however, XML 1.0 parser cannot parse some characters, such as characters
-	// with an ASCII value from 0 to 10. For characters that are not supported in
-	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
-	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+// GetExpression returns the value of Expression, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetExpression() string {
+	if s == nil || s.Expression == nil {
+		return ""
+	}
+	return *s.Expression
+}
 
-	// Specifies the key to start with when listing objects in a bucket.
-	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
+// SetExpression sets the value of Expression.
+func (s *SelectObjectContentInput) SetExpression(v string) *SelectObjectContentInput {
+	s.Expression = &v
+	return s
+}
 
-	// Sets the maximum number of keys returned in the response. The response might
-	// contain fewer keys but will never contain more.
-	MaxKeys *int64 `location:"querystring" locationName:"max-keys" type:"integer"`
+// GetExpressionType returns the value of ExpressionType, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetExpressionType() string {
+	if s == nil || s.ExpressionType == nil {
+		return ""
+	}
+	return *s.ExpressionType
+}
 
-	// Limits the response to keys that begin with the specified prefix.
-	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+// SetExpressionType sets the value of ExpressionType.
+func (s *SelectObjectContentInput) SetExpressionType(v string) *SelectObjectContentInput {
+	s.ExpressionType = &v
+	return s
+}
 
-	// Specifies the object version you want to start listing from.
-	VersionIDMarker *string `location:"querystring" locationName:"version-id-marker" type:"string"`
+// GetInputSerialization returns the value of InputSerialization, or nil if it is not set.
+func (s *SelectObjectContentInput) GetInputSerialization() *InputSerialization {
+	if s == nil {
+		return nil
+	}
+	return s.InputSerialization
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetInputSerialization sets the value of InputSerialization.
+func (s *SelectObjectContentInput) SetInputSerialization(v *InputSerialization) *SelectObjectContentInput {
+	s.InputSerialization = v
+	return s
+}
 
-	metadataListObjectVersionsInput `json:"-" xml:"-"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
 }
 
-type metadataListObjectVersionsInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetKey sets the value of Key.
+func (s *SelectObjectContentInput) SetKey(v string) *SelectObjectContentInput {
+	s.Key = &v
+	return s
 }
 
-type ListObjectVersionsOutput struct {
-	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+// GetOutputSerialization returns the value of OutputSerialization, or nil if it is not set.
+func (s *SelectObjectContentInput) GetOutputSerialization() *OutputSerialization {
+	if s == nil {
+		return nil
+	}
+	return s.OutputSerialization
+}
 
-	DeleteMarkers []*DeleteMarkerEntry `locationName:"DeleteMarker" type:"list" flattened:"true"`
+// SetOutputSerialization sets the value of OutputSerialization.
+func (s *SelectObjectContentInput) SetOutputSerialization(v *OutputSerialization) *SelectObjectContentInput {
+	s.OutputSerialization = v
+	return s
+}
 
-	Delimiter *string `type:"string"`
+// GetRequestProgress returns the value of RequestProgress, or nil if it is not set.
+func (s *SelectObjectContentInput) GetRequestProgress() *RequestProgress {
+	if s == nil {
+		return nil
+	}
+	return s.RequestProgress
+}
 
-	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+// SetRequestProgress sets the value of RequestProgress.
+func (s *SelectObjectContentInput) SetRequestProgress(v *RequestProgress) *SelectObjectContentInput {
+	s.RequestProgress = v
+	return s
+}
 
-	// A flag that indicates whether or not Amazon S3 returned all of the results
-	// that satisfied the search criteria. If your results were truncated, you can
-	// make a follow-up paginated request using the NextKeyMarker and NextVersionIdMarker
-	// response parameters as a starting place in another request to return the
-	// rest of the results.
-	IsTruncated *bool `type:"boolean"`
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
 
-	// Marks the last Key returned in a truncated response.
-	KeyMarker *string `type:"string"`
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *SelectObjectContentInput) SetSSECustomerAlgorithm(v string) *SelectObjectContentInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
 
-	MaxKeys *int64 `type:"integer"`
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
 
-	Name *string `type:"string"`
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *SelectObjectContentInput) SetSSECustomerKey(v string) *SelectObjectContentInput {
+	s.SSECustomerKey = &v
+	return s
+}
 
-	// Use this value for the key marker request parameter in a subsequent request.
-	NextKeyMarker *string `type:"string"`
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *SelectObjectContentInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
 
-	// Use this value for the next version id marker parameter in a subsequent request.
-	NextVersionIDMarker *string `locationName:"NextVersionIdMarker" type:"string"`
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *SelectObjectContentInput) SetSSECustomerKeyMD5(v string) *SelectObjectContentInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
 
-	Prefix *string `type:"string"`
+// GetScanRange returns the value of ScanRange, or nil if it is not set.
+func (s *SelectObjectContentInput) GetScanRange() *ScanRange {
+	if s == nil {
+		return nil
+	}
+	return s.ScanRange
+}
 
-	VersionIDMarker *string `locationName:"VersionIdMarker" type:"string"`
+// SetScanRange sets the value of ScanRange.
+func (s *SelectObjectContentInput) SetScanRange(v *ScanRange) *SelectObjectContentInput {
+	s.ScanRange = v
+	return s
+}
 
-	Versions []*ObjectVersion `locationName:"Version" type:"list" flattened:"true"`
+type SelectObjectContentOutput struct {
+	// The stream of Records, Stats, Progress, Continuation and End events of the
+	// query. Close the stream once done reading the events.
+	EventStream *SelectObjectContentEventStream `type:"eventstream"`
 
-	metadataListObjectVersionsOutput `json:"-" xml:"-"`
+	metadataSelectObjectContentOutput `json:"-" xml:"-"`
 }
 
-type metadataListObjectVersionsOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+type metadataSelectObjectContentOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"EventStream"`
 }
 
-type ListObjectsInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	// A delimiter is a character you use to group keys.
-	Delimiter *string `location:"querystring" locationName:"delimiter" type:"string"`
+// String returns the string representation.
+func (s SelectObjectContentOutput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Requests Amazon S3 to encode the object keys in the response and specifies
-	// the encoding method to use. An object key may contain any Unicode character;
-	// however, XML 1.0 parser cannot parse some characters, such as characters
-	// with an ASCII value from 0 to 10. For characters that are not supported in
-	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
-	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+// GoString returns the string representation.
+func (s SelectObjectContentOutput) GoString() string {
+	return s.String()
+}
 
-	// Specifies the key to start with when listing objects in a bucket.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+// GetEventStream returns the value of EventStream, or nil if it is not set.
+func (s *SelectObjectContentOutput) GetEventStream() *SelectObjectContentEventStream {
+	if s == nil {
+		return nil
+	}
+	return s.EventStream
+}
 
-	// Sets the maximum number of keys returned in the response. The response might
-	// contain fewer keys but will never contain more.
-	MaxKeys *int64 `location:"querystring" locationName:"max-keys" type:"integer"`
+// SetEventStream sets the value of EventStream.
+func (s *SelectObjectContentOutput) SetEventStream(v *SelectObjectContentEventStream) *SelectObjectContentOutput {
+	s.EventStream = v
+	return s
+}
 
-	// Limits the response to keys that begin with the specified prefix.
-	Prefix *string `location:"querystring" locationName:"prefix" type:"string"`
+type ServerSideEncryptionByDefault struct {
+	// KMS master key ID to use for the default encryption. This parameter is allowed
+	// if SSEAlgorithm is aws:kms.
+	KMSMasterKeyID *string `type:"string"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Server-side encryption algorithm to use for the default encryption.
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	SSEAlgorithm *string `type:"string" enum:"AES256,aws:kms" required:"true"`
 
-	metadataListObjectsInput `json:"-" xml:"-"`
+	metadataServerSideEncryptionByDefault `json:"-" xml:"-"`
 }
 
-type metadataListObjectsInput struct {
+type metadataServerSideEncryptionByDefault struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ListObjectsOutput struct {
-	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
+// String returns the string representation.
+func (s ServerSideEncryptionByDefault) String() string {
+	return awsutil.StringValue(s)
+}
 
-	Contents []*Object `type:"list" flattened:"true"`
+// GoString returns the string representation.
+func (s ServerSideEncryptionByDefault) GoString() string {
+	return s.String()
+}
 
-	Delimiter *string `type:"string"`
+// GetKMSMasterKeyID returns the value of KMSMasterKeyID, or the zero value if it is not set.
+func (s *ServerSideEncryptionByDefault) GetKMSMasterKeyID() string {
+	if s == nil || s.KMSMasterKeyID == nil {
+		return ""
+	}
+	return *s.KMSMasterKeyID
+}
 
-	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+// SetKMSMasterKeyID sets the value of KMSMasterKeyID.
+func (s *ServerSideEncryptionByDefault) SetKMSMasterKeyID(v string) *ServerSideEncryptionByDefault {
+	s.KMSMasterKeyID = &v
+	return s
+}
 
-	// A flag that indicates whether or not Amazon S3 returned all of the results
-	// that satisfied the search criteria.
-	IsTruncated *bool `type:"boolean"`
+// GetSSEAlgorithm returns the value of SSEAlgorithm, or the zero value if it is not set.
+func (s *ServerSideEncryptionByDefault) GetSSEAlgorithm() string {
+	if s == nil || s.SSEAlgorithm == nil {
+		return ""
+	}
+	return *s.SSEAlgorithm
+}
+
+// SetSSEAlgorithm sets the value of SSEAlgorithm.
+func (s *ServerSideEncryptionByDefault) SetSSEAlgorithm(v string) *ServerSideEncryptionByDefault {
+	s.SSEAlgorithm = &v
+	return s
+}
+
+type ServerSideEncryptionConfiguration struct {
+	// Container for information about a particular server-side encryption configuration
+	// rule.
+	Rules []*ServerSideEncryptionRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+
+	metadataServerSideEncryptionConfiguration `json:"-" xml:"-"`
+}
+
+type metadataServerSideEncryptionConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	Marker *string `type:"string"`
+// String returns the string representation.
+func (s ServerSideEncryptionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
 
-	MaxKeys *int64 `type:"integer"`
+// GoString returns the string representation.
+func (s ServerSideEncryptionConfiguration) GoString() string {
+	return s.String()
+}
 
-	Name *string `type:"string"`
+// GetRules returns the value of Rules, or nil if it is not set.
+func (s *ServerSideEncryptionConfiguration) GetRules() []*ServerSideEncryptionRule {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
 
-	// When response is truncated (the IsTruncated element value in the response
-	// is true), you can use the key name in this field as marker in the subsequent
-	// request to get next set of objects. Amazon S3 lists objects in alphabetical
-	// order Note: This element is returned only if you have delimiter request parameter
-	// specified. If response does not include the NextMaker and it is truncated,
-	// you can use the value of the last Key in the response as the marker in the
-	// subsequent request to get the next set of object keys.
-	NextMarker *string `type:"string"`
+// SetRules sets the value of Rules.
+func (s *ServerSideEncryptionConfiguration) SetRules(v []*ServerSideEncryptionRule) *ServerSideEncryptionConfiguration {
+	s.Rules = v
+	return s
+}
 
-	Prefix *string `type:"string"`
+type ServerSideEncryptionRule struct {
+	// Describes the default server-side encryption to apply to new objects in the
+	// bucket. If Put Object request does not specify any server-side encryption,
+	// this default encryption will be applied.
+	ApplyServerSideEncryptionByDefault *ServerSideEncryptionByDefault `type:"structure"`
 
-	metadataListObjectsOutput `json:"-" xml:"-"`
+	metadataServerSideEncryptionRule `json:"-" xml:"-"`
 }
 
-type metadataListObjectsOutput struct {
+type metadataServerSideEncryptionRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ListPartsInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// String returns the string representation.
+func (s ServerSideEncryptionRule) String() string {
+	return awsutil.StringValue(s)
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// GoString returns the string representation.
+func (s ServerSideEncryptionRule) GoString() string {
+	return s.String()
+}
 
-	// Sets the maximum number of parts to return.
-	MaxParts *int64 `location:"querystring" locationName:"max-parts" type:"integer"`
+// GetApplyServerSideEncryptionByDefault returns the value of ApplyServerSideEncryptionByDefault, or nil if it is not set.
+func (s *ServerSideEncryptionRule) GetApplyServerSideEncryptionByDefault() *ServerSideEncryptionByDefault {
+	if s == nil {
+		return nil
+	}
+	return s.ApplyServerSideEncryptionByDefault
+}
 
-	// Specifies the part after which listing should begin. Only parts with higher
-	// part numbers will be listed.
-	PartNumberMarker *int64 `location:"querystring" locationName:"part-number-marker" type:"integer"`
+// SetApplyServerSideEncryptionByDefault sets the value of ApplyServerSideEncryptionByDefault.
+func (s *ServerSideEncryptionRule) SetApplyServerSideEncryptionByDefault(v *ServerSideEncryptionByDefault) *ServerSideEncryptionRule {
+	s.ApplyServerSideEncryptionByDefault = v
+	return s
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+type Stats struct {
+	// The total number of uncompressed object bytes processed.
+	BytesProcessed *int64 `type:"long"`
 
-	// Upload ID identifying the multipart upload whose parts are being listed.
-	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+	// The total number of bytes of records payload data returned.
+	BytesReturned *int64 `type:"long"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// The total number of object bytes scanned.
+	BytesScanned *int64 `type:"long"`
 
-	metadataListPartsInput `json:"-" xml:"-"`
+	metadataStats `json:"-" xml:"-"`
 }
 
-type metadataListPartsInput struct {
+type metadataStats struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ListPartsOutput struct {
-	// Name of the bucket to which the multipart upload was initiated.
-	Bucket *string `type:"string"`
-
-	// Identifies who initiated the multipart upload.
-	Initiator *Initiator `type:"structure"`
+// String returns the string representation.
+func (s Stats) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Indicates whether the returned list of parts is truncated.
-	IsTruncated *bool `type:"boolean"`
+// GoString returns the string representation.
+func (s Stats) GoString() string {
+	return s.String()
+}
 
-	// Object key for which the multipart upload was initiated.
-	Key *string `type:"string"`
+// GetBytesProcessed returns the value of BytesProcessed, or the zero value if it is not set.
+func (s *Stats) GetBytesProcessed() int64 {
+	if s == nil || s.BytesProcessed == nil {
+		return 0
+	}
+	return *s.BytesProcessed
+}
 
-	// Maximum number of parts that were allowed in the response.
-	MaxParts *int64 `type:"integer"`
+// SetBytesProcessed sets the value of BytesProcessed.
+func (s *Stats) SetBytesProcessed(v int64) *Stats {
+	s.BytesProcessed = &v
+	return s
+}
 
-	// When a list is truncated, this element specifies the last part in the list,
-	// as well as the value to use for the part-number-marker request parameter
-	// in a subsequent request.
-	NextPartNumberMarker *int64 `type:"integer"`
+// GetBytesReturned returns the value of BytesReturned, or the zero value if it is not set.
+func (s *Stats) GetBytesReturned() int64 {
+	if s == nil || s.BytesReturned == nil {
+		return 0
+	}
+	return *s.BytesReturned
+}
 
-	Owner *Owner `type:"structure"`
+// SetBytesReturned sets the value of BytesReturned.
+func (s *Stats) SetBytesReturned(v int64) *Stats {
+	s.BytesReturned = &v
+	return s
+}
 
-	// Part number after which listing begins.
-	PartNumberMarker *int64 `type:"integer"`
+// GetBytesScanned returns the value of BytesScanned, or the zero value if it is not set.
+func (s *Stats) GetBytesScanned() int64 {
+	if s == nil || s.BytesScanned == nil {
+		return 0
+	}
+	return *s.BytesScanned
+}
 
-	Parts []*Part `locationName:"Part" type:"list" flattened:"true"`
+// SetBytesScanned sets the value of BytesScanned.
+func (s *Stats) SetBytesScanned(v int64) *Stats {
+	s.BytesScanned = &v
+	return s
+}
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+type StatsEvent struct {
+	// The Stats event details.
+	Details *Stats `type:"structure"`
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	metadataStatsEvent `json:"-" xml:"-"`
+}
 
-	// Upload ID identifying the multipart upload whose parts are being listed.
-	UploadID *string `locationName:"UploadId" type:"string"`
+type metadataStatsEvent struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	metadataListPartsOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s StatsEvent) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataListPartsOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s StatsEvent) GoString() string {
+	return s.String()
 }
 
-type LoggingEnabled struct {
-	// Specifies the bucket where you want Amazon S3 to store server access logs.
-	// You can have your logs delivered to any bucket that you own, including the
-	// same bucket that is being logged. You can also configure multiple buckets
-	// to deliver their logs to the same target bucket. In this case you should
-	// choose a different TargetPrefix for each source bucket so that the delivered
-	// log files can be distinguished by key.
-	TargetBucket *string `type:"string"`
+// GetDetails returns the value of Details, or nil if it is not set.
+func (s *StatsEvent) GetDetails() *Stats {
+	if s == nil {
+		return nil
+	}
+	return s.Details
+}
 
-	TargetGrants []*TargetGrant `locationNameList:"Grant" type:"list"`
+// SetDetails sets the value of Details.
+func (s *StatsEvent) SetDetails(v *Stats) *StatsEvent {
+	s.Details = v
+	return s
+}
 
-	// This element lets you specify a prefix for the keys that the log files will
-	// be stored under.
-	TargetPrefix *string `type:"string"`
+type StorageClassAnalysis struct {
+	// A container used to describe how data related to the storage class analysis
+	// should be exported.
+	DataExport *StorageClassAnalysisDataExport `type:"structure"`
 
-	metadataLoggingEnabled `json:"-" xml:"-"`
+	metadataStorageClassAnalysis `json:"-" xml:"-"`
 }
 
-type metadataLoggingEnabled struct {
+type metadataStorageClassAnalysis struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type MultipartUpload struct {
-	// Date and time at which the multipart upload was initiated.
-	Initiated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+// String returns the string representation.
+func (s StorageClassAnalysis) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Identifies who initiated the multipart upload.
-	Initiator *Initiator `type:"structure"`
+// GoString returns the string representation.
+func (s StorageClassAnalysis) GoString() string {
+	return s.String()
+}
 
-	// Key of the object for which the multipart upload was initiated.
-	Key *string `type:"string"`
+// GetDataExport returns the value of DataExport, or nil if it is not set.
+func (s *StorageClassAnalysis) GetDataExport() *StorageClassAnalysisDataExport {
+	if s == nil {
+		return nil
+	}
+	return s.DataExport
+}
 
-	Owner *Owner `type:"structure"`
+// SetDataExport sets the value of DataExport.
+func (s *StorageClassAnalysis) SetDataExport(v *StorageClassAnalysisDataExport) *StorageClassAnalysis {
+	s.DataExport = v
+	return s
+}
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+type StorageClassAnalysisDataExport struct {
+	// The place to store the data for an analysis.
+	Destination *AnalyticsExportDestination `type:"structure" required:"true"`
 
-	// Upload ID that identifies the multipart upload.
-	UploadID *string `locationName:"UploadId" type:"string"`
+	// The version of the output schema to use when exporting data. Must be V_1.
+	//
+	// The valid values are the StorageClassAnalysisSchemaVersion enum constants.
+	OutputSchemaVersion *string `type:"string" enum:"V_1" required:"true"`
 
-	metadataMultipartUpload `json:"-" xml:"-"`
+	metadataStorageClassAnalysisDataExport `json:"-" xml:"-"`
 }
 
-type metadataMultipartUpload struct {
+type metadataStorageClassAnalysisDataExport struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Specifies when noncurrent object versions expire. Upon expiration, Amazon
-// S3 permanently deletes the noncurrent object versions. You set this lifecycle
-// configuration action on a bucket that has versioning enabled (or suspended)
-// to request that Amazon S3 delete noncurrent object versions at a specific
-// period in the object's lifetime.
-type NoncurrentVersionExpiration struct {
-	// Specifies the number of days an object is noncurrent before Amazon S3 can
-	// perform the associated action. For information about the noncurrent days
-	// calculations, see How Amazon S3 Calculates When an Object Became Noncurrent
-	// (/AmazonS3/latest/dev/s3-access-control.html) in the Amazon Simple Storage
-	// Service Developer Guide.
-	NoncurrentDays *int64 `type:"integer"`
+// String returns the string representation.
+func (s StorageClassAnalysisDataExport) String() string {
+	return awsutil.StringValue(s)
+}
 
-	metadataNoncurrentVersionExpiration `json:"-" xml:"-"`
+// GoString returns the string representation.
+func (s StorageClassAnalysisDataExport) GoString() string {
+	return s.String()
 }
 
-type metadataNoncurrentVersionExpiration struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetDestination returns the value of Destination, or nil if it is not set.
+func (s *StorageClassAnalysisDataExport) GetDestination() *AnalyticsExportDestination {
+	if s == nil {
+		return nil
+	}
+	return s.Destination
 }
 
-// Container for the transition rule that describes when noncurrent objects
-// transition to the GLACIER storage class. If your bucket is versioning-enabled
-// (or versioning is suspended), you can set this action to request that Amazon
-// S3 transition noncurrent object versions to the GLACIER storage class at
-// a specific period in the object's lifetime.
-type NoncurrentVersionTransition struct {
-	// Specifies the number of days an object is noncurrent before Amazon S3 can
-	// perform the associated action. For information about the noncurrent days
-	// calculations, see How Amazon S3 Calculates When an Object Became Noncurrent
-	// (/AmazonS3/latest/dev/s3-access-control.html) in the Amazon Simple Storage
-	// Service Developer Guide.
-	NoncurrentDays *int64 `type:"integer"`
+// SetDestination sets the value of Destination.
+func (s *StorageClassAnalysisDataExport) SetDestination(v *AnalyticsExportDestination) *StorageClassAnalysisDataExport {
+	s.Destination = v
+	return s
+}
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+// GetOutputSchemaVersion returns the value of OutputSchemaVersion, or the zero value if it is not set.
+func (s *StorageClassAnalysisDataExport) GetOutputSchemaVersion() string {
+	if s == nil || s.OutputSchemaVersion == nil {
+		return ""
+	}
+	return *s.OutputSchemaVersion
+}
 
-	metadataNoncurrentVersionTransition `json:"-" xml:"-"`
+// SetOutputSchemaVersion sets the value of OutputSchemaVersion.
+func (s *StorageClassAnalysisDataExport) SetOutputSchemaVersion(v string) *StorageClassAnalysisDataExport {
+	s.OutputSchemaVersion = &v
+	return s
 }
 
-type metadataNoncurrentVersionTransition struct {
-	SDKShapeTraits bool `type:"structure"`
+type Tag struct {
+	// Name of the tag.
+	Key *string `type:"string" required:"true"`
+
+	// Value of the tag.
+	Value *string `type:"string" required:"true"`
+
+	metadataTag `json:"-" xml:"-"`
 }
 
-// Container for specifying the notification configuration of the bucket. If
-// this element is empty, notifications are turned off on the bucket.
-type NotificationConfiguration struct {
-	LambdaFunctionConfigurations []*LambdaFunctionConfiguration `locationName:"CloudFunctionConfiguration" type:"list" flattened:"true"`
+type metadataTag struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	QueueConfigurations []*QueueConfiguration `locationName:"QueueConfiguration" type:"list" flattened:"true"`
+// String returns the string representation.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
 
-	TopicConfigurations []*TopicConfiguration `locationName:"TopicConfiguration" type:"list" flattened:"true"`
+// GoString returns the string representation.
+func (s Tag) GoString() string {
+	return s.String()
+}
 
-	metadataNotificationConfiguration `json:"-" xml:"-"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *Tag) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
 }
 
-type metadataNotificationConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetKey sets the value of Key.
+func (s *Tag) SetKey(v string) *Tag {
+	s.Key = &v
+	return s
 }
 
-type NotificationConfigurationDeprecated struct {
-	CloudFunctionConfiguration *CloudFunctionConfiguration `type:"structure"`
+// GetValue returns the value of Value, or the zero value if it is not set.
+func (s *Tag) GetValue() string {
+	if s == nil || s.Value == nil {
+		return ""
+	}
+	return *s.Value
+}
 
-	QueueConfiguration *QueueConfigurationDeprecated `type:"structure"`
+// SetValue sets the value of Value.
+func (s *Tag) SetValue(v string) *Tag {
+	s.Value = &v
+	return s
+}
 
-	TopicConfiguration *TopicConfigurationDeprecated `type:"structure"`
+type Tagging struct {
+	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
 
-	metadataNotificationConfigurationDeprecated `json:"-" xml:"-"`
+	metadataTagging `json:"-" xml:"-"`
 }
 
-type metadataNotificationConfigurationDeprecated struct {
+type metadataTagging struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type Object struct {
-	ETag *string `type:"string"`
+// String returns the string representation.
+func (s Tagging) String() string {
+	return awsutil.StringValue(s)
+}
 
-	Key *string `type:"string"`
+// GoString returns the string representation.
+func (s Tagging) GoString() string {
+	return s.String()
+}
 
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+// GetTagSet returns the value of TagSet, or nil if it is not set.
+func (s *Tagging) GetTagSet() []*Tag {
+	if s == nil {
+		return nil
+	}
+	return s.TagSet
+}
 
-	Owner *Owner `type:"structure"`
+// SetTagSet sets the value of TagSet.
+func (s *Tagging) SetTagSet(v []*Tag) *Tagging {
+	s.TagSet = v
+	return s
+}
 
-	Size *int64 `type:"integer"`
+type TargetGrant struct {
+	Grantee *Grantee `type:"structure"`
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	// Logging permissions assigned to the Grantee for the bucket.
+	//
+	// The valid values are the BucketLogsPermission enum constants.
+	Permission *string `type:"string" enum:"FULL_CONTROL,READ,WRITE"`
 
-	metadataObject `json:"-" xml:"-"`
+	metadataTargetGrant `json:"-" xml:"-"`
 }
 
-type metadataObject struct {
+type metadataTargetGrant struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type ObjectIdentifier struct {
-	// Key name of the object to delete.
-	Key *string `type:"string" required:"true"`
+// String returns the string representation.
+func (s TargetGrant) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// VersionId for the specific version of the object to delete.
-	VersionID *string `locationName:"VersionId" type:"string"`
+// GoString returns the string representation.
+func (s TargetGrant) GoString() string {
+	return s.String()
+}
 
-	metadataObjectIdentifier `json:"-" xml:"-"`
+// GetGrantee returns the value of Grantee, or nil if it is not set.
+func (s *TargetGrant) GetGrantee() *Grantee {
+	if s == nil {
+		return nil
+	}
+	return s.Grantee
 }
 
-type metadataObjectIdentifier struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetGrantee sets the value of Grantee.
+func (s *TargetGrant) SetGrantee(v *Grantee) *TargetGrant {
+	s.Grantee = v
+	return s
 }
 
-type ObjectVersion struct {
-	ETag *string `type:"string"`
+// GetPermission returns the value of Permission, or the zero value if it is not set.
+func (s *TargetGrant) GetPermission() string {
+	if s == nil || s.Permission == nil {
+		return ""
+	}
+	return *s.Permission
+}
 
-	// Specifies whether the object is (true) or is not (false) the latest version
-	// of an object.
-	IsLatest *bool `type:"boolean"`
+// SetPermission sets the value of Permission.
+func (s *TargetGrant) SetPermission(v string) *TargetGrant {
+	s.Permission = &v
+	return s
+}
 
-	// The object key.
-	Key *string `type:"string"`
+// Container for specifying the configuration when you want Amazon S3 to publish
+// events to an Amazon Simple Notification Service (Amazon SNS) topic.
+type TopicConfiguration struct {
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true" required:"true"`
 
-	// Date and time the object was last modified.
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
 
-	Owner *Owner `type:"structure"`
+	// Amazon SNS topic ARN to which Amazon S3 will publish a message when it detects
+	// events of specified type.
+	TopicARN *string `locationName:"Topic" type:"string" required:"true"`
 
-	// Size in bytes of the object.
-	Size *int64 `type:"integer"`
+	metadataTopicConfiguration `json:"-" xml:"-"`
+}
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+type metadataTopicConfiguration struct {
+	SDKShapeTraits bool `type:"structure"`
+}
 
-	// Version ID of an object.
-	VersionID *string `locationName:"VersionId" type:"string"`
+// String returns the string representation.
+func (s TopicConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GoString returns the string representation.
+func (s TopicConfiguration) GoString() string {
+	return s.String()
+}
 
-	metadataObjectVersion `json:"-" xml:"-"`
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *TopicConfiguration) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
 }
 
-type metadataObjectVersion struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetEvents sets the value of Events.
+func (s *TopicConfiguration) SetEvents(v []*string) *TopicConfiguration {
+	s.Events = v
+	return s
 }
 
-type Owner struct {
-	DisplayName *string `type:"string"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *TopicConfiguration) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
+}
 
-	ID *string `type:"string"`
+// SetID sets the value of ID.
+func (s *TopicConfiguration) SetID(v string) *TopicConfiguration {
+	s.ID = &v
+	return s
+}
 
-	metadataOwner `json:"-" xml:"-"`
+// GetTopicARN returns the value of TopicARN, or the zero value if it is not set.
+func (s *TopicConfiguration) GetTopicARN() string {
+	if s == nil || s.TopicARN == nil {
+		return ""
+	}
+	return *s.TopicARN
 }
 
-type metadataOwner struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetTopicARN sets the value of TopicARN.
+func (s *TopicConfiguration) SetTopicARN(v string) *TopicConfiguration {
+	s.TopicARN = &v
+	return s
 }
 
-type Part struct {
-	// Entity tag returned when the part was uploaded.
-	ETag *string `type:"string"`
+type TopicConfigurationDeprecated struct {
+	// Bucket event for which to send notifications.
+	//
+	// The valid values are the Event enum constants.
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated"`
 
-	// Date and time at which the part was uploaded.
-	LastModified *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	// The valid values of the elements are the Event enum constants.
+	Events []*string `locationName:"Event" type:"list" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:*,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload,s3:ObjectRemoved:*,s3:ObjectRemoved:Delete,s3:ObjectRemoved:DeleteMarkerCreated" flattened:"true"`
 
-	// Part number identifying the part.
-	PartNumber *int64 `type:"integer"`
+	// Optional unique identifier for configurations in a notification configuration.
+	// If you don't provide one, Amazon S3 will assign an ID.
+	ID *string `locationName:"Id" type:"string"`
 
-	// Size of the uploaded part data.
-	Size *int64 `type:"integer"`
+	// Amazon SNS topic to which Amazon S3 will publish a message to report the
+	// specified events for the bucket.
+	Topic *string `type:"string"`
 
-	metadataPart `json:"-" xml:"-"`
+	metadataTopicConfigurationDeprecated `json:"-" xml:"-"`
 }
 
-type metadataPart struct {
+type metadataTopicConfigurationDeprecated struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type PutBucketACLInput struct {
-	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
-
-	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
-
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	// Allows grantee the read, write, read ACP, and write ACP permissions on the
-	// bucket.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+// String returns the string representation.
+func (s TopicConfigurationDeprecated) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Allows grantee to list the objects in the bucket.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+// GoString returns the string representation.
+func (s TopicConfigurationDeprecated) GoString() string {
+	return s.String()
+}
 
-	// Allows grantee to read the bucket ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+// GetEvent returns the value of Event, or the zero value if it is not set.
+func (s *TopicConfigurationDeprecated) GetEvent() string {
+	if s == nil || s.Event == nil {
+		return ""
+	}
+	return *s.Event
+}
 
-	// Allows grantee to create, overwrite, and delete any object in the bucket.
-	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+// SetEvent sets the value of Event.
+func (s *TopicConfigurationDeprecated) SetEvent(v string) *TopicConfigurationDeprecated {
+	s.Event = &v
+	return s
+}
 
-	// Allows grantee to write the ACL for the applicable bucket.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+// GetEvents returns the value of Events, or nil if it is not set.
+func (s *TopicConfigurationDeprecated) GetEvents() []*string {
+	if s == nil {
+		return nil
+	}
+	return s.Events
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetEvents sets the value of Events.
+func (s *TopicConfigurationDeprecated) SetEvents(v []*string) *TopicConfigurationDeprecated {
+	s.Events = v
+	return s
+}
 
-	metadataPutBucketACLInput `json:"-" xml:"-"`
+// GetID returns the value of ID, or the zero value if it is not set.
+func (s *TopicConfigurationDeprecated) GetID() string {
+	if s == nil || s.ID == nil {
+		return ""
+	}
+	return *s.ID
 }
 
-type metadataPutBucketACLInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
+// SetID sets the value of ID.
+func (s *TopicConfigurationDeprecated) SetID(v string) *TopicConfigurationDeprecated {
+	s.ID = &v
+	return s
 }
 
-type PutBucketACLOutput struct {
-	metadataPutBucketACLOutput `json:"-" xml:"-"`
+// GetTopic returns the value of Topic, or the zero value if it is not set.
+func (s *TopicConfigurationDeprecated) GetTopic() string {
+	if s == nil || s.Topic == nil {
+		return ""
+	}
+	return *s.Topic
 }
 
-type metadataPutBucketACLOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetTopic sets the value of Topic.
+func (s *TopicConfigurationDeprecated) SetTopic(v string) *TopicConfigurationDeprecated {
+	s.Topic = &v
+	return s
 }
 
-type PutBucketCORSInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+type Transition struct {
+	// Indicates at what date the object is to be moved or deleted. Should be in
+	// GMT ISO 8601 Format.
+	Date *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
-	CORSConfiguration *CORSConfiguration `locationName:"CORSConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+	// Indicates the lifetime, in days, of the objects that are subject to the rule.
+	// The value must be a non-zero positive integer.
+	Days *int64 `type:"integer"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// The class of storage used to store the object.
+	//
+	// The valid values are the TransitionStorageClass enum constants.
+	StorageClass *string `type:"string" enum:"GLACIER,STANDARD_IA,ONEZONE_IA,INTELLIGENT_TIERING,DEEP_ARCHIVE"`
 
-	metadataPutBucketCORSInput `json:"-" xml:"-"`
+	metadataTransition `json:"-" xml:"-"`
 }
 
-type metadataPutBucketCORSInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"CORSConfiguration"`
+type metadataTransition struct {
+	SDKShapeTraits bool `type:"structure"`
 }
 
-type PutBucketCORSOutput struct {
-	metadataPutBucketCORSOutput `json:"-" xml:"-"`
+// String returns the string representation.
+func (s Transition) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataPutBucketCORSOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s Transition) GoString() string {
+	return s.String()
 }
 
-type PutBucketLifecycleInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	LifecycleConfiguration *LifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure"`
+// GetDate returns the value of Date, or the zero value if it is not set.
+func (s *Transition) GetDate() time.Time {
+	if s == nil || s.Date == nil {
+		return time.Time{}
+	}
+	return *s.Date
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetDate sets the value of Date.
+func (s *Transition) SetDate(v time.Time) *Transition {
+	s.Date = &v
+	return s
+}
 
-	metadataPutBucketLifecycleInput `json:"-" xml:"-"`
+// GetDays returns the value of Days, or the zero value if it is not set.
+func (s *Transition) GetDays() int64 {
+	if s == nil || s.Days == nil {
+		return 0
+	}
+	return *s.Days
 }
 
-type metadataPutBucketLifecycleInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"LifecycleConfiguration"`
+// SetDays sets the value of Days.
+func (s *Transition) SetDays(v int64) *Transition {
+	s.Days = &v
+	return s
 }
 
-type PutBucketLifecycleOutput struct {
-	metadataPutBucketLifecycleOutput `json:"-" xml:"-"`
+// GetStorageClass returns the value of StorageClass, or the zero value if it is not set.
+func (s *Transition) GetStorageClass() string {
+	if s == nil || s.StorageClass == nil {
+		return ""
+	}
+	return *s.StorageClass
 }
 
-type metadataPutBucketLifecycleOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetStorageClass sets the value of StorageClass.
+func (s *Transition) SetStorageClass(v string) *Transition {
+	s.StorageClass = &v
+	return s
 }
 
-type PutBucketLoggingInput struct {
+type UploadPartCopyInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	BucketLoggingStatus *BucketLoggingStatus `locationName:"BucketLoggingStatus" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
-
 	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	metadataPutBucketLoggingInput `json:"-" xml:"-"`
-}
+	// The name of the source bucket and key name of the source object, separated
+	// by a slash (/). Must be URL-encoded.
+	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
 
-type metadataPutBucketLoggingInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"BucketLoggingStatus"`
-}
+	// Copies the object if its entity tag (ETag) matches the specified tag.
+	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
 
-type PutBucketLoggingOutput struct {
-	metadataPutBucketLoggingOutput `json:"-" xml:"-"`
-}
+	// Copies the object if it has been modified since the specified time.
+	CopySourceIfModifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-modified-since" type:"timestamp" timestampFormat:"rfc822"`
 
-type metadataPutBucketLoggingOutput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// Copies the object if its entity tag (ETag) is different than the specified
+	// ETag.
+	CopySourceIfNoneMatch *string `location:"header" locationName:"x-amz-copy-source-if-none-match" type:"string"`
 
-type PutBucketNotificationConfigurationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	// Copies the object if it hasn't been modified since the specified time.
+	CopySourceIfUnmodifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-unmodified-since" type:"timestamp" timestampFormat:"rfc822"`
 
-	// Container for specifying the notification configuration of the bucket. If
-	// this element is empty, notifications are turned off on the bucket.
-	NotificationConfiguration *NotificationConfiguration `locationName:"NotificationConfiguration" type:"structure" required:"true"`
+	// The range of bytes to copy from the source object. The range value must use
+	// the form bytes=first-last, where the first and last are the zero-based byte
+	// offsets to copy. For example, bytes=0-9 indicates that you want to copy the
+	// first ten bytes of the source. You can copy a range only if the source object
+	// is greater than 5 GB.
+	CopySourceRange *string `location:"header" locationName:"x-amz-copy-source-range" type:"string"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Specifies the algorithm to use when decrypting the source object (e.g., AES256).
+	CopySourceSSECustomerAlgorithm *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-algorithm" type:"string"`
 
-	metadataPutBucketNotificationConfigurationInput `json:"-" xml:"-"`
-}
+	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
+	// the source object. The encryption key provided in this header must be one
+	// that was used when the source object was created.
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
 
-type metadataPutBucketNotificationConfigurationInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"NotificationConfiguration"`
-}
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	CopySourceSSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key-MD5" type:"string"`
 
-type PutBucketNotificationConfigurationOutput struct {
-	metadataPutBucketNotificationConfigurationOutput `json:"-" xml:"-"`
-}
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
 
-type metadataPutBucketNotificationConfigurationOutput struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+	// The account ID of the expected source bucket owner. If the source bucket
+	// is owned by a different account, the request fails with an HTTP 403 (Access
+	// Denied) error.
+	ExpectedSourceBucketOwner *string `location:"header" locationName:"x-amz-source-expected-bucket-owner" type:"string"`
 
-type PutBucketNotificationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	NotificationConfiguration *NotificationConfigurationDeprecated `locationName:"NotificationConfiguration" type:"structure" required:"true"`
+	// Part number of part being copied.
+	PartNumber *int64 `location:"querystring" locationName:"partNumber" type:"integer" required:"true"`
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
-	metadataPutBucketNotificationInput `json:"-" xml:"-"`
-}
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
 
-type metadataPutBucketNotificationInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"NotificationConfiguration"`
-}
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header. This must be the same encryption key specified in the initiate multipart
+	// upload request.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
 
-type PutBucketNotificationOutput struct {
-	metadataPutBucketNotificationOutput `json:"-" xml:"-"`
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// Upload ID identifying the multipart upload whose part is being copied.
+	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+
+	metadataUploadPartCopyInput `json:"-" xml:"-"`
 }
 
-type metadataPutBucketNotificationOutput struct {
+type metadataUploadPartCopyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type PutBucketPolicyInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
-
-	// The bucket policy as a JSON document.
-	Policy *string `type:"string" required:"true"`
+// String returns the string representation.
+func (s UploadPartCopyInput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GoString returns the string representation.
+func (s UploadPartCopyInput) GoString() string {
+	return s.String()
+}
 
-	metadataPutBucketPolicyInput `json:"-" xml:"-"`
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
 }
 
-type metadataPutBucketPolicyInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Policy"`
+// SetBucket sets the value of Bucket.
+func (s *UploadPartCopyInput) SetBucket(v string) *UploadPartCopyInput {
+	s.Bucket = &v
+	return s
 }
 
-type PutBucketPolicyOutput struct {
-	metadataPutBucketPolicyOutput `json:"-" xml:"-"`
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
 }
 
-type metadataPutBucketPolicyOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetContentType sets the value of ContentType.
+func (s *UploadPartCopyInput) SetContentType(v string) *UploadPartCopyInput {
+	s.ContentType = &v
+	return s
 }
 
-type PutBucketReplicationInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetCopySource returns the value of CopySource, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySource() string {
+	if s == nil || s.CopySource == nil {
+		return ""
+	}
+	return *s.CopySource
+}
 
-	// Container for replication rules. You can add as many as 1,000 rules. Total
-	// replication configuration size can be up to 2 MB.
-	ReplicationConfiguration *ReplicationConfiguration `locationName:"ReplicationConfiguration" type:"structure" required:"true"`
+// SetCopySource sets the value of CopySource.
+func (s *UploadPartCopyInput) SetCopySource(v string) *UploadPartCopyInput {
+	s.CopySource = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetCopySourceIfMatch returns the value of CopySourceIfMatch, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceIfMatch() string {
+	if s == nil || s.CopySourceIfMatch == nil {
+		return ""
+	}
+	return *s.CopySourceIfMatch
+}
 
-	metadataPutBucketReplicationInput `json:"-" xml:"-"`
+// SetCopySourceIfMatch sets the value of CopySourceIfMatch.
+func (s *UploadPartCopyInput) SetCopySourceIfMatch(v string) *UploadPartCopyInput {
+	s.CopySourceIfMatch = &v
+	return s
 }
 
-type metadataPutBucketReplicationInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
+// GetCopySourceIfModifiedSince returns the value of CopySourceIfModifiedSince, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceIfModifiedSince() time.Time {
+	if s == nil || s.CopySourceIfModifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.CopySourceIfModifiedSince
 }
 
-type PutBucketReplicationOutput struct {
-	metadataPutBucketReplicationOutput `json:"-" xml:"-"`
+// SetCopySourceIfModifiedSince sets the value of CopySourceIfModifiedSince.
+func (s *UploadPartCopyInput) SetCopySourceIfModifiedSince(v time.Time) *UploadPartCopyInput {
+	s.CopySourceIfModifiedSince = &v
+	return s
 }
 
-type metadataPutBucketReplicationOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetCopySourceIfNoneMatch returns the value of CopySourceIfNoneMatch, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceIfNoneMatch() string {
+	if s == nil || s.CopySourceIfNoneMatch == nil {
+		return ""
+	}
+	return *s.CopySourceIfNoneMatch
 }
 
-type PutBucketRequestPaymentInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetCopySourceIfNoneMatch sets the value of CopySourceIfNoneMatch.
+func (s *UploadPartCopyInput) SetCopySourceIfNoneMatch(v string) *UploadPartCopyInput {
+	s.CopySourceIfNoneMatch = &v
+	return s
+}
 
-	RequestPaymentConfiguration *RequestPaymentConfiguration `locationName:"RequestPaymentConfiguration" type:"structure" required:"true"`
+// GetCopySourceIfUnmodifiedSince returns the value of CopySourceIfUnmodifiedSince, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceIfUnmodifiedSince() time.Time {
+	if s == nil || s.CopySourceIfUnmodifiedSince == nil {
+		return time.Time{}
+	}
+	return *s.CopySourceIfUnmodifiedSince
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetCopySourceIfUnmodifiedSince sets the value of CopySourceIfUnmodifiedSince.
+func (s *UploadPartCopyInput) SetCopySourceIfUnmodifiedSince(v time.Time) *UploadPartCopyInput {
+	s.CopySourceIfUnmodifiedSince = &v
+	return s
+}
 
-	metadataPutBucketRequestPaymentInput `json:"-" xml:"-"`
+// GetCopySourceRange returns the value of CopySourceRange, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceRange() string {
+	if s == nil || s.CopySourceRange == nil {
+		return ""
+	}
+	return *s.CopySourceRange
 }
 
-type metadataPutBucketRequestPaymentInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"RequestPaymentConfiguration"`
+// SetCopySourceRange sets the value of CopySourceRange.
+func (s *UploadPartCopyInput) SetCopySourceRange(v string) *UploadPartCopyInput {
+	s.CopySourceRange = &v
+	return s
 }
 
-type PutBucketRequestPaymentOutput struct {
-	metadataPutBucketRequestPaymentOutput `json:"-" xml:"-"`
+// GetCopySourceSSECustomerAlgorithm returns the value of CopySourceSSECustomerAlgorithm, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceSSECustomerAlgorithm() string {
+	if s == nil || s.CopySourceSSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerAlgorithm
 }
 
-type metadataPutBucketRequestPaymentOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetCopySourceSSECustomerAlgorithm sets the value of CopySourceSSECustomerAlgorithm.
+func (s *UploadPartCopyInput) SetCopySourceSSECustomerAlgorithm(v string) *UploadPartCopyInput {
+	s.CopySourceSSECustomerAlgorithm = &v
+	return s
 }
 
-type PutBucketTaggingInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetCopySourceSSECustomerKey returns the value of CopySourceSSECustomerKey, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceSSECustomerKey() string {
+	if s == nil || s.CopySourceSSECustomerKey == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerKey
+}
 
-	Tagging *Tagging `locationName:"Tagging" type:"structure" required:"true"`
+// SetCopySourceSSECustomerKey sets the value of CopySourceSSECustomerKey.
+func (s *UploadPartCopyInput) SetCopySourceSSECustomerKey(v string) *UploadPartCopyInput {
+	s.CopySourceSSECustomerKey = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetCopySourceSSECustomerKeyMD5 returns the value of CopySourceSSECustomerKeyMD5, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetCopySourceSSECustomerKeyMD5() string {
+	if s == nil || s.CopySourceSSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.CopySourceSSECustomerKeyMD5
+}
 
-	metadataPutBucketTaggingInput `json:"-" xml:"-"`
+// SetCopySourceSSECustomerKeyMD5 sets the value of CopySourceSSECustomerKeyMD5.
+func (s *UploadPartCopyInput) SetCopySourceSSECustomerKeyMD5(v string) *UploadPartCopyInput {
+	s.CopySourceSSECustomerKeyMD5 = &v
+	return s
 }
 
-type metadataPutBucketTaggingInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Tagging"`
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
 }
 
-type PutBucketTaggingOutput struct {
-	metadataPutBucketTaggingOutput `json:"-" xml:"-"`
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *UploadPartCopyInput) SetExpectedBucketOwner(v string) *UploadPartCopyInput {
+	s.ExpectedBucketOwner = &v
+	return s
 }
 
-type metadataPutBucketTaggingOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetExpectedSourceBucketOwner returns the value of ExpectedSourceBucketOwner, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetExpectedSourceBucketOwner() string {
+	if s == nil || s.ExpectedSourceBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedSourceBucketOwner
 }
 
-type PutBucketVersioningInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetExpectedSourceBucketOwner sets the value of ExpectedSourceBucketOwner.
+func (s *UploadPartCopyInput) SetExpectedSourceBucketOwner(v string) *UploadPartCopyInput {
+	s.ExpectedSourceBucketOwner = &v
+	return s
+}
 
-	// The concatenation of the authentication device's serial number, a space,
-	// and the value that is displayed on your authentication device.
-	MFA *string `location:"header" locationName:"x-amz-mfa" type:"string"`
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
 
-	VersioningConfiguration *VersioningConfiguration `locationName:"VersioningConfiguration" type:"structure" required:"true"`
+// SetKey sets the value of Key.
+func (s *UploadPartCopyInput) SetKey(v string) *UploadPartCopyInput {
+	s.Key = &v
+	return s
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GetPartNumber returns the value of PartNumber, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetPartNumber() int64 {
+	if s == nil || s.PartNumber == nil {
+		return 0
+	}
+	return *s.PartNumber
+}
 
-	metadataPutBucketVersioningInput `json:"-" xml:"-"`
+// SetPartNumber sets the value of PartNumber.
+func (s *UploadPartCopyInput) SetPartNumber(v int64) *UploadPartCopyInput {
+	s.PartNumber = &v
+	return s
 }
 
-type metadataPutBucketVersioningInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"VersioningConfiguration"`
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
 }
 
-type PutBucketVersioningOutput struct {
-	metadataPutBucketVersioningOutput `json:"-" xml:"-"`
+// SetRequestPayer sets the value of RequestPayer.
+func (s *UploadPartCopyInput) SetRequestPayer(v string) *UploadPartCopyInput {
+	s.RequestPayer = &v
+	return s
 }
 
-type metadataPutBucketVersioningOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
 }
 
-type PutBucketWebsiteInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *UploadPartCopyInput) SetSSECustomerAlgorithm(v string) *UploadPartCopyInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
 
-	WebsiteConfiguration *WebsiteConfiguration `locationName:"WebsiteConfiguration" type:"structure" required:"true"`
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *UploadPartCopyInput) SetSSECustomerKey(v string) *UploadPartCopyInput {
+	s.SSECustomerKey = &v
+	return s
+}
 
-	metadataPutBucketWebsiteInput `json:"-" xml:"-"`
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
 }
 
-type metadataPutBucketWebsiteInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"WebsiteConfiguration"`
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *UploadPartCopyInput) SetSSECustomerKeyMD5(v string) *UploadPartCopyInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
 }
 
-type PutBucketWebsiteOutput struct {
-	metadataPutBucketWebsiteOutput `json:"-" xml:"-"`
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *UploadPartCopyInput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
 }
 
-type metadataPutBucketWebsiteOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetUploadID sets the value of UploadID.
+func (s *UploadPartCopyInput) SetUploadID(v string) *UploadPartCopyInput {
+	s.UploadID = &v
+	return s
 }
 
-type PutObjectACLInput struct {
-	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+type UploadPartCopyOutput struct {
+	CopyPartResult *CopyPartResult `type:"structure"`
 
-	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
+	// The version of the source object that was copied, if you have enabled versioning
+	// on the source bucket.
+	CopySourceVersionID *string `location:"header" locationName:"x-amz-copy-source-version-id" type:"string"`
 
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	// If present, indicates that the requester was successfully charged for the
+	// request.
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
-	// Allows grantee the read, write, read ACP, and write ACP permissions on the
-	// bucket.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header confirming the encryption algorithm
+	// used.
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
 
-	// Allows grantee to list the objects in the bucket.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+	// If server-side encryption with a customer-provided encryption key was requested,
+	// the response will include this header to provide round trip message integrity
+	// verification of the customer-provided encryption key.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
 
-	// Allows grantee to read the bucket ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+	// If present, specifies the ID of the AWS Key Management Service (KMS) master
+	// encryption key that was used for the object.
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
 
-	// Allows grantee to create, overwrite, and delete any object in the bucket.
-	GrantWrite *string `location:"header" locationName:"x-amz-grant-write" type:"string"`
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
 
-	// Allows grantee to write the ACL for the applicable bucket.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+	metadataUploadPartCopyOutput `json:"-" xml:"-"`
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+type metadataUploadPartCopyOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"CopyPartResult"`
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// String returns the string representation.
+func (s UploadPartCopyOutput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// GoString returns the string representation.
+func (s UploadPartCopyOutput) GoString() string {
+	return s.String()
+}
 
-	metadataPutObjectACLInput `json:"-" xml:"-"`
+// GetCopyPartResult returns the value of CopyPartResult, or nil if it is not set.
+func (s *UploadPartCopyOutput) GetCopyPartResult() *CopyPartResult {
+	if s == nil {
+		return nil
+	}
+	return s.CopyPartResult
 }
 
-type metadataPutObjectACLInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
+// SetCopyPartResult sets the value of CopyPartResult.
+func (s *UploadPartCopyOutput) SetCopyPartResult(v *CopyPartResult) *UploadPartCopyOutput {
+	s.CopyPartResult = v
+	return s
 }
 
-type PutObjectACLOutput struct {
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// GetCopySourceVersionID returns the value of CopySourceVersionID, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetCopySourceVersionID() string {
+	if s == nil || s.CopySourceVersionID == nil {
+		return ""
+	}
+	return *s.CopySourceVersionID
+}
 
-	metadataPutObjectACLOutput `json:"-" xml:"-"`
+// SetCopySourceVersionID sets the value of CopySourceVersionID.
+func (s *UploadPartCopyOutput) SetCopySourceVersionID(v string) *UploadPartCopyOutput {
+	s.CopySourceVersionID = &v
+	return s
 }
 
-type metadataPutObjectACLOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
 }
 
-type PutObjectInput struct {
-	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+// SetRequestCharged sets the value of RequestCharged.
+func (s *UploadPartCopyOutput) SetRequestCharged(v string) *UploadPartCopyOutput {
+	s.RequestCharged = &v
+	return s
+}
 
-	// Object data.
-	Body io.ReadSeeker `type:"blob"`
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
 
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *UploadPartCopyOutput) SetSSECustomerAlgorithm(v string) *UploadPartCopyOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
 
-	// Specifies caching behavior along the request/reply chain.
-	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
 
-	// Specifies presentational information for the object.
-	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *UploadPartCopyOutput) SetSSECustomerKeyMD5(v string) *UploadPartCopyOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
 
-	// Specifies what content encodings have been applied to the object and thus
-	// what decoding mechanisms must be applied to obtain the media-type referenced
-	// by the Content-Type header field.
-	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
+}
 
-	// The language the content is in.
-	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *UploadPartCopyOutput) SetSSEKMSKeyID(v string) *UploadPartCopyOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
 
-	// Size of the body in bytes. This parameter is useful when the size of the
-	// body cannot be determined automatically.
-	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *UploadPartCopyOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
 
-	// A standard MIME type describing the format of the object data.
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *UploadPartCopyOutput) SetServerSideEncryption(v string) *UploadPartCopyOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
 
-	// The date and time at which the object is no longer cacheable.
-	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+type UploadPartInput struct {
+	Body io.ReadSeeker `type:"blob"`
 
-	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
-	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
-	// Allows grantee to read the object data and its metadata.
-	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+	// Size of the body in bytes. This parameter is useful when the size of the
+	// body cannot be determined automatically.
+	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
 
-	// Allows grantee to read the object ACL.
-	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
-	// Allows grantee to write the ACL for the applicable object.
-	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
 
 	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
 
-	// A map of metadata to store with the object in S3.
-	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+	// Part number of part being uploaded.
+	PartNumber *int64 `location:"querystring" locationName:"partNumber" type:"integer" required:"true"`
 
 	// Confirms that the requester knows that she or he will be charged for the
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	//
+	// The valid values are the RequestPayer enum constants.
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4901,7 +24326,8 @@ type PutObjectInput struct {
 	// data. This value is used to store the object and then it is discarded; Amazon
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header.
+	// header. This must be the same encryption key specified in the initiate multipart
+	// upload request.
 	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
@@ -4909,47 +24335,203 @@ type PutObjectInput struct {
 	// key was transmitted without error.
 	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
 
-	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
-	// requests for an object protected by AWS KMS will fail if not made via SSL
-	// or using SigV4. Documentation on configuring any of the officially supported
-	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	// Upload ID identifying the multipart upload whose part is being uploaded.
+	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	metadataUploadPartInput `json:"-" xml:"-"`
+}
 
-	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+type metadataUploadPartInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
 
-	// If the bucket is configured as a website, redirects requests for this object
-	// to another object in the same bucket or to an external URL. Amazon S3 stores
-	// the value of this header in the object metadata.
-	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+// String returns the string representation.
+func (s UploadPartInput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	ContentMaxLength *int64 `location:"header" locationName:"x-amz-content-maxlength" type:"integer"`
+// GoString returns the string representation.
+func (s UploadPartInput) GoString() string {
+	return s.String()
+}
+
+// GetBody returns the value of Body, or nil if it is not set.
+func (s *UploadPartInput) GetBody() io.ReadSeeker {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+// SetBody sets the value of Body.
+func (s *UploadPartInput) SetBody(v io.ReadSeeker) *UploadPartInput {
+	s.Body = v
+	return s
+}
+
+// GetBucket returns the value of Bucket, or the zero value if it is not set.
+func (s *UploadPartInput) GetBucket() string {
+	if s == nil || s.Bucket == nil {
+		return ""
+	}
+	return *s.Bucket
+}
+
+// SetBucket sets the value of Bucket.
+func (s *UploadPartInput) SetBucket(v string) *UploadPartInput {
+	s.Bucket = &v
+	return s
+}
+
+// GetContentLength returns the value of ContentLength, or the zero value if it is not set.
+func (s *UploadPartInput) GetContentLength() int64 {
+	if s == nil || s.ContentLength == nil {
+		return 0
+	}
+	return *s.ContentLength
+}
+
+// SetContentLength sets the value of ContentLength.
+func (s *UploadPartInput) SetContentLength(v int64) *UploadPartInput {
+	s.ContentLength = &v
+	return s
+}
+
+// GetContentType returns the value of ContentType, or the zero value if it is not set.
+func (s *UploadPartInput) GetContentType() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// SetContentType sets the value of ContentType.
+func (s *UploadPartInput) SetContentType(v string) *UploadPartInput {
+	s.ContentType = &v
+	return s
+}
+
+// GetExpectedBucketOwner returns the value of ExpectedBucketOwner, or the zero value if it is not set.
+func (s *UploadPartInput) GetExpectedBucketOwner() string {
+	if s == nil || s.ExpectedBucketOwner == nil {
+		return ""
+	}
+	return *s.ExpectedBucketOwner
+}
+
+// SetExpectedBucketOwner sets the value of ExpectedBucketOwner.
+func (s *UploadPartInput) SetExpectedBucketOwner(v string) *UploadPartInput {
+	s.ExpectedBucketOwner = &v
+	return s
+}
+
+// GetKey returns the value of Key, or the zero value if it is not set.
+func (s *UploadPartInput) GetKey() string {
+	if s == nil || s.Key == nil {
+		return ""
+	}
+	return *s.Key
+}
+
+// SetKey sets the value of Key.
+func (s *UploadPartInput) SetKey(v string) *UploadPartInput {
+	s.Key = &v
+	return s
+}
+
+// GetPartNumber returns the value of PartNumber, or the zero value if it is not set.
+func (s *UploadPartInput) GetPartNumber() int64 {
+	if s == nil || s.PartNumber == nil {
+		return 0
+	}
+	return *s.PartNumber
+}
+
+// SetPartNumber sets the value of PartNumber.
+func (s *UploadPartInput) SetPartNumber(v int64) *UploadPartInput {
+	s.PartNumber = &v
+	return s
+}
+
+// GetRequestPayer returns the value of RequestPayer, or the zero value if it is not set.
+func (s *UploadPartInput) GetRequestPayer() string {
+	if s == nil || s.RequestPayer == nil {
+		return ""
+	}
+	return *s.RequestPayer
+}
+
+// SetRequestPayer sets the value of RequestPayer.
+func (s *UploadPartInput) SetRequestPayer(v string) *UploadPartInput {
+	s.RequestPayer = &v
+	return s
+}
+
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *UploadPartInput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
+
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *UploadPartInput) SetSSECustomerAlgorithm(v string) *UploadPartInput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
+
+// GetSSECustomerKey returns the value of SSECustomerKey, or the zero value if it is not set.
+func (s *UploadPartInput) GetSSECustomerKey() string {
+	if s == nil || s.SSECustomerKey == nil {
+		return ""
+	}
+	return *s.SSECustomerKey
+}
+
+// SetSSECustomerKey sets the value of SSECustomerKey.
+func (s *UploadPartInput) SetSSECustomerKey(v string) *UploadPartInput {
+	s.SSECustomerKey = &v
+	return s
+}
+
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *UploadPartInput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
 
-	CallbackUrl  *string `location:"header" locationName:"x-kss-callbackurl" type:"string"`
-	CallbackBody *string `location:"header" locationName:"x-kss-callbackbody" type:"string"`
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *UploadPartInput) SetSSECustomerKeyMD5(v string) *UploadPartInput {
+	s.SSECustomerKeyMD5 = &v
+	return s
+}
 
-	metadataPutObjectInput `json:"-" xml:"-"`
+// GetUploadID returns the value of UploadID, or the zero value if it is not set.
+func (s *UploadPartInput) GetUploadID() string {
+	if s == nil || s.UploadID == nil {
+		return ""
+	}
+	return *s.UploadID
 }
 
-type metadataPutObjectInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Body"`
+// SetUploadID sets the value of UploadID.
+func (s *UploadPartInput) SetUploadID(v string) *UploadPartInput {
+	s.UploadID = &v
+	return s
 }
 
-type PutObjectOutput struct {
+type UploadPartOutput struct {
 	// Entity tag for the uploaded object.
 	ETag *string `location:"header" locationName:"ETag" type:"string"`
 
-	// If the object expiration is configured, this will contain the expiration
-	// date (expiry-date) and rule ID (rule-id). The value of rule-id is URL encoded.
-	Expiration *string `location:"header" locationName:"x-amz-expiration" type:"string"`
-
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	//
+	// The valid values are the RequestCharged enum constants.
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4967,545 +24549,1017 @@ type PutObjectOutput struct {
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
-
-	// Version of the object.
-	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
+	//
+	// The valid values are the ServerSideEncryption enum constants.
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256,aws:kms"`
 
-	NewFileName *string `location:"header" locationName:"newfilename" type:"string"`
-
-	metadataPutObjectOutput `json:"-" xml:"-"`
+	metadataUploadPartOutput `json:"-" xml:"-"`
 }
 
-type metadataPutObjectOutput struct {
+type metadataUploadPartOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Container for specifying an configuration when you want Amazon S3 to publish
-// events to an Amazon Simple Queue Service (Amazon SQS) queue.
-type QueueConfiguration struct {
-	Events []*string `locationName:"Event" type:"list" flattened:"true" required:"true"`
+// String returns the string representation.
+func (s UploadPartOutput) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+// GoString returns the string representation.
+func (s UploadPartOutput) GoString() string {
+	return s.String()
+}
 
-	// Amazon SQS queue ARN to which Amazon S3 will publish a message when it detects
-	// events of specified type.
-	QueueARN *string `locationName:"Queue" type:"string" required:"true"`
+// GetETag returns the value of ETag, or the zero value if it is not set.
+func (s *UploadPartOutput) GetETag() string {
+	if s == nil || s.ETag == nil {
+		return ""
+	}
+	return *s.ETag
+}
 
-	metadataQueueConfiguration `json:"-" xml:"-"`
+// SetETag sets the value of ETag.
+func (s *UploadPartOutput) SetETag(v string) *UploadPartOutput {
+	s.ETag = &v
+	return s
 }
 
-type metadataQueueConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetRequestCharged returns the value of RequestCharged, or the zero value if it is not set.
+func (s *UploadPartOutput) GetRequestCharged() string {
+	if s == nil || s.RequestCharged == nil {
+		return ""
+	}
+	return *s.RequestCharged
 }
 
-type QueueConfigurationDeprecated struct {
-	// Bucket event for which to send notifications.
-	Event *string `type:"string"`
+// SetRequestCharged sets the value of RequestCharged.
+func (s *UploadPartOutput) SetRequestCharged(v string) *UploadPartOutput {
+	s.RequestCharged = &v
+	return s
+}
 
-	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+// GetSSECustomerAlgorithm returns the value of SSECustomerAlgorithm, or the zero value if it is not set.
+func (s *UploadPartOutput) GetSSECustomerAlgorithm() string {
+	if s == nil || s.SSECustomerAlgorithm == nil {
+		return ""
+	}
+	return *s.SSECustomerAlgorithm
+}
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+// SetSSECustomerAlgorithm sets the value of SSECustomerAlgorithm.
+func (s *UploadPartOutput) SetSSECustomerAlgorithm(v string) *UploadPartOutput {
+	s.SSECustomerAlgorithm = &v
+	return s
+}
 
-	Queue *string `type:"string"`
+// GetSSECustomerKeyMD5 returns the value of SSECustomerKeyMD5, or the zero value if it is not set.
+func (s *UploadPartOutput) GetSSECustomerKeyMD5() string {
+	if s == nil || s.SSECustomerKeyMD5 == nil {
+		return ""
+	}
+	return *s.SSECustomerKeyMD5
+}
 
-	metadataQueueConfigurationDeprecated `json:"-" xml:"-"`
+// SetSSECustomerKeyMD5 sets the value of SSECustomerKeyMD5.
+func (s *UploadPartOutput) SetSSECustomerKeyMD5(v string) *UploadPartOutput {
+	s.SSECustomerKeyMD5 = &v
+	return s
 }
 
-type metadataQueueConfigurationDeprecated struct {
-	SDKShapeTraits bool `type:"structure"`
+// GetSSEKMSKeyID returns the value of SSEKMSKeyID, or the zero value if it is not set.
+func (s *UploadPartOutput) GetSSEKMSKeyID() string {
+	if s == nil || s.SSEKMSKeyID == nil {
+		return ""
+	}
+	return *s.SSEKMSKeyID
 }
 
-type Redirect struct {
-	// The HTTP redirect code to use on the response. Not required if one of the
-	// siblings is present.
-	HTTPRedirectCode *string `locationName:"HttpRedirectCode" type:"string"`
+// SetSSEKMSKeyID sets the value of SSEKMSKeyID.
+func (s *UploadPartOutput) SetSSEKMSKeyID(v string) *UploadPartOutput {
+	s.SSEKMSKeyID = &v
+	return s
+}
 
-	// The host name to use in the redirect request.
-	HostName *string `type:"string"`
+// GetServerSideEncryption returns the value of ServerSideEncryption, or the zero value if it is not set.
+func (s *UploadPartOutput) GetServerSideEncryption() string {
+	if s == nil || s.ServerSideEncryption == nil {
+		return ""
+	}
+	return *s.ServerSideEncryption
+}
 
-	// Protocol to use (http, https) when redirecting requests. The default is the
-	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
+// SetServerSideEncryption sets the value of ServerSideEncryption.
+func (s *UploadPartOutput) SetServerSideEncryption(v string) *UploadPartOutput {
+	s.ServerSideEncryption = &v
+	return s
+}
 
-	// The object key prefix to use in the redirect request. For example, to redirect
-	// requests for all pages with prefix docs/ (objects in the docs/ folder) to
-	// documents/, you can set a condition block with KeyPrefixEquals set to docs/
-	// and in the Redirect set ReplaceKeyPrefixWith to /documents. Not required
-	// if one of the siblings is present. Can be present only if ReplaceKeyWith
-	// is not provided.
-	ReplaceKeyPrefixWith *string `type:"string"`
+type VersioningConfiguration struct {
+	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
+	// This element is only returned if the bucket has been configured with MFA
+	// delete. If the bucket has never been so configured, this element is not returned.
+	//
+	// The valid values are the MFADelete enum constants.
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
 
-	// The specific object key to use in the redirect request. For example, redirect
-	// request to error.html. Not required if one of the sibling is present. Can
-	// be present only if ReplaceKeyPrefixWith is not provided.
-	ReplaceKeyWith *string `type:"string"`
+	// The versioning state of the bucket.
+	//
+	// The valid values are the BucketVersioningStatus enum constants.
+	Status *string `type:"string" enum:"Enabled,Suspended"`
 
-	metadataRedirect `json:"-" xml:"-"`
+	metadataVersioningConfiguration `json:"-" xml:"-"`
 }
 
-type metadataRedirect struct {
+type metadataVersioningConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type RedirectAllRequestsTo struct {
-	// Name of the host where requests will be redirected.
-	HostName *string `type:"string" required:"true"`
-
-	// Protocol to use (http, https) when redirecting requests. The default is the
-	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
-
-	metadataRedirectAllRequestsTo `json:"-" xml:"-"`
+// String returns the string representation.
+func (s VersioningConfiguration) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataRedirectAllRequestsTo struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s VersioningConfiguration) GoString() string {
+	return s.String()
 }
 
-// Container for replication rules. You can add as many as 1,000 rules. Total
-// replication configuration size can be up to 2 MB.
-type ReplicationConfiguration struct {
-	// Amazon Resource Name (ARN) of an IAM role for Amazon S3 to assume when replicating
-	// the objects.
-	Role *string `type:"string" required:"true"`
+// GetMFADelete returns the value of MFADelete, or the zero value if it is not set.
+func (s *VersioningConfiguration) GetMFADelete() string {
+	if s == nil || s.MFADelete == nil {
+		return ""
+	}
+	return *s.MFADelete
+}
 
-	// Container for information about a particular replication rule. Replication
-	// configuration must have at least one rule and can contain up to 1,000 rules.
-	Rules []*ReplicationRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+// SetMFADelete sets the value of MFADelete.
+func (s *VersioningConfiguration) SetMFADelete(v string) *VersioningConfiguration {
+	s.MFADelete = &v
+	return s
+}
 
-	metadataReplicationConfiguration `json:"-" xml:"-"`
+// GetStatus returns the value of Status, or the zero value if it is not set.
+func (s *VersioningConfiguration) GetStatus() string {
+	if s == nil || s.Status == nil {
+		return ""
+	}
+	return *s.Status
 }
 
-type metadataReplicationConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// SetStatus sets the value of Status.
+func (s *VersioningConfiguration) SetStatus(v string) *VersioningConfiguration {
+	s.Status = &v
+	return s
 }
 
-type ReplicationRule struct {
-	Destination *Destination `type:"structure" required:"true"`
+type WebsiteConfiguration struct {
+	ErrorDocument *ErrorDocument `type:"structure"`
 
-	// Unique identifier for the rule. The value cannot be longer than 255 characters.
-	ID *string `type:"string"`
+	IndexDocument *IndexDocument `type:"structure"`
 
-	// Object keyname prefix identifying one or more objects to which the rule applies.
-	// Maximum prefix length can be up to 1,024 characters. Overlapping prefixes
-	// are not supported.
-	Prefix *string `type:"string" required:"true"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `type:"structure"`
 
-	// The rule is ignored if status is not Enabled.
-	Status *string `type:"string" required:"true"`
+	RoutingRules []*RoutingRule `locationNameList:"RoutingRule" type:"list"`
 
-	metadataReplicationRule `json:"-" xml:"-"`
+	metadataWebsiteConfiguration `json:"-" xml:"-"`
 }
 
-type metadataReplicationRule struct {
+type metadataWebsiteConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type RequestPaymentConfiguration struct {
-	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string" required:"true"`
-
-	metadataRequestPaymentConfiguration `json:"-" xml:"-"`
+// String returns the string representation.
+func (s WebsiteConfiguration) String() string {
+	return awsutil.StringValue(s)
 }
 
-type metadataRequestPaymentConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// GoString returns the string representation.
+func (s WebsiteConfiguration) GoString() string {
+	return s.String()
 }
 
-type RestoreObjectInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// GetErrorDocument returns the value of ErrorDocument, or nil if it is not set.
+func (s *WebsiteConfiguration) GetErrorDocument() *ErrorDocument {
+	if s == nil {
+		return nil
+	}
+	return s.ErrorDocument
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// SetErrorDocument sets the value of ErrorDocument.
+func (s *WebsiteConfiguration) SetErrorDocument(v *ErrorDocument) *WebsiteConfiguration {
+	s.ErrorDocument = v
+	return s
+}
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// GetIndexDocument returns the value of IndexDocument, or nil if it is not set.
+func (s *WebsiteConfiguration) GetIndexDocument() *IndexDocument {
+	if s == nil {
+		return nil
+	}
+	return s.IndexDocument
+}
 
-	RestoreRequest *RestoreRequest `locationName:"RestoreRequest" type:"structure"`
+// SetIndexDocument sets the value of IndexDocument.
+func (s *WebsiteConfiguration) SetIndexDocument(v *IndexDocument) *WebsiteConfiguration {
+	s.IndexDocument = v
+	return s
+}
 
-	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
+// GetRedirectAllRequestsTo returns the value of RedirectAllRequestsTo, or nil if it is not set.
+func (s *WebsiteConfiguration) GetRedirectAllRequestsTo() *RedirectAllRequestsTo {
+	if s == nil {
+		return nil
+	}
+	return s.RedirectAllRequestsTo
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// SetRedirectAllRequestsTo sets the value of RedirectAllRequestsTo.
+func (s *WebsiteConfiguration) SetRedirectAllRequestsTo(v *RedirectAllRequestsTo) *WebsiteConfiguration {
+	s.RedirectAllRequestsTo = v
+	return s
+}
 
-	metadataRestoreObjectInput `json:"-" xml:"-"`
+// GetRoutingRules returns the value of RoutingRules, or nil if it is not set.
+func (s *WebsiteConfiguration) GetRoutingRules() []*RoutingRule {
+	if s == nil {
+		return nil
+	}
+	return s.RoutingRules
 }
 
-type metadataRestoreObjectInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"RestoreRequest"`
+// SetRoutingRules sets the value of RoutingRules.
+func (s *WebsiteConfiguration) SetRoutingRules(v []*RoutingRule) *WebsiteConfiguration {
+	s.RoutingRules = v
+	return s
 }
 
-type RestoreObjectOutput struct {
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// AnalyticsS3ExportFileFormat is the type of the AnalyticsS3ExportFileFormat enum values.
+type AnalyticsS3ExportFileFormat string
 
-	metadataRestoreObjectOutput `json:"-" xml:"-"`
+// Enum values for AnalyticsS3ExportFileFormat.
+const (
+	AnalyticsS3ExportFileFormatCSV AnalyticsS3ExportFileFormat = "CSV"
+)
+
+// AnalyticsS3ExportFileFormatValues returns the values of the AnalyticsS3ExportFileFormat enum.
+func AnalyticsS3ExportFileFormatValues() []AnalyticsS3ExportFileFormat {
+	return []AnalyticsS3ExportFileFormat{
+		AnalyticsS3ExportFileFormatCSV,
+	}
 }
 
-type metadataRestoreObjectOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// BucketCannedACL is the type of the BucketCannedACL enum values.
+type BucketCannedACL string
+
+// Enum values for BucketCannedACL.
+const (
+	BucketCannedACLPrivate           BucketCannedACL = "private"
+	BucketCannedACLPublicRead        BucketCannedACL = "public-read"
+	BucketCannedACLPublicReadWrite   BucketCannedACL = "public-read-write"
+	BucketCannedACLAuthenticatedRead BucketCannedACL = "authenticated-read"
+)
+
+// BucketCannedACLValues returns the values of the BucketCannedACL enum.
+func BucketCannedACLValues() []BucketCannedACL {
+	return []BucketCannedACL{
+		BucketCannedACLPrivate,
+		BucketCannedACLPublicRead,
+		BucketCannedACLPublicReadWrite,
+		BucketCannedACLAuthenticatedRead,
+	}
 }
 
-type RestoreRequest struct {
-	// Lifetime of the active copy in days
-	Days *int64 `type:"integer" required:"true"`
+// BucketLocationConstraint is the type of the BucketLocationConstraint enum values.
+type BucketLocationConstraint string
+
+// Enum values for BucketLocationConstraint.
+const (
+	BucketLocationConstraintEU           BucketLocationConstraint = "EU"
+	BucketLocationConstraintEUWest1      BucketLocationConstraint = "eu-west-1"
+	BucketLocationConstraintUsWest1      BucketLocationConstraint = "us-west-1"
+	BucketLocationConstraintUsWest2      BucketLocationConstraint = "us-west-2"
+	BucketLocationConstraintAPSoutheast1 BucketLocationConstraint = "ap-southeast-1"
+	BucketLocationConstraintAPSoutheast2 BucketLocationConstraint = "ap-southeast-2"
+	BucketLocationConstraintAPNortheast1 BucketLocationConstraint = "ap-northeast-1"
+	BucketLocationConstraintSAEast1      BucketLocationConstraint = "sa-east-1"
+	BucketLocationConstraintCNNorth1     BucketLocationConstraint = "cn-north-1"
+	BucketLocationConstraintEUCentral1   BucketLocationConstraint = "eu-central-1"
+)
 
-	metadataRestoreRequest `json:"-" xml:"-"`
+// BucketLocationConstraintValues returns the values of the BucketLocationConstraint enum.
+func BucketLocationConstraintValues() []BucketLocationConstraint {
+	return []BucketLocationConstraint{
+		BucketLocationConstraintEU,
+		BucketLocationConstraintEUWest1,
+		BucketLocationConstraintUsWest1,
+		BucketLocationConstraintUsWest2,
+		BucketLocationConstraintAPSoutheast1,
+		BucketLocationConstraintAPSoutheast2,
+		BucketLocationConstraintAPNortheast1,
+		BucketLocationConstraintSAEast1,
+		BucketLocationConstraintCNNorth1,
+		BucketLocationConstraintEUCentral1,
+	}
 }
 
-type metadataRestoreRequest struct {
-	SDKShapeTraits bool `type:"structure"`
+// BucketLogsPermission is the type of the BucketLogsPermission enum values.
+type BucketLogsPermission string
+
+// Enum values for BucketLogsPermission.
+const (
+	BucketLogsPermissionFullControl BucketLogsPermission = "FULL_CONTROL"
+	BucketLogsPermissionRead        BucketLogsPermission = "READ"
+	BucketLogsPermissionWrite       BucketLogsPermission = "WRITE"
+)
+
+// BucketLogsPermissionValues returns the values of the BucketLogsPermission enum.
+func BucketLogsPermissionValues() []BucketLogsPermission {
+	return []BucketLogsPermission{
+		BucketLogsPermissionFullControl,
+		BucketLogsPermissionRead,
+		BucketLogsPermissionWrite,
+	}
 }
 
-type RoutingRule struct {
-	// A container for describing a condition that must be met for the specified
-	// redirect to apply. For example, 1. If request is for pages in the /docs folder,
-	// redirect to the /documents folder. 2. If request results in HTTP error 4xx,
-	// redirect request to another host where you might process the error.
-	Condition *Condition `type:"structure"`
+// BucketVersioningStatus is the type of the BucketVersioningStatus enum values.
+type BucketVersioningStatus string
 
-	// Container for redirect information. You can redirect requests to another
-	// host, to another page, or with another protocol. In the event of an error,
-	// you can can specify a different error code to return.
-	Redirect *Redirect `type:"structure" required:"true"`
+// Enum values for BucketVersioningStatus.
+const (
+	BucketVersioningStatusEnabled   BucketVersioningStatus = "Enabled"
+	BucketVersioningStatusSuspended BucketVersioningStatus = "Suspended"
+)
 
-	metadataRoutingRule `json:"-" xml:"-"`
+// BucketVersioningStatusValues returns the values of the BucketVersioningStatus enum.
+func BucketVersioningStatusValues() []BucketVersioningStatus {
+	return []BucketVersioningStatus{
+		BucketVersioningStatusEnabled,
+		BucketVersioningStatusSuspended,
+	}
 }
 
-type metadataRoutingRule struct {
-	SDKShapeTraits bool `type:"structure"`
+// CompressionType is the type of the CompressionType enum values.
+type CompressionType string
+
+// Enum values for CompressionType.
+const (
+	CompressionTypeNone  CompressionType = "NONE"
+	CompressionTypeGZIP  CompressionType = "GZIP"
+	CompressionTypeBZIP2 CompressionType = "BZIP2"
+)
+
+// CompressionTypeValues returns the values of the CompressionType enum.
+func CompressionTypeValues() []CompressionType {
+	return []CompressionType{
+		CompressionTypeNone,
+		CompressionTypeGZIP,
+		CompressionTypeBZIP2,
+	}
 }
 
-type Tag struct {
-	// Name of the tag.
-	Key *string `type:"string" required:"true"`
+// EncodingType is the type of the EncodingType enum values.
+type EncodingType string
 
-	// Value of the tag.
-	Value *string `type:"string" required:"true"`
+// Enum values for EncodingType.
+const (
+	EncodingTypeURL EncodingType = "url"
+)
 
-	metadataTag `json:"-" xml:"-"`
+// EncodingTypeValues returns the values of the EncodingType enum.
+func EncodingTypeValues() []EncodingType {
+	return []EncodingType{
+		EncodingTypeURL,
+	}
 }
 
-type metadataTag struct {
-	SDKShapeTraits bool `type:"structure"`
+// Event is the type of the Event enum values.
+type Event string
+
+// Enum values for Event.
+const (
+	EventS3ReducedRedundancyLostObject          Event = "s3:ReducedRedundancyLostObject"
+	EventS3ObjectCreated                        Event = "s3:ObjectCreated:*"
+	EventS3ObjectCreatedPut                     Event = "s3:ObjectCreated:Put"
+	EventS3ObjectCreatedPost                    Event = "s3:ObjectCreated:Post"
+	EventS3ObjectCreatedCopy                    Event = "s3:ObjectCreated:Copy"
+	EventS3ObjectCreatedCompleteMultipartUpload Event = "s3:ObjectCreated:CompleteMultipartUpload"
+	EventS3ObjectRemoved                        Event = "s3:ObjectRemoved:*"
+	EventS3ObjectRemovedDelete                  Event = "s3:ObjectRemoved:Delete"
+	EventS3ObjectRemovedDeleteMarkerCreated     Event = "s3:ObjectRemoved:DeleteMarkerCreated"
+)
+
+// EventValues returns the values of the Event enum.
+func EventValues() []Event {
+	return []Event{
+		EventS3ReducedRedundancyLostObject,
+		EventS3ObjectCreated,
+		EventS3ObjectCreatedPut,
+		EventS3ObjectCreatedPost,
+		EventS3ObjectCreatedCopy,
+		EventS3ObjectCreatedCompleteMultipartUpload,
+		EventS3ObjectRemoved,
+		EventS3ObjectRemovedDelete,
+		EventS3ObjectRemovedDeleteMarkerCreated,
+	}
 }
 
-type Tagging struct {
-	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
+// ExpirationStatus is the type of the ExpirationStatus enum values.
+type ExpirationStatus string
 
-	metadataTagging `json:"-" xml:"-"`
+// Enum values for ExpirationStatus.
+const (
+	ExpirationStatusEnabled  ExpirationStatus = "Enabled"
+	ExpirationStatusDisabled ExpirationStatus = "Disabled"
+)
+
+// ExpirationStatusValues returns the values of the ExpirationStatus enum.
+func ExpirationStatusValues() []ExpirationStatus {
+	return []ExpirationStatus{
+		ExpirationStatusEnabled,
+		ExpirationStatusDisabled,
+	}
 }
 
-type metadataTagging struct {
-	SDKShapeTraits bool `type:"structure"`
+// ExpressionType is the type of the ExpressionType enum values.
+type ExpressionType string
+
+// Enum values for ExpressionType.
+const (
+	ExpressionTypeSQL ExpressionType = "SQL"
+)
+
+// ExpressionTypeValues returns the values of the ExpressionType enum.
+func ExpressionTypeValues() []ExpressionType {
+	return []ExpressionType{
+		ExpressionTypeSQL,
+	}
 }
 
-type TargetGrant struct {
-	Grantee *Grantee `type:"structure"`
+// FileHeaderInfo is the type of the FileHeaderInfo enum values.
+type FileHeaderInfo string
 
-	// Logging permissions assigned to the Grantee for the bucket.
-	Permission *string `type:"string"`
+// Enum values for FileHeaderInfo.
+const (
+	FileHeaderInfoUse    FileHeaderInfo = "USE"
+	FileHeaderInfoIgnore FileHeaderInfo = "IGNORE"
+	FileHeaderInfoNone   FileHeaderInfo = "NONE"
+)
 
-	metadataTargetGrant `json:"-" xml:"-"`
+// FileHeaderInfoValues returns the values of the FileHeaderInfo enum.
+func FileHeaderInfoValues() []FileHeaderInfo {
+	return []FileHeaderInfo{
+		FileHeaderInfoUse,
+		FileHeaderInfoIgnore,
+		FileHeaderInfoNone,
+	}
 }
 
-type metadataTargetGrant struct {
-	SDKShapeTraits bool `type:"structure"`
+// InventoryFormat is the type of the InventoryFormat enum values.
+type InventoryFormat string
+
+// Enum values for InventoryFormat.
+const (
+	InventoryFormatCSV     InventoryFormat = "CSV"
+	InventoryFormatORC     InventoryFormat = "ORC"
+	InventoryFormatParquet InventoryFormat = "Parquet"
+)
+
+// InventoryFormatValues returns the values of the InventoryFormat enum.
+func InventoryFormatValues() []InventoryFormat {
+	return []InventoryFormat{
+		InventoryFormatCSV,
+		InventoryFormatORC,
+		InventoryFormatParquet,
+	}
 }
 
-// Container for specifying the configuration when you want Amazon S3 to publish
-// events to an Amazon Simple Notification Service (Amazon SNS) topic.
-type TopicConfiguration struct {
-	Events []*string `locationName:"Event" type:"list" flattened:"true" required:"true"`
+// InventoryFrequency is the type of the InventoryFrequency enum values.
+type InventoryFrequency string
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+// Enum values for InventoryFrequency.
+const (
+	InventoryFrequencyDaily  InventoryFrequency = "Daily"
+	InventoryFrequencyWeekly InventoryFrequency = "Weekly"
+)
 
-	// Amazon SNS topic ARN to which Amazon S3 will publish a message when it detects
-	// events of specified type.
-	TopicARN *string `locationName:"Topic" type:"string" required:"true"`
+// InventoryFrequencyValues returns the values of the InventoryFrequency enum.
+func InventoryFrequencyValues() []InventoryFrequency {
+	return []InventoryFrequency{
+		InventoryFrequencyDaily,
+		InventoryFrequencyWeekly,
+	}
+}
+
+// InventoryIncludedObjectVersions is the type of the InventoryIncludedObjectVersions enum values.
+type InventoryIncludedObjectVersions string
+
+// Enum values for InventoryIncludedObjectVersions.
+const (
+	InventoryIncludedObjectVersionsAll     InventoryIncludedObjectVersions = "All"
+	InventoryIncludedObjectVersionsCurrent InventoryIncludedObjectVersions = "Current"
+)
 
-	metadataTopicConfiguration `json:"-" xml:"-"`
+// InventoryIncludedObjectVersionsValues returns the values of the InventoryIncludedObjectVersions enum.
+func InventoryIncludedObjectVersionsValues() []InventoryIncludedObjectVersions {
+	return []InventoryIncludedObjectVersions{
+		InventoryIncludedObjectVersionsAll,
+		InventoryIncludedObjectVersionsCurrent,
+	}
 }
 
-type metadataTopicConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// InventoryOptionalField is the type of the InventoryOptionalField enum values.
+type InventoryOptionalField string
+
+// Enum values for InventoryOptionalField.
+const (
+	InventoryOptionalFieldSize                      InventoryOptionalField = "Size"
+	InventoryOptionalFieldLastModifiedDate          InventoryOptionalField = "LastModifiedDate"
+	InventoryOptionalFieldStorageClass              InventoryOptionalField = "StorageClass"
+	InventoryOptionalFieldETag                      InventoryOptionalField = "ETag"
+	InventoryOptionalFieldIsMultipartUploaded       InventoryOptionalField = "IsMultipartUploaded"
+	InventoryOptionalFieldReplicationStatus         InventoryOptionalField = "ReplicationStatus"
+	InventoryOptionalFieldEncryptionStatus          InventoryOptionalField = "EncryptionStatus"
+	InventoryOptionalFieldObjectLockRetainUntilDate InventoryOptionalField = "ObjectLockRetainUntilDate"
+	InventoryOptionalFieldObjectLockMode            InventoryOptionalField = "ObjectLockMode"
+	InventoryOptionalFieldObjectLockLegalHoldStatus InventoryOptionalField = "ObjectLockLegalHoldStatus"
+)
+
+// InventoryOptionalFieldValues returns the values of the InventoryOptionalField enum.
+func InventoryOptionalFieldValues() []InventoryOptionalField {
+	return []InventoryOptionalField{
+		InventoryOptionalFieldSize,
+		InventoryOptionalFieldLastModifiedDate,
+		InventoryOptionalFieldStorageClass,
+		InventoryOptionalFieldETag,
+		InventoryOptionalFieldIsMultipartUploaded,
+		InventoryOptionalFieldReplicationStatus,
+		InventoryOptionalFieldEncryptionStatus,
+		InventoryOptionalFieldObjectLockRetainUntilDate,
+		InventoryOptionalFieldObjectLockMode,
+		InventoryOptionalFieldObjectLockLegalHoldStatus,
+	}
 }
 
-type TopicConfigurationDeprecated struct {
-	// Bucket event for which to send notifications.
-	Event *string `type:"string"`
+// JSONType is the type of the JSONType enum values.
+type JSONType string
 
-	Events []*string `locationName:"Event" type:"list" flattened:"true"`
+// Enum values for JSONType.
+const (
+	JSONTypeDocument JSONType = "DOCUMENT"
+	JSONTypeLines    JSONType = "LINES"
+)
 
-	// Optional unique identifier for configurations in a notification configuration.
-	// If you don't provide one, Amazon S3 will assign an ID.
-	ID *string `locationName:"Id" type:"string"`
+// JSONTypeValues returns the values of the JSONType enum.
+func JSONTypeValues() []JSONType {
+	return []JSONType{
+		JSONTypeDocument,
+		JSONTypeLines,
+	}
+}
 
-	// Amazon SNS topic to which Amazon S3 will publish a message to report the
-	// specified events for the bucket.
-	Topic *string `type:"string"`
+// MFADelete is the type of the MFADelete enum values.
+type MFADelete string
 
-	metadataTopicConfigurationDeprecated `json:"-" xml:"-"`
-}
+// Enum values for MFADelete.
+const (
+	MFADeleteEnabled  MFADelete = "Enabled"
+	MFADeleteDisabled MFADelete = "Disabled"
+)
 
-type metadataTopicConfigurationDeprecated struct {
-	SDKShapeTraits bool `type:"structure"`
+// MFADeleteValues returns the values of the MFADelete enum.
+func MFADeleteValues() []MFADelete {
+	return []MFADelete{
+		MFADeleteEnabled,
+		MFADeleteDisabled,
+	}
 }
 
-type Transition struct {
-	// Indicates at what date the object is to be moved or deleted. Should be in
-	// GMT ISO 8601 Format.
-	Date *time.Time `type:"timestamp" timestampFormat:"iso8601"`
-
-	// Indicates the lifetime, in days, of the objects that are subject to the rule.
-	// The value must be a non-zero positive integer.
-	Days *int64 `type:"integer"`
+// MFADeleteStatus is the type of the MFADeleteStatus enum values.
+type MFADeleteStatus string
 
-	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+// Enum values for MFADeleteStatus.
+const (
+	MFADeleteStatusEnabled  MFADeleteStatus = "Enabled"
+	MFADeleteStatusDisabled MFADeleteStatus = "Disabled"
+)
 
-	metadataTransition `json:"-" xml:"-"`
+// MFADeleteStatusValues returns the values of the MFADeleteStatus enum.
+func MFADeleteStatusValues() []MFADeleteStatus {
+	return []MFADeleteStatus{
+		MFADeleteStatusEnabled,
+		MFADeleteStatusDisabled,
+	}
 }
 
-type metadataTransition struct {
-	SDKShapeTraits bool `type:"structure"`
-}
+// MetadataDirective is the type of the MetadataDirective enum values.
+type MetadataDirective string
 
-type UploadPartCopyInput struct {
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// Enum values for MetadataDirective.
+const (
+	MetadataDirectiveCopy    MetadataDirective = "COPY"
+	MetadataDirectiveReplace MetadataDirective = "REPLACE"
+)
 
-	// The name of the source bucket and key name of the source object, separated
-	// by a slash (/). Must be URL-encoded.
-	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
+// MetadataDirectiveValues returns the values of the MetadataDirective enum.
+func MetadataDirectiveValues() []MetadataDirective {
+	return []MetadataDirective{
+		MetadataDirectiveCopy,
+		MetadataDirectiveReplace,
+	}
+}
 
-	// Copies the object if its entity tag (ETag) matches the specified tag.
-	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
+// ObjectCannedACL is the type of the ObjectCannedACL enum values.
+type ObjectCannedACL string
 
-	// Copies the object if it has been modified since the specified time.
-	CopySourceIfModifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-modified-since" type:"timestamp" timestampFormat:"rfc822"`
+// Enum values for ObjectCannedACL.
+const (
+	ObjectCannedACLPrivate                ObjectCannedACL = "private"
+	ObjectCannedACLPublicRead             ObjectCannedACL = "public-read"
+	ObjectCannedACLPublicReadWrite        ObjectCannedACL = "public-read-write"
+	ObjectCannedACLAuthenticatedRead      ObjectCannedACL = "authenticated-read"
+	ObjectCannedACLAWSExecRead            ObjectCannedACL = "aws-exec-read"
+	ObjectCannedACLBucketOwnerRead        ObjectCannedACL = "bucket-owner-read"
+	ObjectCannedACLBucketOwnerFullControl ObjectCannedACL = "bucket-owner-full-control"
+)
 
-	// Copies the object if its entity tag (ETag) is different than the specified
-	// ETag.
-	CopySourceIfNoneMatch *string `location:"header" locationName:"x-amz-copy-source-if-none-match" type:"string"`
+// ObjectCannedACLValues returns the values of the ObjectCannedACL enum.
+func ObjectCannedACLValues() []ObjectCannedACL {
+	return []ObjectCannedACL{
+		ObjectCannedACLPrivate,
+		ObjectCannedACLPublicRead,
+		ObjectCannedACLPublicReadWrite,
+		ObjectCannedACLAuthenticatedRead,
+		ObjectCannedACLAWSExecRead,
+		ObjectCannedACLBucketOwnerRead,
+		ObjectCannedACLBucketOwnerFullControl,
+	}
+}
 
-	// Copies the object if it hasn't been modified since the specified time.
-	CopySourceIfUnmodifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-unmodified-since" type:"timestamp" timestampFormat:"rfc822"`
+// ObjectLockEnabled is the type of the ObjectLockEnabled enum values.
+type ObjectLockEnabled string
 
-	// The range of bytes to copy from the source object. The range value must use
-	// the form bytes=first-last, where the first and last are the zero-based byte
-	// offsets to copy. For example, bytes=0-9 indicates that you want to copy the
-	// first ten bytes of the source. You can copy a range only if the source object
-	// is greater than 5 GB.
-	CopySourceRange *string `location:"header" locationName:"x-amz-copy-source-range" type:"string"`
+// Enum values for ObjectLockEnabled.
+const (
+	ObjectLockEnabledEnabled ObjectLockEnabled = "Enabled"
+)
 
-	// Specifies the algorithm to use when decrypting the source object (e.g., AES256).
-	CopySourceSSECustomerAlgorithm *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-algorithm" type:"string"`
+// ObjectLockEnabledValues returns the values of the ObjectLockEnabled enum.
+func ObjectLockEnabledValues() []ObjectLockEnabled {
+	return []ObjectLockEnabled{
+		ObjectLockEnabledEnabled,
+	}
+}
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
-	// the source object. The encryption key provided in this header must be one
-	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+// ObjectLockLegalHoldStatus is the type of the ObjectLockLegalHoldStatus enum values.
+type ObjectLockLegalHoldStatus string
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	CopySourceSSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key-MD5" type:"string"`
+// Enum values for ObjectLockLegalHoldStatus.
+const (
+	ObjectLockLegalHoldStatusOn  ObjectLockLegalHoldStatus = "ON"
+	ObjectLockLegalHoldStatusOff ObjectLockLegalHoldStatus = "OFF"
+)
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// ObjectLockLegalHoldStatusValues returns the values of the ObjectLockLegalHoldStatus enum.
+func ObjectLockLegalHoldStatusValues() []ObjectLockLegalHoldStatus {
+	return []ObjectLockLegalHoldStatus{
+		ObjectLockLegalHoldStatusOn,
+		ObjectLockLegalHoldStatusOff,
+	}
+}
 
-	// Part number of part being copied.
-	PartNumber *int64 `location:"querystring" locationName:"partNumber" type:"integer" required:"true"`
+// ObjectLockMode is the type of the ObjectLockMode enum values.
+type ObjectLockMode string
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// Enum values for ObjectLockMode.
+const (
+	ObjectLockModeGovernance ObjectLockMode = "GOVERNANCE"
+	ObjectLockModeCompliance ObjectLockMode = "COMPLIANCE"
+)
 
-	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
-	// aws:kms).
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// ObjectLockModeValues returns the values of the ObjectLockMode enum.
+func ObjectLockModeValues() []ObjectLockMode {
+	return []ObjectLockMode{
+		ObjectLockModeGovernance,
+		ObjectLockModeCompliance,
+	}
+}
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
-	// data. This value is used to store the object and then it is discarded; Amazon
-	// does not store the encryption key. The key must be appropriate for use with
-	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header. This must be the same encryption key specified in the initiate multipart
-	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+// ObjectLockRetentionMode is the type of the ObjectLockRetentionMode enum values.
+type ObjectLockRetentionMode string
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+// Enum values for ObjectLockRetentionMode.
+const (
+	ObjectLockRetentionModeGovernance ObjectLockRetentionMode = "GOVERNANCE"
+	ObjectLockRetentionModeCompliance ObjectLockRetentionMode = "COMPLIANCE"
+)
 
-	// Upload ID identifying the multipart upload whose part is being copied.
-	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+// ObjectLockRetentionModeValues returns the values of the ObjectLockRetentionMode enum.
+func ObjectLockRetentionModeValues() []ObjectLockRetentionMode {
+	return []ObjectLockRetentionMode{
+		ObjectLockRetentionModeGovernance,
+		ObjectLockRetentionModeCompliance,
+	}
+}
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// ObjectStorageClass is the type of the ObjectStorageClass enum values.
+type ObjectStorageClass string
 
-	metadataUploadPartCopyInput `json:"-" xml:"-"`
-}
+// Enum values for ObjectStorageClass.
+const (
+	ObjectStorageClassStandard           ObjectStorageClass = "STANDARD"
+	ObjectStorageClassReducedRedundancy  ObjectStorageClass = "REDUCED_REDUNDANCY"
+	ObjectStorageClassGlacier            ObjectStorageClass = "GLACIER"
+	ObjectStorageClassStandardIA         ObjectStorageClass = "STANDARD_IA"
+	ObjectStorageClassOnezoneIA          ObjectStorageClass = "ONEZONE_IA"
+	ObjectStorageClassIntelligentTiering ObjectStorageClass = "INTELLIGENT_TIERING"
+	ObjectStorageClassDeepArchive        ObjectStorageClass = "DEEP_ARCHIVE"
+)
 
-type metadataUploadPartCopyInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// ObjectStorageClassValues returns the values of the ObjectStorageClass enum.
+func ObjectStorageClassValues() []ObjectStorageClass {
+	return []ObjectStorageClass{
+		ObjectStorageClassStandard,
+		ObjectStorageClassReducedRedundancy,
+		ObjectStorageClassGlacier,
+		ObjectStorageClassStandardIA,
+		ObjectStorageClassOnezoneIA,
+		ObjectStorageClassIntelligentTiering,
+		ObjectStorageClassDeepArchive,
+	}
 }
 
-type UploadPartCopyOutput struct {
-	CopyPartResult *CopyPartResult `type:"structure"`
+// ObjectVersionStorageClass is the type of the ObjectVersionStorageClass enum values.
+type ObjectVersionStorageClass string
 
-	// The version of the source object that was copied, if you have enabled versioning
-	// on the source bucket.
-	CopySourceVersionID *string `location:"header" locationName:"x-amz-copy-source-version-id" type:"string"`
+// Enum values for ObjectVersionStorageClass.
+const (
+	ObjectVersionStorageClassStandard ObjectVersionStorageClass = "STANDARD"
+)
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// ObjectVersionStorageClassValues returns the values of the ObjectVersionStorageClass enum.
+func ObjectVersionStorageClassValues() []ObjectVersionStorageClass {
+	return []ObjectVersionStorageClass{
+		ObjectVersionStorageClassStandard,
+	}
+}
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// Payer is the type of the Payer enum values.
+type Payer string
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+// Enum values for Payer.
+const (
+	PayerRequester   Payer = "Requester"
+	PayerBucketOwner Payer = "BucketOwner"
+)
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+// PayerValues returns the values of the Payer enum.
+func PayerValues() []Payer {
+	return []Payer{
+		PayerRequester,
+		PayerBucketOwner,
+	}
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// Permission is the type of the Permission enum values.
+type Permission string
 
-	metadataUploadPartCopyOutput `json:"-" xml:"-"`
-}
+// Enum values for Permission.
+const (
+	PermissionFullControl Permission = "FULL_CONTROL"
+	PermissionWrite       Permission = "WRITE"
+	PermissionWriteACP    Permission = "WRITE_ACP"
+	PermissionRead        Permission = "READ"
+	PermissionReadACP     Permission = "READ_ACP"
+)
 
-type metadataUploadPartCopyOutput struct {
-	SDKShapeTraits bool `type:"structure" payload:"CopyPartResult"`
+// PermissionValues returns the values of the Permission enum.
+func PermissionValues() []Permission {
+	return []Permission{
+		PermissionFullControl,
+		PermissionWrite,
+		PermissionWriteACP,
+		PermissionRead,
+		PermissionReadACP,
+	}
 }
 
-type UploadPartInput struct {
-	Body io.ReadSeeker `type:"blob"`
+// Protocol is the type of the Protocol enum values.
+type Protocol string
 
-	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+// Enum values for Protocol.
+const (
+	ProtocolHTTP  Protocol = "http"
+	ProtocolHTTPS Protocol = "https"
+)
 
-	// Size of the body in bytes. This parameter is useful when the size of the
-	// body cannot be determined automatically.
-	ContentLength *int64 `location:"header" locationName:"Content-Length" type:"integer"`
+// ProtocolValues returns the values of the Protocol enum.
+func ProtocolValues() []Protocol {
+	return []Protocol{
+		ProtocolHTTP,
+		ProtocolHTTPS,
+	}
+}
 
-	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+// QuoteFields is the type of the QuoteFields enum values.
+type QuoteFields string
 
-	// Part number of part being uploaded.
-	PartNumber *int64 `location:"querystring" locationName:"partNumber" type:"integer" required:"true"`
+// Enum values for QuoteFields.
+const (
+	QuoteFieldsAlways   QuoteFields = "ALWAYS"
+	QuoteFieldsAsNeeded QuoteFields = "ASNEEDED"
+)
 
-	// Confirms that the requester knows that she or he will be charged for the
-	// request. Bucket owners need not specify this parameter in their requests.
-	// Documentation on downloading objects from requester pays buckets can be found
-	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+// QuoteFieldsValues returns the values of the QuoteFields enum.
+func QuoteFieldsValues() []QuoteFields {
+	return []QuoteFields{
+		QuoteFieldsAlways,
+		QuoteFieldsAsNeeded,
+	}
+}
 
-	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
-	// aws:kms).
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// ReplicationRuleStatus is the type of the ReplicationRuleStatus enum values.
+type ReplicationRuleStatus string
 
-	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
-	// data. This value is used to store the object and then it is discarded; Amazon
-	// does not store the encryption key. The key must be appropriate for use with
-	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
-	// header. This must be the same encryption key specified in the initiate multipart
-	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+// Enum values for ReplicationRuleStatus.
+const (
+	ReplicationRuleStatusEnabled  ReplicationRuleStatus = "Enabled"
+	ReplicationRuleStatusDisabled ReplicationRuleStatus = "Disabled"
+)
 
-	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
-	// Amazon S3 uses this header for a message integrity check to ensure the encryption
-	// key was transmitted without error.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+// ReplicationRuleStatusValues returns the values of the ReplicationRuleStatus enum.
+func ReplicationRuleStatusValues() []ReplicationRuleStatus {
+	return []ReplicationRuleStatus{
+		ReplicationRuleStatusEnabled,
+		ReplicationRuleStatusDisabled,
+	}
+}
 
-	// Upload ID identifying the multipart upload whose part is being uploaded.
-	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
+// ReplicationStatus is the type of the ReplicationStatus enum values.
+type ReplicationStatus string
 
-	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+// Enum values for ReplicationStatus.
+const (
+	ReplicationStatusComplete ReplicationStatus = "COMPLETE"
+	ReplicationStatusPending  ReplicationStatus = "PENDING"
+	ReplicationStatusFailed   ReplicationStatus = "FAILED"
+	ReplicationStatusReplica  ReplicationStatus = "REPLICA"
+)
 
-	metadataUploadPartInput `json:"-" xml:"-"`
+// ReplicationStatusValues returns the values of the ReplicationStatus enum.
+func ReplicationStatusValues() []ReplicationStatus {
+	return []ReplicationStatus{
+		ReplicationStatusComplete,
+		ReplicationStatusPending,
+		ReplicationStatusFailed,
+		ReplicationStatusReplica,
+	}
 }
 
-type metadataUploadPartInput struct {
-	SDKShapeTraits bool `type:"structure" payload:"Body"`
-}
+// RequestCharged is the type of the RequestCharged enum values.
+type RequestCharged string
 
-type UploadPartOutput struct {
-	// Entity tag for the uploaded object.
-	ETag *string `location:"header" locationName:"ETag" type:"string"`
+// Enum values for RequestCharged.
+const (
+	RequestChargedRequester RequestCharged = "requester"
+)
 
-	// If present, indicates that the requester was successfully charged for the
-	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+// RequestChargedValues returns the values of the RequestCharged enum.
+func RequestChargedValues() []RequestCharged {
+	return []RequestCharged{
+		RequestChargedRequester,
+	}
+}
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header confirming the encryption algorithm
-	// used.
-	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+// RequestPayer is the type of the RequestPayer enum values.
+type RequestPayer string
 
-	// If server-side encryption with a customer-provided encryption key was requested,
-	// the response will include this header to provide round trip message integrity
-	// verification of the customer-provided encryption key.
-	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+// Enum values for RequestPayer.
+const (
+	RequestPayerRequester RequestPayer = "requester"
+)
 
-	// If present, specifies the ID of the AWS Key Management Service (KMS) master
-	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+// RequestPayerValues returns the values of the RequestPayer enum.
+func RequestPayerValues() []RequestPayer {
+	return []RequestPayer{
+		RequestPayerRequester,
+	}
+}
 
-	// The Server-side encryption algorithm used when storing this object in S3
-	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+// ServerSideEncryption is the type of the ServerSideEncryption enum values.
+type ServerSideEncryption string
 
-	metadataUploadPartOutput `json:"-" xml:"-"`
-}
+// Enum values for ServerSideEncryption.
+const (
+	ServerSideEncryptionAES256 ServerSideEncryption = "AES256"
+	ServerSideEncryptionAWSKMS ServerSideEncryption = "aws:kms"
+)
 
-type metadataUploadPartOutput struct {
-	SDKShapeTraits bool `type:"structure"`
+// ServerSideEncryptionValues returns the values of the ServerSideEncryption enum.
+func ServerSideEncryptionValues() []ServerSideEncryption {
+	return []ServerSideEncryption{
+		ServerSideEncryptionAES256,
+		ServerSideEncryptionAWSKMS,
+	}
 }
 
-type VersioningConfiguration struct {
-	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
-	// This element is only returned if the bucket has been configured with MFA
-	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+// StorageClass is the type of the StorageClass enum values.
+type StorageClass string
 
-	// The versioning state of the bucket.
-	Status *string `type:"string"`
+// Enum values for StorageClass.
+const (
+	StorageClassStandard           StorageClass = "STANDARD"
+	StorageClassReducedRedundancy  StorageClass = "REDUCED_REDUNDANCY"
+	StorageClassStandardIA         StorageClass = "STANDARD_IA"
+	StorageClassOnezoneIA          StorageClass = "ONEZONE_IA"
+	StorageClassIntelligentTiering StorageClass = "INTELLIGENT_TIERING"
+	StorageClassGlacier            StorageClass = "GLACIER"
+	StorageClassDeepArchive        StorageClass = "DEEP_ARCHIVE"
+)
 
-	metadataVersioningConfiguration `json:"-" xml:"-"`
+// StorageClassValues returns the values of the StorageClass enum.
+func StorageClassValues() []StorageClass {
+	return []StorageClass{
+		StorageClassStandard,
+		StorageClassReducedRedundancy,
+		StorageClassStandardIA,
+		StorageClassOnezoneIA,
+		StorageClassIntelligentTiering,
+		StorageClassGlacier,
+		StorageClassDeepArchive,
+	}
 }
 
-type metadataVersioningConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// StorageClassAnalysisSchemaVersion is the type of the StorageClassAnalysisSchemaVersion enum values.
+type StorageClassAnalysisSchemaVersion string
+
+// Enum values for StorageClassAnalysisSchemaVersion.
+const (
+	StorageClassAnalysisSchemaVersionV1 StorageClassAnalysisSchemaVersion = "V_1"
+)
+
+// StorageClassAnalysisSchemaVersionValues returns the values of the StorageClassAnalysisSchemaVersion enum.
+func StorageClassAnalysisSchemaVersionValues() []StorageClassAnalysisSchemaVersion {
+	return []StorageClassAnalysisSchemaVersion{
+		StorageClassAnalysisSchemaVersionV1,
+	}
 }
 
-type WebsiteConfiguration struct {
-	ErrorDocument *ErrorDocument `type:"structure"`
+// TaggingDirective is the type of the TaggingDirective enum values.
+type TaggingDirective string
 
-	IndexDocument *IndexDocument `type:"structure"`
+// Enum values for TaggingDirective.
+const (
+	TaggingDirectiveCopy    TaggingDirective = "COPY"
+	TaggingDirectiveReplace TaggingDirective = "REPLACE"
+)
 
-	RedirectAllRequestsTo *RedirectAllRequestsTo `type:"structure"`
+// TaggingDirectiveValues returns the values of the TaggingDirective enum.
+func TaggingDirectiveValues() []TaggingDirective {
+	return []TaggingDirective{
+		TaggingDirectiveCopy,
+		TaggingDirectiveReplace,
+	}
+}
 
-	RoutingRules []*RoutingRule `locationNameList:"RoutingRule" type:"list"`
+// TransitionStorageClass is the type of the TransitionStorageClass enum values.
+type TransitionStorageClass string
 
-	metadataWebsiteConfiguration `json:"-" xml:"-"`
+// Enum values for TransitionStorageClass.
+const (
+	TransitionStorageClassGlacier            TransitionStorageClass = "GLACIER"
+	TransitionStorageClassStandardIA         TransitionStorageClass = "STANDARD_IA"
+	TransitionStorageClassOnezoneIA          TransitionStorageClass = "ONEZONE_IA"
+	TransitionStorageClassIntelligentTiering TransitionStorageClass = "INTELLIGENT_TIERING"
+	TransitionStorageClassDeepArchive        TransitionStorageClass = "DEEP_ARCHIVE"
+)
+
+// TransitionStorageClassValues returns the values of the TransitionStorageClass enum.
+func TransitionStorageClassValues() []TransitionStorageClass {
+	return []TransitionStorageClass{
+		TransitionStorageClassGlacier,
+		TransitionStorageClassStandardIA,
+		TransitionStorageClassOnezoneIA,
+		TransitionStorageClassIntelligentTiering,
+		TransitionStorageClassDeepArchive,
+	}
 }
 
-type metadataWebsiteConfiguration struct {
-	SDKShapeTraits bool `type:"structure"`
+// Type is the type of the Type enum values.
+type Type string
+
+// Enum values for Type.
+const (
+	TypeCanonicalUser         Type = "CanonicalUser"
+	TypeAmazonCustomerByEmail Type = "AmazonCustomerByEmail"
+	TypeGroup                 Type = "Group"
+)
+
+// TypeValues returns the values of the Type enum.
+func TypeValues() []Type {
+	return []Type{
+		TypeCanonicalUser,
+		TypeAmazonCustomerByEmail,
+		TypeGroup,
+	}
 }
@@ -0,0 +1,231 @@
+package s3
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/credentials"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// bucketRegionHeader is the header S3 returns the region of a bucket in.
+const bucketRegionHeader = "X-Amz-Bucket-Region"
+
+var (
+	reErrorCode     = regexp.MustCompile(`<Code>([^<>]+)</Code>`)
+	reErrorRegion   = regexp.MustCompile(`<Region>([^<>]+)</Region>`)
+	reErrorEndpoint = regexp.MustCompile(`<Endpoint>[^<>]*s3[\.\-]([a-z0-9\-]+)\.amazonaws\.com</Endpoint>`)
+)
+
+// bucketRegions caches the regions of buckets learned from redirects, so
+// that later requests for those buckets go to the right endpoint directly.
+var bucketRegions = struct {
+	sync.RWMutex
+	m map[string]string
+}{m: map[string]string{}}
+
+func cachedBucketRegion(bucket string) string {
+	bucketRegions.RLock()
+	defer bucketRegions.RUnlock()
+	return bucketRegions.m[bucket]
+}
+
+func cacheBucketRegion(bucket, region string) {
+	bucketRegions.Lock()
+	defer bucketRegions.Unlock()
+	bucketRegions.m[bucket] = region
+}
+
+// requestRegion returns the region the request is currently sent to.
+func requestRegion(r *aws.Request) string {
+	if r.Service.SigningRegion != "" {
+		return r.Service.SigningRegion
+	}
+	return r.Config.Region
+}
+
+// redirectEnabled returns whether requests of r may be sent to other regions.
+// Requests to a custom endpoint or through S3 Transfer Acceleration are never
+// redirected.
+func redirectEnabled(r *aws.Request) bool {
+	return r.Config.Endpoint == "" && !r.Config.S3BucketEndpoint && !useAccelerate(r) &&
+		r.Operation != opCreateBucket
+}
+
+// useCachedBucketRegion sends the request to the regional endpoint of its
+// bucket if the region of the bucket is already known.
+func useCachedBucketRegion(r *aws.Request) {
+	if !redirectEnabled(r) {
+		return
+	}
+	bucket := requestParam(r, "Bucket")
+	if bucket == "" {
+		return
+	}
+	if region := cachedBucketRegion(bucket); region != "" && region != requestRegion(r) {
+		redirectToRegion(r, region)
+	}
+}
+
+// addBucketRegionRedirect makes the request follow its bucket once to
+// another region if S3 reports that the bucket lives there. A redirect does
+// not count against the retries of the request.
+func addBucketRegionRedirect(r *aws.Request) {
+	redirected, pending := false, false
+	r.Handlers.UnmarshalError.PushFront(func(r *aws.Request) {
+		if !redirected && redirectBucketRegion(r) {
+			redirected, pending = true, true
+		}
+	})
+	r.Handlers.AfterRetry.PushFront(func(r *aws.Request) {
+		if pending {
+			pending = false
+			r.Error = nil
+		}
+	})
+}
+
+// redirectBucketRegion moves a request which failed because its bucket
+// lives in another region to the endpoint of that region, and returns true
+// if the request should be sent again. The region is taken from the
+// x-amz-bucket-region header, or from the error body of PermanentRedirect
+// and AuthorizationHeaderMalformed responses.
+func redirectBucketRegion(r *aws.Request) bool {
+	if !redirectEnabled(r) {
+		return false
+	}
+	status := r.HTTPResponse.StatusCode
+	if status != 301 && status != 400 {
+		return false
+	}
+	bucket := requestParam(r, "Bucket")
+	if bucket == "" {
+		return false
+	}
+
+	region := r.HTTPResponse.Header.Get(bucketRegionHeader)
+	code := ""
+	if r.HTTPResponse.Body != nil {
+		// buffer the body, it is still needed to unmarshal the error
+		b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+		r.HTTPResponse.Body.Close()
+		if err != nil {
+			return false
+		}
+		r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+		if m := reErrorCode.FindSubmatch(b); m != nil {
+			code = string(m[1])
+		}
+		if region == "" {
+			if m := reErrorRegion.FindSubmatch(b); m != nil {
+				region = string(m[1])
+			} else if m := reErrorEndpoint.FindSubmatch(b); m != nil {
+				region = string(m[1])
+			}
+		}
+	}
+	if status == 400 && code != "AuthorizationHeaderMalformed" {
+		return false
+	}
+	if region == "" {
+		return false
+	}
+
+	cacheBucketRegion(bucket, region)
+	if region == requestRegion(r) || !redirectToRegion(r, region) {
+		return false
+	}
+	r.Retryable.Set(true)
+	return true
+}
+
+// redirectToRegion moves the request to the endpoint of region, keeping the
+// bucket in the host for host-style requests. It returns false if region
+// has no known endpoint.
+func redirectToRegion(r *aws.Request, region string) bool {
+	e, err := r.Config.ResolveEndpoint(r.ServiceName, region)
+	if err != nil || e.URL == "" {
+		return false
+	}
+	if e.SigningRegion == "" {
+		e.SigningRegion = region
+	}
+	if !strings.Contains(e.URL, "://") {
+		e.URL = "https://" + e.URL
+	}
+
+	oldEndpoint, err := url.Parse(r.Service.Endpoint)
+	if err != nil {
+		return false
+	}
+	newEndpoint, err := url.Parse(e.URL)
+	if err != nil || newEndpoint.Host == "" {
+		return false
+	}
+	oldHost, newHost := oldEndpoint.Host, newEndpoint.Host
+
+	scheme := r.HTTPRequest.URL.Scheme
+	if scheme == "" {
+		scheme = "https"
+	}
+
+	// copy the service so the client's own endpoint is left untouched
+	svc := *r.Service
+	svc.Endpoint = scheme + "://" + newHost
+	svc.SigningRegion = e.SigningRegion
+	svc.SignatureVersion = e.SignatureVersion
+	if e.SigningName != "" {
+		svc.SigningName = e.SigningName
+	}
+	r.Service = &svc
+
+	u := r.HTTPRequest.URL
+	host := newHost
+	if strings.HasSuffix(u.Host, "."+oldHost) {
+		host = strings.TrimSuffix(u.Host, oldHost) + newHost
+	}
+	if strings.HasPrefix(u.Opaque, "//"+u.Host) {
+		// built requests carry the host in the opaque URI as well
+		u.Opaque = "//" + host + u.Opaque[len(u.Host)+2:]
+	}
+	u.Host = host
+	r.HTTPRequest.Host = ""
+
+	// drop the previous signature so the request is signed again
+	r.HTTPRequest.Header.Del("Authorization")
+	return true
+}
+
+// GetBucketRegion returns the region bucket lives in. It sends an anonymous
+// HeadBucket request, so the caller needs no access to the bucket, and reads
+// the region from the x-amz-bucket-region header of the response. The
+// credentials of config are ignored. The region found is cached for later
+// requests to the bucket.
+func GetBucketRegion(config *aws.Config, bucket string) (string, error) {
+	cfg := aws.DefaultConfig.Merge(config)
+	cfg.Credentials = credentials.AnonymousCredentials
+	cfg.MaxRetries = 0
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+
+	req, _ := New(cfg).HeadBucketRequest(&HeadBucketInput{Bucket: &bucket})
+	err := req.Send()
+	if req.HTTPResponse != nil {
+		if region := req.HTTPResponse.Header.Get(bucketRegionHeader); region != "" {
+			cacheBucketRegion(bucket, region)
+			return region, nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return "", apierr.New("BucketRegionError",
+		"bucket region not found in HeadBucket response", nil)
+}
@@ -0,0 +1,150 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+type roundTripFunc func(*http.Request) *http.Response
+
+func (fn roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return fn(r), nil
+}
+
+// regionServer returns a client whose buckets all live in region. Requests
+// sent to other hosts get the response built by redirect. The hosts of all
+// requests are recorded.
+func regionServer(region string, redirect func() *http.Response) (*aws.Config, *[]string) {
+	var m sync.Mutex
+	hosts := []string{}
+	client := &http.Client{Transport: roundTripFunc(func(r *http.Request) *http.Response {
+		m.Lock()
+		hosts = append(hosts, r.URL.Host)
+		m.Unlock()
+
+		resp := &http.Response{StatusCode: 200, Header: http.Header{},
+			Body: ioutil.NopCloser(bytes.NewReader([]byte{}))}
+		if !bytes.Contains([]byte(r.URL.Host), []byte(region)) {
+			resp = redirect()
+		}
+		if resp.Header.Get("X-Amz-Bucket-Region") == "" {
+			resp.Header.Set("X-Amz-Bucket-Region", region)
+		}
+		return resp
+	})}
+
+	return &aws.Config{HTTPClient: client}, &hosts
+}
+
+func errorResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode:    status,
+		Status:        http.StatusText(status),
+		Header:        http.Header{},
+		ContentLength: int64(len(body)),
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+}
+
+func permanentRedirect() *http.Response {
+	return errorResponse(301, `<Error><Code>PermanentRedirect</Code>`+
+		`<Message>The bucket you are attempting to access must be addressed using the specified endpoint.</Message>`+
+		`<Endpoint>bucket.s3-us-west-2.amazonaws.com</Endpoint></Error>`)
+}
+
+func TestPermanentRedirect(t *testing.T) {
+	cfg, hosts := regionServer("us-west-2", permanentRedirect)
+	svc := s3.New(cfg)
+
+	_, err := svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("redirected-bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"redirected-bucket.s3.mock-region.amazonaws.com",
+		"redirected-bucket.s3-us-west-2.amazonaws.com",
+	}, *hosts)
+	assert.Equal(t, "https://s3.mock-region.amazonaws.com", svc.Endpoint)
+
+	// the region of the bucket is cached
+	_, err = svc.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("redirected-bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, *hosts, 3)
+	assert.Equal(t, "redirected-bucket.s3-us-west-2.amazonaws.com", (*hosts)[2])
+}
+
+func TestPermanentRedirectPathStyle(t *testing.T) {
+	cfg, hosts := regionServer("eu-west-1", permanentRedirect)
+	cfg.S3ForcePathStyle = true
+	svc := s3.New(cfg)
+
+	_, err := svc.GetBucketACL(&s3.GetBucketACLInput{Bucket: aws.String("path-style-bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"s3.mock-region.amazonaws.com",
+		"s3-eu-west-1.amazonaws.com",
+	}, *hosts)
+}
+
+func TestAuthorizationHeaderMalformed(t *testing.T) {
+	cfg, hosts := regionServer("eu-central-1", func() *http.Response {
+		return errorResponse(400, `<Error><Code>AuthorizationHeaderMalformed</Code>`+
+			`<Message>the region 'mock-region' is wrong; expecting 'eu-central-1'</Message>`+
+			`<Region>eu-central-1</Region></Error>`)
+	})
+	svc := s3.New(cfg)
+
+	_, err := svc.GetBucketACL(&s3.GetBucketACLInput{Bucket: aws.String("malformed-bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "malformed-bucket.s3.eu-central-1.amazonaws.com", (*hosts)[1])
+}
+
+func TestRedirectCustomEndpoint(t *testing.T) {
+	cfg, hosts := regionServer("us-west-2", permanentRedirect)
+	cfg.Endpoint = "https://s3.example.com"
+	svc := s3.New(cfg)
+
+	_, err := svc.GetBucketACL(&s3.GetBucketACLInput{Bucket: aws.String("custom-bucket")})
+	assert.Equal(t, "PermanentRedirect", err.(awserr.Error).Code())
+	assert.Len(t, *hosts, 1)
+}
+
+func TestRedirectNoLoop(t *testing.T) {
+	cfg, hosts := regionServer("us-west-2", func() *http.Response {
+		resp := permanentRedirect()
+		resp.Header.Set("X-Amz-Bucket-Region", "mock-region")
+		return resp
+	})
+	svc := s3.New(cfg)
+
+	// redirected to the region the request was already sent to
+	_, err := svc.GetBucketACL(&s3.GetBucketACLInput{Bucket: aws.String("loop-bucket")})
+	assert.Equal(t, "PermanentRedirect", err.(awserr.Error).Code())
+	assert.Len(t, *hosts, 1)
+}
+
+func TestGetBucketRegion(t *testing.T) {
+	cfg, hosts := regionServer("ap-northeast-1", func() *http.Response {
+		return errorResponse(403, "")
+	})
+
+	region, err := s3.GetBucketRegion(cfg, "region-bucket")
+	assert.NoError(t, err)
+	assert.Equal(t, "ap-northeast-1", region)
+	assert.Len(t, *hosts, 1)
+}
@@ -11,14 +11,31 @@ func init() {
 		s.Handlers.Validate.PushBack(validateSSERequiresSSL)
 		s.Handlers.Build.PushBack(computeSSEKeys)
 
+		// Validate the key and part number limits of S3
+		s.Handlers.Validate.PushBack(validateObjectLimits)
+
 		// S3 uses custom error unmarshaling logic
 		s.Handlers.UnmarshalError.Clear()
 		s.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+		// Request url-encoded keys in listings, and decode them in the
+		// output. Set on the service so that next pages are decoded too.
+		s.Handlers.Validate.PushBack(setURLEncodingType)
+		s.Handlers.Unmarshal.PushBack(decodeURLEncodedListing)
+
+		// Send requests to the cached region of their bucket
+		s.Handlers.Validate.PushBack(useCachedBucketRegion)
 	}
 
 	initRequest = func(r *aws.Request) {
+		// Follow buckets which live in other regions
+		addBucketRegionRedirect(r)
+
 		switch r.Operation {
-		case opPutBucketCORS, opPutBucketLifecycle, opPutBucketPolicy, opPutBucketTagging, opDeleteObjects:
+		case opPutBucketCORS, opPutBucketLifecycle, opPutBucketLifecycleConfiguration,
+			opPutBucketPolicy, opPutBucketTagging, opPutBucketEncryption, opPutPublicAccessBlock,
+			opPutObjectTagging, opPutObjectLockConfiguration, opPutObjectRetention,
+			opPutObjectLegalHold, opDeleteObjects:
 			// These S3 operations require Content-MD5 to be set
 			r.Handlers.Build.PushBack(contentMD5)
 		case opGetBucketLocation:
@@ -27,6 +44,9 @@ func init() {
 		case opCreateBucket:
 			// Auto-populate LocationConstraint with current region
 			r.Handlers.Validate.PushFront(populateLocationConstraint)
+		case opSelectObjectContent:
+			// SelectObjectContent streams its events in the response body
+			r.Handlers.Unmarshal.PushBack(unmarshalSelectObjectContent)
 		}
 	}
 }
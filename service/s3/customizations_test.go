@@ -88,3 +88,156 @@ func TestMD5InDeleteObjects(t *testing.T) {
 	})
 	assertMD5(t, req)
 }
+
+func TestMD5InPutBucketLifecycleConfiguration(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutBucketLifecycleConfigurationRequest(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String("bucketname"),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{
+				{
+					Filter: &s3.LifecycleFilter{
+						Tag: &s3.Tag{Key: aws.String("KEY"), Value: aws.String("VALUE")},
+					},
+					Status: aws.String("Enabled"),
+				},
+			},
+		},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutBucketEncryption(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutBucketEncryptionRequest(&s3.PutBucketEncryptionInput{
+		Bucket: aws.String("bucketname"),
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{
+			Rules: []*s3.ServerSideEncryptionRule{
+				{
+					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+						SSEAlgorithm: aws.String("AES256"),
+					},
+				},
+			},
+		},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutPublicAccessBlock(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutPublicAccessBlockRequest(&s3.PutPublicAccessBlockInput{
+		Bucket: aws.String("bucketname"),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicACLs: aws.Boolean(true),
+		},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutObjectTagging(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectTaggingRequest(&s3.PutObjectTaggingInput{
+		Bucket: aws.String("bucketname"),
+		Key:    aws.String("key"),
+		Tagging: &s3.Tagging{
+			TagSet: []*s3.Tag{
+				{Key: aws.String("KEY"), Value: aws.String("VALUE")},
+			},
+		},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutObjectLockConfiguration(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectLockConfigurationRequest(&s3.PutObjectLockConfigurationInput{
+		Bucket: aws.String("bucketname"),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String("Enabled"),
+		},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutObjectRetention(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectRetentionRequest(&s3.PutObjectRetentionInput{
+		Bucket:    aws.String("bucketname"),
+		Key:       aws.String("key"),
+		Retention: &s3.ObjectLockRetention{Mode: aws.String("GOVERNANCE")},
+	})
+	assertMD5(t, req)
+}
+
+func TestMD5InPutObjectLegalHold(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.PutObjectLegalHoldRequest(&s3.PutObjectLegalHoldInput{
+		Bucket:    aws.String("bucketname"),
+		Key:       aws.String("key"),
+		LegalHold: &s3.ObjectLockLegalHold{Status: aws.String("ON")},
+	})
+	assertMD5(t, req)
+}
+
+func TestValidateKeyAndPartNumber(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:     aws.String("bucketname"),
+		Key:        aws.String(""),
+		PartNumber: aws.Long(10001),
+		UploadID:   aws.String("upload"),
+	})
+
+	err := req.Build()
+	assert.Equal(t, aws.ErrInvalidParams{Errs: []aws.ErrInvalidParam{
+		{Field: "Key", Reason: "parameter shorter than minimum length of 1"},
+		{Field: "PartNumber", Reason: "parameter greater than maximum value of 10000"},
+	}}, err)
+}
+
+func TestValidateCompletedPartNumbers(t *testing.T) {
+	svc := s3.New(nil)
+	req, _ := svc.CompleteMultipartUploadRequest(&s3.CompleteMultipartUploadInput{
+		Key:      aws.String("key"),
+		UploadID: aws.String("upload"),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: []*s3.CompletedPart{
+			{PartNumber: aws.Long(1)},
+			{PartNumber: aws.Long(0)},
+		}},
+	})
+
+	err := req.Build()
+	assert.Equal(t, aws.ErrInvalidParams{Errs: []aws.ErrInvalidParam{
+		{Field: "Bucket", Reason: "missing required parameter"},
+		{Field: "MultipartUpload.Parts[1].PartNumber", Reason: "parameter less than minimum value of 1"},
+	}}, err)
+}
+
+func TestValidateKeyDisabled(t *testing.T) {
+	svc := s3.New(&aws.Config{DisableParamValidation: true})
+	req, _ := svc.HeadObjectRequest(&s3.HeadObjectInput{
+		Bucket: aws.String("bucketname"),
+		Key:    aws.String(""),
+	})
+	assert.NoError(t, req.Build())
+}
+
+func TestValidateEnumsEnabled(t *testing.T) {
+	svc := s3.New(&aws.Config{ValidateParamEnums: true})
+	req, _ := svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:       aws.String("bucketname"),
+		Key:          aws.String("key"),
+		StorageClass: aws.String("STANDARD-IA"),
+	})
+	err := req.Build()
+	assert.Error(t, err)
+	assert.Equal(t, "StorageClass", err.(aws.ErrInvalidParams).Errs[0].Field)
+
+	req, _ = svc.PutObjectRequest(&s3.PutObjectInput{
+		Bucket:       aws.String("bucketname"),
+		Key:          aws.String("key"),
+		StorageClass: aws.String(string(s3.StorageClassStandardIA)),
+	})
+	assert.NoError(t, req.Build())
+}
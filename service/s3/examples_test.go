@@ -20,10 +20,12 @@ func ExampleS3_AbortMultipartUpload() {
 	svc := s3.New(nil)
 
 	params := &s3.AbortMultipartUploadInput{
-		Bucket:       aws.String("BucketName"),        // Required
-		Key:          aws.String("ObjectKey"),         // Required
-		UploadID:     aws.String("MultipartUploadId"), // Required
-		RequestPayer: aws.String("RequestPayer"),
+		Bucket:              aws.String("BucketName"),        // Required
+		Key:                 aws.String("ObjectKey"),         // Required
+		UploadID:            aws.String("MultipartUploadId"), // Required
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		RequestPayer:        aws.String("RequestPayer"),
 	}
 	resp, err := svc.AbortMultipartUpload(params)
 
@@ -50,9 +52,11 @@ func ExampleS3_CompleteMultipartUpload() {
 	svc := s3.New(nil)
 
 	params := &s3.CompleteMultipartUploadInput{
-		Bucket:   aws.String("BucketName"),        // Required
-		Key:      aws.String("ObjectKey"),         // Required
-		UploadID: aws.String("MultipartUploadId"), // Required
+		Bucket:              aws.String("BucketName"),        // Required
+		Key:                 aws.String("ObjectKey"),         // Required
+		UploadID:            aws.String("MultipartUploadId"), // Required
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
 		MultipartUpload: &s3.CompletedMultipartUpload{
 			Parts: []*s3.CompletedPart{
 				{ // Required
@@ -105,6 +109,8 @@ func ExampleS3_CopyObject() {
 		CopySourceSSECustomerAlgorithm: aws.String("CopySourceSSECustomerAlgorithm"),
 		CopySourceSSECustomerKey:       aws.String("CopySourceSSECustomerKey"),
 		CopySourceSSECustomerKeyMD5:    aws.String("CopySourceSSECustomerKeyMD5"),
+		ExpectedBucketOwner:            aws.String("ExpectedBucketOwner"),
+		ExpectedSourceBucketOwner:      aws.String("ExpectedSourceBucketOwner"),
 		Expires:                        aws.Time(time.Now()),
 		GrantFullControl:               aws.String("GrantFullControl"),
 		GrantRead:                      aws.String("GrantRead"),
@@ -114,15 +120,20 @@ func ExampleS3_CopyObject() {
 			"Key": aws.String("MetadataValue"), // Required
 			// More values...
 		},
-		MetadataDirective:       aws.String("MetadataDirective"),
-		RequestPayer:            aws.String("RequestPayer"),
-		SSECustomerAlgorithm:    aws.String("SSECustomerAlgorithm"),
-		SSECustomerKey:          aws.String("SSECustomerKey"),
-		SSECustomerKeyMD5:       aws.String("SSECustomerKeyMD5"),
-		SSEKMSKeyID:             aws.String("SSEKMSKeyId"),
-		ServerSideEncryption:    aws.String("ServerSideEncryption"),
-		StorageClass:            aws.String("StorageClass"),
-		WebsiteRedirectLocation: aws.String("WebsiteRedirectLocation"),
+		MetadataDirective:         aws.String("MetadataDirective"),
+		ObjectLockLegalHoldStatus: aws.String("ObjectLockLegalHoldStatus"),
+		ObjectLockMode:            aws.String("ObjectLockMode"),
+		ObjectLockRetainUntilDate: aws.Time(time.Now()),
+		RequestPayer:              aws.String("RequestPayer"),
+		SSECustomerAlgorithm:      aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:            aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:         aws.String("SSECustomerKeyMD5"),
+		SSEKMSKeyID:               aws.String("SSEKMSKeyId"),
+		ServerSideEncryption:      aws.String("ServerSideEncryption"),
+		StorageClass:              aws.String("StorageClass"),
+		Tagging:                   aws.String("TaggingString"),
+		TaggingDirective:          aws.String("TaggingDirective"),
+		WebsiteRedirectLocation:   aws.String("WebsiteRedirectLocation"),
 	}
 	resp, err := svc.CopyObject(params)
 
@@ -149,16 +160,18 @@ func ExampleS3_CreateBucket() {
 	svc := s3.New(nil)
 
 	params := &s3.CreateBucketInput{
-		Bucket: aws.String("BucketName"), // Required
-		ACL:    aws.String("BucketCannedACL"),
+		Bucket:      aws.String("BucketName"), // Required
+		ACL:         aws.String("BucketCannedACL"),
+		ContentType: aws.String("ContentType"),
 		CreateBucketConfiguration: &s3.CreateBucketConfiguration{
 			LocationConstraint: aws.String("BucketLocationConstraint"),
 		},
-		GrantFullControl: aws.String("GrantFullControl"),
-		GrantRead:        aws.String("GrantRead"),
-		GrantReadACP:     aws.String("GrantReadACP"),
-		GrantWrite:       aws.String("GrantWrite"),
-		GrantWriteACP:    aws.String("GrantWriteACP"),
+		GrantFullControl:           aws.String("GrantFullControl"),
+		GrantRead:                  aws.String("GrantRead"),
+		GrantReadACP:               aws.String("GrantReadACP"),
+		GrantWrite:                 aws.String("GrantWrite"),
+		GrantWriteACP:              aws.String("GrantWriteACP"),
+		ObjectLockEnabledForBucket: aws.Boolean(true),
 	}
 	resp, err := svc.CreateBucket(params)
 
@@ -185,31 +198,36 @@ func ExampleS3_CreateMultipartUpload() {
 	svc := s3.New(nil)
 
 	params := &s3.CreateMultipartUploadInput{
-		Bucket:             aws.String("BucketName"), // Required
-		Key:                aws.String("ObjectKey"),  // Required
-		ACL:                aws.String("ObjectCannedACL"),
-		CacheControl:       aws.String("CacheControl"),
-		ContentDisposition: aws.String("ContentDisposition"),
-		ContentEncoding:    aws.String("ContentEncoding"),
-		ContentLanguage:    aws.String("ContentLanguage"),
-		ContentType:        aws.String("ContentType"),
-		Expires:            aws.Time(time.Now()),
-		GrantFullControl:   aws.String("GrantFullControl"),
-		GrantRead:          aws.String("GrantRead"),
-		GrantReadACP:       aws.String("GrantReadACP"),
-		GrantWriteACP:      aws.String("GrantWriteACP"),
+		Bucket:              aws.String("BucketName"), // Required
+		Key:                 aws.String("ObjectKey"),  // Required
+		ACL:                 aws.String("ObjectCannedACL"),
+		CacheControl:        aws.String("CacheControl"),
+		ContentDisposition:  aws.String("ContentDisposition"),
+		ContentEncoding:     aws.String("ContentEncoding"),
+		ContentLanguage:     aws.String("ContentLanguage"),
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		Expires:             aws.Time(time.Now()),
+		GrantFullControl:    aws.String("GrantFullControl"),
+		GrantRead:           aws.String("GrantRead"),
+		GrantReadACP:        aws.String("GrantReadACP"),
+		GrantWriteACP:       aws.String("GrantWriteACP"),
 		Metadata: map[string]*string{
 			"Key": aws.String("MetadataValue"), // Required
 			// More values...
 		},
-		RequestPayer:            aws.String("RequestPayer"),
-		SSECustomerAlgorithm:    aws.String("SSECustomerAlgorithm"),
-		SSECustomerKey:          aws.String("SSECustomerKey"),
-		SSECustomerKeyMD5:       aws.String("SSECustomerKeyMD5"),
-		SSEKMSKeyID:             aws.String("SSEKMSKeyId"),
-		ServerSideEncryption:    aws.String("ServerSideEncryption"),
-		StorageClass:            aws.String("StorageClass"),
-		WebsiteRedirectLocation: aws.String("WebsiteRedirectLocation"),
+		ObjectLockLegalHoldStatus: aws.String("ObjectLockLegalHoldStatus"),
+		ObjectLockMode:            aws.String("ObjectLockMode"),
+		ObjectLockRetainUntilDate: aws.Time(time.Now()),
+		RequestPayer:              aws.String("RequestPayer"),
+		SSECustomerAlgorithm:      aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:            aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:         aws.String("SSECustomerKeyMD5"),
+		SSEKMSKeyID:               aws.String("SSEKMSKeyId"),
+		ServerSideEncryption:      aws.String("ServerSideEncryption"),
+		StorageClass:              aws.String("StorageClass"),
+		Tagging:                   aws.String("TaggingString"),
+		WebsiteRedirectLocation:   aws.String("WebsiteRedirectLocation"),
 	}
 	resp, err := svc.CreateMultipartUpload(params)
 
@@ -236,7 +254,8 @@ func ExampleS3_DeleteBucket() {
 	svc := s3.New(nil)
 
 	params := &s3.DeleteBucketInput{
-		Bucket: aws.String("BucketName"), // Required
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.DeleteBucket(params)
 
@@ -259,13 +278,15 @@ func ExampleS3_DeleteBucket() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketCORS() {
+func ExampleS3_DeleteBucketAnalyticsConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketCORSInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketAnalyticsConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketCORS(params)
+	resp, err := svc.DeleteBucketAnalyticsConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -286,13 +307,14 @@ func ExampleS3_DeleteBucketCORS() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketLifecycle() {
+func ExampleS3_DeleteBucketCORS() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketLifecycleInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketCORSInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketLifecycle(params)
+	resp, err := svc.DeleteBucketCORS(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -313,13 +335,14 @@ func ExampleS3_DeleteBucketLifecycle() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketPolicy() {
+func ExampleS3_DeleteBucketEncryption() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketPolicyInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketEncryptionInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketPolicy(params)
+	resp, err := svc.DeleteBucketEncryption(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -340,13 +363,15 @@ func ExampleS3_DeleteBucketPolicy() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketReplication() {
+func ExampleS3_DeleteBucketInventoryConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketReplicationInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketInventoryConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketReplication(params)
+	resp, err := svc.DeleteBucketInventoryConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -367,13 +392,14 @@ func ExampleS3_DeleteBucketReplication() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketTagging() {
+func ExampleS3_DeleteBucketLifecycle() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketTaggingInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketLifecycleInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketTagging(params)
+	resp, err := svc.DeleteBucketLifecycle(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -394,13 +420,15 @@ func ExampleS3_DeleteBucketTagging() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteBucketWebsite() {
+func ExampleS3_DeleteBucketMetricsConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteBucketWebsiteInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketMetricsConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteBucketWebsite(params)
+	resp, err := svc.DeleteBucketMetricsConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -421,17 +449,14 @@ func ExampleS3_DeleteBucketWebsite() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteObject() {
+func ExampleS3_DeleteBucketPolicy() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteObjectInput{
-		Bucket:       aws.String("BucketName"), // Required
-		Key:          aws.String("ObjectKey"),  // Required
-		MFA:          aws.String("MFA"),
-		RequestPayer: aws.String("RequestPayer"),
-		VersionID:    aws.String("ObjectVersionId"),
+	params := &s3.DeleteBucketPolicyInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteObject(params)
+	resp, err := svc.DeleteBucketPolicy(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -452,25 +477,14 @@ func ExampleS3_DeleteObject() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_DeleteObjects() {
+func ExampleS3_DeleteBucketReplication() {
 	svc := s3.New(nil)
 
-	params := &s3.DeleteObjectsInput{
-		Bucket: aws.String("BucketName"), // Required
-		Delete: &s3.Delete{ // Required
-			Objects: []*s3.ObjectIdentifier{ // Required
-				{ // Required
-					Key:       aws.String("ObjectKey"), // Required
-					VersionID: aws.String("ObjectVersionId"),
-				},
-				// More values...
-			},
-			Quiet: aws.Boolean(true),
-		},
-		MFA:          aws.String("MFA"),
-		RequestPayer: aws.String("RequestPayer"),
+	params := &s3.DeleteBucketReplicationInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.DeleteObjects(params)
+	resp, err := svc.DeleteBucketReplication(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -491,13 +505,14 @@ func ExampleS3_DeleteObjects() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketACL() {
+func ExampleS3_DeleteBucketTagging() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketACLInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketTaggingInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketACL(params)
+	resp, err := svc.DeleteBucketTagging(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -518,13 +533,14 @@ func ExampleS3_GetBucketACL() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketCORS() {
+func ExampleS3_DeleteBucketWebsite() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketCORSInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteBucketWebsiteInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketCORS(params)
+	resp, err := svc.DeleteBucketWebsite(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -545,13 +561,19 @@ func ExampleS3_GetBucketCORS() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketLifecycle() {
+func ExampleS3_DeleteObject() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketLifecycleInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteObjectInput{
+		Bucket:              aws.String("BucketName"), // Required
+		Key:                 aws.String("ObjectKey"),  // Required
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		MFA:                 aws.String("MFA"),
+		RequestPayer:        aws.String("RequestPayer"),
+		VersionID:           aws.String("ObjectVersionId"),
 	}
-	resp, err := svc.GetBucketLifecycle(params)
+	resp, err := svc.DeleteObject(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -572,13 +594,16 @@ func ExampleS3_GetBucketLifecycle() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketLocation() {
+func ExampleS3_DeleteObjectTagging() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketLocationInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeleteObjectTaggingInput{
+		Bucket:      aws.String("BucketString"), // Required
+		Key:         aws.String("Key"),          // Required
+		ContentType: aws.String("ContentType"),
+		VersionID:   aws.String("VersionID"),
 	}
-	resp, err := svc.GetBucketLocation(params)
+	resp, err := svc.DeleteObjectTagging(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -599,13 +624,27 @@ func ExampleS3_GetBucketLocation() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketLogging() {
+func ExampleS3_DeleteObjects() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketLoggingInput{
+	params := &s3.DeleteObjectsInput{
 		Bucket: aws.String("BucketName"), // Required
+		Delete: &s3.Delete{ // Required
+			Objects: []*s3.ObjectIdentifier{ // Required
+				{ // Required
+					Key:       aws.String("ObjectKey"), // Required
+					VersionID: aws.String("ObjectVersionId"),
+				},
+				// More values...
+			},
+			Quiet: aws.Boolean(true),
+		},
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		MFA:                 aws.String("MFA"),
+		RequestPayer:        aws.String("RequestPayer"),
 	}
-	resp, err := svc.GetBucketLogging(params)
+	resp, err := svc.DeleteObjects(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -626,13 +665,14 @@ func ExampleS3_GetBucketLogging() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketNotification() {
+func ExampleS3_DeletePublicAccessBlock() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketNotificationConfigurationRequest{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.DeletePublicAccessBlockInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketNotification(params)
+	resp, err := svc.DeletePublicAccessBlock(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -653,13 +693,14 @@ func ExampleS3_GetBucketNotification() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketNotificationConfiguration() {
+func ExampleS3_GetBucketACL() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketNotificationConfigurationRequest{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketACLInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketNotificationConfiguration(params)
+	resp, err := svc.GetBucketACL(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -680,13 +721,15 @@ func ExampleS3_GetBucketNotificationConfiguration() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketPolicy() {
+func ExampleS3_GetBucketAnalyticsConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketPolicyInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketAnalyticsConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketPolicy(params)
+	resp, err := svc.GetBucketAnalyticsConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -707,13 +750,14 @@ func ExampleS3_GetBucketPolicy() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketReplication() {
+func ExampleS3_GetBucketCORS() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketReplicationInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketCORSInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketReplication(params)
+	resp, err := svc.GetBucketCORS(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -734,13 +778,14 @@ func ExampleS3_GetBucketReplication() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketRequestPayment() {
+func ExampleS3_GetBucketEncryption() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketRequestPaymentInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketEncryptionInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketRequestPayment(params)
+	resp, err := svc.GetBucketEncryption(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -761,13 +806,15 @@ func ExampleS3_GetBucketRequestPayment() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketTagging() {
+func ExampleS3_GetBucketInventoryConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketTaggingInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketInventoryConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketTagging(params)
+	resp, err := svc.GetBucketInventoryConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -788,13 +835,14 @@ func ExampleS3_GetBucketTagging() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketVersioning() {
+func ExampleS3_GetBucketLifecycle() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketVersioningInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketLifecycleInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketVersioning(params)
+	resp, err := svc.GetBucketLifecycle(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -815,13 +863,14 @@ func ExampleS3_GetBucketVersioning() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetBucketWebsite() {
+func ExampleS3_GetBucketLifecycleConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.GetBucketWebsiteInput{
-		Bucket: aws.String("BucketName"), // Required
+	params := &s3.GetBucketLifecycleConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetBucketWebsite(params)
+	resp, err := svc.GetBucketLifecycleConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -842,30 +891,14 @@ func ExampleS3_GetBucketWebsite() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetObject() {
+func ExampleS3_GetBucketLocation() {
 	svc := s3.New(nil)
 
-	params := &s3.GetObjectInput{
-		Bucket:                     aws.String("BucketName"), // Required
-		Key:                        aws.String("ObjectKey"),  // Required
-		IfMatch:                    aws.String("IfMatch"),
-		IfModifiedSince:            aws.Time(time.Now()),
-		IfNoneMatch:                aws.String("IfNoneMatch"),
-		IfUnmodifiedSince:          aws.Time(time.Now()),
-		Range:                      aws.String("Range"),
-		RequestPayer:               aws.String("RequestPayer"),
-		ResponseCacheControl:       aws.String("ResponseCacheControl"),
-		ResponseContentDisposition: aws.String("ResponseContentDisposition"),
-		ResponseContentEncoding:    aws.String("ResponseContentEncoding"),
-		ResponseContentLanguage:    aws.String("ResponseContentLanguage"),
-		ResponseContentType:        aws.String("ResponseContentType"),
-		ResponseExpires:            aws.Time(time.Now()),
-		SSECustomerAlgorithm:       aws.String("SSECustomerAlgorithm"),
-		SSECustomerKey:             aws.String("SSECustomerKey"),
-		SSECustomerKeyMD5:          aws.String("SSECustomerKeyMD5"),
-		VersionID:                  aws.String("ObjectVersionId"),
+	params := &s3.GetBucketLocationInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetObject(params)
+	resp, err := svc.GetBucketLocation(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -886,16 +919,14 @@ func ExampleS3_GetObject() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetObjectACL() {
+func ExampleS3_GetBucketLogging() {
 	svc := s3.New(nil)
 
-	params := &s3.GetObjectACLInput{
-		Bucket:       aws.String("BucketName"), // Required
-		Key:          aws.String("ObjectKey"),  // Required
-		RequestPayer: aws.String("RequestPayer"),
-		VersionID:    aws.String("ObjectVersionId"),
+	params := &s3.GetBucketLoggingInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetObjectACL(params)
+	resp, err := svc.GetBucketLogging(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -916,15 +947,15 @@ func ExampleS3_GetObjectACL() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_GetObjectTorrent() {
+func ExampleS3_GetBucketMetricsConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.GetObjectTorrentInput{
-		Bucket:       aws.String("BucketName"), // Required
-		Key:          aws.String("ObjectKey"),  // Required
-		RequestPayer: aws.String("RequestPayer"),
+	params := &s3.GetBucketMetricsConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.GetObjectTorrent(params)
+	resp, err := svc.GetBucketMetricsConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -945,13 +976,13 @@ func ExampleS3_GetObjectTorrent() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_HeadBucket() {
+func ExampleS3_GetBucketNotification() {
 	svc := s3.New(nil)
 
-	params := &s3.HeadBucketInput{
+	params := &s3.GetBucketNotificationConfigurationRequest{
 		Bucket: aws.String("BucketName"), // Required
 	}
-	resp, err := svc.HeadBucket(params)
+	resp, err := svc.GetBucketNotification(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -972,24 +1003,13 @@ func ExampleS3_HeadBucket() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_HeadObject() {
+func ExampleS3_GetBucketNotificationConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.HeadObjectInput{
-		Bucket:               aws.String("BucketName"), // Required
-		Key:                  aws.String("ObjectKey"),  // Required
-		IfMatch:              aws.String("IfMatch"),
-		IfModifiedSince:      aws.Time(time.Now()),
-		IfNoneMatch:          aws.String("IfNoneMatch"),
-		IfUnmodifiedSince:    aws.Time(time.Now()),
-		Range:                aws.String("Range"),
-		RequestPayer:         aws.String("RequestPayer"),
-		SSECustomerAlgorithm: aws.String("SSECustomerAlgorithm"),
-		SSECustomerKey:       aws.String("SSECustomerKey"),
-		SSECustomerKeyMD5:    aws.String("SSECustomerKeyMD5"),
-		VersionID:            aws.String("ObjectVersionId"),
+	params := &s3.GetBucketNotificationConfigurationRequest{
+		Bucket: aws.String("BucketName"), // Required
 	}
-	resp, err := svc.HeadObject(params)
+	resp, err := svc.GetBucketNotificationConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1010,11 +1030,14 @@ func ExampleS3_HeadObject() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_ListBuckets() {
+func ExampleS3_GetBucketPolicy() {
 	svc := s3.New(nil)
 
-	var params *s3.ListBucketsInput
-	resp, err := svc.ListBuckets(params)
+	params := &s3.GetBucketPolicyInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.GetBucketPolicy(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1035,19 +1058,14 @@ func ExampleS3_ListBuckets() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_ListMultipartUploads() {
+func ExampleS3_GetBucketReplication() {
 	svc := s3.New(nil)
 
-	params := &s3.ListMultipartUploadsInput{
-		Bucket:         aws.String("BucketName"), // Required
-		Delimiter:      aws.String("Delimiter"),
-		EncodingType:   aws.String("EncodingType"),
-		KeyMarker:      aws.String("KeyMarker"),
-		MaxUploads:     aws.Long(1),
-		Prefix:         aws.String("Prefix"),
-		UploadIDMarker: aws.String("UploadIdMarker"),
+	params := &s3.GetBucketReplicationInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.ListMultipartUploads(params)
+	resp, err := svc.GetBucketReplication(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1068,19 +1086,14 @@ func ExampleS3_ListMultipartUploads() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_ListObjectVersions() {
+func ExampleS3_GetBucketRequestPayment() {
 	svc := s3.New(nil)
 
-	params := &s3.ListObjectVersionsInput{
-		Bucket:          aws.String("BucketName"), // Required
-		Delimiter:       aws.String("Delimiter"),
-		EncodingType:    aws.String("EncodingType"),
-		KeyMarker:       aws.String("KeyMarker"),
-		MaxKeys:         aws.Long(1),
-		Prefix:          aws.String("Prefix"),
-		VersionIDMarker: aws.String("VersionIdMarker"),
+	params := &s3.GetBucketRequestPaymentInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.ListObjectVersions(params)
+	resp, err := svc.GetBucketRequestPayment(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1101,18 +1114,14 @@ func ExampleS3_ListObjectVersions() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_ListObjects() {
+func ExampleS3_GetBucketTagging() {
 	svc := s3.New(nil)
 
-	params := &s3.ListObjectsInput{
-		Bucket:       aws.String("BucketName"), // Required
-		Delimiter:    aws.String("Delimiter"),
-		EncodingType: aws.String("EncodingType"),
-		Marker:       aws.String("Marker"),
-		MaxKeys:      aws.Long(1),
-		Prefix:       aws.String("Prefix"),
+	params := &s3.GetBucketTaggingInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
 	}
-	resp, err := svc.ListObjects(params)
+	resp, err := svc.GetBucketTagging(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1133,13 +1142,647 @@ func ExampleS3_ListObjects() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_ListParts() {
+func ExampleS3_GetBucketVersioning() {
 	svc := s3.New(nil)
 
-	params := &s3.ListPartsInput{
+	params := &s3.GetBucketVersioningInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.GetBucketVersioning(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetBucketWebsite() {
+	svc := s3.New(nil)
+
+	params := &s3.GetBucketWebsiteInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.GetBucketWebsite(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObject() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectInput{
+		Bucket:                     aws.String("BucketName"), // Required
+		Key:                        aws.String("ObjectKey"),  // Required
+		ContentType:                aws.String("ContentType"),
+		ExpectedBucketOwner:        aws.String("ExpectedBucketOwner"),
+		IfMatch:                    aws.String("IfMatch"),
+		IfModifiedSince:            aws.Time(time.Now()),
+		IfNoneMatch:                aws.String("IfNoneMatch"),
+		IfUnmodifiedSince:          aws.Time(time.Now()),
+		Range:                      aws.String("Range"),
+		RequestPayer:               aws.String("RequestPayer"),
+		ResponseCacheControl:       aws.String("ResponseCacheControl"),
+		ResponseContentDisposition: aws.String("ResponseContentDisposition"),
+		ResponseContentEncoding:    aws.String("ResponseContentEncoding"),
+		ResponseContentLanguage:    aws.String("ResponseContentLanguage"),
+		ResponseContentType:        aws.String("ResponseContentType"),
+		ResponseExpires:            aws.Time(time.Now()),
+		SSECustomerAlgorithm:       aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:             aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:          aws.String("SSECustomerKeyMD5"),
+		VersionID:                  aws.String("ObjectVersionId"),
+	}
+	resp, err := svc.GetObject(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectACL() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectACLInput{
+		Bucket:       aws.String("BucketName"), // Required
+		Key:          aws.String("ObjectKey"),  // Required
+		ContentType:  aws.String("ContentType"),
+		RequestPayer: aws.String("RequestPayer"),
+		VersionID:    aws.String("ObjectVersionId"),
+	}
+	resp, err := svc.GetObjectACL(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectLegalHold() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectLegalHoldInput{
+		Bucket:       aws.String("BucketString"), // Required
+		Key:          aws.String("Key"),          // Required
+		ContentType:  aws.String("ContentType"),
+		RequestPayer: aws.String("RequestPayer"),
+		VersionID:    aws.String("VersionID"),
+	}
+	resp, err := svc.GetObjectLegalHold(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectLockConfiguration() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectLockConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.GetObjectLockConfiguration(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectRetention() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectRetentionInput{
+		Bucket:       aws.String("BucketString"), // Required
+		Key:          aws.String("Key"),          // Required
+		ContentType:  aws.String("ContentType"),
+		RequestPayer: aws.String("RequestPayer"),
+		VersionID:    aws.String("VersionID"),
+	}
+	resp, err := svc.GetObjectRetention(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectTagging() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectTaggingInput{
+		Bucket:      aws.String("BucketString"), // Required
+		Key:         aws.String("Key"),          // Required
+		ContentType: aws.String("ContentType"),
+		VersionID:   aws.String("VersionID"),
+	}
+	resp, err := svc.GetObjectTagging(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetObjectTorrent() {
+	svc := s3.New(nil)
+
+	params := &s3.GetObjectTorrentInput{
+		Bucket:       aws.String("BucketName"), // Required
+		Key:          aws.String("ObjectKey"),  // Required
+		ContentType:  aws.String("ContentType"),
+		RequestPayer: aws.String("RequestPayer"),
+	}
+	resp, err := svc.GetObjectTorrent(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_GetPublicAccessBlock() {
+	svc := s3.New(nil)
+
+	params := &s3.GetPublicAccessBlockInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.GetPublicAccessBlock(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_HeadBucket() {
+	svc := s3.New(nil)
+
+	params := &s3.HeadBucketInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.HeadBucket(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_HeadObject() {
+	svc := s3.New(nil)
+
+	params := &s3.HeadObjectInput{
+		Bucket:               aws.String("BucketName"), // Required
+		Key:                  aws.String("ObjectKey"),  // Required
+		ContentType:          aws.String("ContentType"),
+		ExpectedBucketOwner:  aws.String("ExpectedBucketOwner"),
+		IfMatch:              aws.String("IfMatch"),
+		IfModifiedSince:      aws.Time(time.Now()),
+		IfNoneMatch:          aws.String("IfNoneMatch"),
+		IfUnmodifiedSince:    aws.Time(time.Now()),
+		Range:                aws.String("Range"),
+		RequestPayer:         aws.String("RequestPayer"),
+		SSECustomerAlgorithm: aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:       aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:    aws.String("SSECustomerKeyMD5"),
+		VersionID:            aws.String("ObjectVersionId"),
+	}
+	resp, err := svc.HeadObject(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListBucketAnalyticsConfigurations() {
+	svc := s3.New(nil)
+
+	params := &s3.ListBucketAnalyticsConfigurationsInput{
+		Bucket:            aws.String("BucketString"), // Required
+		ContentType:       aws.String("ContentType"),
+		ContinuationToken: aws.String("ContinuationToken"),
+	}
+	resp, err := svc.ListBucketAnalyticsConfigurations(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListBucketInventoryConfigurations() {
+	svc := s3.New(nil)
+
+	params := &s3.ListBucketInventoryConfigurationsInput{
+		Bucket:            aws.String("BucketString"), // Required
+		ContentType:       aws.String("ContentType"),
+		ContinuationToken: aws.String("ContinuationToken"),
+	}
+	resp, err := svc.ListBucketInventoryConfigurations(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListBucketMetricsConfigurations() {
+	svc := s3.New(nil)
+
+	params := &s3.ListBucketMetricsConfigurationsInput{
+		Bucket:            aws.String("BucketString"), // Required
+		ContentType:       aws.String("ContentType"),
+		ContinuationToken: aws.String("ContinuationToken"),
+	}
+	resp, err := svc.ListBucketMetricsConfigurations(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListBuckets() {
+	svc := s3.New(nil)
+
+	params := &s3.ListBucketsInput{
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.ListBuckets(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListMultipartUploads() {
+	svc := s3.New(nil)
+
+	params := &s3.ListMultipartUploadsInput{
+		Bucket:         aws.String("BucketName"), // Required
+		ContentType:    aws.String("ContentType"),
+		Delimiter:      aws.String("Delimiter"),
+		EncodingType:   aws.String("EncodingType"),
+		KeyMarker:      aws.String("KeyMarker"),
+		MaxUploads:     aws.Long(1),
+		Prefix:         aws.String("Prefix"),
+		UploadIDMarker: aws.String("UploadIdMarker"),
+	}
+	resp, err := svc.ListMultipartUploads(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListObjectVersions() {
+	svc := s3.New(nil)
+
+	params := &s3.ListObjectVersionsInput{
+		Bucket:          aws.String("BucketName"), // Required
+		ContentType:     aws.String("ContentType"),
+		Delimiter:       aws.String("Delimiter"),
+		EncodingType:    aws.String("EncodingType"),
+		KeyMarker:       aws.String("KeyMarker"),
+		MaxKeys:         aws.Long(1),
+		Prefix:          aws.String("Prefix"),
+		VersionIDMarker: aws.String("VersionIdMarker"),
+	}
+	resp, err := svc.ListObjectVersions(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListObjects() {
+	svc := s3.New(nil)
+
+	params := &s3.ListObjectsInput{
+		Bucket:              aws.String("BucketName"), // Required
+		ContentType:         aws.String("ContentType"),
+		Delimiter:           aws.String("Delimiter"),
+		EncodingType:        aws.String("EncodingType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		Marker:              aws.String("Marker"),
+		MaxKeys:             aws.Long(1),
+		Prefix:              aws.String("Prefix"),
+		RequestPayer:        aws.String("RequestPayer"),
+	}
+	resp, err := svc.ListObjects(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListObjectsV2() {
+	svc := s3.New(nil)
+
+	params := &s3.ListObjectsV2Input{
+		Bucket:              aws.String("BucketString"), // Required
+		ContentType:         aws.String("ContentType"),
+		ContinuationToken:   aws.String("ContinuationToken"),
+		Delimiter:           aws.String("Delimiter"),
+		EncodingType:        aws.String("EncodingType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		FetchOwner:          aws.Boolean(true),
+		MaxKeys:             aws.Long(1),
+		Prefix:              aws.String("Prefix"),
+		RequestPayer:        aws.String("RequestPayer"),
+		StartAfter:          aws.String("StartAfter"),
+	}
+	resp, err := svc.ListObjectsV2(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_ListParts() {
+	svc := s3.New(nil)
+
+	params := &s3.ListPartsInput{
 		Bucket:           aws.String("BucketName"),        // Required
 		Key:              aws.String("ObjectKey"),         // Required
 		UploadID:         aws.String("MultipartUploadId"), // Required
+		ContentType:      aws.String("ContentType"),
 		MaxParts:         aws.Long(1),
 		PartNumberMarker: aws.Long(1),
 		RequestPayer:     aws.String("RequestPayer"),
@@ -1190,13 +1833,225 @@ func ExampleS3_PutBucketACL() {
 				ID:          aws.String("ID"),
 			},
 		},
+		ContentType:      aws.String("ContentType"),
 		GrantFullControl: aws.String("GrantFullControl"),
 		GrantRead:        aws.String("GrantRead"),
 		GrantReadACP:     aws.String("GrantReadACP"),
 		GrantWrite:       aws.String("GrantWrite"),
 		GrantWriteACP:    aws.String("GrantWriteACP"),
 	}
-	resp, err := svc.PutBucketACL(params)
+	resp, err := svc.PutBucketACL(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutBucketAnalyticsConfiguration() {
+	svc := s3.New(nil)
+
+	params := &s3.PutBucketAnalyticsConfigurationInput{
+		AnalyticsConfiguration: &s3.AnalyticsConfiguration{ // Required
+			ID: aws.String("ID"), // Required
+			StorageClassAnalysis: &s3.StorageClassAnalysis{ // Required
+				DataExport: &s3.StorageClassAnalysisDataExport{
+					Destination: &s3.AnalyticsExportDestination{ // Required
+						S3BucketDestination: &s3.AnalyticsS3BucketDestination{ // Required
+							Bucket:          aws.String("BucketString"),                // Required
+							Format:          aws.String("AnalyticsS3ExportFileFormat"), // Required
+							BucketAccountID: aws.String("BucketAccountID"),
+							Prefix:          aws.String("Prefix"),
+						},
+					},
+					OutputSchemaVersion: aws.String("StorageClassAnalysisSchemaVersion"), // Required
+				},
+			},
+			Filter: &s3.AnalyticsFilter{
+				And: &s3.AnalyticsAndOperator{
+					Prefix: aws.String("Prefix"),
+					Tags: []*s3.Tag{
+						{ // Required
+							Key:   aws.String("ObjectKey"), // Required
+							Value: aws.String("Value"),     // Required
+						},
+						// More values...
+					},
+				},
+				Prefix: aws.String("Prefix"),
+				Tag: &s3.Tag{
+					Key:   aws.String("ObjectKey"), // Required
+					Value: aws.String("Value"),     // Required
+				},
+			},
+		},
+		Bucket:      aws.String("BucketString"), // Required
+		ID:          aws.String("ID"),           // Required
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutBucketAnalyticsConfiguration(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutBucketCORS() {
+	svc := s3.New(nil)
+
+	params := &s3.PutBucketCORSInput{
+		Bucket: aws.String("BucketName"), // Required
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: []*s3.CORSRule{
+				{ // Required
+					AllowedHeaders: []*string{
+						aws.String("AllowedHeader"), // Required
+						// More values...
+					},
+					AllowedMethods: []*string{
+						aws.String("AllowedMethod"), // Required
+						// More values...
+					},
+					AllowedOrigins: []*string{
+						aws.String("AllowedOrigin"), // Required
+						// More values...
+					},
+					ExposeHeaders: []*string{
+						aws.String("ExposeHeader"), // Required
+						// More values...
+					},
+					MaxAgeSeconds: aws.Long(1),
+				},
+				// More values...
+			},
+		},
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutBucketCORS(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutBucketEncryption() {
+	svc := s3.New(nil)
+
+	params := &s3.PutBucketEncryptionInput{
+		Bucket: aws.String("BucketString"), // Required
+		ServerSideEncryptionConfiguration: &s3.ServerSideEncryptionConfiguration{ // Required
+			Rules: []*s3.ServerSideEncryptionRule{ // Required
+				{ // Required
+					ApplyServerSideEncryptionByDefault: &s3.ServerSideEncryptionByDefault{
+						SSEAlgorithm:   aws.String("ServerSideEncryption"), // Required
+						KMSMasterKeyID: aws.String("KMSMasterKeyID"),
+					},
+				},
+				// More values...
+			},
+		},
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutBucketEncryption(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutBucketInventoryConfiguration() {
+	svc := s3.New(nil)
+
+	params := &s3.PutBucketInventoryConfigurationInput{
+		Bucket: aws.String("BucketString"), // Required
+		ID:     aws.String("ID"),           // Required
+		InventoryConfiguration: &s3.InventoryConfiguration{ // Required
+			Destination: &s3.InventoryDestination{ // Required
+				S3BucketDestination: &s3.InventoryS3BucketDestination{ // Required
+					Bucket:    aws.String("BucketString"),    // Required
+					Format:    aws.String("InventoryFormat"), // Required
+					AccountID: aws.String("AccountID"),
+					Encryption: &s3.InventoryEncryption{
+						SSEKMS: &s3.SSEKMS{
+							KeyID: aws.String("KeyID"), // Required
+						},
+						SSES3: &s3.SSES3{},
+					},
+					Prefix: aws.String("Prefix"),
+				},
+			},
+			ID:                     aws.String("ID"),                              // Required
+			IncludedObjectVersions: aws.String("InventoryIncludedObjectVersions"), // Required
+			IsEnabled:              aws.Boolean(true),                             // Required
+			Schedule: &s3.InventorySchedule{ // Required
+				Frequency: aws.String("InventoryFrequency"), // Required
+			},
+			Filter: &s3.InventoryFilter{
+				Prefix: aws.String("Prefix"), // Required
+			},
+			OptionalFields: []*string{
+				aws.String("InventoryOptionalField"), // Required
+				// More values...
+			},
+		},
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutBucketInventoryConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1217,37 +2072,60 @@ func ExampleS3_PutBucketACL() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_PutBucketCORS() {
+func ExampleS3_PutBucketLifecycle() {
 	svc := s3.New(nil)
 
-	params := &s3.PutBucketCORSInput{
-		Bucket: aws.String("BucketName"), // Required
-		CORSConfiguration: &s3.CORSConfiguration{
-			CORSRules: []*s3.CORSRule{
+	params := &s3.PutBucketLifecycleInput{
+		Bucket:      aws.String("BucketName"), // Required
+		ContentType: aws.String("ContentType"),
+		LifecycleConfiguration: &s3.LifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{ // Required
 				{ // Required
-					AllowedHeaders: []*string{
-						aws.String("AllowedHeader"), // Required
-						// More values...
+					Status: aws.String("ExpirationStatus"), // Required
+					AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Long(1),
 					},
-					AllowedMethods: []*string{
-						aws.String("AllowedMethod"), // Required
-						// More values...
+					Expiration: &s3.LifecycleExpiration{
+						Date: aws.Time(time.Now()),
+						Days: aws.Long(1),
 					},
-					AllowedOrigins: []*string{
-						aws.String("AllowedOrigin"), // Required
-						// More values...
+					Filter: &s3.LifecycleFilter{
+						And: &s3.LifecycleRuleAndOperator{
+							Prefix: aws.String("Prefix"),
+							Tags: []*s3.Tag{
+								{ // Required
+									Key:   aws.String("ObjectKey"), // Required
+									Value: aws.String("Value"),     // Required
+								},
+								// More values...
+							},
+						},
+						Prefix: aws.String("Prefix"),
+						Tag: &s3.Tag{
+							Key:   aws.String("ObjectKey"), // Required
+							Value: aws.String("Value"),     // Required
+						},
 					},
-					ExposeHeaders: []*string{
-						aws.String("ExposeHeader"), // Required
-						// More values...
+					ID: aws.String("ID"),
+					NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{
+						NoncurrentDays: aws.Long(1),
+					},
+					NoncurrentVersionTransition: &s3.NoncurrentVersionTransition{
+						NoncurrentDays: aws.Long(1),
+						StorageClass:   aws.String("TransitionStorageClass"),
+					},
+					Prefix: aws.String("Prefix"),
+					Transition: &s3.Transition{
+						Date:         aws.Time(time.Now()),
+						Days:         aws.Long(1),
+						StorageClass: aws.String("TransitionStorageClass"),
 					},
-					MaxAgeSeconds: aws.Long(1),
 				},
 				// More values...
 			},
 		},
 	}
-	resp, err := svc.PutBucketCORS(params)
+	resp, err := svc.PutBucketLifecycle(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1268,20 +2146,40 @@ func ExampleS3_PutBucketCORS() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
-func ExampleS3_PutBucketLifecycle() {
+func ExampleS3_PutBucketLifecycleConfiguration() {
 	svc := s3.New(nil)
 
-	params := &s3.PutBucketLifecycleInput{
-		Bucket: aws.String("BucketName"), // Required
-		LifecycleConfiguration: &s3.LifecycleConfiguration{
+	params := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
 			Rules: []*s3.LifecycleRule{ // Required
 				{ // Required
-					Prefix: aws.String("Prefix"),           // Required
 					Status: aws.String("ExpirationStatus"), // Required
+					AbortIncompleteMultipartUpload: &s3.AbortIncompleteMultipartUpload{
+						DaysAfterInitiation: aws.Long(1),
+					},
 					Expiration: &s3.LifecycleExpiration{
 						Date: aws.Time(time.Now()),
 						Days: aws.Long(1),
 					},
+					Filter: &s3.LifecycleFilter{
+						And: &s3.LifecycleRuleAndOperator{
+							Prefix: aws.String("Prefix"),
+							Tags: []*s3.Tag{
+								{ // Required
+									Key:   aws.String("ObjectKey"), // Required
+									Value: aws.String("Value"),     // Required
+								},
+								// More values...
+							},
+						},
+						Prefix: aws.String("Prefix"),
+						Tag: &s3.Tag{
+							Key:   aws.String("ObjectKey"), // Required
+							Value: aws.String("Value"),     // Required
+						},
+					},
 					ID: aws.String("ID"),
 					NoncurrentVersionExpiration: &s3.NoncurrentVersionExpiration{
 						NoncurrentDays: aws.Long(1),
@@ -1290,6 +2188,7 @@ func ExampleS3_PutBucketLifecycle() {
 						NoncurrentDays: aws.Long(1),
 						StorageClass:   aws.String("TransitionStorageClass"),
 					},
+					Prefix: aws.String("Prefix"),
 					Transition: &s3.Transition{
 						Date:         aws.Time(time.Now()),
 						Days:         aws.Long(1),
@@ -1300,7 +2199,7 @@ func ExampleS3_PutBucketLifecycle() {
 			},
 		},
 	}
-	resp, err := svc.PutBucketLifecycle(params)
+	resp, err := svc.PutBucketLifecycleConfiguration(params)
 
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok {
@@ -1345,6 +2244,7 @@ func ExampleS3_PutBucketLogging() {
 				TargetPrefix: aws.String("TargetPrefix"),
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketLogging(params)
 
@@ -1367,6 +2267,55 @@ func ExampleS3_PutBucketLogging() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
+func ExampleS3_PutBucketMetricsConfiguration() {
+	svc := s3.New(nil)
+
+	params := &s3.PutBucketMetricsConfigurationInput{
+		Bucket: aws.String("BucketString"), // Required
+		ID:     aws.String("ID"),           // Required
+		MetricsConfiguration: &s3.MetricsConfiguration{ // Required
+			ID: aws.String("ID"), // Required
+			Filter: &s3.MetricsFilter{
+				And: &s3.MetricsAndOperator{
+					Prefix: aws.String("Prefix"),
+					Tags: []*s3.Tag{
+						{ // Required
+							Key:   aws.String("ObjectKey"), // Required
+							Value: aws.String("Value"),     // Required
+						},
+						// More values...
+					},
+				},
+				Prefix: aws.String("Prefix"),
+				Tag: &s3.Tag{
+					Key:   aws.String("ObjectKey"), // Required
+					Value: aws.String("Value"),     // Required
+				},
+			},
+		},
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutBucketMetricsConfiguration(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
 func ExampleS3_PutBucketNotification() {
 	svc := s3.New(nil)
 
@@ -1402,6 +2351,7 @@ func ExampleS3_PutBucketNotification() {
 				Topic: aws.String("TopicArn"),
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketNotification(params)
 
@@ -1464,6 +2414,7 @@ func ExampleS3_PutBucketNotificationConfiguration() {
 				// More values...
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketNotificationConfiguration(params)
 
@@ -1490,8 +2441,9 @@ func ExampleS3_PutBucketPolicy() {
 	svc := s3.New(nil)
 
 	params := &s3.PutBucketPolicyInput{
-		Bucket: aws.String("BucketName"), // Required
-		Policy: aws.String("Policy"),     // Required
+		Bucket:      aws.String("BucketName"), // Required
+		Policy:      aws.String("Policy"),     // Required
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketPolicy(params)
 
@@ -1533,6 +2485,7 @@ func ExampleS3_PutBucketReplication() {
 				// More values...
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketReplication(params)
 
@@ -1563,6 +2516,7 @@ func ExampleS3_PutBucketRequestPayment() {
 		RequestPaymentConfiguration: &s3.RequestPaymentConfiguration{ // Required
 			Payer: aws.String("Payer"), // Required
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketRequestPayment(params)
 
@@ -1599,6 +2553,7 @@ func ExampleS3_PutBucketTagging() {
 				// More values...
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketTagging(params)
 
@@ -1630,7 +2585,8 @@ func ExampleS3_PutBucketVersioning() {
 			MFADelete: aws.String("MFADelete"),
 			Status:    aws.String("BucketVersioningStatus"),
 		},
-		MFA: aws.String("MFA"),
+		ContentType: aws.String("ContentType"),
+		MFA:         aws.String("MFA"),
 	}
 	resp, err := svc.PutBucketVersioning(params)
 
@@ -1686,6 +2642,7 @@ func ExampleS3_PutBucketWebsite() {
 				// More values...
 			},
 		},
+		ContentType: aws.String("ContentType"),
 	}
 	resp, err := svc.PutBucketWebsite(params)
 
@@ -1712,33 +2669,41 @@ func ExampleS3_PutObject() {
 	svc := s3.New(nil)
 
 	params := &s3.PutObjectInput{
-		Bucket:             aws.String("BucketName"), // Required
-		Key:                aws.String("ObjectKey"),  // Required
-		ACL:                aws.String("ObjectCannedACL"),
-		Body:               bytes.NewReader([]byte("PAYLOAD")),
-		CacheControl:       aws.String("CacheControl"),
-		ContentDisposition: aws.String("ContentDisposition"),
-		ContentEncoding:    aws.String("ContentEncoding"),
-		ContentLanguage:    aws.String("ContentLanguage"),
-		ContentLength:      aws.Long(1),
-		ContentType:        aws.String("ContentType"),
-		Expires:            aws.Time(time.Now()),
-		GrantFullControl:   aws.String("GrantFullControl"),
-		GrantRead:          aws.String("GrantRead"),
-		GrantReadACP:       aws.String("GrantReadACP"),
-		GrantWriteACP:      aws.String("GrantWriteACP"),
+		Bucket:              aws.String("BucketName"), // Required
+		Key:                 aws.String("ObjectKey"),  // Required
+		ACL:                 aws.String("ObjectCannedACL"),
+		Body:                bytes.NewReader([]byte("PAYLOAD")),
+		CacheControl:        aws.String("CacheControl"),
+		CallbackBody:        aws.String("CallbackBody"),
+		CallbackUrl:         aws.String("CallbackUrl"),
+		ContentDisposition:  aws.String("ContentDisposition"),
+		ContentEncoding:     aws.String("ContentEncoding"),
+		ContentLanguage:     aws.String("ContentLanguage"),
+		ContentLength:       aws.Long(1),
+		ContentMaxLength:    aws.Long(1),
+		ContentType:         aws.String("ContentType"),
+		ExpectedBucketOwner: aws.String("ExpectedBucketOwner"),
+		Expires:             aws.Time(time.Now()),
+		GrantFullControl:    aws.String("GrantFullControl"),
+		GrantRead:           aws.String("GrantRead"),
+		GrantReadACP:        aws.String("GrantReadACP"),
+		GrantWriteACP:       aws.String("GrantWriteACP"),
 		Metadata: map[string]*string{
 			"Key": aws.String("MetadataValue"), // Required
 			// More values...
 		},
-		RequestPayer:            aws.String("RequestPayer"),
-		SSECustomerAlgorithm:    aws.String("SSECustomerAlgorithm"),
-		SSECustomerKey:          aws.String("SSECustomerKey"),
-		SSECustomerKeyMD5:       aws.String("SSECustomerKeyMD5"),
-		SSEKMSKeyID:             aws.String("SSEKMSKeyId"),
-		ServerSideEncryption:    aws.String("ServerSideEncryption"),
-		StorageClass:            aws.String("StorageClass"),
-		WebsiteRedirectLocation: aws.String("WebsiteRedirectLocation"),
+		ObjectLockLegalHoldStatus: aws.String("ObjectLockLegalHoldStatus"),
+		ObjectLockMode:            aws.String("ObjectLockMode"),
+		ObjectLockRetainUntilDate: aws.Time(time.Now()),
+		RequestPayer:              aws.String("RequestPayer"),
+		SSECustomerAlgorithm:      aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:            aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:         aws.String("SSECustomerKeyMD5"),
+		SSEKMSKeyID:               aws.String("SSEKMSKeyId"),
+		ServerSideEncryption:      aws.String("ServerSideEncryption"),
+		StorageClass:              aws.String("StorageClass"),
+		Tagging:                   aws.String("TaggingString"),
+		WebsiteRedirectLocation:   aws.String("WebsiteRedirectLocation"),
 	}
 	resp, err := svc.PutObject(params)
 
@@ -1787,6 +2752,7 @@ func ExampleS3_PutObjectACL() {
 				ID:          aws.String("ID"),
 			},
 		},
+		ContentType:      aws.String("ContentType"),
 		GrantFullControl: aws.String("GrantFullControl"),
 		GrantRead:        aws.String("GrantRead"),
 		GrantReadACP:     aws.String("GrantReadACP"),
@@ -1815,12 +2781,196 @@ func ExampleS3_PutObjectACL() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
+func ExampleS3_PutObjectLegalHold() {
+	svc := s3.New(nil)
+
+	params := &s3.PutObjectLegalHoldInput{
+		Bucket:      aws.String("BucketString"), // Required
+		Key:         aws.String("Key"),          // Required
+		ContentType: aws.String("ContentType"),
+		LegalHold: &s3.ObjectLockLegalHold{
+			Status: aws.String("ObjectLockLegalHoldStatus"),
+		},
+		RequestPayer: aws.String("RequestPayer"),
+		VersionID:    aws.String("VersionID"),
+	}
+	resp, err := svc.PutObjectLegalHold(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutObjectLockConfiguration() {
+	svc := s3.New(nil)
+
+	params := &s3.PutObjectLockConfigurationInput{
+		Bucket:      aws.String("BucketString"), // Required
+		ContentType: aws.String("ContentType"),
+		ObjectLockConfiguration: &s3.ObjectLockConfiguration{
+			ObjectLockEnabled: aws.String("ObjectLockEnabled"),
+			Rule: &s3.ObjectLockRule{
+				DefaultRetention: &s3.DefaultRetention{
+					Days:  aws.Long(1),
+					Mode:  aws.String("ObjectLockRetentionMode"),
+					Years: aws.Long(1),
+				},
+			},
+		},
+		RequestPayer: aws.String("RequestPayer"),
+		Token:        aws.String("Token"),
+	}
+	resp, err := svc.PutObjectLockConfiguration(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutObjectRetention() {
+	svc := s3.New(nil)
+
+	params := &s3.PutObjectRetentionInput{
+		Bucket:                    aws.String("BucketString"), // Required
+		Key:                       aws.String("Key"),          // Required
+		BypassGovernanceRetention: aws.Boolean(true),
+		ContentType:               aws.String("ContentType"),
+		RequestPayer:              aws.String("RequestPayer"),
+		Retention: &s3.ObjectLockRetention{
+			Mode:            aws.String("ObjectLockRetentionMode"),
+			RetainUntilDate: aws.Time(time.Now()),
+		},
+		VersionID: aws.String("VersionID"),
+	}
+	resp, err := svc.PutObjectRetention(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutObjectTagging() {
+	svc := s3.New(nil)
+
+	params := &s3.PutObjectTaggingInput{
+		Bucket: aws.String("BucketString"), // Required
+		Key:    aws.String("Key"),          // Required
+		Tagging: &s3.Tagging{ // Required
+			TagSet: []*s3.Tag{ // Required
+				{ // Required
+					Key:   aws.String("ObjectKey"), // Required
+					Value: aws.String("Value"),     // Required
+				},
+				// More values...
+			},
+		},
+		ContentType: aws.String("ContentType"),
+		VersionID:   aws.String("VersionID"),
+	}
+	resp, err := svc.PutObjectTagging(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
+func ExampleS3_PutPublicAccessBlock() {
+	svc := s3.New(nil)
+
+	params := &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String("BucketString"), // Required
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{ // Required
+			BlockPublicACLs:       aws.Boolean(true),
+			BlockPublicPolicy:     aws.Boolean(true),
+			IgnorePublicACLs:      aws.Boolean(true),
+			RestrictPublicBuckets: aws.Boolean(true),
+		},
+		ContentType: aws.String("ContentType"),
+	}
+	resp, err := svc.PutPublicAccessBlock(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
 func ExampleS3_RestoreObject() {
 	svc := s3.New(nil)
 
 	params := &s3.RestoreObjectInput{
 		Bucket:       aws.String("BucketName"), // Required
 		Key:          aws.String("ObjectKey"),  // Required
+		ContentType:  aws.String("ContentType"),
 		RequestPayer: aws.String("RequestPayer"),
 		RestoreRequest: &s3.RestoreRequest{
 			Days: aws.Long(1), // Required
@@ -1848,6 +2998,75 @@ func ExampleS3_RestoreObject() {
 	fmt.Println(awsutil.StringValue(resp))
 }
 
+func ExampleS3_SelectObjectContent() {
+	svc := s3.New(nil)
+
+	params := &s3.SelectObjectContentInput{
+		Bucket:         aws.String("BucketString"),   // Required
+		Expression:     aws.String("Expression"),     // Required
+		ExpressionType: aws.String("ExpressionType"), // Required
+		InputSerialization: &s3.InputSerialization{ // Required
+			CSV: &s3.CSVInput{
+				AllowQuotedRecordDelimiter: aws.Boolean(true),
+				Comments:                   aws.String("Comments"),
+				FieldDelimiter:             aws.String("FieldDelimiter"),
+				FileHeaderInfo:             aws.String("FileHeaderInfo"),
+				QuoteCharacter:             aws.String("QuoteCharacter"),
+				QuoteEscapeCharacter:       aws.String("QuoteEscapeCharacter"),
+				RecordDelimiter:            aws.String("RecordDelimiter"),
+			},
+			CompressionType: aws.String("CompressionType"),
+			JSON: &s3.JSONInput{
+				Type: aws.String("JSONType"),
+			},
+			Parquet: &s3.ParquetInput{},
+		},
+		Key: aws.String("Key"), // Required
+		OutputSerialization: &s3.OutputSerialization{ // Required
+			CSV: &s3.CSVOutput{
+				FieldDelimiter:       aws.String("FieldDelimiter"),
+				QuoteCharacter:       aws.String("QuoteCharacter"),
+				QuoteEscapeCharacter: aws.String("QuoteEscapeCharacter"),
+				QuoteFields:          aws.String("QuoteFields"),
+				RecordDelimiter:      aws.String("RecordDelimiter"),
+			},
+			JSON: &s3.JSONOutput{
+				RecordDelimiter: aws.String("RecordDelimiter"),
+			},
+		},
+		ContentType: aws.String("ContentType"),
+		RequestProgress: &s3.RequestProgress{
+			Enabled: aws.Boolean(true),
+		},
+		SSECustomerAlgorithm: aws.String("SSECustomerAlgorithm"),
+		SSECustomerKey:       aws.String("SSECustomerKey"),
+		SSECustomerKeyMD5:    aws.String("SSECustomerKeyMD5"),
+		ScanRange: &s3.ScanRange{
+			End:   aws.Long(1),
+			Start: aws.Long(1),
+		},
+	}
+	resp, err := svc.SelectObjectContent(params)
+
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok {
+			// Generic AWS Error with Code, Message, and original error (if any)
+			fmt.Println(awsErr.Code(), awsErr.Message(), awsErr.OrigErr())
+			if reqErr, ok := err.(awserr.RequestFailure); ok {
+				// A service error occurred
+				fmt.Println(reqErr.Code(), reqErr.Message(), reqErr.StatusCode(), reqErr.RequestID())
+			}
+		} else {
+			// This case should never be hit, The SDK should alwsy return an
+			// error which satisfies the awserr.Error interface.
+			fmt.Println(err.Error())
+		}
+	}
+
+	// Pretty-print the response data.
+	fmt.Println(awsutil.StringValue(resp))
+}
+
 func ExampleS3_UploadPart() {
 	svc := s3.New(nil)
 
@@ -1858,6 +3077,8 @@ func ExampleS3_UploadPart() {
 		UploadID:             aws.String("MultipartUploadId"), // Required
 		Body:                 bytes.NewReader([]byte("PAYLOAD")),
 		ContentLength:        aws.Long(1),
+		ContentType:          aws.String("ContentType"),
+		ExpectedBucketOwner:  aws.String("ExpectedBucketOwner"),
 		RequestPayer:         aws.String("RequestPayer"),
 		SSECustomerAlgorithm: aws.String("SSECustomerAlgorithm"),
 		SSECustomerKey:       aws.String("SSECustomerKey"),
@@ -1893,6 +3114,7 @@ func ExampleS3_UploadPartCopy() {
 		Key:                            aws.String("ObjectKey"),         // Required
 		PartNumber:                     aws.Long(1),                     // Required
 		UploadID:                       aws.String("MultipartUploadId"), // Required
+		ContentType:                    aws.String("ContentType"),
 		CopySourceIfMatch:              aws.String("CopySourceIfMatch"),
 		CopySourceIfModifiedSince:      aws.Time(time.Now()),
 		CopySourceIfNoneMatch:          aws.String("CopySourceIfNoneMatch"),
@@ -1901,6 +3123,8 @@ func ExampleS3_UploadPartCopy() {
 		CopySourceSSECustomerAlgorithm: aws.String("CopySourceSSECustomerAlgorithm"),
 		CopySourceSSECustomerKey:       aws.String("CopySourceSSECustomerKey"),
 		CopySourceSSECustomerKeyMD5:    aws.String("CopySourceSSECustomerKeyMD5"),
+		ExpectedBucketOwner:            aws.String("ExpectedBucketOwner"),
+		ExpectedSourceBucketOwner:      aws.String("ExpectedSourceBucketOwner"),
 		RequestPayer:                   aws.String("RequestPayer"),
 		SSECustomerAlgorithm:           aws.String("SSECustomerAlgorithm"),
 		SSECustomerKey:                 aws.String("SSECustomerKey"),
@@ -1,16 +1,23 @@
 package s3
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/dongfangx/aws-sdk-go/aws"
 	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
 )
 
 var reDomain = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-]{1,61}[a-z0-9]$`)
 var reIPAddress = regexp.MustCompile(`^(\d+\.){3}\d+$`)
 
+const (
+	accelerateHost          = "s3-accelerate.amazonaws.com"
+	accelerateDualStackHost = "s3-accelerate.dualstack.amazonaws.com"
+)
+
 // dnsCompatibleBucketName returns true if the bucket name is DNS compatible.
 // Buckets created outside of the classic region MUST be DNS compatible.
 func dnsCompatibleBucketName(bucket string) bool {
@@ -19,14 +26,33 @@ func dnsCompatibleBucketName(bucket string) bool {
 		!strings.Contains(bucket, "..")
 }
 
+// accelerateCompatibleBucketName returns true if the bucket name can be used
+// with S3 Transfer Acceleration, which requires DNS compatible names without
+// dots.
+func accelerateCompatibleBucketName(bucket string) bool {
+	return dnsCompatibleBucketName(bucket) && !strings.Contains(bucket, ".")
+}
+
+// useAccelerate returns true if the request should be sent through S3
+// Transfer Acceleration. Bucket creation and deletion are not supported by
+// accelerated endpoints, so they are always sent to the regional endpoint.
+func useAccelerate(r *aws.Request) bool {
+	return r.Config.S3UseAccelerate && !r.Config.S3BucketEndpoint &&
+		r.Operation != opCreateBucket && r.Operation != opDeleteBucket
+}
+
 // hostStyleBucketName returns true if the request should put the bucket in
-// the host. This is false if S3ForcePathStyle is explicitly set or if the
+// the host. This is false if path-style addressing is configured or if the
 // bucket is not DNS compatible.
 func hostStyleBucketName(r *aws.Request, bucket string) bool {
-	if r.Config.S3ForcePathStyle {
+	if r.Config.S3ForcePathStyle || r.Config.S3AddressingStyle == aws.S3AddressingStylePath {
 		return false
 	}
 
+	if r.Config.S3AddressingStyle == aws.S3AddressingStyleVirtual {
+		return true
+	}
+
 	// Bucket might be DNS compatible but dots in the hostname will fail
 	// certificate validation, so do not use host-style.
 	if r.HTTPRequest.URL.Scheme == "https" && strings.Contains(bucket, ".") {
@@ -37,17 +63,55 @@ func hostStyleBucketName(r *aws.Request, bucket string) bool {
 	return dnsCompatibleBucketName(bucket)
 }
 
+// removeBucketFromPath removes the bucket placeholder from the request path,
+// for requests which address the bucket in the host.
+func removeBucketFromPath(r *aws.Request) {
+	r.HTTPRequest.URL.Path = strings.Replace(r.HTTPRequest.URL.Path, "/{Bucket}", "", -1)
+	if r.HTTPRequest.URL.Path == "" {
+		r.HTTPRequest.URL.Path = "/"
+	}
+}
+
 func updateHostWithBucket(r *aws.Request) {
 	b := awsutil.ValuesAtPath(r.Params, "Bucket")
 	if len(b) == 0 {
 		return
 	}
+	bucket := b[0].(string)
+	if bucket == "" {
+		return
+	}
+
+	if r.Config.S3BucketEndpoint {
+		// The endpoint already addresses the bucket
+		removeBucketFromPath(r)
+		return
+	}
 
-	if bucket := b[0].(string); bucket != "" && hostStyleBucketName(r, bucket) {
+	if useAccelerate(r) {
+		if !accelerateCompatibleBucketName(bucket) {
+			r.Error = apierr.New("InvalidParameterException",
+				fmt.Sprintf("bucket name %s is not compatible with S3 Accelerate", bucket), nil)
+			return
+		}
+		r.HTTPRequest.URL.Host = accelerateHost
+		if r.Config.UseDualStack {
+			r.HTTPRequest.URL.Host = accelerateDualStackHost
+		}
+
+		// Accelerated endpoints only support host-style requests
 		r.HTTPRequest.URL.Host = bucket + "." + r.HTTPRequest.URL.Host
-		r.HTTPRequest.URL.Path = strings.Replace(r.HTTPRequest.URL.Path, "/{Bucket}", "", -1)
-		if r.HTTPRequest.URL.Path == "" {
-			r.HTTPRequest.URL.Path = "/"
+		removeBucketFromPath(r)
+		return
+	}
+
+	if hostStyleBucketName(r, bucket) {
+		if !dnsCompatibleBucketName(bucket) {
+			r.Error = apierr.New("InvalidParameterException",
+				fmt.Sprintf("bucket name %s is not compatible with virtual-host addressing", bucket), nil)
+			return
 		}
+		r.HTTPRequest.URL.Host = bucket + "." + r.HTTPRequest.URL.Host
+		removeBucketFromPath(r)
 	}
 }
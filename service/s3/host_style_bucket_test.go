@@ -1,9 +1,12 @@
 package s3_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
 	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
 	"github.com/dongfangx/aws-sdk-go/service/s3"
 	"github.com/stretchr/testify/assert"
@@ -41,6 +44,10 @@ func runTests(t *testing.T, svc *s3.S3, tests []s3BucketTest) {
 	for _, test := range tests {
 		req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: &test.bucket})
 		req.Build()
+		assert.Equal(t, test.url+"?encoding-type=url", req.HTTPRequest.URL.String())
+
+		req, _ = svc.HeadBucketRequest(&s3.HeadBucketInput{Bucket: &test.bucket})
+		req.Build()
 		assert.Equal(t, test.url, req.HTTPRequest.URL.String())
 	}
 }
@@ -59,3 +66,128 @@ func TestPathStyleBucketBuild(t *testing.T) {
 	s := s3.New(&aws.Config{S3ForcePathStyle: true})
 	runTests(t, s, forcepathTests)
 }
+
+func TestAddressingStyleBuild(t *testing.T) {
+	s := s3.New(&aws.Config{S3AddressingStyle: aws.S3AddressingStylePath})
+	runTests(t, s, forcepathTests)
+
+	s = s3.New(&aws.Config{S3AddressingStyle: aws.S3AddressingStyleVirtual})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.s3.mock-region.amazonaws.com/"},
+		{"a.b.c", "https://a.b.c.s3.mock-region.amazonaws.com/"},
+	})
+}
+
+func TestAddressingStyleCustomEndpoint(t *testing.T) {
+	s := s3.New(&aws.Config{
+		Endpoint:          "http://storage.example.com:9000",
+		S3AddressingStyle: aws.S3AddressingStyleVirtual,
+	})
+	runTests(t, s, []s3BucketTest{
+		{"a.b.c", "http://a.b.c.storage.example.com:9000/"},
+	})
+
+	s = s3.New(&aws.Config{Endpoint: "http://storage.example.com:9000", S3ForcePathStyle: true})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "http://storage.example.com:9000/abc"},
+	})
+}
+
+func TestBucketEndpointBuild(t *testing.T) {
+	s := s3.New(&aws.Config{Endpoint: "https://abc.storage.example.com", S3BucketEndpoint: true})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.storage.example.com/"},
+	})
+
+	req, _ := s.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("abc"), Key: aws.String("a/b")})
+	req.Build()
+	assert.Equal(t, "https://abc.storage.example.com/a/b", req.HTTPRequest.URL.String())
+}
+
+func TestAccelerateBuild(t *testing.T) {
+	s := s3.New(&aws.Config{S3UseAccelerate: true})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.s3-accelerate.amazonaws.com/"},
+	})
+
+	s = s3.New(&aws.Config{S3UseAccelerate: true, UseDualStack: true})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.s3-accelerate.dualstack.amazonaws.com/"},
+	})
+
+	// bucket creation is not supported by accelerated endpoints
+	req, _ := s.CreateBucketRequest(&s3.CreateBucketInput{Bucket: aws.String("abc")})
+	req.Build()
+	assert.Equal(t, "https://abc.s3.dualstack.mock-region.amazonaws.com/", req.HTTPRequest.URL.String())
+}
+
+func TestAccelerateInvalidBucket(t *testing.T) {
+	s := s3.New(&aws.Config{S3UseAccelerate: true})
+	for _, bucket := range []string{"a.b.c", "a$b$c", "ab"} {
+		req, _ := s.HeadBucketRequest(&s3.HeadBucketInput{Bucket: aws.String(bucket)})
+		err := req.Build()
+		assert.Equal(t, "InvalidParameterException", err.(awserr.Error).Code())
+	}
+}
+
+func TestVirtualAddressingInvalidBucket(t *testing.T) {
+	s := s3.New(&aws.Config{S3AddressingStyle: aws.S3AddressingStyleVirtual})
+	req, _ := s.HeadBucketRequest(&s3.HeadBucketInput{Bucket: aws.String("a$b$c")})
+	err := req.Build()
+	assert.Equal(t, "InvalidParameterException", err.(awserr.Error).Code())
+}
+
+func TestDualStackBuild(t *testing.T) {
+	s := s3.New(&aws.Config{UseDualStack: true})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.s3.dualstack.mock-region.amazonaws.com/"},
+		{"a.b.c", "https://s3.dualstack.mock-region.amazonaws.com/a.b.c"},
+	})
+
+	// dualstack only applies to the regional endpoints
+	s = s3.New(&aws.Config{UseDualStack: true, Endpoint: "https://storage.example.com"})
+	runTests(t, s, []s3BucketTest{
+		{"abc", "https://abc.storage.example.com/"},
+	})
+}
+
+func TestSignCanonicalResource(t *testing.T) {
+	cases := []struct {
+		config   *aws.Config
+		resource string
+	}{
+		{&aws.Config{}, "/abc/a/b"},
+		{&aws.Config{S3ForcePathStyle: true}, "/abc/a/b"},
+		{&aws.Config{S3UseAccelerate: true}, "/abc/a/b"},
+		{&aws.Config{UseDualStack: true}, "/abc/a/b"},
+		{&aws.Config{Endpoint: "https://abc.storage.example.com", S3BucketEndpoint: true}, "/abc/a/b"},
+	}
+
+	for _, c := range cases {
+		var buf bytes.Buffer
+		c.config.LogLevel = 1
+		c.config.Logger = &buf
+		s := s3.New(c.config)
+		req, _ := s.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("abc"), Key: aws.String("a/b")})
+		assert.NoError(t, req.Sign())
+		assert.Contains(t, buf.String(), "\n"+c.resource+"\n")
+	}
+}
+
+func TestSignatureVersionFromEndpoint(t *testing.T) {
+	cases := []struct {
+		region string
+		prefix string
+	}{
+		{"us-west-2", "AWS AKID:"},
+		{"eu-central-1", "AWS4-HMAC-SHA256 "},
+		{"cn-north-1", "AWS4-HMAC-SHA256 "},
+	}
+
+	for _, c := range cases {
+		s := s3.New(&aws.Config{Region: c.region})
+		req, _ := s.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String("abc"), Key: aws.String("a/b")})
+		assert.NoError(t, req.Sign())
+		assert.True(t, strings.HasPrefix(req.HTTPRequest.Header.Get("Authorization"), c.prefix), c.region)
+	}
+}
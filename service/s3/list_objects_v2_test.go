@@ -0,0 +1,98 @@
+package s3_test
+
+import (
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+// fixtureSvc returns a client responding with the recorded XML fixtures in
+// order, and the queries of the requests sent.
+func fixtureSvc(t *testing.T, fixtures ...string) (*s3.S3, *[]url.Values) {
+	queries := []url.Values{}
+	svc := s3.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		queries = append(queries, r.HTTPRequest.URL.Query())
+		f, err := os.Open("testdata/" + fixtures[len(queries)-1])
+		assert.NoError(t, err)
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}, Body: f}
+	})
+	return svc, &queries
+}
+
+func TestListObjectsV2Pages(t *testing.T) {
+	svc, queries := fixtureSvc(t, "list_objects_v2_page1.xml", "list_objects_v2_page2.xml")
+
+	pages := []*s3.ListObjectsV2Output{}
+	err := svc.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket:     aws.String("bucket"),
+		Prefix:     aws.String("photos/2006/"),
+		Delimiter:  aws.String("/"),
+		StartAfter: aws.String("photos/2006/a"),
+		FetchOwner: aws.Boolean(true),
+		MaxKeys:    aws.Long(2),
+	}, func(p *s3.ListObjectsV2Output, lastPage bool) bool {
+		pages = append(pages, p)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Len(t, pages, 2)
+
+	q := (*queries)[0]
+	assert.Equal(t, "2", q.Get("list-type"))
+	assert.Equal(t, "photos/2006/a", q.Get("start-after"))
+	assert.Equal(t, "true", q.Get("fetch-owner"))
+	assert.Equal(t, "2", q.Get("max-keys"))
+	assert.Equal(t, "", q.Get("continuation-token"))
+	assert.Equal(t, "1ueGcxLPRx1Tr/XYExHnhbYLgveDs2J/wm36Hy4vbOwM=",
+		(*queries)[1].Get("continuation-token"))
+	assert.Equal(t, "2", (*queries)[1].Get("list-type"))
+
+	p := pages[0]
+	assert.Equal(t, "bucket", *p.Name)
+	assert.Equal(t, "photos/2006/a", *p.StartAfter)
+	assert.Equal(t, int64(2), *p.KeyCount)
+	assert.True(t, *p.IsTruncated)
+	assert.Equal(t, "1ueGcxLPRx1Tr/XYExHnhbYLgveDs2J/wm36Hy4vbOwM=", *p.NextContinuationToken)
+	assert.Len(t, p.Contents, 2)
+	assert.Equal(t, "photos/2006/index.html", *p.Contents[0].Key)
+	assert.Equal(t, `"bf1d737a4d46a19f3bced6905cc8b902"`, *p.Contents[0].ETag)
+	assert.Equal(t, int64(142863), *p.Contents[0].Size)
+	assert.Equal(t, "mtd@amazon.com", *p.Contents[0].Owner.DisplayName)
+	assert.Equal(t, time.Date(2016, 5, 11, 21, 32, 57, 0, time.UTC), *p.Contents[0].LastModified)
+
+	p = pages[1]
+	assert.Equal(t, "1ueGcxLPRx1Tr/XYExHnhbYLgveDs2J/wm36Hy4vbOwM=", *p.ContinuationToken)
+	assert.False(t, *p.IsTruncated)
+	assert.Nil(t, p.NextContinuationToken)
+	assert.Equal(t, int64(2), *p.KeyCount)
+	assert.Equal(t, "photos/2006/summary.txt", *p.Contents[0].Key)
+	assert.Nil(t, p.Contents[0].Owner)
+	assert.Equal(t, "photos/2006/January/", *p.CommonPrefixes[0].Prefix)
+}
+
+func TestListObjectsV2URLEncoding(t *testing.T) {
+	svc, queries := listingSvc(
+		`<ListBucketResult><EncodingType>url</EncodingType><StartAfter>a%01</StartAfter>` +
+			`<Contents><Key>a%02</Key></Contents></ListBucketResult>`,
+	)
+
+	out, err := svc.ListObjectsV2(&s3.ListObjectsV2Input{
+		Bucket:     aws.String("bucket"),
+		StartAfter: aws.String("a\x01"),
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, (*queries)[0], "encoding-type=url")
+	assert.Equal(t, "a\x01", *out.StartAfter)
+	assert.Equal(t, "a\x02", *out.Contents[0].Key)
+}
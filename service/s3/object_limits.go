@@ -0,0 +1,73 @@
+package s3
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+)
+
+// The range of part numbers S3 accepts in a multipart upload.
+const (
+	minPartNumber = 1
+	maxPartNumber = 10000
+)
+
+// validateObjectLimits validates the S3 service limits the model does not
+// carry as constraints: object keys must not be empty, and part numbers must
+// be between 1 and 10000. Violations are added to the ErrInvalidParams of
+// the parameter validation.
+func validateObjectLimits(r *aws.Request) {
+	if r.Config.DisableParamValidation || !r.ParamsFilled() {
+		return
+	}
+	if _, ok := r.Error.(aws.ErrInvalidParams); r.Error != nil && !ok {
+		return
+	}
+
+	errs := []aws.ErrInvalidParam{}
+	for _, v := range awsutil.ValuesAtPath(r.Params, "Key") {
+		if k, ok := v.(string); ok && k == "" {
+			errs = append(errs, aws.ErrInvalidParam{
+				Field: "Key", Reason: "parameter shorter than minimum length of 1",
+			})
+		}
+	}
+	for _, v := range awsutil.ValuesAtPath(r.Params, "PartNumber") {
+		if n, ok := v.(int64); ok {
+			errs = appendPartNumberErr(errs, "PartNumber", n)
+		}
+	}
+	if in, ok := r.Params.(*CompleteMultipartUploadInput); ok && in.MultipartUpload != nil {
+		for i, p := range in.MultipartUpload.Parts {
+			if p != nil && p.PartNumber != nil {
+				path := fmt.Sprintf("MultipartUpload.Parts[%d].PartNumber", i)
+				errs = appendPartNumberErr(errs, path, *p.PartNumber)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		if e, ok := r.Error.(aws.ErrInvalidParams); ok {
+			errs = append(e.Errs, errs...)
+		}
+		r.Error = aws.ErrInvalidParams{Errs: errs}
+	}
+}
+
+// appendPartNumberErr appends the error of the part number n at path to
+// errs, if n is out of range.
+func appendPartNumberErr(errs []aws.ErrInvalidParam, path string, n int64) []aws.ErrInvalidParam {
+	switch {
+	case n < minPartNumber:
+		return append(errs, aws.ErrInvalidParam{
+			Field: path, Reason: "parameter less than minimum value of " + strconv.Itoa(minPartNumber),
+		})
+	case n > maxPartNumber:
+		return append(errs, aws.ErrInvalidParam{
+			Field: path, Reason: "parameter greater than maximum value of " + strconv.Itoa(maxPartNumber),
+		})
+	}
+	return errs
+}
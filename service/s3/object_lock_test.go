@@ -0,0 +1,189 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+// echoSvc returns a client responding with body and headers, and the last
+// request sent with its body.
+func echoSvc(body string, header http.Header) (*s3.S3, *http.Request, *[]byte) {
+	sent, sentBody := &http.Request{}, &[]byte{}
+	svc := s3.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		*sent = *r.HTTPRequest
+		if r.HTTPRequest.Body != nil {
+			*sentBody, _ = ioutil.ReadAll(r.HTTPRequest.Body)
+		}
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     header,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	return svc, sent, sentBody
+}
+
+func TestPutObjectLockHeaders(t *testing.T) {
+	svc, sent, _ := echoSvc("", http.Header{})
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	_, err := svc.PutObject(&s3.PutObjectInput{
+		Bucket:                    aws.String("bucket"),
+		Key:                       aws.String("key"),
+		ObjectLockMode:            aws.String("COMPLIANCE"),
+		ObjectLockRetainUntilDate: &until,
+		ObjectLockLegalHoldStatus: aws.String("ON"),
+		Tagging:                   aws.String("a=b&c=d"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLIANCE", sent.Header.Get("x-amz-object-lock-mode"))
+	assert.Equal(t, "2030-01-02T03:04:05Z", sent.Header.Get("x-amz-object-lock-retain-until-date"))
+	assert.Equal(t, "ON", sent.Header.Get("x-amz-object-lock-legal-hold"))
+	assert.Equal(t, "a=b&c=d", sent.Header.Get("x-amz-tagging"))
+}
+
+func TestHeadObjectLockHeaders(t *testing.T) {
+	svc, _, _ := echoSvc("", http.Header{
+		"X-Amz-Object-Lock-Mode":              []string{"GOVERNANCE"},
+		"X-Amz-Object-Lock-Retain-Until-Date": []string{"2030-01-02T03:04:05.000Z"},
+		"X-Amz-Object-Lock-Legal-Hold":        []string{"OFF"},
+	})
+
+	out, err := svc.HeadObject(&s3.HeadObjectInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Equal(t, "GOVERNANCE", *out.ObjectLockMode)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), *out.ObjectLockRetainUntilDate)
+	assert.Equal(t, "OFF", *out.ObjectLockLegalHoldStatus)
+}
+
+func TestPutObjectRetention(t *testing.T) {
+	svc, sent, body := echoSvc("", http.Header{})
+	until := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	_, err := svc.PutObjectRetention(&s3.PutObjectRetentionInput{
+		Bucket:                    aws.String("bucket"),
+		Key:                       aws.String("key"),
+		VersionID:                 aws.String("v1"),
+		BypassGovernanceRetention: aws.Boolean(true),
+		Retention: &s3.ObjectLockRetention{
+			Mode:            aws.String("GOVERNANCE"),
+			RetainUntilDate: &until,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Regexp(t, `^//bucket\..*/key$`, sent.URL.Opaque)
+	assert.Contains(t, sent.URL.RawQuery, "retention")
+	assert.Equal(t, "v1", sent.URL.Query().Get("versionId"))
+	assert.Equal(t, "true", sent.Header.Get("x-amz-bypass-governance-retention"))
+	assert.Contains(t, string(*body), "<Retention")
+	assert.Contains(t, string(*body), "<Mode>GOVERNANCE</Mode>")
+	assert.Contains(t, string(*body), "<RetainUntilDate>2030-01-02T03:04:05Z</RetainUntilDate>")
+}
+
+func TestGetObjectLockConfiguration(t *testing.T) {
+	svc, _, _ := echoSvc(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled>`+
+		`<Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></Rule>`+
+		`</ObjectLockConfiguration>`, http.Header{})
+
+	out, err := svc.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "Enabled", *out.ObjectLockConfiguration.ObjectLockEnabled)
+	assert.Equal(t, "COMPLIANCE", *out.ObjectLockConfiguration.Rule.DefaultRetention.Mode)
+	assert.Equal(t, int64(30), *out.ObjectLockConfiguration.Rule.DefaultRetention.Days)
+}
+
+func TestGetObjectTagging(t *testing.T) {
+	svc, sent, _ := echoSvc(`<Tagging><TagSet><Tag><Key>a</Key><Value>b</Value></Tag>`+
+		`<Tag><Key>c</Key><Value>d</Value></Tag></TagSet></Tagging>`,
+		http.Header{"X-Amz-Version-Id": []string{"v1"}})
+
+	out, err := svc.GetObjectTagging(&s3.GetObjectTaggingInput{Bucket: aws.String("bucket"), Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Contains(t, sent.URL.RawQuery, "tagging")
+	assert.Equal(t, "v1", *out.VersionID)
+	assert.Len(t, out.TagSet, 2)
+	assert.Equal(t, "c", *out.TagSet[1].Key)
+	assert.Equal(t, "d", *out.TagSet[1].Value)
+}
+
+func TestLifecycleConfigurationFilters(t *testing.T) {
+	svc, _, body := echoSvc("", http.Header{})
+
+	_, err := svc.PutBucketLifecycleConfiguration(&s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String("bucket"),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: []*s3.LifecycleRule{
+				{
+					ID: aws.String("tagged"),
+					Filter: &s3.LifecycleFilter{
+						And: &s3.LifecycleRuleAndOperator{
+							Prefix: aws.String("logs/"),
+							Tags: []*s3.Tag{
+								{Key: aws.String("a"), Value: aws.String("b")},
+								{Key: aws.String("c"), Value: aws.String("d")},
+							},
+						},
+					},
+					Status:     aws.String("Enabled"),
+					Expiration: &s3.LifecycleExpiration{Days: aws.Long(7)},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(*body), "<Filter><And>")
+	assert.Contains(t, string(*body), "<Prefix>logs/</Prefix>")
+	assert.Equal(t, 2, strings.Count(string(*body), "<Tag>"))
+	assert.Contains(t, string(*body), "<Key>c</Key>")
+	assert.Contains(t, string(*body), "<Value>d</Value>")
+
+	svc, _, _ = echoSvc(`<LifecycleConfiguration><Rule><ID>tagged</ID><Filter><Tag><Key>a</Key><Value>b</Value></Tag></Filter>`+
+		`<Status>Enabled</Status><AbortIncompleteMultipartUpload><DaysAfterInitiation>3</DaysAfterInitiation>`+
+		`</AbortIncompleteMultipartUpload></Rule></LifecycleConfiguration>`, http.Header{})
+
+	out, err := svc.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Len(t, out.Rules, 1)
+	assert.Equal(t, "a", *out.Rules[0].Filter.Tag.Key)
+	assert.Equal(t, int64(3), *out.Rules[0].AbortIncompleteMultipartUpload.DaysAfterInitiation)
+}
+
+func TestListBucketInventoryConfigurations(t *testing.T) {
+	svc, sent, _ := echoSvc(`<ListInventoryConfigurationsResult><IsTruncated>true</IsTruncated>`+
+		`<NextContinuationToken>next</NextContinuationToken>`+
+		`<InventoryConfiguration><Id>report</Id><IsEnabled>true</IsEnabled>`+
+		`<Destination><S3BucketDestination><Bucket>arn:aws:s3:::dest</Bucket><Format>CSV</Format>`+
+		`<Encryption><SSE-KMS><KeyId>key</KeyId></SSE-KMS></Encryption></S3BucketDestination></Destination>`+
+		`<OptionalFields><Field>Size</Field><Field>ETag</Field></OptionalFields>`+
+		`<Schedule><Frequency>Daily</Frequency></Schedule></InventoryConfiguration>`+
+		`</ListInventoryConfigurationsResult>`, http.Header{})
+
+	out, err := svc.ListBucketInventoryConfigurations(&s3.ListBucketInventoryConfigurationsInput{
+		Bucket:            aws.String("bucket"),
+		ContinuationToken: aws.String("token"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "token", sent.URL.Query().Get("continuation-token"))
+	assert.True(t, *out.IsTruncated)
+	assert.Equal(t, "next", *out.NextContinuationToken)
+	assert.Len(t, out.InventoryConfigurationList, 1)
+
+	c := out.InventoryConfigurationList[0]
+	assert.Equal(t, "report", *c.ID)
+	assert.Equal(t, "key", *c.Destination.S3BucketDestination.Encryption.SSEKMS.KeyID)
+	assert.Equal(t, []*string{aws.String("Size"), aws.String("ETag")}, c.OptionalFields)
+	assert.Equal(t, "Daily", *c.Schedule.Frequency)
+}
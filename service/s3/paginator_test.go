@@ -0,0 +1,83 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListObjectsV2PaginatorMaxItems(t *testing.T) {
+	svc, queries := fixtureSvc(t, "list_objects_v2_page1.xml", "list_objects_v2_page2.xml")
+
+	p := svc.ListObjectsV2Paginator(&s3.ListObjectsV2Input{
+		Bucket:  aws.String("bucket"),
+		MaxKeys: aws.Long(2),
+	})
+	p.MaxItems = 3
+
+	keys := []string{}
+	for p.Next() {
+		for _, o := range p.Page().Contents {
+			keys = append(keys, *o.Key)
+		}
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, []string{"photos/2006/index.html", "photos/2006/readme.txt", "photos/2006/summary.txt"}, keys)
+	assert.Len(t, *queries, 2)
+	assert.Equal(t, "2", (*queries)[0].Get("max-keys"))
+	assert.Equal(t, "1", (*queries)[1].Get("max-keys"))
+}
+
+func TestListObjectsV2PaginatorResume(t *testing.T) {
+	svc, _ := fixtureSvc(t, "list_objects_v2_page1.xml")
+	p := svc.ListObjectsV2Paginator(&s3.ListObjectsV2Input{Bucket: aws.String("bucket")})
+	assert.True(t, p.Next())
+	token := p.Token()
+	assert.Equal(t, `["1ueGcxLPRx1Tr/XYExHnhbYLgveDs2J/wm36Hy4vbOwM="]`, token)
+
+	svc, queries := fixtureSvc(t, "list_objects_v2_page2.xml")
+	p = svc.ListObjectsV2Paginator(&s3.ListObjectsV2Input{Bucket: aws.String("bucket")})
+	p.StartToken = token
+	assert.True(t, p.Next())
+	assert.Equal(t, "photos/2006/summary.txt", *p.Page().Contents[0].Key)
+	assert.False(t, p.Next())
+	assert.NoError(t, p.Err())
+	assert.Equal(t, "1ueGcxLPRx1Tr/XYExHnhbYLgveDs2J/wm36Hy4vbOwM=", (*queries)[0].Get("continuation-token"))
+}
+
+func TestListPartsPaginatorRepeatedToken(t *testing.T) {
+	page := `<ListPartsResult><IsTruncated>true</IsTruncated>` +
+		`<NextPartNumberMarker>1</NextPartNumberMarker>` +
+		`<Part><PartNumber>1</PartNumber></Part></ListPartsResult>`
+	svc, queries := listingSvc(page, page, page)
+
+	p := svc.ListPartsPaginator(&s3.ListPartsInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		UploadID: aws.String("upload"),
+	})
+	pages := 0
+	for p.Next() {
+		pages++
+	}
+	assert.NoError(t, p.Err())
+	assert.Equal(t, 2, pages)
+	assert.Len(t, *queries, 2)
+	assert.Contains(t, (*queries)[1], "part-number-marker=1")
+}
+
+func TestListPartsPaginatorResumeIntegerToken(t *testing.T) {
+	svc, queries := listingSvc(`<ListPartsResult><IsTruncated>false</IsTruncated></ListPartsResult>`)
+
+	p := svc.ListPartsPaginator(&s3.ListPartsInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		UploadID: aws.String("upload"),
+	})
+	p.StartToken = `[5]`
+	assert.True(t, p.Next())
+	assert.NoError(t, p.Err())
+	assert.Contains(t, (*queries)[0], "part-number-marker=5")
+}
@@ -0,0 +1,50 @@
+package s3crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// The content encryption algorithm of objects written by an
+// EncryptionClient.
+const AESGCMContentCipher = "AES/GCM/NoPadding"
+
+const (
+	gcmKeySize   = 32
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+)
+
+// newGCM returns the AES-GCM cipher for key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// gcmEncrypt seals plaintext with AES-GCM, returning the ciphertext
+// followed by the authentication tag.
+func gcmEncrypt(key, iv, plaintext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, iv, plaintext, nil), nil
+}
+
+// gcmDecrypt authenticates and opens a ciphertext sealed by gcmEncrypt. No
+// plaintext is returned unless the whole ciphertext is authentic.
+func gcmDecrypt(key, iv, ciphertext []byte) ([]byte, error) {
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(iv) != aead.NonceSize() {
+		return nil, apierr.New("DecryptionError", "invalid IV length", nil)
+	}
+	return aead.Open(nil, iv, ciphertext, nil)
+}
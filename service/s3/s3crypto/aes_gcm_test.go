@@ -0,0 +1,65 @@
+package s3crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var gcmTestKey = bytes.Repeat([]byte{0x42}, gcmKeySize)
+var gcmTestIV = bytes.Repeat([]byte{0x24}, gcmNonceSize)
+
+func gcmTestPlaintext(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i * 7)
+	}
+	return b
+}
+
+func TestGCMRoundTrip(t *testing.T) {
+	for _, n := range []int{0, 1, 15, 16, 17, 1000, 70000} {
+		plaintext := gcmTestPlaintext(n)
+		ciphertext, err := gcmEncrypt(gcmTestKey, gcmTestIV, plaintext)
+		assert.NoError(t, err)
+		assert.Len(t, ciphertext, n+gcmTagSize)
+
+		b, err := gcmDecrypt(gcmTestKey, gcmTestIV, ciphertext)
+		assert.NoError(t, err, "length %d", n)
+		assert.True(t, bytes.Equal(plaintext, b), "length %d", n)
+	}
+}
+
+func TestGCMDecryptTampered(t *testing.T) {
+	ciphertext, err := gcmEncrypt(gcmTestKey, gcmTestIV, gcmTestPlaintext(100))
+	assert.NoError(t, err)
+
+	for _, i := range []int{0, 50, len(ciphertext) - 1} {
+		tampered := append([]byte{}, ciphertext...)
+		tampered[i] ^= 1
+
+		b, err := gcmDecrypt(gcmTestKey, gcmTestIV, tampered)
+		assert.Error(t, err)
+		assert.Nil(t, b)
+	}
+}
+
+func TestGCMDecryptTruncated(t *testing.T) {
+	b, err := gcmDecrypt(gcmTestKey, gcmTestIV, make([]byte, 10))
+	assert.Error(t, err)
+	assert.Nil(t, b)
+}
+
+func TestGCMDecryptInvalidIV(t *testing.T) {
+	_, err := gcmDecrypt(gcmTestKey, gcmTestIV[:8], make([]byte, 32))
+	assert.Contains(t, err.Error(), "invalid IV length")
+}
+
+func BenchmarkGCMDecrypt(b *testing.B) {
+	ciphertext, _ := gcmEncrypt(gcmTestKey, gcmTestIV, gcmTestPlaintext(1024*1024))
+	b.SetBytes(int64(len(ciphertext)))
+	for i := 0; i < b.N; i++ {
+		gcmDecrypt(gcmTestKey, gcmTestIV, ciphertext)
+	}
+}
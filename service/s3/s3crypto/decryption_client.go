@@ -0,0 +1,200 @@
+package s3crypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// DecryptionOptions keeps track of extra options to pass to a
+// DecryptionClient.
+type DecryptionOptions struct {
+	// The client to download objects with. Leave this as nil to use the
+	// default S3 client.
+	S3 *s3.S3
+
+	// The suffix of instruction files, which are read when an object's
+	// metadata does not contain an Envelope. If empty,
+	// DefaultInstructionKeySuffix is used.
+	InstructionFileSuffix string
+}
+
+// A DecryptionClient downloads and decrypts objects uploaded by an
+// EncryptionClient. The Envelope is read from the object's metadata, or
+// from its instruction file, and the KeyWrapper matching its wrap algorithm
+// and material description unwraps the content key.
+//
+// It is safe to use a DecryptionClient across concurrent goroutines.
+type DecryptionClient struct {
+	wrappers []KeyWrapper
+	opts     DecryptionOptions
+}
+
+// NewDecryptionClient creates a new DecryptionClient able to unwrap content
+// keys with any of wrappers. Pass in an optional opts structure to customize
+// the client.
+func NewDecryptionClient(wrappers []KeyWrapper, opts *DecryptionOptions) *DecryptionClient {
+	c := &DecryptionClient{wrappers: wrappers}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.S3 == nil {
+		c.opts.S3 = s3.New(nil)
+	}
+	if c.opts.InstructionFileSuffix == "" {
+		c.opts.InstructionFileSuffix = DefaultInstructionKeySuffix
+	}
+	return c
+}
+
+// GetObject downloads an encrypted object. The object, which must not be
+// longer than MaxContentLength, is read into memory and authenticated
+// before GetObject returns, so the returned Body only ever holds
+// authentic, decrypted content. ContentLength is the length of the
+// decrypted content.
+//
+// Range requests are not supported, as the whole object is needed to
+// authenticate it.
+func (c *DecryptionClient) GetObject(input *s3.GetObjectInput) (*s3.GetObjectOutput, error) {
+	if input.Range != nil {
+		return nil, apierr.New("ConfigError", "range requests are not supported for encrypted objects", nil)
+	}
+
+	out, err := c.opts.S3.GetObject(input)
+	if err != nil {
+		return nil, err
+	}
+
+	body := out.Body
+	defer body.Close()
+
+	if err := c.decrypt(input, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// decrypt replaces the body of out with its authenticated plaintext.
+func (c *DecryptionClient) decrypt(input *s3.GetObjectInput, out *s3.GetObjectOutput) error {
+	env := envelopeFromMetadata(out.Metadata)
+	if env == nil {
+		var err error
+		if env, err = c.instructionFile(input); err != nil {
+			return err
+		}
+	}
+
+	if env.CEKAlg != AESGCMContentCipher {
+		msg := fmt.Sprintf("unsupported content cipher %q", env.CEKAlg)
+		return apierr.New("DecryptionError", msg, nil)
+	}
+	if env.TagLen != "" && env.TagLen != strconv.Itoa(gcmTagSize*8) {
+		msg := fmt.Sprintf("unsupported tag length %s", env.TagLen)
+		return apierr.New("DecryptionError", msg, nil)
+	}
+
+	wrapper, err := c.keyWrapper(env)
+	if err != nil {
+		return err
+	}
+
+	wrapped, err := base64.StdEncoding.DecodeString(env.CipherKey)
+	if err != nil {
+		return apierr.New("InvalidEnvelope", "failed to decode content key", err)
+	}
+	iv, err := base64.StdEncoding.DecodeString(env.IV)
+	if err != nil {
+		return apierr.New("InvalidEnvelope", "failed to decode IV", err)
+	}
+
+	key, err := wrapper.UnwrapKey(wrapped, env.CEKAlg)
+	if err != nil {
+		return err
+	}
+
+	limit := MaxContentLength + gcmTagSize
+	if out.ContentLength != nil && *out.ContentLength > limit {
+		return errContentTooLarge()
+	}
+	ciphertext, err := ioutil.ReadAll(io.LimitReader(out.Body, limit+1))
+	if err != nil {
+		return apierr.New("ReadResponseBody", "failed to read encrypted object", err)
+	}
+	if int64(len(ciphertext)) > limit {
+		return errContentTooLarge()
+	}
+	plaintext, err := gcmDecrypt(key, iv, ciphertext)
+	if err != nil {
+		return apierr.New("DecryptionError", "failed to decrypt content", err)
+	}
+
+	out.Body = ioutil.NopCloser(bytes.NewReader(plaintext))
+	out.ContentLength = aws.Long(int64(len(plaintext)))
+	return nil
+}
+
+// instructionFile downloads the Envelope stored in the instruction file of
+// the object described by input.
+func (c *DecryptionClient) instructionFile(input *s3.GetObjectInput) (*Envelope, error) {
+	key := *input.Key + c.opts.InstructionFileSuffix
+	out, err := c.opts.S3.GetObject(&s3.GetObjectInput{
+		Bucket:               input.Bucket,
+		Key:                  &key,
+		ExpectedBucketOwner:  input.ExpectedBucketOwner,
+		RequestPayer:         input.RequestPayer,
+		SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+		SSECustomerKey:       input.SSECustomerKey,
+		SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+	})
+	if err != nil {
+		return nil, apierr.New("InvalidEnvelope", "object has no envelope in its metadata or instruction file", err)
+	}
+	defer out.Body.Close()
+
+	b, err := ioutil.ReadAll(out.Body)
+	if err != nil {
+		return nil, apierr.New("InvalidEnvelope", "failed to read instruction file", err)
+	}
+
+	env := &Envelope{}
+	if err := json.Unmarshal(b, env); err != nil {
+		return nil, apierr.New("InvalidEnvelope", "failed to decode instruction file", err)
+	}
+	return env, nil
+}
+
+// keyWrapper returns the KeyWrapper matching the wrap algorithm and
+// material description of env.
+func (c *DecryptionClient) keyWrapper(env *Envelope) (KeyWrapper, error) {
+	matDesc := map[string]string{}
+	if env.MatDesc != "" {
+		if err := json.Unmarshal([]byte(env.MatDesc), &matDesc); err != nil {
+			return nil, apierr.New("InvalidEnvelope", "failed to decode material description", err)
+		}
+	}
+
+	for _, w := range c.wrappers {
+		if w.WrapAlgorithm() != env.WrapAlg {
+			continue
+		}
+		desc := w.MaterialDescription()
+		if desc == nil {
+			desc = map[string]string{}
+		}
+		if reflect.DeepEqual(desc, matDesc) {
+			return w, nil
+		}
+	}
+
+	msg := fmt.Sprintf("no key wrapper for %s with material description %s", env.WrapAlg, env.MatDesc)
+	return nil, apierr.New("UnknownKeyWrap", msg, nil)
+}
@@ -0,0 +1,151 @@
+package s3crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// EncryptionOptions keeps track of extra options to pass to an
+// EncryptionClient.
+type EncryptionOptions struct {
+	// The client to upload objects with. Leave this as nil to use the
+	// default S3 client.
+	S3 *s3.S3
+
+	// Where to store the Envelope of each object. Leave this as nil to store
+	// it in the object's metadata headers.
+	SaveStrategy SaveStrategy
+}
+
+// An EncryptionClient encrypts objects on the client before uploading them
+// to S3. Each object is encrypted with a new content key using AES-GCM, and
+// the content key is wrapped with the KeyWrapper's master key.
+//
+// Example:
+//
+//     key, err := s3crypto.NewLocalMasterKey(masterKey, map[string]string{"kid": "1"})
+//     client := s3crypto.NewEncryptionClient(key, nil)
+//     _, err = client.PutObject(&s3.PutObjectInput{
+//         Bucket: aws.String("bucket"),
+//         Key:    aws.String("key"),
+//         Body:   bytes.NewReader(data),
+//     })
+//
+// It is safe to use an EncryptionClient across concurrent goroutines.
+type EncryptionClient struct {
+	wrapper KeyWrapper
+	opts    EncryptionOptions
+}
+
+// NewEncryptionClient creates a new EncryptionClient wrapping content keys
+// with wrapper. Pass in an optional opts structure to customize the client.
+func NewEncryptionClient(wrapper KeyWrapper, opts *EncryptionOptions) *EncryptionClient {
+	c := &EncryptionClient{wrapper: wrapper}
+	if opts != nil {
+		c.opts = *opts
+	}
+	if c.opts.S3 == nil {
+		c.opts.S3 = s3.New(nil)
+	}
+	switch s := c.opts.SaveStrategy.(type) {
+	case nil:
+		c.opts.SaveStrategy = HeaderSaveStrategy{}
+	case InstructionFileSaveStrategy:
+		if s.S3 == nil {
+			// Upload instruction files with the client's S3 client
+			s.S3 = c.opts.S3
+			c.opts.SaveStrategy = s
+		}
+	case *InstructionFileSaveStrategy:
+		if s != nil && s.S3 == nil {
+			cp := *s
+			cp.S3 = c.opts.S3
+			c.opts.SaveStrategy = cp
+		}
+	}
+	return c
+}
+
+// PutObject encrypts input.Body and uploads it. The body is read into
+// memory and encrypted before the upload starts, and must not be longer
+// than MaxContentLength. input is not modified.
+func (c *EncryptionClient) PutObject(input *s3.PutObjectInput) (*s3.PutObjectOutput, error) {
+	if input.ContentLength != nil && *input.ContentLength > MaxContentLength {
+		return nil, errContentTooLarge()
+	}
+
+	var plaintext []byte
+	if input.Body != nil {
+		b, err := ioutil.ReadAll(io.LimitReader(input.Body, MaxContentLength+1))
+		if err != nil {
+			return nil, apierr.New("ReadRequestBody", "read upload data failed", err)
+		}
+		if int64(len(b)) > MaxContentLength {
+			return nil, errContentTooLarge()
+		}
+		plaintext = b
+	}
+
+	key := make([]byte, gcmKeySize)
+	iv := make([]byte, gcmNonceSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, apierr.New("EncryptionError", "failed to generate content key", err)
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, apierr.New("EncryptionError", "failed to generate IV", err)
+	}
+
+	ciphertext, err := gcmEncrypt(key, iv, plaintext)
+	if err != nil {
+		return nil, apierr.New("EncryptionError", "failed to encrypt content", err)
+	}
+
+	env, err := c.envelope(key, iv, len(plaintext))
+	if err != nil {
+		return nil, err
+	}
+
+	params := &s3.PutObjectInput{}
+	awsutil.Copy(params, input)
+	params.Body = bytes.NewReader(ciphertext)
+	params.ContentLength = aws.Long(int64(len(ciphertext)))
+
+	req, out := c.opts.S3.PutObjectRequest(params)
+	if err := c.opts.SaveStrategy.Save(env, req); err != nil {
+		return nil, err
+	}
+	return out, req.Send()
+}
+
+// envelope wraps the content key and builds the Envelope of an object.
+func (c *EncryptionClient) envelope(key, iv []byte, size int) (*Envelope, error) {
+	wrapped, err := c.wrapper.WrapKey(key, AESGCMContentCipher)
+	if err != nil {
+		return nil, err
+	}
+
+	matDesc, err := json.Marshal(c.wrapper.MaterialDescription())
+	if err != nil {
+		return nil, apierr.New("Marshal", "failed to encode material description", err)
+	}
+
+	return &Envelope{
+		CipherKey:                base64.StdEncoding.EncodeToString(wrapped),
+		IV:                       base64.StdEncoding.EncodeToString(iv),
+		MatDesc:                  string(matDesc),
+		WrapAlg:                  c.wrapper.WrapAlgorithm(),
+		CEKAlg:                   AESGCMContentCipher,
+		TagLen:                   strconv.Itoa(gcmTagSize * 8),
+		UnencryptedContentLength: strconv.Itoa(size),
+	}, nil
+}
@@ -0,0 +1,203 @@
+// Package s3crypto provides client-side envelope encryption for Amazon S3
+// objects.
+//
+// Each object is encrypted with its own randomly generated content key
+// using AES-GCM. The content key is then wrapped by a KeyWrapper, such as a
+// LocalMasterKey, and stored alongside the object in an Envelope, either in
+// the object's metadata headers or in a separate instruction file.
+//
+// AES-GCM can only authenticate an object once all of it has been read, so
+// objects are encrypted and decrypted in memory. Objects larger than
+// MaxContentLength are rejected.
+package s3crypto
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// The metadata keys the Envelope fields are stored under.
+const (
+	keyV2Header                    = "x-amz-key-v2"
+	ivHeader                       = "x-amz-iv"
+	matDescHeader                  = "x-amz-matdesc"
+	wrapAlgorithmHeader            = "x-amz-wrap-alg"
+	cekAlgorithmHeader             = "x-amz-cek-alg"
+	tagLengthHeader                = "x-amz-tag-len"
+	unencryptedContentLengthHeader = "x-amz-unencrypted-content-length"
+)
+
+// DefaultInstructionKeySuffix is appended to an object's key to form the
+// key of its instruction file.
+var DefaultInstructionKeySuffix = ".instruction"
+
+// MaxContentLength is the largest object, in bytes before encryption, that
+// an EncryptionClient uploads or a DecryptionClient downloads. Both clients
+// hold the whole object in memory.
+var MaxContentLength int64 = 64 * 1024 * 1024
+
+// An Envelope holds everything needed to decrypt an object except the
+// master key: the wrapped content key, the IV, and the algorithms used.
+type Envelope struct {
+	// The base64 encoded content key, wrapped by the key wrap algorithm.
+	CipherKey string `json:"x-amz-key-v2"`
+
+	// The base64 encoded initialization vector of the content cipher.
+	IV string `json:"x-amz-iv"`
+
+	// The JSON encoded material description of the master key.
+	MatDesc string `json:"x-amz-matdesc"`
+
+	// The algorithm used to wrap the content key.
+	WrapAlg string `json:"x-amz-wrap-alg"`
+
+	// The algorithm used to encrypt the content.
+	CEKAlg string `json:"x-amz-cek-alg"`
+
+	// The length in bits of the authentication tag.
+	TagLen string `json:"x-amz-tag-len"`
+
+	// The length in bytes of the object before it was encrypted.
+	UnencryptedContentLength string `json:"x-amz-unencrypted-content-length"`
+}
+
+// errContentTooLarge returns the error for objects over MaxContentLength.
+func errContentTooLarge() error {
+	msg := fmt.Sprintf("object exceeds the %d byte limit of client-side encryption", MaxContentLength)
+	return apierr.New("EntityTooLarge", msg, nil)
+}
+
+// metadata returns the envelope fields keyed by their metadata names.
+func (e *Envelope) metadata() map[string]string {
+	return map[string]string{
+		keyV2Header:                    e.CipherKey,
+		ivHeader:                       e.IV,
+		matDescHeader:                  e.MatDesc,
+		wrapAlgorithmHeader:            e.WrapAlg,
+		cekAlgorithmHeader:             e.CEKAlg,
+		tagLengthHeader:                e.TagLen,
+		unencryptedContentLengthHeader: e.UnencryptedContentLength,
+	}
+}
+
+// envelopeFromMetadata reads an Envelope from an object's metadata. It
+// returns nil if the metadata does not contain an envelope.
+func envelopeFromMetadata(meta map[string]*string) *Envelope {
+	get := func(name string) string {
+		// Metadata keys are returned in canonical header form.
+		for _, k := range []string{name, http.CanonicalHeaderKey(name)} {
+			if v, ok := meta[k]; ok && v != nil {
+				return *v
+			}
+		}
+		return ""
+	}
+
+	if get(keyV2Header) == "" {
+		return nil
+	}
+	return &Envelope{
+		CipherKey:                get(keyV2Header),
+		IV:                       get(ivHeader),
+		MatDesc:                  get(matDescHeader),
+		WrapAlg:                  get(wrapAlgorithmHeader),
+		CEKAlg:                   get(cekAlgorithmHeader),
+		TagLen:                   get(tagLengthHeader),
+		UnencryptedContentLength: get(unencryptedContentLengthHeader),
+	}
+}
+
+// A SaveStrategy stores the Envelope of an object being uploaded.
+type SaveStrategy interface {
+	// Save stores env for the object uploaded by req, the request of an
+	// s3.PutObjectInput. It is called before req is sent, and may add the
+	// envelope to the request or handlers to store it once the object has
+	// been uploaded.
+	Save(env *Envelope, req *aws.Request) error
+}
+
+// HeaderSaveStrategy stores the Envelope in the object's metadata headers.
+type HeaderSaveStrategy struct{}
+
+// Save adds the envelope fields to the metadata of the request's input.
+func (HeaderSaveStrategy) Save(env *Envelope, req *aws.Request) error {
+	input := req.Params.(*s3.PutObjectInput)
+	if input.Metadata == nil {
+		input.Metadata = map[string]*string{}
+	}
+	for k, v := range env.metadata() {
+		v := v
+		input.Metadata[k] = &v
+	}
+	return nil
+}
+
+// InstructionFileSaveStrategy stores the Envelope as JSON in a separate
+// instruction file object next to the encrypted object. Use it when the
+// metadata headers are needed for other purposes or would be too large.
+//
+// The instruction file is only uploaded once the object has been uploaded,
+// with the same expected bucket owner, SSE-C key and request payer as the
+// object. If the instruction file upload fails, the request fails with its
+// error.
+type InstructionFileSaveStrategy struct {
+	// The client to upload the instruction file with. Leave this as nil to
+	// use the S3 client of the EncryptionClient, or the default S3 client
+	// if the strategy is used on its own.
+	S3 *s3.S3
+
+	// The suffix appended to the object key to form the instruction file
+	// key. If empty, DefaultInstructionKeySuffix is used.
+	Suffix string
+}
+
+// Save adds a handler uploading the envelope to the instruction file of
+// the object once req has succeeded.
+func (s InstructionFileSaveStrategy) Save(env *Envelope, req *aws.Request) error {
+	b, err := json.Marshal(env)
+	if err != nil {
+		return apierr.New("Marshal", "failed to encode envelope", err)
+	}
+
+	suffix := s.Suffix
+	if suffix == "" {
+		suffix = DefaultInstructionKeySuffix
+	}
+
+	svc := s.S3
+	if svc == nil {
+		svc = s3.New(nil)
+	}
+
+	// Unmarshal handlers only run once the object has been uploaded.
+	req.Handlers.Unmarshal.PushBack(func(r *aws.Request) {
+		if r.Error != nil {
+			return
+		}
+
+		input := r.Params.(*s3.PutObjectInput)
+		key := *input.Key + suffix
+		_, r.Error = svc.PutObject(&s3.PutObjectInput{
+			Bucket:               input.Bucket,
+			Key:                  &key,
+			Body:                 bytes.NewReader(b),
+			ExpectedBucketOwner:  input.ExpectedBucketOwner,
+			RequestPayer:         input.RequestPayer,
+			SSECustomerAlgorithm: input.SSECustomerAlgorithm,
+			SSECustomerKey:       input.SSECustomerKey,
+			SSECustomerKeyMD5:    input.SSECustomerKeyMD5,
+		})
+		if r.Error != nil {
+			// The instruction file upload has been retried on its own, and
+			// retrying would upload the object again.
+			r.Retryable.Set(false)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,96 @@
+package s3crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// A KeyWrapper encrypts (wraps) and decrypts (unwraps) the content keys of
+// objects with a master key. Implementations may keep the master key
+// locally or delegate to a key management service.
+type KeyWrapper interface {
+	// WrapAlgorithm returns the name of the wrap algorithm, which is stored
+	// in the Envelope.
+	WrapAlgorithm() string
+
+	// MaterialDescription returns the description of the master key, which
+	// is stored in the Envelope and used to select the KeyWrapper when
+	// decrypting.
+	MaterialDescription() map[string]string
+
+	// WrapKey encrypts key. cekAlg is the content encryption algorithm the
+	// key is used with and must be bound to the wrapped key.
+	WrapKey(key []byte, cekAlg string) ([]byte, error)
+
+	// UnwrapKey decrypts a key previously wrapped with WrapKey.
+	UnwrapKey(wrapped []byte, cekAlg string) ([]byte, error)
+}
+
+// LocalMasterKeyWrapAlgorithm is the wrap algorithm of a LocalMasterKey.
+const LocalMasterKeyWrapAlgorithm = "AES/GCM"
+
+// A LocalMasterKey wraps content keys with a symmetric master key held in
+// memory, using AES-GCM. The content encryption algorithm is authenticated
+// along with the key.
+type LocalMasterKey struct {
+	aead    cipher.AEAD
+	matDesc map[string]string
+}
+
+// NewLocalMasterKey returns a LocalMasterKey using key, which must be 16, 24
+// or 32 bytes long. matDesc describes the key, for example with an ID, so
+// that the right key can be found to decrypt an object. It may be nil.
+func NewLocalMasterKey(key []byte, matDesc map[string]string) (*LocalMasterKey, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, apierr.New("ConfigError", "invalid master key", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, apierr.New("ConfigError", "invalid master key", err)
+	}
+
+	if matDesc == nil {
+		matDesc = map[string]string{}
+	}
+	return &LocalMasterKey{aead: aead, matDesc: matDesc}, nil
+}
+
+// WrapAlgorithm returns LocalMasterKeyWrapAlgorithm.
+func (k *LocalMasterKey) WrapAlgorithm() string {
+	return LocalMasterKeyWrapAlgorithm
+}
+
+// MaterialDescription returns the description the key was created with.
+func (k *LocalMasterKey) MaterialDescription() map[string]string {
+	return k.matDesc
+}
+
+// WrapKey encrypts key with the master key. The result is the nonce
+// followed by the sealed key.
+func (k *LocalMasterKey) WrapKey(key []byte, cekAlg string) ([]byte, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, apierr.New("EncryptionError", "failed to generate nonce", err)
+	}
+	return k.aead.Seal(nonce, nonce, key, []byte(cekAlg)), nil
+}
+
+// UnwrapKey decrypts a key wrapped by WrapKey.
+func (k *LocalMasterKey) UnwrapKey(wrapped []byte, cekAlg string) ([]byte, error) {
+	n := k.aead.NonceSize()
+	if len(wrapped) < n+k.aead.Overhead() {
+		msg := fmt.Sprintf("wrapped key is too short (%d bytes)", len(wrapped))
+		return nil, apierr.New("DecryptionError", msg, nil)
+	}
+
+	key, err := k.aead.Open(nil, wrapped[:n], wrapped[n:], []byte(cekAlg))
+	if err != nil {
+		return nil, apierr.New("DecryptionError", "failed to unwrap content key", err)
+	}
+	return key, nil
+}
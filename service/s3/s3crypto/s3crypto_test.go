@@ -0,0 +1,366 @@
+package s3crypto_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+type storedObject struct {
+	body []byte
+	meta map[string]*string
+}
+
+// memorySvc returns a client backed by an in-memory bucket. Metadata keys
+// are returned in canonical header form, as S3 does.
+func memorySvc() (*s3.S3, map[string]*storedObject) {
+	var m sync.Mutex
+	objects := map[string]*storedObject{}
+
+	svc := s3.New(nil)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch p := r.Params.(type) {
+		case *s3.PutObjectInput:
+			b, _ := ioutil.ReadAll(p.Body)
+			meta := map[string]*string{}
+			for k, v := range p.Metadata {
+				meta[http.CanonicalHeaderKey(k)] = v
+			}
+			objects[*p.Key] = &storedObject{body: b, meta: meta}
+		case *s3.GetObjectInput:
+			obj, ok := objects[*p.Key]
+			if !ok {
+				r.Error = apierr.New("NoSuchKey", "The specified key does not exist.", nil)
+				r.Retryable.Set(false)
+				return
+			}
+			out := r.Data.(*s3.GetObjectOutput)
+			out.Body = ioutil.NopCloser(bytes.NewReader(obj.body))
+			out.ContentLength = aws.Long(int64(len(obj.body)))
+			out.Metadata = obj.meta
+		}
+	})
+
+	return svc, objects
+}
+
+func masterKey(t *testing.T, id string) *s3crypto.LocalMasterKey {
+	k, err := s3crypto.NewLocalMasterKey(bytes.Repeat([]byte(id), 32), map[string]string{"kid": id})
+	assert.NoError(t, err)
+	return k
+}
+
+func TestEncryptDecryptHeaders(t *testing.T) {
+	svc, objects := memorySvc()
+	key := masterKey(t, "a")
+	plaintext := bytes.Repeat([]byte("secret data "), 1000)
+
+	enc := s3crypto.NewEncryptionClient(key, &s3crypto.EncryptionOptions{S3: svc})
+	input := &s3.PutObjectInput{
+		Bucket:   aws.String("bucket"),
+		Key:      aws.String("key"),
+		Body:     bytes.NewReader(plaintext),
+		Metadata: map[string]*string{"Foo": aws.String("bar")},
+	}
+	_, err := enc.PutObject(input)
+	assert.NoError(t, err)
+	assert.Len(t, input.Metadata, 1)
+
+	stored := objects["key"]
+	assert.Len(t, stored.body, len(plaintext)+16)
+	assert.NotContains(t, string(stored.body), "secret data")
+	assert.Equal(t, "bar", *stored.meta["Foo"])
+	assert.Equal(t, "AES/GCM/NoPadding", *stored.meta["X-Amz-Cek-Alg"])
+	assert.Equal(t, "AES/GCM", *stored.meta["X-Amz-Wrap-Alg"])
+	assert.Equal(t, `{"kid":"a"}`, *stored.meta["X-Amz-Matdesc"])
+
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{masterKey(t, "b"), key},
+		&s3crypto.DecryptionOptions{S3: svc})
+	out, err := dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(plaintext)), *out.ContentLength)
+
+	b, err := ioutil.ReadAll(out.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, plaintext, b)
+}
+
+func TestEncryptDecryptInstructionFile(t *testing.T) {
+	svc, objects := memorySvc()
+	key := masterKey(t, "a")
+
+	enc := s3crypto.NewEncryptionClient(key, &s3crypto.EncryptionOptions{
+		S3:           svc,
+		SaveStrategy: s3crypto.InstructionFileSaveStrategy{S3: svc},
+	})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello")),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, objects["key"].meta, 0)
+	assert.Contains(t, string(objects["key.instruction"].body), `"x-amz-key-v2"`)
+
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{key}, &s3crypto.DecryptionOptions{S3: svc})
+	out, err := dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.NoError(t, err)
+	b, err := ioutil.ReadAll(out.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(b))
+}
+
+func TestInstructionFileDefaultClient(t *testing.T) {
+	for _, strategy := range []s3crypto.SaveStrategy{
+		s3crypto.InstructionFileSaveStrategy{},
+		&s3crypto.InstructionFileSaveStrategy{},
+	} {
+		svc, objects := memorySvc()
+		enc := s3crypto.NewEncryptionClient(masterKey(t, "a"), &s3crypto.EncryptionOptions{
+			S3:           svc,
+			SaveStrategy: strategy,
+		})
+		_, err := enc.PutObject(&s3.PutObjectInput{
+			Bucket: aws.String("bucket"),
+			Key:    aws.String("key"),
+			Body:   bytes.NewReader([]byte("hello")),
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, string(objects["key.instruction"].body), `"x-amz-key-v2"`)
+	}
+}
+
+func TestDecryptUnknownKey(t *testing.T) {
+	svc, _ := memorySvc()
+	enc := s3crypto.NewEncryptionClient(masterKey(t, "a"), &s3crypto.EncryptionOptions{S3: svc})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello")),
+	})
+	assert.NoError(t, err)
+
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{masterKey(t, "b")},
+		&s3crypto.DecryptionOptions{S3: svc})
+	_, err = dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.Equal(t, "UnknownKeyWrap", err.(awserr.Error).Code())
+}
+
+func TestDecryptTampered(t *testing.T) {
+	svc, objects := memorySvc()
+	key := masterKey(t, "a")
+	enc := s3crypto.NewEncryptionClient(key, &s3crypto.EncryptionOptions{S3: svc})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello world")),
+	})
+	assert.NoError(t, err)
+	objects["key"].body[3] ^= 1
+
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{key}, &s3crypto.DecryptionOptions{S3: svc})
+	out, err := dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.Nil(t, out)
+	assert.Equal(t, "DecryptionError", err.(awserr.Error).Code())
+}
+
+func TestDecryptMissingEnvelope(t *testing.T) {
+	svc, _ := memorySvc()
+	svc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("plain"),
+		Body:   bytes.NewReader([]byte("hello")),
+	})
+
+	dec := s3crypto.NewDecryptionClient(nil, &s3crypto.DecryptionOptions{S3: svc})
+	_, err := dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("plain"),
+	})
+	assert.Equal(t, "InvalidEnvelope", err.(awserr.Error).Code())
+}
+
+func TestDecryptRangeUnsupported(t *testing.T) {
+	svc, _ := memorySvc()
+	dec := s3crypto.NewDecryptionClient(nil, &s3crypto.DecryptionOptions{S3: svc})
+	_, err := dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Range:  aws.String("bytes=0-10"),
+	})
+	assert.Equal(t, "ConfigError", err.(awserr.Error).Code())
+}
+
+func TestNewLocalMasterKeyInvalid(t *testing.T) {
+	_, err := s3crypto.NewLocalMasterKey([]byte("short"), nil)
+	assert.Equal(t, "ConfigError", err.(awserr.Error).Code())
+}
+
+func TestEncryptContentTooLarge(t *testing.T) {
+	defer func(n int64) { s3crypto.MaxContentLength = n }(s3crypto.MaxContentLength)
+	s3crypto.MaxContentLength = 10
+
+	svc, objects := memorySvc()
+	enc := s3crypto.NewEncryptionClient(masterKey(t, "a"), &s3crypto.EncryptionOptions{S3: svc})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello world")),
+	})
+	assert.Equal(t, "EntityTooLarge", err.(awserr.Error).Code())
+
+	_, err = enc.PutObject(&s3.PutObjectInput{
+		Bucket:        aws.String("bucket"),
+		Key:           aws.String("key"),
+		Body:          bytes.NewReader([]byte("hello")),
+		ContentLength: aws.Long(11),
+	})
+	assert.Equal(t, "EntityTooLarge", err.(awserr.Error).Code())
+	assert.Len(t, objects, 0)
+
+	_, err = enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("0123456789")),
+	})
+	assert.NoError(t, err)
+}
+
+func TestDecryptContentTooLarge(t *testing.T) {
+	defer func(n int64) { s3crypto.MaxContentLength = n }(s3crypto.MaxContentLength)
+
+	svc, _ := memorySvc()
+	key := masterKey(t, "a")
+	enc := s3crypto.NewEncryptionClient(key, &s3crypto.EncryptionOptions{S3: svc})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello world")),
+	})
+	assert.NoError(t, err)
+
+	s3crypto.MaxContentLength = 10
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{key}, &s3crypto.DecryptionOptions{S3: svc})
+	_, err = dec.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+	})
+	assert.Equal(t, "EntityTooLarge", err.(awserr.Error).Code())
+}
+
+func TestInstructionFileAfterObject(t *testing.T) {
+	svc, objects := memorySvc()
+	var inputs []interface{}
+	svc.Handlers.Send.PushFront(func(r *aws.Request) {
+		inputs = append(inputs, r.Params)
+	})
+	key := masterKey(t, "a")
+
+	enc := s3crypto.NewEncryptionClient(key, &s3crypto.EncryptionOptions{
+		S3:           svc,
+		SaveStrategy: s3crypto.InstructionFileSaveStrategy{},
+	})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		Body:                 bytes.NewReader([]byte("hello")),
+		ExpectedBucketOwner:  aws.String("123456789012"),
+		RequestPayer:         aws.String("requester"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String("customer key"),
+		SSECustomerKeyMD5:    aws.String("customer key md5"),
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, objects["key.instruction"])
+
+	dec := s3crypto.NewDecryptionClient([]s3crypto.KeyWrapper{key}, &s3crypto.DecryptionOptions{S3: svc})
+	_, err = dec.GetObject(&s3.GetObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		ExpectedBucketOwner:  aws.String("123456789012"),
+		RequestPayer:         aws.String("requester"),
+		SSECustomerAlgorithm: aws.String("AES256"),
+		SSECustomerKey:       aws.String("customer key"),
+		SSECustomerKeyMD5:    aws.String("customer key md5"),
+	})
+	assert.NoError(t, err)
+
+	keys := []string{"key", "key.instruction", "key", "key.instruction"}
+	assert.Len(t, inputs, len(keys))
+	for i, in := range inputs {
+		switch p := in.(type) {
+		case *s3.PutObjectInput:
+			assert.Equal(t, keys[i], *p.Key)
+			assert.Equal(t, "123456789012", *p.ExpectedBucketOwner)
+			assert.Equal(t, "requester", *p.RequestPayer)
+			assert.Equal(t, "AES256", *p.SSECustomerAlgorithm)
+			assert.Equal(t, "customer key", *p.SSECustomerKey)
+			assert.Equal(t, "customer key md5", *p.SSECustomerKeyMD5)
+		case *s3.GetObjectInput:
+			assert.Equal(t, keys[i], *p.Key)
+			assert.Equal(t, "123456789012", *p.ExpectedBucketOwner)
+			assert.Equal(t, "requester", *p.RequestPayer)
+			assert.Equal(t, "AES256", *p.SSECustomerAlgorithm)
+			assert.Equal(t, "customer key", *p.SSECustomerKey)
+			assert.Equal(t, "customer key md5", *p.SSECustomerKeyMD5)
+		}
+	}
+}
+
+func TestInstructionFileObjectFailed(t *testing.T) {
+	svc, objects := memorySvc()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		if p, ok := r.Params.(*s3.PutObjectInput); ok && *p.Key == "key" {
+			r.Error = apierr.New("AccessDenied", "Access Denied", nil)
+			r.Retryable.Set(false)
+		}
+	})
+
+	enc := s3crypto.NewEncryptionClient(masterKey(t, "a"), &s3crypto.EncryptionOptions{
+		S3:           svc,
+		SaveStrategy: s3crypto.InstructionFileSaveStrategy{},
+	})
+	_, err := enc.PutObject(&s3.PutObjectInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("key"),
+		Body:   bytes.NewReader([]byte("hello")),
+	})
+	assert.Equal(t, "AccessDenied", err.(awserr.Error).Code())
+	assert.Nil(t, objects["key.instruction"])
+}
@@ -4,116 +4,540 @@
 package s3iface
 
 import (
+	"net/url"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
 	"github.com/dongfangx/aws-sdk-go/service/s3"
 )
 
 // S3API is the interface type for s3.S3.
 type S3API interface {
+	AbortMultipartUploadRequest(*s3.AbortMultipartUploadInput) (*aws.Request, *s3.AbortMultipartUploadOutput)
+
 	AbortMultipartUpload(*s3.AbortMultipartUploadInput) (*s3.AbortMultipartUploadOutput, error)
 
+	AbortMultipartUploadPresignedUrl(*s3.AbortMultipartUploadInput, time.Duration) (*url.URL, error)
+
+	CompleteMultipartUploadRequest(*s3.CompleteMultipartUploadInput) (*aws.Request, *s3.CompleteMultipartUploadOutput)
+
 	CompleteMultipartUpload(*s3.CompleteMultipartUploadInput) (*s3.CompleteMultipartUploadOutput, error)
 
+	CompleteMultipartUploadPresignedUrl(*s3.CompleteMultipartUploadInput, time.Duration) (*url.URL, error)
+
+	CopyObjectRequest(*s3.CopyObjectInput) (*aws.Request, *s3.CopyObjectOutput)
+
 	CopyObject(*s3.CopyObjectInput) (*s3.CopyObjectOutput, error)
 
+	CopyObjectPresignedUrl(*s3.CopyObjectInput, time.Duration) (*url.URL, error)
+
+	CreateBucketRequest(*s3.CreateBucketInput) (*aws.Request, *s3.CreateBucketOutput)
+
 	CreateBucket(*s3.CreateBucketInput) (*s3.CreateBucketOutput, error)
 
+	CreateBucketPresignedUrl(*s3.CreateBucketInput, time.Duration) (*url.URL, error)
+
+	CreateMultipartUploadRequest(*s3.CreateMultipartUploadInput) (*aws.Request, *s3.CreateMultipartUploadOutput)
+
 	CreateMultipartUpload(*s3.CreateMultipartUploadInput) (*s3.CreateMultipartUploadOutput, error)
 
+	CreateMultipartUploadPresignedUrl(*s3.CreateMultipartUploadInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketRequest(*s3.DeleteBucketInput) (*aws.Request, *s3.DeleteBucketOutput)
+
 	DeleteBucket(*s3.DeleteBucketInput) (*s3.DeleteBucketOutput, error)
 
+	DeleteBucketPresignedUrl(*s3.DeleteBucketInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketAnalyticsConfigurationRequest(*s3.DeleteBucketAnalyticsConfigurationInput) (*aws.Request, *s3.DeleteBucketAnalyticsConfigurationOutput)
+
+	DeleteBucketAnalyticsConfiguration(*s3.DeleteBucketAnalyticsConfigurationInput) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	DeleteBucketAnalyticsConfigurationPresignedUrl(*s3.DeleteBucketAnalyticsConfigurationInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketCORSRequest(*s3.DeleteBucketCORSInput) (*aws.Request, *s3.DeleteBucketCORSOutput)
+
 	DeleteBucketCORS(*s3.DeleteBucketCORSInput) (*s3.DeleteBucketCORSOutput, error)
 
+	DeleteBucketCORSPresignedUrl(*s3.DeleteBucketCORSInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketEncryptionRequest(*s3.DeleteBucketEncryptionInput) (*aws.Request, *s3.DeleteBucketEncryptionOutput)
+
+	DeleteBucketEncryption(*s3.DeleteBucketEncryptionInput) (*s3.DeleteBucketEncryptionOutput, error)
+
+	DeleteBucketEncryptionPresignedUrl(*s3.DeleteBucketEncryptionInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketInventoryConfigurationRequest(*s3.DeleteBucketInventoryConfigurationInput) (*aws.Request, *s3.DeleteBucketInventoryConfigurationOutput)
+
+	DeleteBucketInventoryConfiguration(*s3.DeleteBucketInventoryConfigurationInput) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	DeleteBucketInventoryConfigurationPresignedUrl(*s3.DeleteBucketInventoryConfigurationInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketLifecycleRequest(*s3.DeleteBucketLifecycleInput) (*aws.Request, *s3.DeleteBucketLifecycleOutput)
+
 	DeleteBucketLifecycle(*s3.DeleteBucketLifecycleInput) (*s3.DeleteBucketLifecycleOutput, error)
 
+	DeleteBucketLifecyclePresignedUrl(*s3.DeleteBucketLifecycleInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketMetricsConfigurationRequest(*s3.DeleteBucketMetricsConfigurationInput) (*aws.Request, *s3.DeleteBucketMetricsConfigurationOutput)
+
+	DeleteBucketMetricsConfiguration(*s3.DeleteBucketMetricsConfigurationInput) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+
+	DeleteBucketMetricsConfigurationPresignedUrl(*s3.DeleteBucketMetricsConfigurationInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketPolicyRequest(*s3.DeleteBucketPolicyInput) (*aws.Request, *s3.DeleteBucketPolicyOutput)
+
 	DeleteBucketPolicy(*s3.DeleteBucketPolicyInput) (*s3.DeleteBucketPolicyOutput, error)
 
+	DeleteBucketPolicyPresignedUrl(*s3.DeleteBucketPolicyInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketReplicationRequest(*s3.DeleteBucketReplicationInput) (*aws.Request, *s3.DeleteBucketReplicationOutput)
+
 	DeleteBucketReplication(*s3.DeleteBucketReplicationInput) (*s3.DeleteBucketReplicationOutput, error)
 
+	DeleteBucketReplicationPresignedUrl(*s3.DeleteBucketReplicationInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketTaggingRequest(*s3.DeleteBucketTaggingInput) (*aws.Request, *s3.DeleteBucketTaggingOutput)
+
 	DeleteBucketTagging(*s3.DeleteBucketTaggingInput) (*s3.DeleteBucketTaggingOutput, error)
 
+	DeleteBucketTaggingPresignedUrl(*s3.DeleteBucketTaggingInput, time.Duration) (*url.URL, error)
+
+	DeleteBucketWebsiteRequest(*s3.DeleteBucketWebsiteInput) (*aws.Request, *s3.DeleteBucketWebsiteOutput)
+
 	DeleteBucketWebsite(*s3.DeleteBucketWebsiteInput) (*s3.DeleteBucketWebsiteOutput, error)
 
+	DeleteBucketWebsitePresignedUrl(*s3.DeleteBucketWebsiteInput, time.Duration) (*url.URL, error)
+
+	DeleteObjectRequest(*s3.DeleteObjectInput) (*aws.Request, *s3.DeleteObjectOutput)
+
 	DeleteObject(*s3.DeleteObjectInput) (*s3.DeleteObjectOutput, error)
 
+	DeleteObjectPresignedUrl(*s3.DeleteObjectInput, time.Duration) (*url.URL, error)
+
+	DeleteObjectTaggingRequest(*s3.DeleteObjectTaggingInput) (*aws.Request, *s3.DeleteObjectTaggingOutput)
+
+	DeleteObjectTagging(*s3.DeleteObjectTaggingInput) (*s3.DeleteObjectTaggingOutput, error)
+
+	DeleteObjectTaggingPresignedUrl(*s3.DeleteObjectTaggingInput, time.Duration) (*url.URL, error)
+
+	DeleteObjectsRequest(*s3.DeleteObjectsInput) (*aws.Request, *s3.DeleteObjectsOutput)
+
 	DeleteObjects(*s3.DeleteObjectsInput) (*s3.DeleteObjectsOutput, error)
 
+	DeleteObjectsPresignedUrl(*s3.DeleteObjectsInput, time.Duration) (*url.URL, error)
+
+	DeletePublicAccessBlockRequest(*s3.DeletePublicAccessBlockInput) (*aws.Request, *s3.DeletePublicAccessBlockOutput)
+
+	DeletePublicAccessBlock(*s3.DeletePublicAccessBlockInput) (*s3.DeletePublicAccessBlockOutput, error)
+
+	DeletePublicAccessBlockPresignedUrl(*s3.DeletePublicAccessBlockInput, time.Duration) (*url.URL, error)
+
+	GetBucketACLRequest(*s3.GetBucketACLInput) (*aws.Request, *s3.GetBucketACLOutput)
+
 	GetBucketACL(*s3.GetBucketACLInput) (*s3.GetBucketACLOutput, error)
 
+	GetBucketACLPresignedUrl(*s3.GetBucketACLInput, time.Duration) (*url.URL, error)
+
+	GetBucketAnalyticsConfigurationRequest(*s3.GetBucketAnalyticsConfigurationInput) (*aws.Request, *s3.GetBucketAnalyticsConfigurationOutput)
+
+	GetBucketAnalyticsConfiguration(*s3.GetBucketAnalyticsConfigurationInput) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+
+	GetBucketAnalyticsConfigurationPresignedUrl(*s3.GetBucketAnalyticsConfigurationInput, time.Duration) (*url.URL, error)
+
+	GetBucketCORSRequest(*s3.GetBucketCORSInput) (*aws.Request, *s3.GetBucketCORSOutput)
+
 	GetBucketCORS(*s3.GetBucketCORSInput) (*s3.GetBucketCORSOutput, error)
 
+	GetBucketCORSPresignedUrl(*s3.GetBucketCORSInput, time.Duration) (*url.URL, error)
+
+	GetBucketEncryptionRequest(*s3.GetBucketEncryptionInput) (*aws.Request, *s3.GetBucketEncryptionOutput)
+
+	GetBucketEncryption(*s3.GetBucketEncryptionInput) (*s3.GetBucketEncryptionOutput, error)
+
+	GetBucketEncryptionPresignedUrl(*s3.GetBucketEncryptionInput, time.Duration) (*url.URL, error)
+
+	GetBucketInventoryConfigurationRequest(*s3.GetBucketInventoryConfigurationInput) (*aws.Request, *s3.GetBucketInventoryConfigurationOutput)
+
+	GetBucketInventoryConfiguration(*s3.GetBucketInventoryConfigurationInput) (*s3.GetBucketInventoryConfigurationOutput, error)
+
+	GetBucketInventoryConfigurationPresignedUrl(*s3.GetBucketInventoryConfigurationInput, time.Duration) (*url.URL, error)
+
+	GetBucketLifecycleRequest(*s3.GetBucketLifecycleInput) (*aws.Request, *s3.GetBucketLifecycleOutput)
+
 	GetBucketLifecycle(*s3.GetBucketLifecycleInput) (*s3.GetBucketLifecycleOutput, error)
 
+	GetBucketLifecyclePresignedUrl(*s3.GetBucketLifecycleInput, time.Duration) (*url.URL, error)
+
+	GetBucketLifecycleConfigurationRequest(*s3.GetBucketLifecycleConfigurationInput) (*aws.Request, *s3.GetBucketLifecycleConfigurationOutput)
+
+	GetBucketLifecycleConfiguration(*s3.GetBucketLifecycleConfigurationInput) (*s3.GetBucketLifecycleConfigurationOutput, error)
+
+	GetBucketLifecycleConfigurationPresignedUrl(*s3.GetBucketLifecycleConfigurationInput, time.Duration) (*url.URL, error)
+
+	GetBucketLocationRequest(*s3.GetBucketLocationInput) (*aws.Request, *s3.GetBucketLocationOutput)
+
 	GetBucketLocation(*s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error)
 
+	GetBucketLocationPresignedUrl(*s3.GetBucketLocationInput, time.Duration) (*url.URL, error)
+
+	GetBucketLoggingRequest(*s3.GetBucketLoggingInput) (*aws.Request, *s3.GetBucketLoggingOutput)
+
 	GetBucketLogging(*s3.GetBucketLoggingInput) (*s3.GetBucketLoggingOutput, error)
 
+	GetBucketLoggingPresignedUrl(*s3.GetBucketLoggingInput, time.Duration) (*url.URL, error)
+
+	GetBucketMetricsConfigurationRequest(*s3.GetBucketMetricsConfigurationInput) (*aws.Request, *s3.GetBucketMetricsConfigurationOutput)
+
+	GetBucketMetricsConfiguration(*s3.GetBucketMetricsConfigurationInput) (*s3.GetBucketMetricsConfigurationOutput, error)
+
+	GetBucketMetricsConfigurationPresignedUrl(*s3.GetBucketMetricsConfigurationInput, time.Duration) (*url.URL, error)
+
+	GetBucketNotificationRequest(*s3.GetBucketNotificationConfigurationRequest) (*aws.Request, *s3.NotificationConfigurationDeprecated)
+
 	GetBucketNotification(*s3.GetBucketNotificationConfigurationRequest) (*s3.NotificationConfigurationDeprecated, error)
 
+	GetBucketNotificationPresignedUrl(*s3.GetBucketNotificationConfigurationRequest, time.Duration) (*url.URL, error)
+
+	GetBucketNotificationConfigurationRequest(*s3.GetBucketNotificationConfigurationRequest) (*aws.Request, *s3.NotificationConfiguration)
+
 	GetBucketNotificationConfiguration(*s3.GetBucketNotificationConfigurationRequest) (*s3.NotificationConfiguration, error)
 
+	GetBucketNotificationConfigurationPresignedUrl(*s3.GetBucketNotificationConfigurationRequest, time.Duration) (*url.URL, error)
+
+	GetBucketPolicyRequest(*s3.GetBucketPolicyInput) (*aws.Request, *s3.GetBucketPolicyOutput)
+
 	GetBucketPolicy(*s3.GetBucketPolicyInput) (*s3.GetBucketPolicyOutput, error)
 
+	GetBucketPolicyPresignedUrl(*s3.GetBucketPolicyInput, time.Duration) (*url.URL, error)
+
+	GetBucketReplicationRequest(*s3.GetBucketReplicationInput) (*aws.Request, *s3.GetBucketReplicationOutput)
+
 	GetBucketReplication(*s3.GetBucketReplicationInput) (*s3.GetBucketReplicationOutput, error)
 
+	GetBucketReplicationPresignedUrl(*s3.GetBucketReplicationInput, time.Duration) (*url.URL, error)
+
+	GetBucketRequestPaymentRequest(*s3.GetBucketRequestPaymentInput) (*aws.Request, *s3.GetBucketRequestPaymentOutput)
+
 	GetBucketRequestPayment(*s3.GetBucketRequestPaymentInput) (*s3.GetBucketRequestPaymentOutput, error)
 
+	GetBucketRequestPaymentPresignedUrl(*s3.GetBucketRequestPaymentInput, time.Duration) (*url.URL, error)
+
+	GetBucketTaggingRequest(*s3.GetBucketTaggingInput) (*aws.Request, *s3.GetBucketTaggingOutput)
+
 	GetBucketTagging(*s3.GetBucketTaggingInput) (*s3.GetBucketTaggingOutput, error)
 
+	GetBucketTaggingPresignedUrl(*s3.GetBucketTaggingInput, time.Duration) (*url.URL, error)
+
+	GetBucketVersioningRequest(*s3.GetBucketVersioningInput) (*aws.Request, *s3.GetBucketVersioningOutput)
+
 	GetBucketVersioning(*s3.GetBucketVersioningInput) (*s3.GetBucketVersioningOutput, error)
 
+	GetBucketVersioningPresignedUrl(*s3.GetBucketVersioningInput, time.Duration) (*url.URL, error)
+
+	GetBucketWebsiteRequest(*s3.GetBucketWebsiteInput) (*aws.Request, *s3.GetBucketWebsiteOutput)
+
 	GetBucketWebsite(*s3.GetBucketWebsiteInput) (*s3.GetBucketWebsiteOutput, error)
 
+	GetBucketWebsitePresignedUrl(*s3.GetBucketWebsiteInput, time.Duration) (*url.URL, error)
+
+	GetObjectRequest(*s3.GetObjectInput) (*aws.Request, *s3.GetObjectOutput)
+
 	GetObject(*s3.GetObjectInput) (*s3.GetObjectOutput, error)
 
+	GetObjectPresignedUrl(*s3.GetObjectInput, time.Duration) (*url.URL, error)
+
+	GetObjectACLRequest(*s3.GetObjectACLInput) (*aws.Request, *s3.GetObjectACLOutput)
+
 	GetObjectACL(*s3.GetObjectACLInput) (*s3.GetObjectACLOutput, error)
 
+	GetObjectACLPresignedUrl(*s3.GetObjectACLInput, time.Duration) (*url.URL, error)
+
+	GetObjectLegalHoldRequest(*s3.GetObjectLegalHoldInput) (*aws.Request, *s3.GetObjectLegalHoldOutput)
+
+	GetObjectLegalHold(*s3.GetObjectLegalHoldInput) (*s3.GetObjectLegalHoldOutput, error)
+
+	GetObjectLegalHoldPresignedUrl(*s3.GetObjectLegalHoldInput, time.Duration) (*url.URL, error)
+
+	GetObjectLockConfigurationRequest(*s3.GetObjectLockConfigurationInput) (*aws.Request, *s3.GetObjectLockConfigurationOutput)
+
+	GetObjectLockConfiguration(*s3.GetObjectLockConfigurationInput) (*s3.GetObjectLockConfigurationOutput, error)
+
+	GetObjectLockConfigurationPresignedUrl(*s3.GetObjectLockConfigurationInput, time.Duration) (*url.URL, error)
+
+	GetObjectRetentionRequest(*s3.GetObjectRetentionInput) (*aws.Request, *s3.GetObjectRetentionOutput)
+
+	GetObjectRetention(*s3.GetObjectRetentionInput) (*s3.GetObjectRetentionOutput, error)
+
+	GetObjectRetentionPresignedUrl(*s3.GetObjectRetentionInput, time.Duration) (*url.URL, error)
+
+	GetObjectTaggingRequest(*s3.GetObjectTaggingInput) (*aws.Request, *s3.GetObjectTaggingOutput)
+
+	GetObjectTagging(*s3.GetObjectTaggingInput) (*s3.GetObjectTaggingOutput, error)
+
+	GetObjectTaggingPresignedUrl(*s3.GetObjectTaggingInput, time.Duration) (*url.URL, error)
+
+	GetObjectTorrentRequest(*s3.GetObjectTorrentInput) (*aws.Request, *s3.GetObjectTorrentOutput)
+
 	GetObjectTorrent(*s3.GetObjectTorrentInput) (*s3.GetObjectTorrentOutput, error)
 
+	GetObjectTorrentPresignedUrl(*s3.GetObjectTorrentInput, time.Duration) (*url.URL, error)
+
+	GetPublicAccessBlockRequest(*s3.GetPublicAccessBlockInput) (*aws.Request, *s3.GetPublicAccessBlockOutput)
+
+	GetPublicAccessBlock(*s3.GetPublicAccessBlockInput) (*s3.GetPublicAccessBlockOutput, error)
+
+	GetPublicAccessBlockPresignedUrl(*s3.GetPublicAccessBlockInput, time.Duration) (*url.URL, error)
+
+	HeadBucketRequest(*s3.HeadBucketInput) (*aws.Request, *s3.HeadBucketOutput)
+
 	HeadBucket(*s3.HeadBucketInput) (*s3.HeadBucketOutput, error)
 
+	HeadBucketPresignedUrl(*s3.HeadBucketInput, time.Duration) (*url.URL, error)
+
+	HeadObjectRequest(*s3.HeadObjectInput) (*aws.Request, *s3.HeadObjectOutput)
+
 	HeadObject(*s3.HeadObjectInput) (*s3.HeadObjectOutput, error)
 
+	HeadObjectPresignedUrl(*s3.HeadObjectInput, time.Duration) (*url.URL, error)
+
+	ListBucketAnalyticsConfigurationsRequest(*s3.ListBucketAnalyticsConfigurationsInput) (*aws.Request, *s3.ListBucketAnalyticsConfigurationsOutput)
+
+	ListBucketAnalyticsConfigurations(*s3.ListBucketAnalyticsConfigurationsInput) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+
+	ListBucketAnalyticsConfigurationsPresignedUrl(*s3.ListBucketAnalyticsConfigurationsInput, time.Duration) (*url.URL, error)
+
+	ListBucketInventoryConfigurationsRequest(*s3.ListBucketInventoryConfigurationsInput) (*aws.Request, *s3.ListBucketInventoryConfigurationsOutput)
+
+	ListBucketInventoryConfigurations(*s3.ListBucketInventoryConfigurationsInput) (*s3.ListBucketInventoryConfigurationsOutput, error)
+
+	ListBucketInventoryConfigurationsPresignedUrl(*s3.ListBucketInventoryConfigurationsInput, time.Duration) (*url.URL, error)
+
+	ListBucketMetricsConfigurationsRequest(*s3.ListBucketMetricsConfigurationsInput) (*aws.Request, *s3.ListBucketMetricsConfigurationsOutput)
+
+	ListBucketMetricsConfigurations(*s3.ListBucketMetricsConfigurationsInput) (*s3.ListBucketMetricsConfigurationsOutput, error)
+
+	ListBucketMetricsConfigurationsPresignedUrl(*s3.ListBucketMetricsConfigurationsInput, time.Duration) (*url.URL, error)
+
+	ListBucketsRequest(*s3.ListBucketsInput) (*aws.Request, *s3.ListBucketsOutput)
+
 	ListBuckets(*s3.ListBucketsInput) (*s3.ListBucketsOutput, error)
 
+	ListBucketsPresignedUrl(*s3.ListBucketsInput, time.Duration) (*url.URL, error)
+
+	ListMultipartUploadsRequest(*s3.ListMultipartUploadsInput) (*aws.Request, *s3.ListMultipartUploadsOutput)
+
 	ListMultipartUploads(*s3.ListMultipartUploadsInput) (*s3.ListMultipartUploadsOutput, error)
 
+	ListMultipartUploadsPresignedUrl(*s3.ListMultipartUploadsInput, time.Duration) (*url.URL, error)
+
+	ListMultipartUploadsPages(*s3.ListMultipartUploadsInput, func(*s3.ListMultipartUploadsOutput, bool) bool) error
+
+	ListObjectVersionsRequest(*s3.ListObjectVersionsInput) (*aws.Request, *s3.ListObjectVersionsOutput)
+
 	ListObjectVersions(*s3.ListObjectVersionsInput) (*s3.ListObjectVersionsOutput, error)
 
+	ListObjectVersionsPresignedUrl(*s3.ListObjectVersionsInput, time.Duration) (*url.URL, error)
+
+	ListObjectVersionsPages(*s3.ListObjectVersionsInput, func(*s3.ListObjectVersionsOutput, bool) bool) error
+
+	ListObjectsRequest(*s3.ListObjectsInput) (*aws.Request, *s3.ListObjectsOutput)
+
 	ListObjects(*s3.ListObjectsInput) (*s3.ListObjectsOutput, error)
 
+	ListObjectsPresignedUrl(*s3.ListObjectsInput, time.Duration) (*url.URL, error)
+
+	ListObjectsPages(*s3.ListObjectsInput, func(*s3.ListObjectsOutput, bool) bool) error
+
+	ListObjectsV2Request(*s3.ListObjectsV2Input) (*aws.Request, *s3.ListObjectsV2Output)
+
+	ListObjectsV2(*s3.ListObjectsV2Input) (*s3.ListObjectsV2Output, error)
+
+	ListObjectsV2PresignedUrl(*s3.ListObjectsV2Input, time.Duration) (*url.URL, error)
+
+	ListObjectsV2Pages(*s3.ListObjectsV2Input, func(*s3.ListObjectsV2Output, bool) bool) error
+
+	ListPartsRequest(*s3.ListPartsInput) (*aws.Request, *s3.ListPartsOutput)
+
 	ListParts(*s3.ListPartsInput) (*s3.ListPartsOutput, error)
 
+	ListPartsPresignedUrl(*s3.ListPartsInput, time.Duration) (*url.URL, error)
+
+	ListPartsPages(*s3.ListPartsInput, func(*s3.ListPartsOutput, bool) bool) error
+
+	PutBucketACLRequest(*s3.PutBucketACLInput) (*aws.Request, *s3.PutBucketACLOutput)
+
 	PutBucketACL(*s3.PutBucketACLInput) (*s3.PutBucketACLOutput, error)
 
+	PutBucketACLPresignedUrl(*s3.PutBucketACLInput, time.Duration) (*url.URL, error)
+
+	PutBucketAnalyticsConfigurationRequest(*s3.PutBucketAnalyticsConfigurationInput) (*aws.Request, *s3.PutBucketAnalyticsConfigurationOutput)
+
+	PutBucketAnalyticsConfiguration(*s3.PutBucketAnalyticsConfigurationInput) (*s3.PutBucketAnalyticsConfigurationOutput, error)
+
+	PutBucketAnalyticsConfigurationPresignedUrl(*s3.PutBucketAnalyticsConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutBucketCORSRequest(*s3.PutBucketCORSInput) (*aws.Request, *s3.PutBucketCORSOutput)
+
 	PutBucketCORS(*s3.PutBucketCORSInput) (*s3.PutBucketCORSOutput, error)
 
+	PutBucketCORSPresignedUrl(*s3.PutBucketCORSInput, time.Duration) (*url.URL, error)
+
+	PutBucketEncryptionRequest(*s3.PutBucketEncryptionInput) (*aws.Request, *s3.PutBucketEncryptionOutput)
+
+	PutBucketEncryption(*s3.PutBucketEncryptionInput) (*s3.PutBucketEncryptionOutput, error)
+
+	PutBucketEncryptionPresignedUrl(*s3.PutBucketEncryptionInput, time.Duration) (*url.URL, error)
+
+	PutBucketInventoryConfigurationRequest(*s3.PutBucketInventoryConfigurationInput) (*aws.Request, *s3.PutBucketInventoryConfigurationOutput)
+
+	PutBucketInventoryConfiguration(*s3.PutBucketInventoryConfigurationInput) (*s3.PutBucketInventoryConfigurationOutput, error)
+
+	PutBucketInventoryConfigurationPresignedUrl(*s3.PutBucketInventoryConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutBucketLifecycleRequest(*s3.PutBucketLifecycleInput) (*aws.Request, *s3.PutBucketLifecycleOutput)
+
 	PutBucketLifecycle(*s3.PutBucketLifecycleInput) (*s3.PutBucketLifecycleOutput, error)
 
+	PutBucketLifecyclePresignedUrl(*s3.PutBucketLifecycleInput, time.Duration) (*url.URL, error)
+
+	PutBucketLifecycleConfigurationRequest(*s3.PutBucketLifecycleConfigurationInput) (*aws.Request, *s3.PutBucketLifecycleConfigurationOutput)
+
+	PutBucketLifecycleConfiguration(*s3.PutBucketLifecycleConfigurationInput) (*s3.PutBucketLifecycleConfigurationOutput, error)
+
+	PutBucketLifecycleConfigurationPresignedUrl(*s3.PutBucketLifecycleConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutBucketLoggingRequest(*s3.PutBucketLoggingInput) (*aws.Request, *s3.PutBucketLoggingOutput)
+
 	PutBucketLogging(*s3.PutBucketLoggingInput) (*s3.PutBucketLoggingOutput, error)
 
+	PutBucketLoggingPresignedUrl(*s3.PutBucketLoggingInput, time.Duration) (*url.URL, error)
+
+	PutBucketMetricsConfigurationRequest(*s3.PutBucketMetricsConfigurationInput) (*aws.Request, *s3.PutBucketMetricsConfigurationOutput)
+
+	PutBucketMetricsConfiguration(*s3.PutBucketMetricsConfigurationInput) (*s3.PutBucketMetricsConfigurationOutput, error)
+
+	PutBucketMetricsConfigurationPresignedUrl(*s3.PutBucketMetricsConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutBucketNotificationRequest(*s3.PutBucketNotificationInput) (*aws.Request, *s3.PutBucketNotificationOutput)
+
 	PutBucketNotification(*s3.PutBucketNotificationInput) (*s3.PutBucketNotificationOutput, error)
 
+	PutBucketNotificationPresignedUrl(*s3.PutBucketNotificationInput, time.Duration) (*url.URL, error)
+
+	PutBucketNotificationConfigurationRequest(*s3.PutBucketNotificationConfigurationInput) (*aws.Request, *s3.PutBucketNotificationConfigurationOutput)
+
 	PutBucketNotificationConfiguration(*s3.PutBucketNotificationConfigurationInput) (*s3.PutBucketNotificationConfigurationOutput, error)
 
+	PutBucketNotificationConfigurationPresignedUrl(*s3.PutBucketNotificationConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutBucketPolicyRequest(*s3.PutBucketPolicyInput) (*aws.Request, *s3.PutBucketPolicyOutput)
+
 	PutBucketPolicy(*s3.PutBucketPolicyInput) (*s3.PutBucketPolicyOutput, error)
 
+	PutBucketPolicyPresignedUrl(*s3.PutBucketPolicyInput, time.Duration) (*url.URL, error)
+
+	PutBucketReplicationRequest(*s3.PutBucketReplicationInput) (*aws.Request, *s3.PutBucketReplicationOutput)
+
 	PutBucketReplication(*s3.PutBucketReplicationInput) (*s3.PutBucketReplicationOutput, error)
 
+	PutBucketReplicationPresignedUrl(*s3.PutBucketReplicationInput, time.Duration) (*url.URL, error)
+
+	PutBucketRequestPaymentRequest(*s3.PutBucketRequestPaymentInput) (*aws.Request, *s3.PutBucketRequestPaymentOutput)
+
 	PutBucketRequestPayment(*s3.PutBucketRequestPaymentInput) (*s3.PutBucketRequestPaymentOutput, error)
 
+	PutBucketRequestPaymentPresignedUrl(*s3.PutBucketRequestPaymentInput, time.Duration) (*url.URL, error)
+
+	PutBucketTaggingRequest(*s3.PutBucketTaggingInput) (*aws.Request, *s3.PutBucketTaggingOutput)
+
 	PutBucketTagging(*s3.PutBucketTaggingInput) (*s3.PutBucketTaggingOutput, error)
 
+	PutBucketTaggingPresignedUrl(*s3.PutBucketTaggingInput, time.Duration) (*url.URL, error)
+
+	PutBucketVersioningRequest(*s3.PutBucketVersioningInput) (*aws.Request, *s3.PutBucketVersioningOutput)
+
 	PutBucketVersioning(*s3.PutBucketVersioningInput) (*s3.PutBucketVersioningOutput, error)
 
+	PutBucketVersioningPresignedUrl(*s3.PutBucketVersioningInput, time.Duration) (*url.URL, error)
+
+	PutBucketWebsiteRequest(*s3.PutBucketWebsiteInput) (*aws.Request, *s3.PutBucketWebsiteOutput)
+
 	PutBucketWebsite(*s3.PutBucketWebsiteInput) (*s3.PutBucketWebsiteOutput, error)
 
+	PutBucketWebsitePresignedUrl(*s3.PutBucketWebsiteInput, time.Duration) (*url.URL, error)
+
+	PutObjectRequest(*s3.PutObjectInput) (*aws.Request, *s3.PutObjectOutput)
+
 	PutObject(*s3.PutObjectInput) (*s3.PutObjectOutput, error)
 
+	PutObjectPresignedUrl(*s3.PutObjectInput, time.Duration) (*url.URL, error)
+
+	PutObjectACLRequest(*s3.PutObjectACLInput) (*aws.Request, *s3.PutObjectACLOutput)
+
 	PutObjectACL(*s3.PutObjectACLInput) (*s3.PutObjectACLOutput, error)
 
+	PutObjectACLPresignedUrl(*s3.PutObjectACLInput, time.Duration) (*url.URL, error)
+
+	PutObjectLegalHoldRequest(*s3.PutObjectLegalHoldInput) (*aws.Request, *s3.PutObjectLegalHoldOutput)
+
+	PutObjectLegalHold(*s3.PutObjectLegalHoldInput) (*s3.PutObjectLegalHoldOutput, error)
+
+	PutObjectLegalHoldPresignedUrl(*s3.PutObjectLegalHoldInput, time.Duration) (*url.URL, error)
+
+	PutObjectLockConfigurationRequest(*s3.PutObjectLockConfigurationInput) (*aws.Request, *s3.PutObjectLockConfigurationOutput)
+
+	PutObjectLockConfiguration(*s3.PutObjectLockConfigurationInput) (*s3.PutObjectLockConfigurationOutput, error)
+
+	PutObjectLockConfigurationPresignedUrl(*s3.PutObjectLockConfigurationInput, time.Duration) (*url.URL, error)
+
+	PutObjectRetentionRequest(*s3.PutObjectRetentionInput) (*aws.Request, *s3.PutObjectRetentionOutput)
+
+	PutObjectRetention(*s3.PutObjectRetentionInput) (*s3.PutObjectRetentionOutput, error)
+
+	PutObjectRetentionPresignedUrl(*s3.PutObjectRetentionInput, time.Duration) (*url.URL, error)
+
+	PutObjectTaggingRequest(*s3.PutObjectTaggingInput) (*aws.Request, *s3.PutObjectTaggingOutput)
+
+	PutObjectTagging(*s3.PutObjectTaggingInput) (*s3.PutObjectTaggingOutput, error)
+
+	PutObjectTaggingPresignedUrl(*s3.PutObjectTaggingInput, time.Duration) (*url.URL, error)
+
+	PutPublicAccessBlockRequest(*s3.PutPublicAccessBlockInput) (*aws.Request, *s3.PutPublicAccessBlockOutput)
+
+	PutPublicAccessBlock(*s3.PutPublicAccessBlockInput) (*s3.PutPublicAccessBlockOutput, error)
+
+	PutPublicAccessBlockPresignedUrl(*s3.PutPublicAccessBlockInput, time.Duration) (*url.URL, error)
+
+	RestoreObjectRequest(*s3.RestoreObjectInput) (*aws.Request, *s3.RestoreObjectOutput)
+
 	RestoreObject(*s3.RestoreObjectInput) (*s3.RestoreObjectOutput, error)
 
+	RestoreObjectPresignedUrl(*s3.RestoreObjectInput, time.Duration) (*url.URL, error)
+
+	SelectObjectContentRequest(*s3.SelectObjectContentInput) (*aws.Request, *s3.SelectObjectContentOutput)
+
+	SelectObjectContent(*s3.SelectObjectContentInput) (*s3.SelectObjectContentOutput, error)
+
+	SelectObjectContentPresignedUrl(*s3.SelectObjectContentInput, time.Duration) (*url.URL, error)
+
+	UploadPartRequest(*s3.UploadPartInput) (*aws.Request, *s3.UploadPartOutput)
+
 	UploadPart(*s3.UploadPartInput) (*s3.UploadPartOutput, error)
 
+	UploadPartPresignedUrl(*s3.UploadPartInput, time.Duration) (*url.URL, error)
+
+	UploadPartCopyRequest(*s3.UploadPartCopyInput) (*aws.Request, *s3.UploadPartCopyOutput)
+
 	UploadPartCopy(*s3.UploadPartCopyInput) (*s3.UploadPartCopyOutput, error)
+
+	UploadPartCopyPresignedUrl(*s3.UploadPartCopyInput, time.Duration) (*url.URL, error)
+
+	WaitUntilBucketExists(*s3.HeadBucketInput) error
+
+	WaitUntilBucketNotExists(*s3.HeadBucketInput) error
+
+	WaitUntilObjectExists(*s3.HeadObjectInput) error
+
+	WaitUntilObjectNotExists(*s3.HeadObjectInput) error
 }
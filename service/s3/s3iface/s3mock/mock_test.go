@@ -0,0 +1,116 @@
+package s3mock_test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3iface"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3iface/s3mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterface(t *testing.T) {
+	assert.Implements(t, (*s3iface.S3API)(nil), &s3mock.S3{})
+}
+
+func TestNoStub(t *testing.T) {
+	m := &s3mock.S3{}
+	out, err := m.HeadObject(&s3.HeadObjectInput{Key: aws.String("key")})
+	assert.NoError(t, err)
+	assert.Equal(t, &s3.HeadObjectOutput{}, out)
+}
+
+func TestFuncStub(t *testing.T) {
+	m := &s3mock.S3{
+		GetBucketLocationFunc: func(in *s3.GetBucketLocationInput) (*s3.GetBucketLocationOutput, error) {
+			return &s3.GetBucketLocationOutput{LocationConstraint: aws.String("eu-west-1")}, nil
+		},
+	}
+	out, err := m.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "eu-west-1", *out.LocationConstraint)
+}
+
+func TestReturnStubAndCalls(t *testing.T) {
+	m := &s3mock.S3{}
+	m.ReturnDeleteObject(nil, errors.New("denied"))
+
+	_, err := m.DeleteObject(&s3.DeleteObjectInput{Key: aws.String("a")})
+	assert.EqualError(t, err, "denied")
+	m.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String("bucket")})
+	_, err = m.DeleteObject(&s3.DeleteObjectInput{Key: aws.String("b")})
+	assert.Error(t, err)
+
+	calls := m.Calls()
+	assert.Len(t, calls, 3)
+	assert.Equal(t, "HeadBucket", calls[1].Method)
+	assert.Equal(t, []interface{}{
+		&s3.DeleteObjectInput{Key: aws.String("a")},
+		&s3.DeleteObjectInput{Key: aws.String("b")},
+	}, m.CallsTo("DeleteObject"))
+}
+
+func TestRequestStub(t *testing.T) {
+	m := &s3mock.S3{}
+	m.ReturnGetObject(&s3.GetObjectOutput{ETag: aws.String("etag")}, nil)
+
+	req, out := m.GetObjectRequest(&s3.GetObjectInput{Key: aws.String("key")})
+	assert.Nil(t, out.ETag)
+	assert.NoError(t, req.Send())
+	assert.Equal(t, "etag", *out.ETag)
+	assert.Len(t, m.CallsTo("GetObject"), 1)
+
+	m.ReturnGetObject(nil, errors.New("missing"))
+	req, _ = m.GetObjectRequest(&s3.GetObjectInput{Key: aws.String("key")})
+	assert.EqualError(t, req.Send(), "missing")
+}
+
+func TestPagesStub(t *testing.T) {
+	m := &s3mock.S3{}
+	m.ReturnListObjectsPages([]*s3.ListObjectsOutput{
+		{Contents: []*s3.Object{{Key: aws.String("a")}}},
+		{Contents: []*s3.Object{{Key: aws.String("b")}}},
+	}, nil)
+
+	keys, last := []string{}, false
+	err := m.ListObjectsPages(&s3.ListObjectsInput{}, func(p *s3.ListObjectsOutput, lastPage bool) bool {
+		keys = append(keys, *p.Contents[0].Key)
+		last = lastPage
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.True(t, last)
+	assert.Len(t, m.CallsTo("ListObjectsPages"), 1)
+}
+
+func TestPagesFromFuncStub(t *testing.T) {
+	m := &s3mock.S3{}
+	m.ReturnListObjectsV2(&s3.ListObjectsV2Output{KeyCount: aws.Int64(1)}, nil)
+
+	pages := 0
+	err := m.ListObjectsV2Pages(&s3.ListObjectsV2Input{}, func(p *s3.ListObjectsV2Output, lastPage bool) bool {
+		pages++
+		assert.Equal(t, int64(1), *p.KeyCount)
+		assert.True(t, lastPage)
+		return true
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pages)
+}
+
+func TestPresignedUrlStub(t *testing.T) {
+	m := &s3mock.S3{
+		GetObjectPresignedUrlFunc: func(in *s3.GetObjectInput, expires time.Duration) (*url.URL, error) {
+			return url.Parse("https://bucket.s3.amazonaws.com/" + *in.Key)
+		},
+	}
+	u, err := m.GetObjectPresignedUrl(&s3.GetObjectInput{Key: aws.String("key")}, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, "https://bucket.s3.amazonaws.com/key", u.String())
+	assert.Len(t, m.CallsTo("GetObjectPresignedUrl"), 1)
+}
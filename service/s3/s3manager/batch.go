@@ -0,0 +1,723 @@
+package s3manager
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// The default number of files to transfer in parallel when using a Batcher.
+var DefaultBatchConcurrency = 5
+
+// The default set of options used when opts is nil in NewBatcher().
+var DefaultBatchOptions = &BatchOptions{
+	Concurrency: DefaultBatchConcurrency,
+	Compare:     CompareSizeAndModTime,
+}
+
+// A CompareMode selects how a Sync decides whether a local file and an S3
+// object are already in sync.
+type CompareMode int
+
+const (
+	// CompareSizeAndModTime treats a file and object as equal if they have
+	// the same size and the destination is not older than the source.
+	CompareSizeAndModTime CompareMode = iota
+
+	// CompareETag treats a file and object as equal if the MD5 of the local
+	// file matches the object's ETag. Objects uploaded in multiple parts do
+	// not have an MD5 ETag and fall back to CompareSizeAndModTime.
+	CompareETag
+
+	// CompareNone always transfers every file.
+	CompareNone
+)
+
+// A SyncDirection selects which side of a Sync is the source of truth.
+type SyncDirection int
+
+const (
+	// SyncUpload makes the S3 prefix match the local directory.
+	SyncUpload SyncDirection = iota
+
+	// SyncDownload makes the local directory match the S3 prefix.
+	SyncDownload
+)
+
+// A BatchAction is the action a batch operation took, or would have taken
+// in dry-run mode, for a single file.
+type BatchAction string
+
+const (
+	// BatchActionUpload means the local file was uploaded to S3.
+	BatchActionUpload BatchAction = "upload"
+
+	// BatchActionDownload means the S3 object was downloaded to disk.
+	BatchActionDownload BatchAction = "download"
+
+	// BatchActionDelete means the extraneous file or object was deleted.
+	BatchActionDelete BatchAction = "delete"
+
+	// BatchActionSkip means the file and object were already in sync.
+	BatchActionSkip BatchAction = "skip"
+)
+
+// BatchOptions keeps track of extra options to pass to a Batcher.
+type BatchOptions struct {
+	// The number of files to transfer in parallel. This bounds the number of
+	// concurrent Upload or Download calls across the whole batch; each
+	// transfer may still use its own part concurrency. If this is set to
+	// zero or a negative value, the DefaultBatchConcurrency value will be
+	// used.
+	Concurrency int
+
+	// Glob patterns a file's path relative to the directory (using forward
+	// slashes) must match to be included. Patterns without a slash are also
+	// matched against the file's base name. An empty list includes all files.
+	Include []string
+
+	// Glob patterns, matched the same way as Include, which exclude a file
+	// from the batch. Exclude takes precedence over Include.
+	Exclude []string
+
+	// How Sync decides whether a file needs to be transferred. UploadDirectory
+	// and DownloadPrefix always transfer every file.
+	Compare CompareMode
+
+	// Setting this value to true will cause Sync to delete files at the
+	// destination which do not exist at the source.
+	Delete bool
+
+	// Setting this value to true will report the actions a batch call would
+	// take without transferring or deleting anything.
+	DryRun bool
+
+	// Options to use for each file's Upload. S3 defaults to the batch's S3
+	// client if not set.
+	UploadOptions *UploadOptions
+
+	// Options to use for each object's Download. S3 defaults to the batch's
+	// S3 client if not set.
+	DownloadOptions *DownloadOptions
+
+	// The client to use for listing and deleting objects. Leave this as nil
+	// to use the default S3 client.
+	S3 *s3.S3
+
+	// The RequestPayer to send with every request of a batch whose input
+	// does not set its own. UploadOptions and DownloadOptions default to it
+	// if they do not set their own.
+	RequestPayer *string
+
+	// The ExpectedBucketOwner to send with every request of a batch whose
+	// input does not set its own. UploadOptions and DownloadOptions default
+	// to it if they do not set their own.
+	ExpectedBucketOwner *string
+}
+
+// A BatchResult is the outcome of a batch operation for a single file.
+type BatchResult struct {
+	// The local path of the file.
+	Path string
+
+	// The S3 key of the object.
+	Key string
+
+	// The action that was taken for the file.
+	Action BatchAction
+
+	// The size in bytes of the source file or object.
+	Size int64
+
+	// The error which occurred transferring the file, if any.
+	Err error
+}
+
+// BatchOutput represents a response from a Batcher call.
+type BatchOutput struct {
+	// Per-file results sorted by key.
+	Results []*BatchResult
+}
+
+// A BatchFailure wraps the per-file errors of a batch operation. An error
+// returned by a Batcher call will satisfy this interface when one or more
+// files failed to transfer. Files which did not fail were still transferred.
+//
+// Example:
+//
+//     b := s3manager.NewBatcher(opts)
+//     output, err := b.UploadDirectory("dir", input)
+//     if err != nil {
+//         if batchErr, ok := err.(s3manager.BatchFailure); ok {
+//             for _, r := range batchErr.Failures() {
+//                 fmt.Println("Failed:", r.Path, r.Err)
+//             }
+//         }
+//     }
+//
+type BatchFailure interface {
+	awserr.Error
+
+	// Returns the results of the files which failed.
+	Failures() []*BatchResult
+}
+
+// A batchError wraps the failed results of a batch operation.
+// Composed of BaseError for code, message, and original error.
+type batchError struct {
+	*apierr.BaseError
+
+	// Results of the files which failed.
+	failures []*BatchResult
+}
+
+// Error returns the string representation of the error.
+//
+// See apierr.BaseError ErrorWithExtra for output format
+//
+// Satisfies the error interface.
+func (b *batchError) Error() string {
+	extra := make([]string, len(b.failures))
+	for i, r := range b.failures {
+		extra[i] = fmt.Sprintf("%s %s: %s", r.Action, r.Key, r.Err)
+	}
+	return b.ErrorWithExtra(strings.Join(extra, "\n\t"))
+}
+
+// String returns the string representation of the error.
+// Alias for Error to satisfy the stringer interface.
+func (b *batchError) String() string {
+	return b.Error()
+}
+
+// Failures returns the results of the files which failed.
+func (b *batchError) Failures() []*BatchResult {
+	return b.failures
+}
+
+// NewBatcher creates a new Batcher object to transfer whole directories and
+// prefixes between the local disk and S3. Pass in an optional opts structure
+// to customize the batch behavior.
+func NewBatcher(opts *BatchOptions) *Batcher {
+	if opts == nil {
+		opts = DefaultBatchOptions
+	}
+	return &Batcher{opts: opts}
+}
+
+// The Batcher structure that calls UploadDirectory(), DownloadPrefix() and
+// Sync(). It is safe to call these methods across concurrent goroutines.
+type Batcher struct {
+	opts *BatchOptions
+}
+
+// SyncInput contains all input for a Sync call.
+type SyncInput struct {
+	// The local directory to sync.
+	Dir string
+
+	// The bucket to sync.
+	Bucket *string
+
+	// The key prefix to sync. Local paths relative to Dir are appended to
+	// Prefix to form object keys.
+	Prefix *string
+
+	// Which side of the sync is the source.
+	Direction SyncDirection
+
+	// Optional template for uploads. All fields except Bucket, Key and Body
+	// are applied to each uploaded object.
+	UploadInput *UploadInput
+}
+
+// UploadDirectory uploads every file under dir to S3. input acts as a
+// template for each upload: input.Key is used as the key prefix, the file's
+// path relative to dir is appended to it, and input.Body is ignored.
+func (b *Batcher) UploadDirectory(dir string, input *UploadInput) (*BatchOutput, error) {
+	i := batcher{opts: *b.opts, dir: dir, bucket: input.Bucket, upload: input}
+	if input.Key != nil {
+		i.prefix = *input.Key
+	}
+	return i.run(SyncUpload, false)
+}
+
+// DownloadPrefix downloads every object listed by input into dir, using each
+// key with input.Prefix removed as the path relative to dir.
+func (b *Batcher) DownloadPrefix(dir string, input *s3.ListObjectsInput) (*BatchOutput, error) {
+	i := batcher{opts: *b.opts, dir: dir, bucket: input.Bucket, list: input}
+	if input.Prefix != nil {
+		i.prefix = *input.Prefix
+	}
+	return i.run(SyncDownload, false)
+}
+
+// Sync transfers only the files which differ between a local directory and
+// an S3 prefix, and optionally deletes files which only exist at the
+// destination.
+func (b *Batcher) Sync(input *SyncInput) (*BatchOutput, error) {
+	i := batcher{opts: *b.opts, dir: input.Dir, bucket: input.Bucket,
+		upload: input.UploadInput}
+	if input.Prefix != nil {
+		i.prefix = *input.Prefix
+	}
+	return i.run(input.Direction, true)
+}
+
+// internal structure to manage a single batch operation.
+type batcher struct {
+	opts   BatchOptions
+	dir    string
+	bucket *string
+	prefix string
+	upload *UploadInput
+	list   *s3.ListObjectsInput
+
+	// request parameters sent with the list, get and delete requests
+	requestPayer *string
+	bucketOwner  *string
+
+	uploader   *Uploader
+	downloader *Downloader
+}
+
+// batchEntry describes one side of a file in the batch.
+type batchEntry struct {
+	size    int64
+	modTime time.Time
+	etag    string
+}
+
+// batchTask is a single unit of work handed to the batch workers.
+type batchTask struct {
+	result *BatchResult
+	run    func() error
+}
+
+// init will initialize all default options.
+func (b *batcher) init() {
+	if b.opts.S3 == nil {
+		b.opts.S3 = s3.New(nil)
+	}
+	if b.opts.Concurrency <= 0 {
+		b.opts.Concurrency = DefaultBatchConcurrency
+	}
+
+	// The request parameters of the input template apply to the whole batch.
+	switch {
+	case b.upload != nil:
+		b.requestPayer, b.bucketOwner = b.upload.RequestPayer, b.upload.ExpectedBucketOwner
+	case b.list != nil:
+		b.requestPayer, b.bucketOwner = b.list.RequestPayer, b.list.ExpectedBucketOwner
+	}
+	b.requestPayer = stringDefault(b.requestPayer, b.opts.RequestPayer)
+	b.bucketOwner = stringDefault(b.bucketOwner, b.opts.ExpectedBucketOwner)
+
+	uopts := UploadOptions{}
+	if b.opts.UploadOptions != nil {
+		uopts = *b.opts.UploadOptions
+	}
+	if uopts.S3 == nil {
+		uopts.S3 = b.opts.S3
+	}
+	uopts.RequestPayer = stringDefault(uopts.RequestPayer, b.opts.RequestPayer)
+	uopts.ExpectedBucketOwner = stringDefault(uopts.ExpectedBucketOwner, b.opts.ExpectedBucketOwner)
+	b.uploader = NewUploader(&uopts)
+
+	dopts := DownloadOptions{}
+	if b.opts.DownloadOptions != nil {
+		dopts = *b.opts.DownloadOptions
+	}
+	if dopts.S3 == nil {
+		dopts.S3 = b.opts.S3
+	}
+	dopts.RequestPayer = stringDefault(dopts.RequestPayer, b.opts.RequestPayer)
+	dopts.ExpectedBucketOwner = stringDefault(dopts.ExpectedBucketOwner, b.opts.ExpectedBucketOwner)
+	b.downloader = NewDownloader(&dopts)
+}
+
+// run builds the list of tasks for the direction, executes them across the
+// worker pool and collects the results.
+func (b *batcher) run(dir SyncDirection, sync bool) (*BatchOutput, error) {
+	b.init()
+
+	local, err := b.walkLocal()
+	if err != nil {
+		return nil, apierr.New("ReadDirectory", "failed to read local directory", err)
+	}
+
+	// Listing the remote side is only needed when comparing or downloading.
+	remote := map[string]*batchEntry{}
+	if sync || dir == SyncDownload {
+		if remote, err = b.listRemote(); err != nil {
+			return nil, err
+		}
+	}
+
+	var tasks []*batchTask
+	switch dir {
+	case SyncUpload:
+		tasks = b.uploadTasks(local, remote, sync)
+	case SyncDownload:
+		tasks = b.downloadTasks(local, remote, sync)
+	}
+
+	b.execute(tasks)
+
+	out := &BatchOutput{Results: make([]*BatchResult, len(tasks))}
+	for i, t := range tasks {
+		out.Results[i] = t.result
+	}
+	sort.Sort(batchResults(out.Results))
+
+	failures := []*BatchResult{}
+	for _, r := range out.Results {
+		if r.Err != nil {
+			failures = append(failures, r)
+		}
+	}
+
+	if len(failures) > 0 {
+		msg := fmt.Sprintf("%d of %d files failed", len(failures), len(tasks))
+		return out, &batchError{
+			BaseError: apierr.New("BatchedErrors", msg, nil),
+			failures:  failures,
+		}
+	}
+	return out, nil
+}
+
+// execute runs all tasks across Concurrency worker goroutines, recording
+// each task's error in its result.
+func (b *batcher) execute(tasks []*batchTask) {
+	var wg sync.WaitGroup
+	ch := make(chan *batchTask, b.opts.Concurrency)
+	for i := 0; i < b.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for t := range ch {
+				if !b.opts.DryRun && t.run != nil {
+					t.result.Err = t.run()
+				}
+			}
+		}()
+	}
+
+	for _, t := range tasks {
+		ch <- t
+	}
+	close(ch)
+	wg.Wait()
+}
+
+// uploadTasks returns the tasks needed to make the remote prefix match the
+// local directory.
+func (b *batcher) uploadTasks(local, remote map[string]*batchEntry, sync bool) []*batchTask {
+	tasks := []*batchTask{}
+	for _, rel := range sortedKeys(local) {
+		l, key, p := local[rel], b.prefix+rel, b.localPath(rel)
+		t := &batchTask{result: &BatchResult{Path: p, Key: key, Size: l.size}}
+
+		if sync && b.unchanged(p, l, remote[rel], l) {
+			t.result.Action = BatchActionSkip
+		} else {
+			t.result.Action = BatchActionUpload
+			t.run = func() error { return b.uploadFile(p, key) }
+		}
+		tasks = append(tasks, t)
+	}
+
+	if sync && b.opts.Delete {
+		for _, rel := range sortedKeys(remote) {
+			if _, ok := local[rel]; ok {
+				continue
+			}
+			key := b.prefix + rel
+			t := &batchTask{result: &BatchResult{Key: key, Action: BatchActionDelete}}
+			t.run = func() error {
+				_, err := b.opts.S3.DeleteObject(&s3.DeleteObjectInput{
+					Bucket:              b.bucket,
+					Key:                 aws.String(key),
+					RequestPayer:        b.requestPayer,
+					ExpectedBucketOwner: b.bucketOwner,
+				})
+				return err
+			}
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks
+}
+
+// downloadTasks returns the tasks needed to make the local directory match
+// the remote prefix.
+func (b *batcher) downloadTasks(local, remote map[string]*batchEntry, sync bool) []*batchTask {
+	tasks := []*batchTask{}
+	for _, rel := range sortedKeys(remote) {
+		r, key, p := remote[rel], b.prefix+rel, b.localPath(rel)
+		t := &batchTask{result: &BatchResult{Path: p, Key: key, Size: r.size}}
+
+		if !b.insideDir(p) {
+			t.result.Action = BatchActionDownload
+			t.result.Err = apierr.New("InvalidKey",
+				"object key resolves outside of the local directory", nil)
+		} else if sync && b.unchanged(p, local[rel], r, r) {
+			t.result.Action = BatchActionSkip
+		} else {
+			t.result.Action = BatchActionDownload
+			t.run = func() error { return b.downloadFile(p, key, r) }
+		}
+		tasks = append(tasks, t)
+	}
+
+	if sync && b.opts.Delete {
+		for _, rel := range sortedKeys(local) {
+			if _, ok := remote[rel]; ok {
+				continue
+			}
+			p := b.localPath(rel)
+			t := &batchTask{result: &BatchResult{Path: p, Key: b.prefix + rel,
+				Action: BatchActionDelete}}
+			t.run = func() error { return os.Remove(p) }
+			tasks = append(tasks, t)
+		}
+	}
+	return tasks
+}
+
+// unchanged returns true if the local and remote entries are in sync
+// according to the configured CompareMode. src is the side being copied from.
+func (b *batcher) unchanged(path string, local, remote, src *batchEntry) bool {
+	if local == nil || remote == nil || local.size != remote.size {
+		return false
+	}
+
+	switch b.opts.Compare {
+	case CompareNone:
+		return false
+	case CompareETag:
+		if remote.etag != "" && !strings.Contains(remote.etag, "-") {
+			sum, err := fileMD5(path)
+			return err == nil && sum == remote.etag
+		}
+	}
+
+	// The destination must not be older than the source.
+	if src == local {
+		return !local.modTime.After(remote.modTime)
+	}
+	return !remote.modTime.After(local.modTime)
+}
+
+// uploadFile uploads a single local file to key.
+func (b *batcher) uploadFile(p, key string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	in := &UploadInput{}
+	if b.upload != nil {
+		awsutil.Copy(in, b.upload)
+	}
+	in.Bucket, in.Key, in.Body = b.bucket, aws.String(key), f
+
+	_, err = b.uploader.Upload(in)
+	return err
+}
+
+// downloadFile downloads a single object to the local path p, creating any
+// parent directories. The file's modification time is set to the object's
+// LastModified time so later syncs can compare them.
+func (b *batcher) downloadFile(p, key string, r *batchEntry) error {
+	if err := os.MkdirAll(filepath.Dir(p), 0775); err != nil {
+		return err
+	}
+
+	f, err := os.Create(p)
+	if err != nil {
+		return err
+	}
+
+	if r.size > 0 {
+		_, err = b.downloader.Download(f, &s3.GetObjectInput{
+			Bucket:              b.bucket,
+			Key:                 aws.String(key),
+			RequestPayer:        b.requestPayer,
+			ExpectedBucketOwner: b.bucketOwner,
+		})
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if !r.modTime.IsZero() {
+		return os.Chtimes(p, r.modTime, r.modTime)
+	}
+	return nil
+}
+
+// walkLocal returns the regular files under the local directory, keyed by
+// their slash separated path relative to the directory. A missing directory
+// is treated as empty.
+func (b *batcher) walkLocal() (map[string]*batchEntry, error) {
+	entries := map[string]*batchEntry{}
+	if _, err := os.Stat(b.dir); os.IsNotExist(err) {
+		return entries, nil
+	}
+
+	err := filepath.Walk(b.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(b.dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if b.included(rel) {
+			entries[rel] = &batchEntry{size: info.Size(), modTime: info.ModTime()}
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// listRemote returns the objects under the prefix, keyed by their key with
+// the prefix removed.
+func (b *batcher) listRemote() (map[string]*batchEntry, error) {
+	in := &s3.ListObjectsInput{}
+	if b.list != nil {
+		awsutil.Copy(in, b.list)
+	}
+	in.Bucket = b.bucket
+	in.RequestPayer, in.ExpectedBucketOwner = b.requestPayer, b.bucketOwner
+	if b.prefix != "" {
+		in.Prefix = aws.String(b.prefix)
+	}
+
+	entries := map[string]*batchEntry{}
+	err := b.opts.S3.ListObjectsPages(in, func(p *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, obj := range p.Contents {
+			if obj.Key == nil {
+				continue
+			}
+			rel := strings.TrimPrefix(*obj.Key, b.prefix)
+			if rel == "" || strings.HasSuffix(rel, "/") || !b.included(rel) {
+				continue // skip the prefix itself and directory markers
+			}
+
+			e := &batchEntry{}
+			if obj.Size != nil {
+				e.size = *obj.Size
+			}
+			if obj.LastModified != nil {
+				e.modTime = *obj.LastModified
+			}
+			if obj.ETag != nil {
+				e.etag = strings.Trim(*obj.ETag, `"`)
+			}
+			entries[rel] = e
+		}
+		return true
+	})
+	return entries, err
+}
+
+// included returns true if the relative path passes the Include and Exclude
+// glob patterns.
+func (b *batcher) included(rel string) bool {
+	for _, pattern := range b.opts.Exclude {
+		if matchGlob(pattern, rel) {
+			return false
+		}
+	}
+	if len(b.opts.Include) == 0 {
+		return true
+	}
+	for _, pattern := range b.opts.Include {
+		if matchGlob(pattern, rel) {
+			return true
+		}
+	}
+	return false
+}
+
+// localPath returns the local file path for a slash separated relative path.
+func (b *batcher) localPath(rel string) string {
+	return filepath.Join(b.dir, filepath.FromSlash(rel))
+}
+
+// insideDir returns true if p is within the local directory. Keys containing
+// ".." segments could otherwise write outside of it.
+func (b *batcher) insideDir(p string) bool {
+	rel, err := filepath.Rel(b.dir, p)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// matchGlob matches a slash separated relative path against a glob pattern.
+// Patterns without a slash also match against the path's base name.
+func matchGlob(pattern, rel string) bool {
+	if ok, _ := path.Match(pattern, rel); ok {
+		return true
+	}
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(rel))
+		return ok
+	}
+	return false
+}
+
+// fileMD5 returns the hex encoded MD5 sum of the file's contents.
+func fileMD5(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// batchResults is a wrapper to make results sortable by their key.
+type batchResults []*BatchResult
+
+func (a batchResults) Len() int           { return len(a) }
+func (a batchResults) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a batchResults) Less(i, j int) bool { return a[i].Key < a[j].Key }
+
+// sortedKeys returns the keys of the entry map in sorted order.
+func sortedKeys(m map[string]*batchEntry) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
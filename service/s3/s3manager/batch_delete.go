@@ -0,0 +1,457 @@
+package s3manager
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// The maximum number of keys S3 accepts in a single DeleteObjects call.
+const MaxBatchDeleteSize = 1000
+
+// The default number of times keys which failed with a transient error
+// are retried.
+var DefaultBatchDeleteMaxRetries = 3
+
+// The default delay before the first retry of failed keys. The delay is
+// doubled for every following retry.
+var DefaultBatchDeleteRetryDelay = 100 * time.Millisecond
+
+// The default set of options used when opts is nil in NewBatchDelete().
+var DefaultBatchDeleteOptions = &BatchDeleteOptions{
+	BatchSize:   MaxBatchDeleteSize,
+	Concurrency: DefaultBatchConcurrency,
+	MaxRetries:  DefaultBatchDeleteMaxRetries,
+	RetryDelay:  DefaultBatchDeleteRetryDelay,
+}
+
+// batchDeleteRetryableCodes are the per-key error codes of a DeleteObjects
+// response which are worth retrying.
+var batchDeleteRetryableCodes = map[string]struct{}{
+	"InternalError":      {},
+	"OperationAborted":   {},
+	"RequestTimeout":     {},
+	"ServiceUnavailable": {},
+	"SlowDown":           {},
+}
+
+// BatchDeleteOptions provides options to control the behavior of a
+// BatchDelete.
+type BatchDeleteOptions struct {
+	// The number of keys sent in each DeleteObjects request. Values above
+	// MaxBatchDeleteSize are capped to it.
+	BatchSize int
+
+	// The number of DeleteObjects requests to send in parallel. If this is
+	// set to zero or a negative value, the DefaultBatchConcurrency value will
+	// be used.
+	Concurrency int
+
+	// The number of times keys which failed with a transient error are
+	// retried before being reported as failures. If this is set to zero, the
+	// DefaultBatchDeleteMaxRetries value will be used. A negative value
+	// disables retries.
+	MaxRetries int
+
+	// The delay before the first retry of failed keys, doubled for every
+	// following retry.
+	RetryDelay time.Duration
+
+	// The service client instance to use for the DeleteObjects calls.
+	S3 *s3.S3
+
+	// The RequestPayer to send with every DeleteObjects request of a delete
+	// whose input does not set its own.
+	RequestPayer *string
+
+	// The ExpectedBucketOwner to send with every DeleteObjects request of a
+	// delete whose input does not set its own.
+	ExpectedBucketOwner *string
+}
+
+// A BatchDeleteIterator yields the objects to delete in a BatchDelete.
+//
+// Example:
+//
+//     for iter.Next() {
+//         obj := iter.Object()
+//     }
+//     if err := iter.Err(); err != nil {
+//         // handle error
+//     }
+//
+type BatchDeleteIterator interface {
+	// Next advances to the next object and returns false when there are
+	// no more objects or an error occurred.
+	Next() bool
+
+	// Err returns the error which stopped the iteration, if any.
+	Err() error
+
+	// Object returns the current object.
+	Object() *s3.ObjectIdentifier
+}
+
+// DeleteObjectsIterator is a BatchDeleteIterator over a fixed list of
+// objects.
+type DeleteObjectsIterator struct {
+	Objects []*s3.ObjectIdentifier
+
+	index int
+}
+
+// Next advances to the next object in the list.
+func (iter *DeleteObjectsIterator) Next() bool {
+	if iter.index >= len(iter.Objects) {
+		return false
+	}
+	iter.index++
+	return true
+}
+
+// Err always returns nil.
+func (iter *DeleteObjectsIterator) Err() error {
+	return nil
+}
+
+// Object returns the current object.
+func (iter *DeleteObjectsIterator) Object() *s3.ObjectIdentifier {
+	return iter.Objects[iter.index-1]
+}
+
+// NewDeleteListIterator returns a BatchDeleteIterator over every object
+// listed by ListObjects with input. Pages are fetched as the iterator
+// advances. Use it to delete every object under a prefix.
+func NewDeleteListIterator(svc *s3.S3, input *s3.ListObjectsInput) BatchDeleteIterator {
+	req, _ := svc.ListObjectsRequest(input)
+	return &deletePagesIterator{req: req, page: func(data interface{}) []*s3.ObjectIdentifier {
+		out := data.(*s3.ListObjectsOutput)
+		objs := make([]*s3.ObjectIdentifier, len(out.Contents))
+		for i, o := range out.Contents {
+			objs[i] = &s3.ObjectIdentifier{Key: o.Key}
+		}
+		return objs
+	}}
+}
+
+// NewDeleteVersionsIterator returns a BatchDeleteIterator over every object
+// version and delete marker listed by ListObjectVersions with input. Pages
+// are fetched as the iterator advances. Use it to empty a versioned bucket.
+func NewDeleteVersionsIterator(svc *s3.S3, input *s3.ListObjectVersionsInput) BatchDeleteIterator {
+	req, _ := svc.ListObjectVersionsRequest(input)
+	return &deletePagesIterator{req: req, page: func(data interface{}) []*s3.ObjectIdentifier {
+		out := data.(*s3.ListObjectVersionsOutput)
+		objs := make([]*s3.ObjectIdentifier, 0, len(out.Versions)+len(out.DeleteMarkers))
+		for _, v := range out.Versions {
+			objs = append(objs, &s3.ObjectIdentifier{Key: v.Key, VersionID: v.VersionID})
+		}
+		for _, m := range out.DeleteMarkers {
+			objs = append(objs, &s3.ObjectIdentifier{Key: m.Key, VersionID: m.VersionID})
+		}
+		return objs
+	}}
+}
+
+// deletePagesIterator iterates over the objects of a paginated list
+// request, sending the request for each page as needed.
+type deletePagesIterator struct {
+	req     *aws.Request
+	page    func(data interface{}) []*s3.ObjectIdentifier
+	objects []*s3.ObjectIdentifier
+	current *s3.ObjectIdentifier
+	err     error
+}
+
+// Next advances to the next object, fetching the next page if needed.
+func (iter *deletePagesIterator) Next() bool {
+	for len(iter.objects) == 0 {
+		if iter.req == nil || iter.err != nil {
+			return false
+		}
+		if iter.err = iter.req.Send(); iter.err != nil {
+			return false
+		}
+		iter.objects = iter.page(iter.req.Data)
+		iter.req = iter.req.NextPage()
+	}
+	iter.current, iter.objects = iter.objects[0], iter.objects[1:]
+	return true
+}
+
+// Err returns the error of the last page request, if any.
+func (iter *deletePagesIterator) Err() error {
+	return iter.err
+}
+
+// Object returns the current object.
+func (iter *deletePagesIterator) Object() *s3.ObjectIdentifier {
+	return iter.current
+}
+
+// BatchDeleteInput contains the bucket and request-wide parameters of a
+// BatchDelete.
+type BatchDeleteInput struct {
+	// The bucket to delete objects from.
+	Bucket *string
+
+	// The account ID of the expected bucket owner. If the bucket is owned by
+	// a different account, the requests fail with an HTTP 403 (Access Denied)
+	// error.
+	ExpectedBucketOwner *string
+
+	// The concatenation of the authentication device's serial number, a
+	// space, and the value that is displayed on your authentication device.
+	MFA *string
+
+	// Confirms that the requester knows that she or he will be charged for
+	// the request.
+	RequestPayer *string
+}
+
+// BatchDeleteOutput represents a response from the Delete() call.
+type BatchDeleteOutput struct {
+	// The number of objects which were deleted.
+	Deleted int64
+}
+
+// A BatchDeleteFailure wraps the per-key errors of a BatchDelete. An error
+// returned by Delete() will satisfy this interface when one or more keys
+// could not be deleted. Keys which did not fail were still deleted.
+//
+// Example:
+//
+//     d := s3manager.NewBatchDelete(opts)
+//     output, err := d.Delete(input, iter)
+//     if err != nil {
+//         if delErr, ok := err.(s3manager.BatchDeleteFailure); ok {
+//             for _, e := range delErr.Failures() {
+//                 fmt.Println("Failed:", *e.Key, *e.Code)
+//             }
+//         }
+//     }
+//
+type BatchDeleteFailure interface {
+	awserr.Error
+
+	// Returns the errors of the keys which could not be deleted.
+	Failures() []*s3.Error
+}
+
+// A batchDeleteError wraps the failed keys of a BatchDelete.
+// Composed of BaseError for code, message, and original error.
+type batchDeleteError struct {
+	*apierr.BaseError
+
+	// Errors of the keys which failed.
+	failures []*s3.Error
+}
+
+// Error returns the string representation of the error.
+//
+// See apierr.BaseError ErrorWithExtra for output format
+//
+// Satisfies the error interface.
+func (b *batchDeleteError) Error() string {
+	extra := make([]string, len(b.failures))
+	for i, e := range b.failures {
+		extra[i] = fmt.Sprintf("%s: %s: %s", deleteErrorValue(e.Key),
+			deleteErrorValue(e.Code), deleteErrorValue(e.Message))
+		if e.VersionID != nil {
+			extra[i] = fmt.Sprintf("%s (version %s)", extra[i], *e.VersionID)
+		}
+	}
+	return b.ErrorWithExtra(strings.Join(extra, "\n\t"))
+}
+
+// String returns the string representation of the error.
+// Alias for Error to satisfy the stringer interface.
+func (b *batchDeleteError) String() string {
+	return b.Error()
+}
+
+// Failures returns the errors of the keys which failed.
+func (b *batchDeleteError) Failures() []*s3.Error {
+	return b.failures
+}
+
+// deleteErrorValue returns the value of s, or an empty string if nil.
+func deleteErrorValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// NewBatchDelete creates a new BatchDelete object to delete large numbers
+// of objects with DeleteObjects. Pass in an optional opts structure to
+// customize the delete behavior.
+func NewBatchDelete(opts *BatchDeleteOptions) *BatchDelete {
+	if opts == nil {
+		opts = DefaultBatchDeleteOptions
+	}
+	return &BatchDelete{opts: opts}
+}
+
+// The BatchDelete structure that calls Delete(). It is safe to call Delete()
+// across concurrent goroutines.
+type BatchDelete struct {
+	opts *BatchDeleteOptions
+}
+
+// Delete deletes every object yielded by iter from input.Bucket. Objects
+// are sent in DeleteObjects requests of up to BatchSize keys, and keys
+// which fail with a transient error are retried. Iteration and deletion
+// are interleaved, so objects are deleted as list pages arrive.
+//
+// If any keys could not be deleted the returned error satisfies
+// BatchDeleteFailure. If iter fails, its error is returned once the
+// objects already read have been deleted.
+func (d *BatchDelete) Delete(input *BatchDeleteInput, iter BatchDeleteIterator) (*BatchDeleteOutput, error) {
+	i := batchDeleter{opts: *d.opts, in: input}
+	return i.delete(iter)
+}
+
+// internal structure to manage a single batch delete.
+type batchDeleter struct {
+	opts BatchDeleteOptions
+	in   *BatchDeleteInput
+
+	m        sync.Mutex
+	deleted  int64
+	failures []*s3.Error
+}
+
+// init will initialize all default options.
+func (d *batchDeleter) init() {
+	if d.opts.S3 == nil {
+		d.opts.S3 = s3.New(nil)
+	}
+	if d.opts.BatchSize <= 0 || d.opts.BatchSize > MaxBatchDeleteSize {
+		d.opts.BatchSize = MaxBatchDeleteSize
+	}
+	if d.opts.Concurrency <= 0 {
+		d.opts.Concurrency = DefaultBatchConcurrency
+	}
+	if d.opts.MaxRetries == 0 {
+		d.opts.MaxRetries = DefaultBatchDeleteMaxRetries
+	} else if d.opts.MaxRetries < 0 {
+		d.opts.MaxRetries = 0
+	}
+
+	// Apply the request defaults to a copy of the caller's input
+	in := *d.in
+	in.RequestPayer = stringDefault(in.RequestPayer, d.opts.RequestPayer)
+	in.ExpectedBucketOwner = stringDefault(in.ExpectedBucketOwner, d.opts.ExpectedBucketOwner)
+	d.in = &in
+}
+
+// delete reads iter into chunks and sends them to the delete workers.
+func (d *batchDeleter) delete(iter BatchDeleteIterator) (*BatchDeleteOutput, error) {
+	d.init()
+
+	var wg sync.WaitGroup
+	ch := make(chan []*s3.ObjectIdentifier, d.opts.Concurrency)
+	for i := 0; i < d.opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for objs := range ch {
+				d.deleteChunk(objs)
+			}
+		}()
+	}
+
+	chunk := make([]*s3.ObjectIdentifier, 0, d.opts.BatchSize)
+	for iter.Next() {
+		chunk = append(chunk, iter.Object())
+		if len(chunk) == d.opts.BatchSize {
+			ch <- chunk
+			chunk = make([]*s3.ObjectIdentifier, 0, d.opts.BatchSize)
+		}
+	}
+	if len(chunk) > 0 {
+		ch <- chunk
+	}
+	close(ch)
+	wg.Wait()
+
+	out := &BatchDeleteOutput{Deleted: d.deleted}
+	iterErr := iter.Err()
+	if len(d.failures) == 0 {
+		return out, iterErr
+	}
+
+	msg := fmt.Sprintf("%d of %d objects failed to delete",
+		len(d.failures), int64(len(d.failures))+d.deleted)
+	return out, &batchDeleteError{
+		BaseError: apierr.New("BatchedErrors", msg, iterErr),
+		failures:  d.failures,
+	}
+}
+
+// deleteChunk deletes a single chunk of objects, retrying keys which
+// failed with a transient error.
+func (d *batchDeleter) deleteChunk(objs []*s3.ObjectIdentifier) {
+	for attempt := 0; len(objs) > 0; attempt++ {
+		if attempt > 0 {
+			time.Sleep(d.opts.RetryDelay << uint(attempt-1))
+		}
+
+		resp, err := d.opts.S3.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket:              d.in.Bucket,
+			MFA:                 d.in.MFA,
+			RequestPayer:        d.in.RequestPayer,
+			ExpectedBucketOwner: d.in.ExpectedBucketOwner,
+			Delete:              &s3.Delete{Objects: objs, Quiet: aws.Boolean(true)},
+		})
+		if err != nil {
+			// The request as a whole failed and was already retried by
+			// the service client, so every key in it has failed.
+			d.fail(objs, err)
+			return
+		}
+
+		retry := []*s3.ObjectIdentifier{}
+		failed := []*s3.Error{}
+		for _, e := range resp.Errors {
+			_, ok := batchDeleteRetryableCodes[deleteErrorValue(e.Code)]
+			if ok && attempt < d.opts.MaxRetries {
+				retry = append(retry, &s3.ObjectIdentifier{Key: e.Key, VersionID: e.VersionID})
+			} else {
+				failed = append(failed, e)
+			}
+		}
+
+		d.m.Lock()
+		d.deleted += int64(len(objs) - len(resp.Errors))
+		d.failures = append(d.failures, failed...)
+		d.m.Unlock()
+
+		objs = retry
+	}
+}
+
+// fail records every object in objs as failed with err.
+func (d *batchDeleter) fail(objs []*s3.ObjectIdentifier, err error) {
+	code, msg := "RequestError", err.Error()
+	if awsErr, ok := err.(awserr.Error); ok {
+		code, msg = awsErr.Code(), awsErr.Message()
+	}
+
+	d.m.Lock()
+	defer d.m.Unlock()
+	for _, o := range objs {
+		d.failures = append(d.failures, &s3.Error{
+			Key:       o.Key,
+			VersionID: o.VersionID,
+			Code:      aws.String(code),
+			Message:   aws.String(msg),
+		})
+	}
+}
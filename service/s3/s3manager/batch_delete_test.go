@@ -0,0 +1,250 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// deleteSvc returns a client backed by an in-memory bucket which lists
+// keys in pages of pageSize and calls fail for every key passed to
+// DeleteObjects. A non-empty code returned by fail makes that key fail.
+func deleteSvc(keys []string, pageSize int, fail func(key string) string) (*s3.S3, *[]string) {
+	var m sync.Mutex
+	ops := []string{}
+	sort.Strings(keys)
+
+	svc := s3.New(nil)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch p := r.Params.(type) {
+		case *s3.ListObjectsInput:
+			ops = append(ops, "ListObjects")
+			start := sort.SearchStrings(keys, "")
+			if p.Marker != nil {
+				start = sort.SearchStrings(keys, *p.Marker+"\x00")
+			}
+			end := start + pageSize
+			if end > len(keys) {
+				end = len(keys)
+			}
+
+			data := r.Data.(*s3.ListObjectsOutput)
+			data.IsTruncated = aws.Boolean(end < len(keys))
+			for _, k := range keys[start:end] {
+				data.Contents = append(data.Contents, &s3.Object{Key: aws.String(k)})
+			}
+		case *s3.ListObjectVersionsInput:
+			ops = append(ops, "ListObjectVersions")
+			data := r.Data.(*s3.ListObjectVersionsOutput)
+			data.IsTruncated = aws.Boolean(false)
+			for _, k := range keys {
+				data.Versions = append(data.Versions, &s3.ObjectVersion{
+					Key: aws.String(k), VersionID: aws.String("v1"),
+				})
+				data.DeleteMarkers = append(data.DeleteMarkers, &s3.DeleteMarkerEntry{
+					Key: aws.String(k), VersionID: aws.String("v2"),
+				})
+			}
+		case *s3.DeleteObjectsInput:
+			ops = append(ops, fmt.Sprintf("DeleteObjects %d", len(p.Delete.Objects)))
+			data := r.Data.(*s3.DeleteObjectsOutput)
+			for _, o := range p.Delete.Objects {
+				if code := fail(*o.Key); code == "RequestError" {
+					r.Error = apierr.New("AccessDenied", "Access Denied", nil)
+					r.Retryable.Set(false)
+					return
+				} else if code != "" {
+					data.Errors = append(data.Errors, &s3.Error{
+						Key: o.Key, VersionID: o.VersionID,
+						Code: aws.String(code), Message: aws.String(code),
+					})
+				}
+			}
+		}
+	})
+
+	return svc, &ops
+}
+
+func deleteKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("key%04d", i)
+	}
+	return keys
+}
+
+func noFailures(string) string { return "" }
+
+func TestBatchDeleteChunks(t *testing.T) {
+	svc, ops := deleteSvc(nil, 0, noFailures)
+	iter := &s3manager.DeleteObjectsIterator{}
+	for _, k := range deleteKeys(2500) {
+		iter.Objects = append(iter.Objects, &s3.ObjectIdentifier{Key: aws.String(k)})
+	}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: svc})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2500), out.Deleted)
+
+	sort.Strings(*ops)
+	assert.Equal(t, []string{"DeleteObjects 1000", "DeleteObjects 1000", "DeleteObjects 500"}, *ops)
+}
+
+func TestBatchDeleteListIterator(t *testing.T) {
+	svc, ops := deleteSvc(deleteKeys(5), 2, noFailures)
+	iter := s3manager.NewDeleteListIterator(svc, &s3.ListObjectsInput{
+		Bucket: aws.String("bucket"),
+	})
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{
+		S3: svc, BatchSize: 3, Concurrency: 1,
+	})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), out.Deleted)
+
+	sort.Strings(*ops)
+	assert.Equal(t, []string{
+		"DeleteObjects 2", "DeleteObjects 3", "ListObjects", "ListObjects", "ListObjects",
+	}, *ops)
+}
+
+func TestBatchDeleteVersionsIterator(t *testing.T) {
+	svc, _ := deleteSvc(deleteKeys(2), 0, noFailures)
+	iter := s3manager.NewDeleteVersionsIterator(svc, &s3.ListObjectVersionsInput{
+		Bucket: aws.String("bucket"),
+	})
+
+	objs := []string{}
+	for iter.Next() {
+		o := iter.Object()
+		objs = append(objs, *o.Key+"@"+*o.VersionID)
+	}
+	assert.NoError(t, iter.Err())
+	assert.Equal(t, []string{"key0000@v1", "key0001@v1", "key0000@v2", "key0001@v2"}, objs)
+}
+
+func TestBatchDeleteRetry(t *testing.T) {
+	attempts := map[string]int{}
+	svc, ops := deleteSvc(nil, 0, func(key string) string {
+		attempts[key]++
+		switch {
+		case key == "slow" && attempts[key] < 3:
+			return "SlowDown"
+		case key == "denied":
+			return "AccessDenied"
+		}
+		return ""
+	})
+	iter := &s3manager.DeleteObjectsIterator{Objects: []*s3.ObjectIdentifier{
+		{Key: aws.String("ok")},
+		{Key: aws.String("slow")},
+		{Key: aws.String("denied"), VersionID: aws.String("v1")},
+	}}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: svc, RetryDelay: 1})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.Equal(t, int64(2), out.Deleted)
+	assert.Equal(t, []string{"DeleteObjects 3", "DeleteObjects 1", "DeleteObjects 1"}, *ops)
+	assert.Equal(t, 1, attempts["denied"])
+
+	delErr, ok := err.(s3manager.BatchDeleteFailure)
+	assert.True(t, ok)
+	assert.Equal(t, "BatchedErrors", delErr.Code())
+	assert.Equal(t, "1 of 3 objects failed to delete", delErr.Message())
+	assert.Len(t, delErr.Failures(), 1)
+	assert.Equal(t, "denied", *delErr.Failures()[0].Key)
+	assert.Equal(t, "AccessDenied", *delErr.Failures()[0].Code)
+	assert.Contains(t, err.Error(), "denied: AccessDenied: AccessDenied (version v1)")
+}
+
+func TestBatchDeleteRetryLimit(t *testing.T) {
+	svc, ops := deleteSvc(nil, 0, func(string) string { return "InternalError" })
+	iter := &s3manager.DeleteObjectsIterator{Objects: []*s3.ObjectIdentifier{
+		{Key: aws.String("key")},
+	}}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{
+		S3: svc, MaxRetries: 2, RetryDelay: 1,
+	})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.Equal(t, int64(0), out.Deleted)
+	assert.Len(t, *ops, 3)
+	assert.Len(t, err.(s3manager.BatchDeleteFailure).Failures(), 1)
+}
+
+func TestBatchDeleteNoRetries(t *testing.T) {
+	svc, ops := deleteSvc(nil, 0, func(string) string { return "InternalError" })
+	iter := &s3manager.DeleteObjectsIterator{Objects: []*s3.ObjectIdentifier{
+		{Key: aws.String("key")},
+	}}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: svc, MaxRetries: -1})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.Equal(t, int64(0), out.Deleted)
+	assert.Len(t, *ops, 1)
+	assert.Len(t, err.(s3manager.BatchDeleteFailure).Failures(), 1)
+}
+
+func TestBatchDeleteRequestError(t *testing.T) {
+	svc, _ := deleteSvc(nil, 0, func(string) string { return "RequestError" })
+	iter := &s3manager.DeleteObjectsIterator{Objects: []*s3.ObjectIdentifier{
+		{Key: aws.String("a")}, {Key: aws.String("b")},
+	}}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{S3: svc})
+	out, err := d.Delete(&s3manager.BatchDeleteInput{Bucket: aws.String("bucket")}, iter)
+	assert.Equal(t, int64(0), out.Deleted)
+
+	failures := err.(s3manager.BatchDeleteFailure).Failures()
+	assert.Len(t, failures, 2)
+	for _, f := range failures {
+		assert.Equal(t, "AccessDenied", *f.Code)
+		assert.Equal(t, "Access Denied", *f.Message)
+	}
+}
+
+func TestBatchDeleteRequestPayer(t *testing.T) {
+	svc, _ := deleteSvc(nil, 0, noFailures)
+	headers := requestHeaders(svc)
+	iter := &s3manager.DeleteObjectsIterator{}
+	for _, k := range deleteKeys(3) {
+		iter.Objects = append(iter.Objects, &s3.ObjectIdentifier{Key: aws.String(k)})
+	}
+
+	d := s3manager.NewBatchDelete(&s3manager.BatchDeleteOptions{
+		S3:           svc,
+		RequestPayer: aws.String("requester"),
+	})
+	_, err := d.Delete(&s3manager.BatchDeleteInput{
+		Bucket:              aws.String("bucket"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	}, iter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"DeleteObjects requester 111111111111"}, *headers)
+}
@@ -0,0 +1,392 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+var batchModTime = time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// batchSvc returns a client backed by an in-memory bucket and a log of the
+// operations and keys it was called with.
+func batchSvc(objects map[string][]byte) (*s3.S3, *[]string) {
+	var m sync.Mutex
+	ops := []string{}
+
+	svc := s3.New(nil)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch p := r.Params.(type) {
+		case *s3.ListObjectsInput:
+			ops = append(ops, "ListObjects")
+			keys := []string{}
+			for k := range objects {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			data := r.Data.(*s3.ListObjectsOutput)
+			for _, k := range keys {
+				data.Contents = append(data.Contents, &s3.Object{
+					Key:          aws.String(k),
+					Size:         aws.Long(int64(len(objects[k]))),
+					LastModified: aws.Time(batchModTime),
+				})
+			}
+		case *s3.PutObjectInput:
+			ops = append(ops, "PutObject "+*p.Key)
+			b, _ := ioutil.ReadAll(p.Body)
+			objects[*p.Key] = b
+		case *s3.GetObjectInput:
+			ops = append(ops, "GetObject "+*p.Key)
+			data := objects[*p.Key]
+			rng := regexp.MustCompile(`bytes=(\d+)-(\d+)`).FindStringSubmatch(*p.Range)
+			start, _ := strconv.ParseInt(rng[1], 10, 64)
+			fin, _ := strconv.ParseInt(rng[2], 10, 64)
+			if fin++; fin > int64(len(data)) {
+				fin = int64(len(data))
+			}
+
+			out := r.Data.(*s3.GetObjectOutput)
+			out.Body = ioutil.NopCloser(bytes.NewReader(data[start:fin]))
+			out.ContentRange = aws.String(fmt.Sprintf("bytes %d-%d/%d", start, fin, len(data)))
+		case *s3.DeleteObjectInput:
+			ops = append(ops, "DeleteObject "+*p.Key)
+			delete(objects, *p.Key)
+		}
+	})
+
+	return svc, &ops
+}
+
+// batchDir creates a temporary directory containing the files.
+func batchDir(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "s3manager-batch")
+	assert.NoError(t, err)
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(p), 0775)
+		assert.NoError(t, ioutil.WriteFile(p, []byte(content), 0664))
+		os.Chtimes(p, batchModTime, batchModTime)
+	}
+	return dir
+}
+
+func batchActions(out *s3manager.BatchOutput) map[string]s3manager.BatchAction {
+	actions := map[string]s3manager.BatchAction{}
+	for _, r := range out.Results {
+		actions[r.Key] = r.Action
+	}
+	return actions
+}
+
+func TestBatchUploadDirectory(t *testing.T) {
+	dir := batchDir(t, map[string]string{
+		"a.txt":       "a",
+		"sub/b.txt":   "bb",
+		"sub/c.log":   "ccc",
+		"skip/d.txt":  "dddd",
+		"sub/e/f.txt": "fffff",
+	})
+	defer os.RemoveAll(dir)
+
+	objects := map[string][]byte{}
+	s, ops := batchSvc(objects)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{
+		S3:      s,
+		Include: []string{"*.txt"},
+		Exclude: []string{"skip/*"},
+	})
+	out, err := b.UploadDirectory(dir, &s3manager.UploadInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("prefix/"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(out.Results))
+	assert.Equal(t, "prefix/a.txt", out.Results[0].Key)
+	assert.Equal(t, "prefix/sub/b.txt", out.Results[1].Key)
+	assert.Equal(t, "prefix/sub/e/f.txt", out.Results[2].Key)
+	assert.Equal(t, []byte("bb"), objects["prefix/sub/b.txt"])
+
+	sort.Strings(*ops)
+	assert.Equal(t, []string{"PutObject prefix/a.txt", "PutObject prefix/sub/b.txt",
+		"PutObject prefix/sub/e/f.txt"}, *ops)
+}
+
+func TestBatchDownloadPrefix(t *testing.T) {
+	dir := batchDir(t, nil)
+	defer os.RemoveAll(dir)
+
+	s, _ := batchSvc(map[string][]byte{
+		"prefix/a.txt":     []byte("a"),
+		"prefix/sub/b.txt": []byte("bb"),
+		"prefix/sub/":      {},
+		"prefix/empty":     {},
+	})
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s})
+	out, err := b.DownloadPrefix(dir, &s3.ListObjectsInput{
+		Bucket: aws.String("bucket"),
+		Prefix: aws.String("prefix/"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(out.Results))
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "sub", "b.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "bb", string(data))
+
+	info, err := os.Stat(filepath.Join(dir, "empty"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), info.Size())
+	assert.True(t, info.ModTime().Equal(batchModTime))
+}
+
+func TestBatchDownloadPrefixOutsideDir(t *testing.T) {
+	dir := batchDir(t, nil)
+	defer os.RemoveAll(dir)
+
+	s, ops := batchSvc(map[string][]byte{"prefix/../../escape": []byte("x")})
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s})
+	_, err := b.DownloadPrefix(dir, &s3.ListObjectsInput{
+		Bucket: aws.String("bucket"),
+		Prefix: aws.String("prefix/"),
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, []string{"ListObjects"}, *ops)
+	failures := err.(s3manager.BatchFailure).Failures()
+	assert.Equal(t, 1, len(failures))
+	assert.Equal(t, "InvalidKey", failures[0].Err.(awserr.Error).Code())
+}
+
+func TestBatchSyncUpload(t *testing.T) {
+	dir := batchDir(t, map[string]string{
+		"same.txt":    "same",
+		"changed.txt": "changed",
+		"new.txt":     "new",
+	})
+	defer os.RemoveAll(dir)
+
+	objects := map[string][]byte{
+		"p/same.txt":    []byte("same"),
+		"p/changed.txt": []byte("old"),
+		"p/extra.txt":   []byte("extra"),
+	}
+	s, ops := batchSvc(objects)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s, Delete: true, Concurrency: 1})
+	out, err := b.Sync(&s3manager.SyncInput{
+		Dir:       dir,
+		Bucket:    aws.String("bucket"),
+		Prefix:    aws.String("p/"),
+		Direction: s3manager.SyncUpload,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]s3manager.BatchAction{
+		"p/same.txt":    s3manager.BatchActionSkip,
+		"p/changed.txt": s3manager.BatchActionUpload,
+		"p/new.txt":     s3manager.BatchActionUpload,
+		"p/extra.txt":   s3manager.BatchActionDelete,
+	}, batchActions(out))
+	assert.Equal(t, []string{"ListObjects", "PutObject p/changed.txt",
+		"PutObject p/new.txt", "DeleteObject p/extra.txt"}, *ops)
+	_, ok := objects["p/extra.txt"]
+	assert.False(t, ok)
+}
+
+func TestBatchSyncDownload(t *testing.T) {
+	dir := batchDir(t, map[string]string{
+		"same.txt":  "same",
+		"local.txt": "local",
+	})
+	defer os.RemoveAll(dir)
+
+	s, ops := batchSvc(map[string][]byte{
+		"p/same.txt":   []byte("same"),
+		"p/remote.txt": []byte("remote"),
+	})
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s, Delete: true})
+	out, err := b.Sync(&s3manager.SyncInput{
+		Dir:       dir,
+		Bucket:    aws.String("bucket"),
+		Prefix:    aws.String("p/"),
+		Direction: s3manager.SyncDownload,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]s3manager.BatchAction{
+		"p/same.txt":   s3manager.BatchActionSkip,
+		"p/remote.txt": s3manager.BatchActionDownload,
+		"p/local.txt":  s3manager.BatchActionDelete,
+	}, batchActions(out))
+	assert.Equal(t, []string{"ListObjects", "GetObject p/remote.txt"}, *ops)
+
+	_, err = os.Stat(filepath.Join(dir, "local.txt"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestBatchSyncETag(t *testing.T) {
+	dir := batchDir(t, map[string]string{"a.txt": "abcd", "b.txt": "wxyz"})
+	defer os.RemoveAll(dir)
+
+	s, ops := batchSvc(map[string][]byte{"a.txt": []byte("wxyz"), "b.txt": []byte("wxyz")})
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		if data, ok := r.Data.(*s3.ListObjectsOutput); ok {
+			// md5 of "wxyz", a.txt differs with the same size and time.
+			for _, obj := range data.Contents {
+				obj.ETag = aws.String(`"a7c3c2aa70d99921f9fb23ac87382997"`)
+			}
+		}
+	})
+
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s, Compare: s3manager.CompareETag})
+	out, err := b.Sync(&s3manager.SyncInput{Dir: dir, Bucket: aws.String("bucket")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]s3manager.BatchAction{
+		"a.txt": s3manager.BatchActionUpload,
+		"b.txt": s3manager.BatchActionSkip,
+	}, batchActions(out))
+	assert.Equal(t, []string{"ListObjects", "PutObject a.txt"}, *ops)
+}
+
+func TestBatchNegativeConcurrency(t *testing.T) {
+	dir := batchDir(t, map[string]string{"a.txt": "a", "b.txt": "bb"})
+	defer os.RemoveAll(dir)
+
+	objects := map[string][]byte{}
+	s, _ := batchSvc(objects)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s, Concurrency: -1})
+	out, err := b.UploadDirectory(dir, &s3manager.UploadInput{Bucket: aws.String("bucket")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(out.Results))
+	assert.Equal(t, []byte("bb"), objects["b.txt"])
+}
+
+func TestBatchDryRun(t *testing.T) {
+	dir := batchDir(t, map[string]string{"a.txt": "a"})
+	defer os.RemoveAll(dir)
+
+	objects := map[string][]byte{"extra.txt": []byte("extra")}
+	s, ops := batchSvc(objects)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s, Delete: true, DryRun: true})
+	out, err := b.Sync(&s3manager.SyncInput{Dir: dir, Bucket: aws.String("bucket")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]s3manager.BatchAction{
+		"a.txt":     s3manager.BatchActionUpload,
+		"extra.txt": s3manager.BatchActionDelete,
+	}, batchActions(out))
+	assert.Equal(t, []string{"ListObjects"}, *ops)
+	assert.Equal(t, 1, len(objects))
+}
+
+func TestBatchErrorReport(t *testing.T) {
+	dir := batchDir(t, map[string]string{"a.txt": "a", "b.txt": "b"})
+	defer os.RemoveAll(dir)
+
+	s, _ := batchSvc(map[string][]byte{})
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		if p, ok := r.Params.(*s3.PutObjectInput); ok && *p.Key == "b.txt" {
+			r.HTTPResponse.StatusCode = 400
+		}
+	})
+
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{S3: s})
+	out, err := b.UploadDirectory(dir, &s3manager.UploadInput{Bucket: aws.String("bucket")})
+
+	assert.Error(t, err)
+	assert.Equal(t, 2, len(out.Results))
+	assert.NoError(t, out.Results[0].Err)
+
+	berr := err.(s3manager.BatchFailure)
+	assert.Equal(t, "BatchedErrors", berr.Code())
+	assert.Equal(t, 1, len(berr.Failures()))
+	assert.Equal(t, "b.txt", berr.Failures()[0].Key)
+	assert.Contains(t, berr.Error(), "upload b.txt")
+}
+
+func TestBatchRequestPayerSync(t *testing.T) {
+	dir := batchDir(t, map[string]string{"new.txt": "new"})
+	defer os.RemoveAll(dir)
+
+	s, _ := batchSvc(map[string][]byte{"p/extra.txt": []byte("extra")})
+	headers := requestHeaders(s)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{
+		S3:                  s,
+		Delete:              true,
+		Concurrency:         1,
+		RequestPayer:        aws.String("requester"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+	_, err := b.Sync(&s3manager.SyncInput{
+		Dir:       dir,
+		Bucket:    aws.String("bucket"),
+		Prefix:    aws.String("p/"),
+		Direction: s3manager.SyncUpload,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ListObjects requester 111111111111",
+		"PutObject requester 111111111111",
+		"DeleteObject requester 111111111111",
+	}, *headers)
+}
+
+func TestBatchRequestPayerDownloadPrefix(t *testing.T) {
+	dir := batchDir(t, nil)
+	defer os.RemoveAll(dir)
+
+	s, _ := batchSvc(map[string][]byte{"prefix/a.txt": []byte("a")})
+	headers := requestHeaders(s)
+	b := s3manager.NewBatcher(&s3manager.BatchOptions{
+		S3:                  s,
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+	_, err := b.DownloadPrefix(dir, &s3.ListObjectsInput{
+		Bucket:       aws.String("bucket"),
+		Prefix:       aws.String("prefix/"),
+		RequestPayer: aws.String("requester"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ListObjects requester 111111111111",
+		"GetObject requester 111111111111",
+	}, *headers)
+}
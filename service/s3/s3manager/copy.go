@@ -0,0 +1,605 @@
+package s3manager
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+)
+
+// The maximum size of an object which can be copied with a single
+// CopyObject request, and the maximum size of a single copied part.
+var MaxCopyObjectSize int64 = 1024 * 1024 * 1024 * 5
+
+// The default part size to split large copies into.
+var DefaultCopyPartSize int64 = 1024 * 1024 * 64
+
+// The default number of goroutines to spin up when using Copy().
+var DefaultCopyConcurrency = 5
+
+// The default set of options used when opts is nil in Copy().
+var DefaultCopyOptions = &CopyOptions{
+	PartSize:          DefaultCopyPartSize,
+	Concurrency:       DefaultCopyConcurrency,
+	LeavePartsOnError: false,
+	S3:                nil,
+}
+
+// CopyInput contains all input for copy requests to Amazon S3.
+type CopyInput struct {
+	// The canned ACL to apply to the object.
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+
+	// Specifies caching behavior along the request/reply chain.
+	CacheControl *string `location:"header" locationName:"Cache-Control" type:"string"`
+
+	// Specifies presentational information for the object.
+	ContentDisposition *string `location:"header" locationName:"Content-Disposition" type:"string"`
+
+	// Specifies what content encodings have been applied to the object and thus
+	// what decoding mechanisms must be applied to obtain the media-type referenced
+	// by the Content-Type header field.
+	ContentEncoding *string `location:"header" locationName:"Content-Encoding" type:"string"`
+
+	// The language the content is in.
+	ContentLanguage *string `location:"header" locationName:"Content-Language" type:"string"`
+
+	// A standard MIME type describing the format of the object data.
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+
+	// The name of the source bucket and key name of the source object, separated
+	// by a slash (/). Must be URL-encoded. A specific version may be selected by
+	// appending ?versionId=<version>.
+	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
+
+	// Copies the object if its entity tag (ETag) matches the specified tag.
+	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
+
+	// Copies the object if it has been modified since the specified time.
+	CopySourceIfModifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-modified-since" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Copies the object if its entity tag (ETag) is different than the specified
+	// ETag.
+	CopySourceIfNoneMatch *string `location:"header" locationName:"x-amz-copy-source-if-none-match" type:"string"`
+
+	// Copies the object if it hasn't been modified since the specified time.
+	CopySourceIfUnmodifiedSince *time.Time `location:"header" locationName:"x-amz-copy-source-if-unmodified-since" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Specifies the algorithm to use when decrypting the source object (e.g.,
+	// AES256).
+	CopySourceSSECustomerAlgorithm *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
+	// the source object. The encryption key provided in this header must be one
+	// that was used when the source object was created.
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	CopySourceSSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// The account ID of the expected destination bucket owner. If the bucket is
+	// owned by a different account, the request fails with an HTTP 403 (Access
+	// Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
+	// The account ID of the expected source bucket owner. If the source bucket
+	// is owned by a different account, the request fails with an HTTP 403 (Access
+	// Denied) error.
+	ExpectedSourceBucketOwner *string `location:"header" locationName:"x-amz-source-expected-bucket-owner" type:"string"`
+
+	// The date and time at which the object is no longer cacheable.
+	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
+
+	// Gives the grantee READ, READ_ACP, and WRITE_ACP permissions on the object.
+	GrantFullControl *string `location:"header" locationName:"x-amz-grant-full-control" type:"string"`
+
+	// Allows grantee to read the object data and its metadata.
+	GrantRead *string `location:"header" locationName:"x-amz-grant-read" type:"string"`
+
+	// Allows grantee to read the object ACL.
+	GrantReadACP *string `location:"header" locationName:"x-amz-grant-read-acp" type:"string"`
+
+	// Allows grantee to write the ACL for the applicable object.
+	GrantWriteACP *string `location:"header" locationName:"x-amz-grant-write-acp" type:"string"`
+
+	Key *string `location:"uri" locationName:"Key" type:"string" required:"true"`
+
+	// A map of metadata to store with the object in S3.
+	Metadata map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+
+	// Specifies whether the metadata is copied from the source object or replaced
+	// with metadata provided in the request. Unless set to REPLACE, the metadata
+	// of the source object is also carried over to multipart copies.
+	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string"`
+
+	// Confirms that the requester knows that she or he will be charged for the
+	// request. Bucket owners need not specify this parameter in their requests.
+	// Documentation on downloading objects from requester pays buckets can be found
+	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+
+	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
+	// aws:kms).
+	SSECustomerAlgorithm *string `location:"header" locationName:"x-amz-server-side-encryption-customer-algorithm" type:"string"`
+
+	// Specifies the customer-provided encryption key for Amazon S3 to use in encrypting
+	// data. This value is used to store the object and then it is discarded; Amazon
+	// does not store the encryption key. The key must be appropriate for use with
+	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
+	// header.
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+
+	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
+	// Amazon S3 uses this header for a message integrity check to ensure the encryption
+	// key was transmitted without error.
+	SSECustomerKeyMD5 *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key-MD5" type:"string"`
+
+	// Specifies the AWS KMS key ID to use for object encryption. All GET and PUT
+	// requests for an object protected by AWS KMS will fail if not made via SSL
+	// or using SigV4. Documentation on configuring any of the officially supported
+	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+
+	// The Server-side encryption algorithm used when storing this object in S3
+	// (e.g., AES256, aws:kms).
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+
+	// The type of storage to use for the object. Defaults to 'STANDARD'.
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+
+	// If the bucket is configured as a website, redirects requests for this object
+	// to another object in the same bucket or to an external URL. Amazon S3 stores
+	// the value of this header in the object metadata.
+	WebsiteRedirectLocation *string `location:"header" locationName:"x-amz-website-redirect-location" type:"string"`
+}
+
+// CopyOutput represents a response from the Copy() call.
+type CopyOutput struct {
+	// The URL of the copied object.
+	Location string
+
+	// The entity tag of the copied object.
+	ETag string
+
+	// The ID for a multipart copy to S3. In the case of an error the error
+	// can be cast to the MultiUploadFailure interface to extract the upload ID.
+	UploadID string
+}
+
+// CopyOptions keeps tracks of extra options to pass to a Copy() call.
+type CopyOptions struct {
+	// The size (in bytes) of each part of a multipart copy. Objects no larger
+	// than PartSize are copied with a single CopyObject request. The part size
+	// must be between 5MB and 5GB, and if this value is set to zero, the
+	// DefaultCopyPartSize value will be used.
+	PartSize int64
+
+	// The number of goroutines to spin up in parallel when copying parts.
+	// If this is set to zero, the DefaultCopyConcurrency value will be used.
+	Concurrency int
+
+	// Setting this value to true will cause the SDK to avoid calling
+	// AbortMultipartUpload on a failure, leaving all successfully copied
+	// parts on S3 for manual recovery.
+	//
+	// Note that storing parts of an incomplete multipart upload counts towards
+	// space usage on S3 and will add additional costs if not cleaned up.
+	LeavePartsOnError bool
+
+	// The client to use when copying to S3. Leave this as nil to use the
+	// default S3 client.
+	S3 *s3.S3
+
+	// The client to use when reading the source object's size and metadata.
+	// For a copy between regions set this to a client configured for the
+	// source bucket's region. Leave this as nil to use S3.
+	SourceS3 *s3.S3
+
+	// The RequestPayer to send with every request of a copy whose input does
+	// not set its own. Set this to s3.RequestPayerRequester to copy between requester pays
+	// buckets without setting it on each CopyInput.
+	RequestPayer *string
+
+	// The ExpectedBucketOwner to send with every request of a copy whose
+	// input does not set its own.
+	ExpectedBucketOwner *string
+
+	// The ExpectedSourceBucketOwner to send with every request reading the
+	// source of a copy whose input does not set its own.
+	ExpectedSourceBucketOwner *string
+}
+
+// NewCopier creates a new Copier object to copy objects within S3. Pass in
+// an optional opts structure to customize the copier behavior.
+func NewCopier(opts *CopyOptions) *Copier {
+	if opts == nil {
+		opts = DefaultCopyOptions
+	}
+	return &Copier{opts: opts}
+}
+
+// The Copier structure that calls Copy(). It is safe to call Copy() on this
+// structure for multiple objects and across concurrent goroutines.
+type Copier struct {
+	opts *CopyOptions
+}
+
+// Copy copies an object within S3. The source object is inspected with a
+// HeadObject request first; objects no larger than PartSize are copied with
+// a single CopyObject request, and larger objects are copied with concurrent
+// UploadPartCopy requests of PartSize bytes each. This allows objects larger
+// than the 5GB CopyObject limit to be copied.
+//
+// If opts is set to nil, DefaultCopyOptions will be used.
+//
+// It is safe to call this method for multiple objects and across concurrent
+// goroutines.
+func (c *Copier) Copy(input *CopyInput) (*CopyOutput, error) {
+	i := copier{in: input, opts: *c.opts}
+	return i.copy()
+}
+
+// internal structure to manage a copy within S3.
+type copier struct {
+	in   *CopyInput
+	opts CopyOptions
+
+	head *s3.HeadObjectOutput
+	size int64
+}
+
+// internal logic for deciding whether to copy with a single CopyObject
+// request or use a multipart copy.
+func (c *copier) copy() (*CopyOutput, error) {
+	c.init()
+
+	if c.opts.PartSize < MinUploadPartSize || c.opts.PartSize > MaxCopyObjectSize {
+		msg := fmt.Sprintf("part size must be between %d and %d bytes",
+			MinUploadPartSize, MaxCopyObjectSize)
+		return nil, apierr.New("ConfigError", msg, nil)
+	}
+
+	if err := c.headSource(); err != nil {
+		return nil, err
+	}
+
+	if c.size <= c.opts.PartSize {
+		return c.singlePart()
+	}
+
+	mc := multicopier{copier: c}
+	return mc.copy()
+}
+
+// init will initialize all default options.
+func (c *copier) init() {
+	if c.opts.S3 == nil {
+		c.opts.S3 = s3.New(nil)
+	}
+	if c.opts.SourceS3 == nil {
+		c.opts.SourceS3 = c.opts.S3
+	}
+	if c.opts.Concurrency == 0 {
+		c.opts.Concurrency = DefaultCopyConcurrency
+	}
+	if c.opts.PartSize == 0 {
+		c.opts.PartSize = DefaultCopyPartSize
+	}
+
+	// Apply the request defaults to a copy of the caller's input
+	in := *c.in
+	in.RequestPayer = stringDefault(in.RequestPayer, c.opts.RequestPayer)
+	in.ExpectedBucketOwner = stringDefault(in.ExpectedBucketOwner, c.opts.ExpectedBucketOwner)
+	in.ExpectedSourceBucketOwner = stringDefault(in.ExpectedSourceBucketOwner, c.opts.ExpectedSourceBucketOwner)
+	c.in = &in
+}
+
+// headSource retrieves the size and metadata of the source object.
+func (c *copier) headSource() error {
+	if c.in.CopySource == nil {
+		return apierr.New("InvalidCopySource", "copy source must be set", nil)
+	}
+
+	u, err := url.Parse("/" + strings.TrimPrefix(*c.in.CopySource, "/"))
+	if err != nil {
+		return apierr.New("InvalidCopySource", "copy source could not be parsed", err)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		msg := fmt.Sprintf("copy source %q must be in the form bucket/key", *c.in.CopySource)
+		return apierr.New("InvalidCopySource", msg, nil)
+	}
+
+	params := &s3.HeadObjectInput{
+		Bucket:               &parts[0],
+		Key:                  &parts[1],
+		IfMatch:              c.in.CopySourceIfMatch,
+		IfNoneMatch:          c.in.CopySourceIfNoneMatch,
+		IfModifiedSince:      c.in.CopySourceIfModifiedSince,
+		IfUnmodifiedSince:    c.in.CopySourceIfUnmodifiedSince,
+		RequestPayer:         c.in.RequestPayer,
+		ExpectedBucketOwner:  c.in.ExpectedSourceBucketOwner,
+		SSECustomerAlgorithm: c.in.CopySourceSSECustomerAlgorithm,
+		SSECustomerKey:       c.in.CopySourceSSECustomerKey,
+		SSECustomerKeyMD5:    c.in.CopySourceSSECustomerKeyMD5,
+	}
+	if v := u.Query().Get("versionId"); v != "" {
+		params.VersionID = &v
+	}
+
+	if c.head, err = c.opts.SourceS3.HeadObject(params); err != nil {
+		return err
+	}
+	if c.head.ContentLength != nil {
+		c.size = *c.head.ContentLength
+	}
+
+	// try to adjust partSize if it is too small
+	if c.size/c.opts.PartSize >= int64(MaxUploadParts) {
+		c.opts.PartSize = c.size/int64(MaxUploadParts) + 1
+	}
+	return nil
+}
+
+// singlePart copies the object with a regular CopyObject request.
+func (c *copier) singlePart() (*CopyOutput, error) {
+	params := &s3.CopyObjectInput{}
+	awsutil.Copy(params, c.in)
+
+	req, resp := c.opts.S3.CopyObjectRequest(params)
+	if err := req.Send(); err != nil {
+		return nil, err
+	}
+
+	out := &CopyOutput{Location: req.HTTPRequest.URL.String()}
+	if resp.CopyObjectResult != nil && resp.CopyObjectResult.ETag != nil {
+		out.ETag = *resp.CopyObjectResult.ETag
+	}
+	return out, nil
+}
+
+// internal structure to manage a specific multipart copy within S3.
+type multicopier struct {
+	*copier
+	wg       sync.WaitGroup
+	m        sync.Mutex
+	err      error
+	uploadID string
+	parts    completedParts
+}
+
+// keeps track of a single byte range of the source being copied.
+type copyChunk struct {
+	num        int64
+	start, end int64
+}
+
+// copy will perform a multipart copy of the source object.
+func (c *multicopier) copy() (*CopyOutput, error) {
+	params := &s3.CreateMultipartUploadInput{}
+	awsutil.Copy(params, c.in)
+	c.sourceMetadata(params)
+
+	// Create the multipart
+	resp, err := c.opts.S3.CreateMultipartUpload(params)
+	if err != nil {
+		return nil, err
+	}
+	c.uploadID = *resp.UploadID
+
+	// Create the workers
+	ch := make(chan copyChunk, c.opts.Concurrency)
+	for i := 0; i < c.opts.Concurrency; i++ {
+		c.wg.Add(1)
+		go c.readChunk(ch)
+	}
+
+	// Queue the byte range of each part
+	var num int64 = 1
+	for start := int64(0); start < c.size && c.geterr() == nil; start += c.opts.PartSize {
+		end := start + c.opts.PartSize - 1
+		if end >= c.size {
+			end = c.size - 1
+		}
+		ch <- copyChunk{num: num, start: start, end: end}
+		num++
+	}
+
+	// Close the channel, wait for workers, and complete the copy
+	close(ch)
+	c.wg.Wait()
+	complete := c.complete()
+
+	if err := c.geterr(); err != nil {
+		var berr *apierr.BaseError
+		switch t := err.(type) {
+		case *apierr.BaseError:
+			berr = t
+		default:
+			berr = apierr.New("MultipartCopy", "copy multipart failed", err)
+		}
+		return nil, &multiUploadError{
+			BaseError: berr,
+			uploadID:  c.uploadID,
+		}
+	}
+
+	out := &CopyOutput{UploadID: c.uploadID}
+	if complete.Location != nil {
+		out.Location = *complete.Location
+	}
+	if complete.ETag != nil {
+		out.ETag = *complete.ETag
+	}
+	return out, nil
+}
+
+// sourceMetadata copies the source object's metadata onto params, as
+// CopyObject would, unless the metadata directive is REPLACE. Values set
+// on the input take precedence.
+func (c *multicopier) sourceMetadata(params *s3.CreateMultipartUploadInput) {
+	if c.in.MetadataDirective != nil && *c.in.MetadataDirective == string(s3.MetadataDirectiveReplace) {
+		return
+	}
+
+	h := c.head
+	if params.CacheControl == nil {
+		params.CacheControl = h.CacheControl
+	}
+	if params.ContentDisposition == nil {
+		params.ContentDisposition = h.ContentDisposition
+	}
+	if params.ContentEncoding == nil {
+		params.ContentEncoding = h.ContentEncoding
+	}
+	if params.ContentLanguage == nil {
+		params.ContentLanguage = h.ContentLanguage
+	}
+	if params.ContentType == nil {
+		params.ContentType = h.ContentType
+	}
+	if params.Expires == nil {
+		params.Expires = h.Expires
+	}
+	if params.WebsiteRedirectLocation == nil {
+		params.WebsiteRedirectLocation = h.WebsiteRedirectLocation
+	}
+	if len(params.Metadata) == 0 {
+		params.Metadata = h.Metadata
+	}
+}
+
+// readChunk runs in worker goroutines to pull chunks off of the ch channel
+// and send() them as UploadPartCopy requests.
+func (c *multicopier) readChunk(ch chan copyChunk) {
+	defer c.wg.Done()
+	for {
+		data, ok := <-ch
+
+		if !ok {
+			break
+		}
+
+		if c.geterr() == nil {
+			if err := c.send(data); err != nil {
+				c.seterr(err)
+			}
+		}
+	}
+}
+
+// send performs an UploadPartCopy request and keeps track of the completed
+// part information. Unless the input sets its own condition, each part is
+// copied only if the source still has the ETag seen by HeadObject, so a
+// source replaced during the copy fails the copy rather than mixing data.
+func (c *multicopier) send(ch copyChunk) error {
+	ifMatch := c.in.CopySourceIfMatch
+	if ifMatch == nil {
+		ifMatch = c.head.ETag
+	}
+
+	rng := fmt.Sprintf("bytes=%d-%d", ch.start, ch.end)
+	resp, err := c.opts.S3.UploadPartCopy(&s3.UploadPartCopyInput{
+		Bucket:                         c.in.Bucket,
+		Key:                            c.in.Key,
+		CopySource:                     c.in.CopySource,
+		CopySourceRange:                &rng,
+		CopySourceIfMatch:              ifMatch,
+		CopySourceIfNoneMatch:          c.in.CopySourceIfNoneMatch,
+		CopySourceIfModifiedSince:      c.in.CopySourceIfModifiedSince,
+		CopySourceIfUnmodifiedSince:    c.in.CopySourceIfUnmodifiedSince,
+		CopySourceSSECustomerAlgorithm: c.in.CopySourceSSECustomerAlgorithm,
+		CopySourceSSECustomerKey:       c.in.CopySourceSSECustomerKey,
+		CopySourceSSECustomerKeyMD5:    c.in.CopySourceSSECustomerKeyMD5,
+		SSECustomerAlgorithm:           c.in.SSECustomerAlgorithm,
+		SSECustomerKey:                 c.in.SSECustomerKey,
+		SSECustomerKeyMD5:              c.in.SSECustomerKeyMD5,
+		RequestPayer:                   c.in.RequestPayer,
+		ExpectedBucketOwner:            c.in.ExpectedBucketOwner,
+		ExpectedSourceBucketOwner:      c.in.ExpectedSourceBucketOwner,
+		UploadID:                       &c.uploadID,
+		PartNumber:                     &ch.num,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	completed := &s3.CompletedPart{PartNumber: &ch.num}
+	if resp.CopyPartResult != nil {
+		completed.ETag = resp.CopyPartResult.ETag
+	}
+
+	c.m.Lock()
+	c.parts = append(c.parts, completed)
+	c.m.Unlock()
+
+	return nil
+}
+
+// geterr is a thread-safe getter for the error object
+func (c *multicopier) geterr() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return c.err
+}
+
+// seterr is a thread-safe setter for the error object
+func (c *multicopier) seterr(e error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	c.err = e
+}
+
+// fail will abort the multipart unless LeavePartsOnError is set to true.
+func (c *multicopier) fail() {
+	if c.opts.LeavePartsOnError {
+		return
+	}
+
+	c.opts.S3.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+		Bucket:              c.in.Bucket,
+		Key:                 c.in.Key,
+		UploadID:            &c.uploadID,
+		RequestPayer:        c.in.RequestPayer,
+		ExpectedBucketOwner: c.in.ExpectedBucketOwner,
+	})
+}
+
+// complete successfully completes a multipart copy and returns the response.
+func (c *multicopier) complete() *s3.CompleteMultipartUploadOutput {
+	if c.geterr() != nil {
+		c.fail()
+		return nil
+	}
+
+	// Parts must be sorted in PartNumber order.
+	sort.Sort(c.parts)
+
+	resp, err := c.opts.S3.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
+		Bucket:              c.in.Bucket,
+		Key:                 c.in.Key,
+		UploadID:            &c.uploadID,
+		RequestPayer:        c.in.RequestPayer,
+		ExpectedBucketOwner: c.in.ExpectedBucketOwner,
+		MultipartUpload:     &s3.CompletedMultipartUpload{Parts: c.parts},
+	})
+	if err != nil {
+		c.seterr(err)
+		c.fail()
+	}
+
+	return resp
+}
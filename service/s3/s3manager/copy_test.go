@@ -0,0 +1,296 @@
+package s3manager_test
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+// copySvc returns a client whose source object is size bytes long and a
+// log of the operations and params it was called with. UploadPartCopy
+// fails for part failPart.
+func copySvc(size int64, failPart int64) (*s3.S3, *[]string, *[]interface{}) {
+	var m sync.Mutex
+	names := []string{}
+	params := []interface{}{}
+
+	svc := s3.New(nil)
+	svc.Handlers.Unmarshal.Clear()
+	svc.Handlers.UnmarshalMeta.Clear()
+	svc.Handlers.UnmarshalError.Clear()
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		names = append(names, r.Operation.Name)
+		params = append(params, r.Params)
+
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+
+		switch data := r.Data.(type) {
+		case *s3.HeadObjectOutput:
+			data.ContentLength = aws.Long(size)
+			data.ETag = aws.String(`"SRCETAG"`)
+			data.ContentType = aws.String("text/plain")
+			data.CacheControl = aws.String("max-age=60")
+			data.Metadata = map[string]*string{"Foo": aws.String("bar")}
+		case *s3.CopyObjectOutput:
+			data.CopyObjectResult = &s3.CopyObjectResult{ETag: aws.String(`"COPYETAG"`)}
+		case *s3.CreateMultipartUploadOutput:
+			data.UploadID = aws.String("UPLOAD-ID")
+		case *s3.UploadPartCopyOutput:
+			num := *r.Params.(*s3.UploadPartCopyInput).PartNumber
+			if num == failPart {
+				r.Error = apierr.New("AccessDenied", "Access Denied", nil)
+				r.Retryable.Set(false)
+				return
+			}
+			data.CopyPartResult = &s3.CopyPartResult{ETag: aws.String(fmt.Sprintf("ETAG%d", num))}
+		case *s3.CompleteMultipartUploadOutput:
+			data.Location = aws.String("https://location")
+			data.ETag = aws.String(`"MULTIETAG"`)
+		}
+	})
+
+	return svc, &names, &params
+}
+
+func TestCopySinglePart(t *testing.T) {
+	s, ops, args := copySvc(1024, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: s})
+	resp, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("src/srckey"),
+		ACL:        aws.String("public-read"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"HeadObject", "CopyObject"}, *ops)
+	assert.Equal(t, `"COPYETAG"`, resp.ETag)
+	assert.Equal(t, "", resp.UploadID)
+
+	head := (*args)[0].(*s3.HeadObjectInput)
+	assert.Equal(t, "src", *head.Bucket)
+	assert.Equal(t, "srckey", *head.Key)
+
+	cp := (*args)[1].(*s3.CopyObjectInput)
+	assert.Equal(t, "src/srckey", *cp.CopySource)
+	assert.Equal(t, "public-read", *cp.ACL)
+}
+
+func TestCopyMultipart(t *testing.T) {
+	s, ops, args := copySvc(1024*1024*12, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: s, PartSize: 1024 * 1024 * 5})
+	resp, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:               aws.String("dst"),
+		Key:                  aws.String("dstkey"),
+		CopySource:           aws.String("src/src%20key?versionId=v1"),
+		ACL:                  aws.String("bucket-owner-full-control"),
+		ServerSideEncryption: aws.String("AES256"),
+		ContentType:          aws.String("content/type"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"HeadObject", "CreateMultipartUpload", "UploadPartCopy",
+		"UploadPartCopy", "UploadPartCopy", "CompleteMultipartUpload"}, *ops)
+	assert.Equal(t, "UPLOAD-ID", resp.UploadID)
+	assert.Equal(t, "https://location", resp.Location)
+	assert.Equal(t, `"MULTIETAG"`, resp.ETag)
+
+	head := (*args)[0].(*s3.HeadObjectInput)
+	assert.Equal(t, "src key", *head.Key)
+	assert.Equal(t, "v1", *head.VersionID)
+
+	// Options and source metadata carried over to the new object
+	create := (*args)[1].(*s3.CreateMultipartUploadInput)
+	assert.Equal(t, "bucket-owner-full-control", *create.ACL)
+	assert.Equal(t, "AES256", *create.ServerSideEncryption)
+	assert.Equal(t, "content/type", *create.ContentType)
+	assert.Equal(t, "max-age=60", *create.CacheControl)
+	assert.Equal(t, "bar", *create.Metadata["Foo"])
+
+	ranges := []string{}
+	for _, a := range (*args)[2:5] {
+		p := a.(*s3.UploadPartCopyInput)
+		assert.Equal(t, "UPLOAD-ID", *p.UploadID)
+		assert.Equal(t, "src/src%20key?versionId=v1", *p.CopySource)
+		assert.Equal(t, `"SRCETAG"`, *p.CopySourceIfMatch)
+		ranges = append(ranges, fmt.Sprintf("%d %s", *p.PartNumber, *p.CopySourceRange))
+	}
+	sort.Strings(ranges)
+	assert.Equal(t, []string{
+		"1 bytes=0-5242879", "2 bytes=5242880-10485759", "3 bytes=10485760-12582911",
+	}, ranges)
+
+	complete := (*args)[5].(*s3.CompleteMultipartUploadInput)
+	parts := complete.MultipartUpload.Parts
+	assert.Len(t, parts, 3)
+	for i, p := range parts {
+		assert.Equal(t, int64(i+1), *p.PartNumber)
+		assert.Equal(t, fmt.Sprintf("ETAG%d", i+1), *p.ETag)
+	}
+}
+
+func TestCopyMultipartReplaceMetadata(t *testing.T) {
+	s, _, args := copySvc(1024*1024*12, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: s, PartSize: 1024 * 1024 * 5})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:            aws.String("dst"),
+		Key:               aws.String("dstkey"),
+		CopySource:        aws.String("src/srckey"),
+		MetadataDirective: aws.String("REPLACE"),
+		Metadata:          map[string]*string{"New": aws.String("value")},
+	})
+
+	assert.NoError(t, err)
+	create := (*args)[1].(*s3.CreateMultipartUploadInput)
+	assert.Nil(t, create.ContentType)
+	assert.Nil(t, create.Metadata["Foo"])
+	assert.Equal(t, "value", *create.Metadata["New"])
+}
+
+func TestCopySourceRegion(t *testing.T) {
+	src, srcOps, _ := copySvc(1024, 0)
+	dst, dstOps, _ := copySvc(0, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: dst, SourceS3: src})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("src/srckey"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"HeadObject"}, *srcOps)
+	assert.Equal(t, []string{"CopyObject"}, *dstOps)
+}
+
+func TestCopyFailAborts(t *testing.T) {
+	s, ops, _ := copySvc(1024*1024*12, 2)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{
+		S3: s, PartSize: 1024 * 1024 * 5, Concurrency: 1,
+	})
+	resp, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("src/srckey"),
+	})
+
+	assert.Nil(t, resp)
+	assert.Equal(t, "AbortMultipartUpload", (*ops)[len(*ops)-1])
+	assert.NotContains(t, *ops, "CompleteMultipartUpload")
+
+	multiErr, ok := err.(s3manager.MultiUploadFailure)
+	assert.True(t, ok)
+	assert.Equal(t, "AccessDenied", multiErr.Code())
+	assert.Equal(t, "UPLOAD-ID", multiErr.UploadID())
+}
+
+func TestCopyFailLeaveParts(t *testing.T) {
+	s, ops, _ := copySvc(1024*1024*12, 2)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{
+		S3: s, PartSize: 1024 * 1024 * 5, Concurrency: 1, LeavePartsOnError: true,
+	})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("src/srckey"),
+	})
+
+	assert.Error(t, err)
+	assert.NotContains(t, *ops, "AbortMultipartUpload")
+}
+
+func TestCopyInvalidSource(t *testing.T) {
+	s, ops, _ := copySvc(1024, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: s})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("srckey"),
+	})
+
+	assert.Equal(t, "InvalidCopySource", err.(awserr.Error).Code())
+	assert.Len(t, *ops, 0)
+}
+
+func TestCopyInvalidPartSize(t *testing.T) {
+	s, ops, _ := copySvc(1024, 0)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{S3: s, PartSize: 1024})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:     aws.String("dst"),
+		Key:        aws.String("dstkey"),
+		CopySource: aws.String("src/srckey"),
+	})
+
+	assert.Equal(t, "ConfigError", err.(awserr.Error).Code())
+	assert.Len(t, *ops, 0)
+}
+
+func TestCopyRequestPayer(t *testing.T) {
+	s, ops, args := copySvc(1024*1024*12, 0)
+	headers := requestHeaders(s)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{
+		S3:                  s,
+		PartSize:            1024 * 1024 * 5,
+		Concurrency:         1,
+		RequestPayer:        aws.String("requester"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:                    aws.String("dst"),
+		Key:                       aws.String("dstkey"),
+		CopySource:                aws.String("src/srckey"),
+		ExpectedSourceBucketOwner: aws.String("222222222222"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"HeadObject requester 222222222222",
+		"CreateMultipartUpload requester 111111111111",
+		"UploadPartCopy requester 111111111111",
+		"UploadPartCopy requester 111111111111",
+		"UploadPartCopy requester 111111111111",
+		"CompleteMultipartUpload requester 111111111111",
+	}, *headers)
+
+	for i, op := range *ops {
+		if op == "UploadPartCopy" {
+			p := (*args)[i].(*s3.UploadPartCopyInput)
+			assert.Equal(t, "222222222222", *p.ExpectedSourceBucketOwner)
+		}
+	}
+}
+
+func TestCopyRequestPayerAbort(t *testing.T) {
+	s, _, _ := copySvc(1024*1024*12, 2)
+	headers := requestHeaders(s)
+	mgr := s3manager.NewCopier(&s3manager.CopyOptions{
+		S3: s, PartSize: 1024 * 1024 * 5, Concurrency: 1,
+	})
+	_, err := mgr.Copy(&s3manager.CopyInput{
+		Bucket:              aws.String("dst"),
+		Key:                 aws.String("dstkey"),
+		CopySource:          aws.String("src/srckey"),
+		RequestPayer:        aws.String("requester"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "AbortMultipartUpload requester 111111111111", (*headers)[len(*headers)-1])
+}
@@ -38,6 +38,15 @@ type DownloadOptions struct {
 	// An S3 client to use when performing downloads. Leave this as nil to use
 	// a default client.
 	S3 *s3.S3
+
+	// The RequestPayer to send with every GET of a download whose input does
+	// not set its own. Set this to s3.RequestPayerRequester to download from requester
+	// pays buckets without setting it on each GetObjectInput.
+	RequestPayer *string
+
+	// The ExpectedBucketOwner to send with every GET of a download whose
+	// input does not set its own.
+	ExpectedBucketOwner *string
 }
 
 // NewDownloader creates a new Downloader structure that downloads an object
@@ -160,6 +169,8 @@ func (d *downloader) downloadPart(ch chan dlchunk) {
 			// Get the next byte range of data
 			in := &s3.GetObjectInput{}
 			awsutil.Copy(in, d.in)
+			in.RequestPayer = stringDefault(in.RequestPayer, d.opts.RequestPayer)
+			in.ExpectedBucketOwner = stringDefault(in.ExpectedBucketOwner, d.opts.ExpectedBucketOwner)
 			rng := fmt.Sprintf("bytes=%d-%d",
 				chunk.start, chunk.start+chunk.size-1)
 			in.Range = &rng
@@ -163,3 +163,29 @@ func TestDownloadError(t *testing.T) {
 	assert.Equal(t, []string{"GetObject", "GetObject"}, *names)
 	assert.Equal(t, []byte{1, 0, 0}, w.buf)
 }
+
+func TestDownloadRequestPayer(t *testing.T) {
+	s, _, _ := dlLoggingSvc(buf12MB)
+	headers := requestHeaders(s)
+
+	d := s3manager.NewDownloader(&s3manager.DownloadOptions{
+		S3:                  s,
+		Concurrency:         1,
+		RequestPayer:        aws.String("requester"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+	input := &s3.GetObjectInput{
+		Bucket:              aws.String("bucket"),
+		Key:                 aws.String("key"),
+		ExpectedBucketOwner: aws.String("222222222222"),
+	}
+	_, err := d.Download(newDLWriter(len(buf12MB)), input)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{
+		"GetObject requester 222222222222",
+		"GetObject requester 222222222222",
+		"GetObject requester 222222222222",
+	}, *headers)
+	assert.Nil(t, input.RequestPayer)
+}
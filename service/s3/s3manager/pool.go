@@ -0,0 +1,147 @@
+package s3manager
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// DefaultBufferPool is the BufferPool shared by all Uploaders which do not
+// set their own. It does not limit memory use; replace it with a pool from
+// NewBufferPool to bound the memory used by all uploads in the process.
+var DefaultBufferPool = NewBufferPool(0)
+
+// A BufferPool hands out the buffers used to hold the parts of uploads
+// from readers which cannot be seeked, and reuses them once a part has been
+// sent. Buffers are pooled by size, so uploads with different part sizes
+// can share a pool.
+//
+// A BufferPool can bound the total size of the buffers in use across every
+// upload sharing it. Uploads wait for buffers to be released when the limit
+// is reached. It is safe to use a BufferPool across concurrent goroutines.
+type BufferPool struct {
+	maxMemory int64
+
+	m     sync.Mutex
+	cond  *sync.Cond
+	inUse int64
+	pools map[int64]*sync.Pool
+}
+
+// NewBufferPool creates a new BufferPool which allows at most maxMemory
+// bytes of buffers to be in use at a time. If maxMemory is zero the memory
+// used is not limited.
+//
+// A single buffer larger than maxMemory is still handed out once no other
+// buffers are in use, so uploads never wait forever.
+func NewBufferPool(maxMemory int64) *BufferPool {
+	p := &BufferPool{maxMemory: maxMemory, pools: map[int64]*sync.Pool{}}
+	p.cond = sync.NewCond(&p.m)
+	return p
+}
+
+// Get returns a buffer of length size, waiting until enough memory is
+// available. The buffer must be returned with Put once it is unused.
+func (p *BufferPool) Get(size int64) []byte {
+	p.m.Lock()
+	for p.maxMemory > 0 && p.inUse > 0 && p.inUse+size > p.maxMemory {
+		p.cond.Wait()
+	}
+	p.inUse += size
+
+	pool, ok := p.pools[size]
+	if !ok {
+		pool = &sync.Pool{}
+		p.pools[size] = pool
+	}
+	p.m.Unlock()
+
+	if b, ok := pool.Get().(*[]byte); ok {
+		return *b
+	}
+	return make([]byte, size)
+}
+
+// Put returns a buffer obtained from Get to the pool. The buffer must not
+// be used after it is returned. Buffers of a size the pool never handed out
+// are ignored.
+func (p *BufferPool) Put(b []byte) {
+	if b == nil {
+		return
+	}
+	b = b[:cap(b)]
+	size := int64(len(b))
+
+	p.m.Lock()
+	pool, ok := p.pools[size]
+	if !ok {
+		p.m.Unlock()
+		return
+	}
+	p.inUse -= size
+	p.m.Unlock()
+	p.cond.Broadcast()
+
+	pool.Put(&b)
+}
+
+// InUse returns the total size of the buffers currently handed out.
+func (p *BufferPool) InUse() int64 {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.inUse
+}
+
+// A pooledReader reads the first n bytes of a buffer from a BufferPool, and
+// returns the buffer to the pool when it is closed. Reads after Close return
+// io.EOF. The HTTP client can still be reading a request body after the
+// request has returned, and those reads must not see the buffer once it is
+// reused for another part.
+type pooledReader struct {
+	m      sync.Mutex
+	r      *bytes.Reader
+	buf    []byte
+	pool   *BufferPool
+	closed bool
+}
+
+func newPooledReader(pool *BufferPool, buf []byte, n int) *pooledReader {
+	return &pooledReader{r: bytes.NewReader(buf[:n]), buf: buf, pool: pool}
+}
+
+// Read reads from the buffer, or returns io.EOF once the reader is closed.
+func (r *pooledReader) Read(b []byte) (int, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.closed {
+		return 0, io.EOF
+	}
+	return r.r.Read(b)
+}
+
+// Seek sets the offset of the next Read.
+func (r *pooledReader) Seek(offset int64, whence int) (int64, error) {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if r.closed {
+		return 0, io.EOF
+	}
+	return r.r.Seek(offset, whence)
+}
+
+// Close returns the buffer to the pool. Closing a reader more than once
+// has no effect.
+func (r *pooledReader) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	if !r.closed {
+		r.closed = true
+		r.pool.Put(r.buf)
+		r.r, r.buf = nil, nil
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package s3manager_test
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/dongfangx/aws-sdk-go/service/s3/s3manager"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferPoolGetPut(t *testing.T) {
+	p := s3manager.NewBufferPool(0)
+
+	a := p.Get(10)
+	b := p.Get(20)
+	assert.Len(t, a, 10)
+	assert.Len(t, b, 20)
+	assert.Equal(t, int64(30), p.InUse())
+
+	p.Put(a[:5])
+	assert.Equal(t, int64(20), p.InUse())
+	assert.Len(t, p.Get(10), 10)
+
+	p.Put(nil)
+	assert.Equal(t, int64(30), p.InUse())
+}
+
+func TestBufferPoolMaxMemory(t *testing.T) {
+	p := s3manager.NewBufferPool(20)
+	a := p.Get(10)
+	p.Get(10)
+
+	got := make(chan []byte)
+	go func() { got <- p.Get(10) }()
+
+	select {
+	case <-got:
+		t.Fatal("expected Get to wait for memory")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	p.Put(a)
+	select {
+	case b := <-got:
+		assert.Len(t, b, 10)
+	case <-time.After(time.Second):
+		t.Fatal("expected Get to return after Put")
+	}
+	assert.Equal(t, int64(20), p.InUse())
+}
+
+func TestBufferPoolOversized(t *testing.T) {
+	p := s3manager.NewBufferPool(10)
+	b := p.Get(100)
+	assert.Len(t, b, 100)
+	p.Put(b)
+	assert.Equal(t, int64(0), p.InUse())
+}
+
+func TestBufferPoolPutUnknownSize(t *testing.T) {
+	p := s3manager.NewBufferPool(0)
+	p.Get(10)
+
+	p.Put(make([]byte, 20))
+	assert.Equal(t, int64(10), p.InUse())
+}
+
+func TestUploadBufferPoolReleased(t *testing.T) {
+	p := s3manager.NewBufferPool(1024 * 1024 * 10)
+	s, ops, _ := loggingSvc()
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s, BufferPool: p})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   sizedReader{&sizedReaderImpl{size: 1024 * 1024 * 12}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CreateMultipartUpload", "UploadPart", "UploadPart", "UploadPart", "CompleteMultipartUpload"}, *ops)
+	assert.Equal(t, int64(0), p.InUse())
+}
+
+func TestUploadBufferPoolReleasedOnFailure(t *testing.T) {
+	p := s3manager.NewBufferPool(0)
+	s, _, _ := loggingSvc()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		switch data := r.Data.(type) {
+		case *s3.UploadPartOutput:
+			if *data.ETag == "ETAG2" {
+				r.HTTPResponse.StatusCode = 400
+			}
+		}
+	})
+
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s, Concurrency: 1, BufferPool: p})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   sizedReader{&sizedReaderImpl{size: 1024 * 1024 * 12}},
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), p.InUse())
+}
+
+func TestUploadBufferPoolSinglePart(t *testing.T) {
+	p := s3manager.NewBufferPool(0)
+	s, ops, _ := loggingSvc()
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s, BufferPool: p})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   sizedReader{&sizedReaderImpl{size: 1024 * 1024 * 2}},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PutObject"}, *ops)
+	assert.Equal(t, int64(0), p.InUse())
+}
+
+func TestUploadBufferPoolBodyReadAfterRelease(t *testing.T) {
+	p := s3manager.NewBufferPool(0)
+	s, _, _ := loggingSvc()
+
+	var m sync.Mutex
+	bodies := []io.Reader{}
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		if _, ok := r.Data.(*s3.UploadPartOutput); ok {
+			m.Lock()
+			bodies = append(bodies, r.HTTPRequest.Body)
+			m.Unlock()
+		}
+	})
+
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s, BufferPool: p})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   sizedReader{&sizedReaderImpl{size: 1024 * 1024 * 12}},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 3)
+	for _, body := range bodies {
+		// the HTTP client reading a body late must not see the reused buffer
+		n, err := body.Read(make([]byte, 10))
+		assert.Equal(t, 0, n)
+		assert.Equal(t, io.EOF, err)
+	}
+	assert.Equal(t, int64(0), p.InUse())
+}
+
+func benchmarkUploadBuffered(b *testing.B, pool func() *s3manager.BufferPool) {
+	s, _, _ := loggingSvc()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s, BufferPool: pool()})
+		mgr.Upload(&s3manager.UploadInput{
+			Bucket: aws.String("Bucket"),
+			Key:    aws.String("Key"),
+			Body:   sizedReader{&sizedReaderImpl{size: 1024 * 1024 * 12}},
+		})
+	}
+}
+
+// BenchmarkUploadBufferedNoReuse measures an upload whose buffers are never
+// reused, as happens without a shared pool.
+func BenchmarkUploadBufferedNoReuse(b *testing.B) {
+	benchmarkUploadBuffered(b, func() *s3manager.BufferPool {
+		return s3manager.NewBufferPool(0)
+	})
+}
+
+// BenchmarkUploadBufferedSharedPool measures uploads sharing one pool.
+func BenchmarkUploadBufferedSharedPool(b *testing.B) {
+	p := s3manager.NewBufferPool(0)
+	benchmarkUploadBuffered(b, func() *s3manager.BufferPool { return p })
+}
@@ -1,7 +1,6 @@
 package s3manager
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"sort"
@@ -116,6 +115,10 @@ type UploadInput struct {
 	// A standard MIME type describing the format of the object data.
 	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
 
+	// The account ID of the expected bucket owner. If the bucket is owned by a
+	// different account, the request fails with an HTTP 403 (Access Denied) error.
+	ExpectedBucketOwner *string `location:"header" locationName:"x-amz-expected-bucket-owner" type:"string"`
+
 	// The date and time at which the object is no longer cacheable.
 	Expires *time.Time `location:"header" locationName:"Expires" type:"timestamp" timestampFormat:"rfc822"`
 
@@ -212,6 +215,20 @@ type UploadOptions struct {
 	// The client to use when uploading to S3. Leave this as nil to use the
 	// default S3 client.
 	S3 *s3.S3
+
+	// The pool to take part buffers from when the upload body cannot be
+	// seeked. Leave this as nil to use DefaultBufferPool, which is shared
+	// by all Uploaders.
+	BufferPool *BufferPool
+
+	// The RequestPayer to send with every request of an upload whose input
+	// does not set its own. Set this to s3.RequestPayerRequester to upload to requester
+	// pays buckets without setting it on each UploadInput.
+	RequestPayer *string
+
+	// The ExpectedBucketOwner to send with every request of an upload whose
+	// input does not set its own.
+	ExpectedBucketOwner *string
 }
 
 // NewUploader creates a new Uploader object to upload data to S3. Pass in
@@ -264,13 +281,15 @@ func (u *uploader) upload() (*UploadOutput, error) {
 	// Do one read to determine if we have more than one part
 	buf, err := u.nextReader()
 	if err == io.EOF || err == io.ErrUnexpectedEOF { // single part
+		defer releaseReader(buf)
 		return u.singlePart(buf)
 	} else if err != nil {
+		releaseReader(buf)
 		return nil, apierr.New("ReadRequestBody", "read upload data failed", err)
 	}
 
 	mu := multiuploader{uploader: u}
-	return mu.upload(buf)
+	return mu.upload(chunk{buf: buf, num: 1})
 }
 
 // init will initialize all default options.
@@ -284,6 +303,15 @@ func (u *uploader) init() {
 	if u.opts.PartSize == 0 {
 		u.opts.PartSize = DefaultUploadPartSize
 	}
+	if u.opts.BufferPool == nil {
+		u.opts.BufferPool = DefaultBufferPool
+	}
+
+	// Apply the request defaults to a copy of the caller's input
+	in := *u.in
+	in.RequestPayer = stringDefault(in.RequestPayer, u.opts.RequestPayer)
+	in.ExpectedBucketOwner = stringDefault(in.ExpectedBucketOwner, u.opts.ExpectedBucketOwner)
+	u.in = &in
 
 	// Try to get the total size for some optimizations
 	u.initSize()
@@ -313,6 +341,8 @@ func (u *uploader) initSize() {
 }
 
 // nextReader returns a seekable reader representing the next packet of data.
+// If the body had to be buffered, the reader must be released with
+// releaseReader once the packet has been sent.
 // This operation increases the shared u.readerPos counter, but note that it
 // does not need to be wrapped in a mutex because nextReader is only called
 // from the main thread.
@@ -339,11 +369,19 @@ func (u *uploader) nextReader() (io.ReadSeeker, error) {
 		return buf, err
 
 	default:
-		packet := make([]byte, u.opts.PartSize)
+		packet := u.opts.BufferPool.Get(u.opts.PartSize)
 		n, err := io.ReadFull(u.in.Body, packet)
 		u.readerPos += int64(n)
 
-		return bytes.NewReader(packet[0:n]), err
+		return newPooledReader(u.opts.BufferPool, packet, n), err
+	}
+}
+
+// releaseReader returns the buffer of a reader from nextReader to its pool.
+// Readers which are not backed by a pooled buffer are left untouched.
+func releaseReader(r io.ReadSeeker) {
+	if p, ok := r.(*pooledReader); ok {
+		p.Close()
 	}
 }
 
@@ -388,15 +426,15 @@ func (a completedParts) Len() int           { return len(a) }
 func (a completedParts) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a completedParts) Less(i, j int) bool { return *a[i].PartNumber < *a[j].PartNumber }
 
-// upload will perform a multipart upload using the firstBuf buffer containing
-// the first chunk of data.
-func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
+// upload will perform a multipart upload using the first chunk of data.
+func (u *multiuploader) upload(first chunk) (*UploadOutput, error) {
 	params := &s3.CreateMultipartUploadInput{}
 	awsutil.Copy(params, u.in)
 
 	// Create the multipart
 	resp, err := u.opts.S3.CreateMultipartUpload(params)
 	if err != nil {
+		releaseReader(first.buf)
 		return nil, err
 	}
 	u.uploadID = *resp.UploadID
@@ -409,8 +447,8 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 	}
 
 	// Send part 1 to the workers
-	var num int64 = 1
-	ch <- chunk{buf: firstBuf, num: num}
+	num := first.num
+	ch <- first
 
 	// Read and queue the rest of the parts
 	for u.geterr() == nil {
@@ -426,6 +464,7 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 
 		buf, err := u.nextReader()
 		if err == io.EOF {
+			releaseReader(buf)
 			break
 		}
 
@@ -462,7 +501,8 @@ func (u *multiuploader) upload(firstBuf io.ReadSeeker) (*UploadOutput, error) {
 }
 
 // readChunk runs in worker goroutines to pull chunks off of the ch channel
-// and send() them as UploadPart requests.
+// and send() them as UploadPart requests. The chunk's reader is released
+// once it is no longer needed.
 func (u *multiuploader) readChunk(ch chan chunk) {
 	defer u.wg.Done()
 	for {
@@ -477,6 +517,7 @@ func (u *multiuploader) readChunk(ch chan chunk) {
 				u.seterr(err)
 			}
 		}
+		releaseReader(data.buf)
 	}
 }
 
@@ -484,11 +525,13 @@ func (u *multiuploader) readChunk(ch chan chunk) {
 // part information.
 func (u *multiuploader) send(c chunk) error {
 	resp, err := u.opts.S3.UploadPart(&s3.UploadPartInput{
-		Bucket:     u.in.Bucket,
-		Key:        u.in.Key,
-		Body:       c.buf,
-		UploadID:   &u.uploadID,
-		PartNumber: &c.num,
+		Bucket:              u.in.Bucket,
+		Key:                 u.in.Key,
+		Body:                c.buf,
+		UploadID:            &u.uploadID,
+		PartNumber:          &c.num,
+		RequestPayer:        u.in.RequestPayer,
+		ExpectedBucketOwner: u.in.ExpectedBucketOwner,
 	})
 
 	if err != nil {
@@ -528,9 +571,11 @@ func (u *multiuploader) fail() {
 	}
 
 	u.opts.S3.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
-		Bucket:   u.in.Bucket,
-		Key:      u.in.Key,
-		UploadID: &u.uploadID,
+		Bucket:              u.in.Bucket,
+		Key:                 u.in.Key,
+		UploadID:            &u.uploadID,
+		RequestPayer:        u.in.RequestPayer,
+		ExpectedBucketOwner: u.in.ExpectedBucketOwner,
 	})
 }
 
@@ -545,10 +590,12 @@ func (u *multiuploader) complete() *s3.CompleteMultipartUploadOutput {
 	sort.Sort(u.parts)
 
 	resp, err := u.opts.S3.CompleteMultipartUpload(&s3.CompleteMultipartUploadInput{
-		Bucket:          u.in.Bucket,
-		Key:             u.in.Key,
-		UploadID:        &u.uploadID,
-		MultipartUpload: &s3.CompletedMultipartUpload{Parts: u.parts},
+		Bucket:              u.in.Bucket,
+		Key:                 u.in.Key,
+		UploadID:            &u.uploadID,
+		RequestPayer:        u.in.RequestPayer,
+		ExpectedBucketOwner: u.in.ExpectedBucketOwner,
+		MultipartUpload:     &s3.CompletedMultipartUpload{Parts: u.parts},
 	})
 	if err != nil {
 		u.seterr(err)
@@ -557,3 +604,11 @@ func (u *multiuploader) complete() *s3.CompleteMultipartUploadOutput {
 
 	return resp
 }
+
+// stringDefault returns v, or def if v is not set.
+func stringDefault(v, def *string) *string {
+	if v == nil {
+		return def
+	}
+	return v
+}
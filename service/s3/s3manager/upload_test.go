@@ -373,7 +373,20 @@ func (s sizedReader) Read(p []byte) (n int, err error) {
 }
 
 func TestUploadOrderMultiBufferedReader(t *testing.T) {
-	s, ops, args := loggingSvc()
+	s, ops, _ := loggingSvc()
+
+	// Part lengths, read while the parts are sent since the pooled part
+	// buffers are released once the upload returns
+	var m sync.Mutex
+	parts := []int{}
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		if r.Operation.Name == "UploadPart" {
+			m.Lock()
+			parts = append(parts, buflen(r.Params.(*s3.UploadPartInput).Body))
+			m.Unlock()
+		}
+	})
+
 	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
 	_, err := mgr.Upload(&s3manager.UploadInput{
 		Bucket: aws.String("Bucket"),
@@ -384,12 +397,6 @@ func TestUploadOrderMultiBufferedReader(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []string{"CreateMultipartUpload", "UploadPart", "UploadPart", "UploadPart", "CompleteMultipartUpload"}, *ops)
 
-	// Part lengths
-	parts := []int{
-		buflen(val((*args)[1], "Body")),
-		buflen(val((*args)[2], "Body")),
-		buflen(val((*args)[3], "Body")),
-	}
 	sort.Ints(parts)
 	assert.Equal(t, []int{1024 * 1024 * 2, 1024 * 1024 * 5, 1024 * 1024 * 5}, parts)
 }
@@ -428,3 +435,91 @@ func TestUploadOrderSingleBufferedReader(t *testing.T) {
 	assert.NotEqual(t, "", resp.Location)
 	assert.Equal(t, "", resp.UploadID)
 }
+
+// requestHeaders returns a log of the request payer and expected bucket
+// owner headers of every request sent by svc, prefixed by the operation.
+func requestHeaders(svc *s3.S3) *[]string {
+	var m sync.Mutex
+	headers := []string{}
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		m.Lock()
+		defer m.Unlock()
+
+		headers = append(headers, fmt.Sprintf("%s %s %s", r.Operation.Name,
+			r.HTTPRequest.Header.Get("x-amz-request-payer"),
+			r.HTTPRequest.Header.Get("x-amz-expected-bucket-owner")))
+	})
+	return &headers
+}
+
+func TestUploadRequestPayerMulti(t *testing.T) {
+	s, _, _ := loggingSvc()
+	headers := requestHeaders(s)
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{
+		S3:                  s,
+		Concurrency:         1,
+		RequestPayer:        aws.String("requester"),
+		ExpectedBucketOwner: aws.String("111111111111"),
+	})
+	input := &s3manager.UploadInput{
+		Bucket:              aws.String("Bucket"),
+		Key:                 aws.String("Key"),
+		Body:                bytes.NewReader(buf12MB),
+		ExpectedBucketOwner: aws.String("222222222222"),
+	}
+	_, err := mgr.Upload(input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"CreateMultipartUpload requester 222222222222",
+		"UploadPart requester 222222222222",
+		"UploadPart requester 222222222222",
+		"UploadPart requester 222222222222",
+		"CompleteMultipartUpload requester 222222222222",
+	}, *headers)
+
+	// The caller's input is left unchanged
+	assert.Nil(t, input.RequestPayer)
+}
+
+func TestUploadRequestPayerSingle(t *testing.T) {
+	s, _, _ := loggingSvc()
+	headers := requestHeaders(s)
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{S3: s})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket:       aws.String("Bucket"),
+		Key:          aws.String("Key"),
+		Body:         bytes.NewReader(buf2MB),
+		RequestPayer: aws.String("requester"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PutObject requester "}, *headers)
+}
+
+func TestUploadRequestPayerAbort(t *testing.T) {
+	s, _, _ := loggingSvc()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		switch data := r.Data.(type) {
+		case *s3.UploadPartOutput:
+			if *data.ETag == "ETAG2" {
+				r.HTTPResponse.StatusCode = 400
+			}
+		}
+	})
+	headers := requestHeaders(s)
+
+	mgr := s3manager.NewUploader(&s3manager.UploadOptions{
+		S3:           s,
+		Concurrency:  1,
+		RequestPayer: aws.String("requester"),
+	})
+	_, err := mgr.Upload(&s3manager.UploadInput{
+		Bucket: aws.String("Bucket"),
+		Key:    aws.String("Key"),
+		Body:   bytes.NewReader(buf12MB),
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "AbortMultipartUpload requester ", (*headers)[len(*headers)-1])
+}
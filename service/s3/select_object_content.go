@@ -0,0 +1,187 @@
+package s3
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+	"github.com/dongfangx/aws-sdk-go/internal/protocol/eventstream"
+	"github.com/dongfangx/aws-sdk-go/internal/protocol/xml/xmlutil"
+)
+
+// SelectObjectContentEvent is an event of the SelectObjectContent event
+// stream, one of *RecordsEvent, *StatsEvent, *ProgressEvent,
+// *ContinuationEvent or *EndEvent.
+type SelectObjectContentEvent interface {
+	selectObjectContentEvent()
+}
+
+func (*RecordsEvent) selectObjectContentEvent()      {}
+func (*StatsEvent) selectObjectContentEvent()        {}
+func (*ProgressEvent) selectObjectContentEvent()     {}
+func (*ContinuationEvent) selectObjectContentEvent() {}
+func (*EndEvent) selectObjectContentEvent()          {}
+
+// A SelectObjectContentEventStream reads the events of a SelectObjectContent
+// response as they are streamed by S3.
+//
+// The events are read from the channel returned by Events, which is closed
+// after the End event, or when the stream fails. Err returns the error the
+// stream failed with, and must be checked once the channel is closed:
+//
+//     out, err := svc.SelectObjectContent(params)
+//     if err != nil {
+//         return err
+//     }
+//     defer out.EventStream.Close()
+//
+//     for event := range out.EventStream.Events() {
+//         if records, ok := event.(*s3.RecordsEvent); ok {
+//             os.Stdout.Write(records.Payload)
+//         }
+//     }
+//     return out.EventStream.Err()
+type SelectObjectContentEventStream struct {
+	body   io.ReadCloser
+	events chan SelectObjectContentEvent
+	done   chan struct{}
+
+	closeOnce sync.Once
+	mu        sync.Mutex
+	err       error
+}
+
+// newSelectObjectContentEventStream starts reading the events of body.
+func newSelectObjectContentEventStream(body io.ReadCloser) *SelectObjectContentEventStream {
+	es := &SelectObjectContentEventStream{
+		body:   body,
+		events: make(chan SelectObjectContentEvent),
+		done:   make(chan struct{}),
+	}
+	go es.readEvents()
+	return es
+}
+
+// Events returns the channel the events of the stream are sent on.
+func (es *SelectObjectContentEventStream) Events() <-chan SelectObjectContentEvent {
+	return es.events
+}
+
+// Err returns the error the stream failed with, or nil if the stream ended
+// with an End event or was closed.
+func (es *SelectObjectContentEventStream) Err() error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	return es.err
+}
+
+// Close stops reading events and closes the response body. It must be called
+// once the events are no longer read, even if the stream ended.
+func (es *SelectObjectContentEventStream) Close() error {
+	var err error
+	es.closeOnce.Do(func() {
+		close(es.done)
+		err = es.body.Close()
+	})
+	return err
+}
+
+func (es *SelectObjectContentEventStream) readEvents() {
+	defer close(es.events)
+
+	d := eventstream.NewDecoder(es.body)
+	for {
+		msg, err := d.Decode()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF // the stream must end with an End event
+		}
+		if err != nil {
+			es.fail(apierr.New("Unmarshal", "failed to decode SelectObjectContent event stream", err))
+			return
+		}
+
+		if err := eventstream.MessageError(msg); err != nil {
+			es.fail(err)
+			return
+		}
+
+		event, err := decodeSelectObjectContentEvent(msg)
+		if err != nil {
+			es.fail(apierr.New("Unmarshal", "failed to decode SelectObjectContent event", err))
+			return
+		}
+		if event == nil {
+			continue // unknown events are skipped
+		}
+
+		select {
+		case es.events <- event:
+		case <-es.done:
+			return
+		}
+
+		if _, ok := event.(*EndEvent); ok {
+			return
+		}
+	}
+}
+
+// fail records the error the stream failed with, unless the stream was
+// closed by the caller, which makes reads of the body fail.
+func (es *SelectObjectContentEventStream) fail(err error) {
+	select {
+	case <-es.done:
+		return
+	default:
+	}
+
+	es.mu.Lock()
+	es.err = err
+	es.mu.Unlock()
+}
+
+// decodeSelectObjectContentEvent returns the event of msg, or nil if the event
+// type is not known.
+func decodeSelectObjectContentEvent(msg eventstream.Message) (SelectObjectContentEvent, error) {
+	switch t := msg.Headers.GetString(eventstream.EventTypeHeader); t {
+	case "Records":
+		return &RecordsEvent{Payload: msg.Payload}, nil
+	case "Stats":
+		details := &Stats{}
+		if err := unmarshalEventPayload(details, msg.Payload); err != nil {
+			return nil, err
+		}
+		return &StatsEvent{Details: details}, nil
+	case "Progress":
+		details := &Progress{}
+		if err := unmarshalEventPayload(details, msg.Payload); err != nil {
+			return nil, err
+		}
+		return &ProgressEvent{Details: details}, nil
+	case "Cont":
+		return &ContinuationEvent{}, nil
+	case "End":
+		return &EndEvent{}, nil
+	case "":
+		return nil, fmt.Errorf("event has no %s header", eventstream.EventTypeHeader)
+	}
+	return nil, nil
+}
+
+func unmarshalEventPayload(v interface{}, payload []byte) error {
+	return xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader(payload)), "")
+}
+
+// unmarshalSelectObjectContent reads the events of the response body as they
+// are streamed.
+func unmarshalSelectObjectContent(r *aws.Request) {
+	if !r.DataFilled() {
+		return
+	}
+	out := r.Data.(*SelectObjectContentOutput)
+	out.EventStream = newSelectObjectContentEventStream(r.HTTPResponse.Body)
+}
@@ -0,0 +1,146 @@
+package s3_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awserr"
+	"github.com/dongfangx/aws-sdk-go/internal/protocol/eventstream"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+func selectInput() *s3.SelectObjectContentInput {
+	return &s3.SelectObjectContentInput{
+		Bucket:         aws.String("bucket"),
+		Key:            aws.String("people.csv"),
+		Expression:     aws.String("SELECT * FROM S3Object s WHERE s._2 > 30"),
+		ExpressionType: aws.String("SQL"),
+		InputSerialization: &s3.InputSerialization{
+			CSV: &s3.CSVInput{FileHeaderInfo: aws.String("NONE")},
+		},
+		OutputSerialization: &s3.OutputSerialization{
+			CSV: &s3.CSVOutput{},
+		},
+		RequestProgress: &s3.RequestProgress{Enabled: aws.Boolean(true)},
+	}
+}
+
+func selectFixture(t *testing.T, name string) string {
+	b, err := ioutil.ReadFile("testdata/" + name)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func TestSelectObjectContent(t *testing.T) {
+	svc, sent, body := echoSvc(selectFixture(t, "select_object_content.bin"), http.Header{})
+
+	out, err := svc.SelectObjectContent(selectInput())
+	assert.NoError(t, err)
+	defer out.EventStream.Close()
+
+	assert.Equal(t, "POST", sent.Method)
+	assert.Regexp(t, `/people\.csv$`, sent.URL.Opaque)
+	assert.Contains(t, sent.URL.Query(), "select")
+	assert.Equal(t, "2", sent.URL.Query().Get("select-type"))
+	assert.Contains(t, string(*body), `<SelectObjectContentRequest xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`)
+	assert.Contains(t, string(*body), `<Expression>SELECT * FROM S3Object s WHERE s._2 &gt; 30</Expression>`)
+	assert.Contains(t, string(*body), `<InputSerialization><CSV><FileHeaderInfo>NONE</FileHeaderInfo></CSV></InputSerialization>`)
+	assert.Contains(t, string(*body), `<RequestProgress><Enabled>true</Enabled></RequestProgress>`)
+
+	records, continuations := "", 0
+	var stats *s3.Stats
+	var progress *s3.Progress
+	var end bool
+	for event := range out.EventStream.Events() {
+		switch e := event.(type) {
+		case *s3.RecordsEvent:
+			records += string(e.Payload)
+		case *s3.ContinuationEvent:
+			continuations++
+		case *s3.ProgressEvent:
+			progress = e.Details
+		case *s3.StatsEvent:
+			stats = e.Details
+		case *s3.EndEvent:
+			end = true
+		}
+	}
+
+	assert.NoError(t, out.EventStream.Err())
+	assert.Equal(t, "Alice,42\nBob,37\n", records)
+	assert.Equal(t, 1, continuations)
+	assert.Equal(t, int64(512), *progress.BytesScanned)
+	assert.Equal(t, int64(1024), *stats.BytesScanned)
+	assert.Equal(t, int64(1024), *stats.BytesProcessed)
+	assert.Equal(t, int64(20), *stats.BytesReturned)
+	assert.True(t, end)
+}
+
+func TestSelectObjectContentErrorEvent(t *testing.T) {
+	svc, _, _ := echoSvc(selectFixture(t, "select_object_content_error.bin"), http.Header{})
+
+	out, err := svc.SelectObjectContent(selectInput())
+	assert.NoError(t, err)
+	defer out.EventStream.Close()
+
+	events := []s3.SelectObjectContentEvent{}
+	for event := range out.EventStream.Events() {
+		events = append(events, event)
+	}
+	assert.Len(t, events, 1)
+
+	aerr, ok := out.EventStream.Err().(awserr.Error)
+	assert.True(t, ok)
+	assert.Equal(t, "InternalError", aerr.Code())
+	assert.Equal(t, "We encountered an internal error. Please try again.", aerr.Message())
+}
+
+func TestSelectObjectContentCorruptStream(t *testing.T) {
+	fixture := selectFixture(t, "select_object_content.bin")
+	cases := []struct {
+		body    string
+		origErr func(error) bool
+	}{
+		{ // stream ends before the End event
+			fixture[:len(fixture)-16],
+			func(err error) bool { return err == io.ErrUnexpectedEOF },
+		},
+		{ // a byte of the first payload is flipped
+			fixture[:60] + string(fixture[60]^0xff) + fixture[61:],
+			func(err error) bool { _, ok := err.(eventstream.ChecksumError); return ok },
+		},
+	}
+
+	for _, c := range cases {
+		svc, _, _ := echoSvc(c.body, http.Header{})
+		out, err := svc.SelectObjectContent(selectInput())
+		assert.NoError(t, err)
+
+		for range out.EventStream.Events() {
+		}
+		aerr := out.EventStream.Err().(awserr.Error)
+		assert.Equal(t, "Unmarshal", aerr.Code())
+		assert.True(t, c.origErr(aerr.OrigErr()), "unexpected error %v", aerr.OrigErr())
+		out.EventStream.Close()
+	}
+}
+
+func TestSelectObjectContentClose(t *testing.T) {
+	svc, _, _ := echoSvc(selectFixture(t, "select_object_content.bin"), http.Header{})
+
+	out, err := svc.SelectObjectContent(selectInput())
+	assert.NoError(t, err)
+
+	<-out.EventStream.Events()
+	assert.NoError(t, out.EventStream.Close())
+	for range out.EventStream.Events() {
+	}
+	assert.NoError(t, out.EventStream.Err())
+}
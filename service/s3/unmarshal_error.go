@@ -2,41 +2,121 @@ package s3
 
 import (
 	"encoding/xml"
-	"io"
+	"io/ioutil"
+	"net/http"
 	"strings"
 
 	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
 	"github.com/dongfangx/aws-sdk-go/internal/apierr"
 )
 
 type xmlErrorResponse struct {
-	XMLName xml.Name `xml:"Error"`
-	Code    string   `xml:"Code"`
-	Message string   `xml:"Message"`
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	Resource   string   `xml:"Resource"`
+	RequestID  string   `xml:"RequestId"`
+	HostID     string   `xml:"HostId"`
+	BucketName string   `xml:"BucketName"`
+	Key        string   `xml:"Key"`
+}
+
+// statusCodeErrors are the codes of errors S3 returns without a body, such
+// as the errors of HEAD requests.
+var statusCodeErrors = map[int]string{
+	http.StatusMovedPermanently:   "MovedPermanently",
+	http.StatusNotModified:        "NotModified",
+	http.StatusBadRequest:         "BadRequest",
+	http.StatusForbidden:          "Forbidden",
+	http.StatusNotFound:           "NotFound",
+	http.StatusPreconditionFailed: "PreconditionFailed",
 }
 
 func unmarshalError(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
 
-	if r.HTTPResponse.ContentLength == int64(0) {
+	statusCode := r.HTTPResponse.StatusCode
+	details := apierr.ErrorDetails{
+		HostID:     r.HTTPResponse.Header.Get("X-Amz-Id-2"),
+		BucketName: requestParam(r, "Bucket"),
+		Key:        requestParam(r, "Key"),
+	}
+	requestID := r.HTTPResponse.Header.Get("X-Amz-Request-Id")
+
+	// the content length of HEAD responses is that of the object, so read
+	// the body to find out whether there is one
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = apierr.New("Unmarshal", "failed reading S3 error response", err)
+		return
+	}
+
+	if len(b) == 0 {
 		// No body, use status code to generate an awserr.Error
-		r.Error = apierr.NewRequestError(
-			apierr.New(strings.Replace(r.HTTPResponse.Status, " ", "", -1), r.HTTPResponse.Status, nil),
-			r.HTTPResponse.StatusCode,
-			"",
-		)
+		r.Error = apierr.NewExtendedRequestError(apierr.NewRequestError(
+			apierr.New(statusCodeError(r.HTTPResponse), statusMessage(r.HTTPResponse), nil),
+			statusCode,
+			requestID,
+		), details)
 		return
 	}
 
 	resp := &xmlErrorResponse{}
-	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
-	if err != nil && err != io.EOF {
-		r.Error = apierr.New("Unmarshal", "failed to decode S3 XML error response", nil)
-	} else {
-		r.Error = apierr.NewRequestError(
-			apierr.New(resp.Code, resp.Message, nil),
-			r.HTTPResponse.StatusCode,
-			"",
-		)
+	if err := xml.Unmarshal(b, resp); err != nil {
+		r.Error = apierr.New("Unmarshal", "failed to decode S3 XML error response", err)
+		return
+	}
+
+	if resp.RequestID != "" {
+		requestID = resp.RequestID
+	}
+	if resp.HostID != "" {
+		details.HostID = resp.HostID
+	}
+	if resp.BucketName != "" {
+		details.BucketName = resp.BucketName
+	}
+	if resp.Key != "" {
+		details.Key = resp.Key
+	}
+	details.Resource = resp.Resource
+	details.Body = b
+
+	r.Error = apierr.NewExtendedRequestError(apierr.NewRequestError(
+		apierr.New(resp.Code, resp.Message, nil),
+		statusCode,
+		requestID,
+	), details)
+}
+
+// statusCodeError returns the error code of a response without a body.
+func statusCodeError(resp *http.Response) string {
+	if code, ok := statusCodeErrors[resp.StatusCode]; ok {
+		return code
+	}
+	return strings.Replace(statusMessage(resp), " ", "", -1)
+}
+
+// statusMessage returns the text of the status of resp, without the numeric
+// status code.
+func statusMessage(resp *http.Response) string {
+	if msg := strings.TrimLeft(resp.Status, "0123456789 "); msg != "" {
+		return msg
+	}
+	return http.StatusText(resp.StatusCode)
+}
+
+// requestParam returns the string value of the named parameter of the
+// request, or an empty string if it has none.
+func requestParam(r *aws.Request, name string) string {
+	if !r.ParamsFilled() {
+		return ""
+	}
+	v := awsutil.ValuesAtPath(r.Params, name)
+	if len(v) == 0 {
+		return ""
 	}
+	s, _ := v[0].(string)
+	return s
 }
@@ -27,6 +27,9 @@ var s3StatusCodeErrorTests = []struct {
 	{400, "Bad Request", "", "BadRequest", "Bad Request"},
 	{404, "Not Found", "", "NotFound", "Not Found"},
 	{500, "Internal Error", "", "InternalError", "Internal Error"},
+	{404, "404 Not Found", "", "NotFound", "Not Found"},
+	{403, "403 Forbidden", "", "Forbidden", "Forbidden"},
+	{503, "503 Slow Down", "", "SlowDown", "Slow Down"},
 }
 
 func TestStatusCodeError(t *testing.T) {
@@ -51,3 +54,88 @@ func TestStatusCodeError(t *testing.T) {
 		assert.Equal(t, test.message, err.(awserr.Error).Message())
 	}
 }
+
+func TestHeadStatusCodeError(t *testing.T) {
+	s := s3.New(nil)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		header := http.Header{}
+		header.Set("X-Amz-Request-Id", "REQUESTID")
+		header.Set("X-Amz-Id-2", "HOSTID")
+		// HEAD responses carry the length of the object, but no body
+		r.HTTPResponse = &http.Response{
+			ContentLength: 1024,
+			StatusCode:    404,
+			Status:        "404 Not Found",
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte{})),
+		}
+	})
+	_, err := s.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String("bucket"), Key: aws.String("key"),
+	})
+
+	reqErr := err.(awserr.ExtendedRequestFailure)
+	assert.Equal(t, "NotFound", reqErr.Code())
+	assert.Equal(t, 404, reqErr.StatusCode())
+	assert.Equal(t, "REQUESTID", reqErr.RequestID())
+	assert.Equal(t, "HOSTID", reqErr.HostID())
+	assert.Equal(t, "bucket", reqErr.BucketName())
+	assert.Equal(t, "key", reqErr.Key())
+	assert.Nil(t, reqErr.Body())
+	assert.Contains(t, reqErr.Error(), "request id: [REQUESTID], host id: [HOSTID]")
+}
+
+func TestExtendedError(t *testing.T) {
+	body := `<?xml version="1.0" encoding="UTF-8"?>
+<Error><Code>NoSuchBucket</Code><Message>The specified bucket does not exist</Message>` +
+		`<BucketName>other-bucket</BucketName><Resource>/other-bucket/key</Resource>` +
+		`<RequestId>BODYREQUESTID</RequestId><HostId>BODYHOSTID</HostId></Error>`
+
+	s := s3.New(nil)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    404,
+			Status:        "404 Not Found",
+			Header:        http.Header{},
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	_, err := s.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"), Key: aws.String("key"),
+	})
+
+	reqErr := err.(awserr.ExtendedRequestFailure)
+	assert.Equal(t, "NoSuchBucket", reqErr.Code())
+	assert.Equal(t, "The specified bucket does not exist", reqErr.Message())
+	assert.Equal(t, "BODYREQUESTID", reqErr.RequestID())
+	assert.Equal(t, "BODYHOSTID", reqErr.HostID())
+	assert.Equal(t, "/other-bucket/key", reqErr.Resource())
+	assert.Equal(t, "other-bucket", reqErr.BucketName())
+	assert.Equal(t, "key", reqErr.Key())
+	assert.Equal(t, body, string(reqErr.Body()))
+}
+
+func TestMalformedErrorBody(t *testing.T) {
+	body := `<Error><Code>NoSuchBucket`
+
+	s := s3.New(nil)
+	s.Handlers.Send.Clear()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			ContentLength: int64(len(body)),
+			StatusCode:    400,
+			Status:        "400 Bad Request",
+			Header:        http.Header{},
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	_, err := s.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String("bucket"), Key: aws.String("key"),
+	})
+
+	assert.Equal(t, "Unmarshal", err.(awserr.Error).Code())
+	assert.Error(t, err.(awserr.Error).OrigErr())
+}
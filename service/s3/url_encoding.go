@@ -0,0 +1,133 @@
+package s3
+
+import (
+	"net/url"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/aws/awsutil"
+	"github.com/dongfangx/aws-sdk-go/internal/apierr"
+)
+
+// setURLEncodingType asks S3 to url-encode the keys of a listing, so that
+// keys with characters XML cannot carry are returned intact. The keys are
+// decoded again by decodeURLEncodedListing.
+func setURLEncodingType(r *aws.Request) {
+	if !r.ParamsFilled() {
+		return
+	}
+
+	switch in := r.Params.(type) {
+	case *ListObjectsInput:
+		if in.EncodingType == nil {
+			in = awsutil.CopyOf(in).(*ListObjectsInput)
+			in.EncodingType = aws.String(string(EncodingTypeURL))
+			r.Params = in
+		}
+	case *ListObjectsV2Input:
+		if in.EncodingType == nil {
+			in = awsutil.CopyOf(in).(*ListObjectsV2Input)
+			in.EncodingType = aws.String(string(EncodingTypeURL))
+			r.Params = in
+		}
+	case *ListObjectVersionsInput:
+		if in.EncodingType == nil {
+			in = awsutil.CopyOf(in).(*ListObjectVersionsInput)
+			in.EncodingType = aws.String(string(EncodingTypeURL))
+			r.Params = in
+		}
+	case *ListMultipartUploadsInput:
+		if in.EncodingType == nil {
+			in = awsutil.CopyOf(in).(*ListMultipartUploadsInput)
+			in.EncodingType = aws.String(string(EncodingTypeURL))
+			r.Params = in
+		}
+	}
+}
+
+// decodeURLEncodedListing decodes the keys, prefixes and markers of a
+// listing S3 returned url-encoded. The EncodingType of the output is cleared
+// once its values are decoded, so they are never decoded twice.
+func decodeURLEncodedListing(r *aws.Request) {
+	if !r.DataFilled() {
+		return
+	}
+
+	var err error
+	switch out := r.Data.(type) {
+	case *ListObjectsOutput:
+		if !isURLEncoded(out.EncodingType) {
+			return
+		}
+		strs := []**string{&out.Delimiter, &out.Marker, &out.NextMarker, &out.Prefix}
+		for _, o := range out.Contents {
+			strs = append(strs, &o.Key)
+		}
+		err = urlDecodeStrings(append(strs, commonPrefixes(out.CommonPrefixes)...))
+		out.EncodingType = nil
+	case *ListObjectsV2Output:
+		if !isURLEncoded(out.EncodingType) {
+			return
+		}
+		strs := []**string{&out.Delimiter, &out.Prefix, &out.StartAfter}
+		for _, o := range out.Contents {
+			strs = append(strs, &o.Key)
+		}
+		err = urlDecodeStrings(append(strs, commonPrefixes(out.CommonPrefixes)...))
+		out.EncodingType = nil
+	case *ListObjectVersionsOutput:
+		if !isURLEncoded(out.EncodingType) {
+			return
+		}
+		strs := []**string{&out.Delimiter, &out.KeyMarker, &out.NextKeyMarker, &out.Prefix}
+		for _, v := range out.Versions {
+			strs = append(strs, &v.Key)
+		}
+		for _, m := range out.DeleteMarkers {
+			strs = append(strs, &m.Key)
+		}
+		err = urlDecodeStrings(append(strs, commonPrefixes(out.CommonPrefixes)...))
+		out.EncodingType = nil
+	case *ListMultipartUploadsOutput:
+		if !isURLEncoded(out.EncodingType) {
+			return
+		}
+		strs := []**string{&out.Delimiter, &out.KeyMarker, &out.NextKeyMarker, &out.Prefix}
+		for _, u := range out.Uploads {
+			strs = append(strs, &u.Key)
+		}
+		err = urlDecodeStrings(append(strs, commonPrefixes(out.CommonPrefixes)...))
+		out.EncodingType = nil
+	}
+
+	if err != nil {
+		r.Error = apierr.New("Unmarshal", "failed decoding url-encoded listing", err)
+	}
+}
+
+func isURLEncoded(encodingType *string) bool {
+	return encodingType != nil && *encodingType == string(EncodingTypeURL)
+}
+
+func commonPrefixes(prefixes []*CommonPrefix) []**string {
+	strs := make([]**string, len(prefixes))
+	for i, p := range prefixes {
+		strs[i] = &p.Prefix
+	}
+	return strs
+}
+
+// urlDecodeStrings replaces each of the non-nil strings with its decoded
+// value.
+func urlDecodeStrings(strs []**string) error {
+	for _, s := range strs {
+		if *s == nil {
+			continue
+		}
+		v, err := url.QueryUnescape(**s)
+		if err != nil {
+			return err
+		}
+		*s = &v
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/dongfangx/aws-sdk-go/aws"
+	"github.com/dongfangx/aws-sdk-go/internal/test/unit"
+	"github.com/dongfangx/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+var _ = unit.Imported
+
+// listingSvc returns a client responding with the bodies in order, and the
+// query strings of the requests sent.
+func listingSvc(bodies ...string) (*s3.S3, *[]string) {
+	queries := []string{}
+	svc := s3.New(nil)
+	svc.Handlers.Send.Clear()
+	svc.Handlers.Send.PushBack(func(r *aws.Request) {
+		queries = append(queries, r.HTTPRequest.URL.RawQuery)
+		body := bodies[len(queries)-1]
+		r.HTTPResponse = &http.Response{
+			StatusCode: 200,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		}
+	})
+	return svc, &queries
+}
+
+func TestListObjectsURLEncoding(t *testing.T) {
+	svc, queries := listingSvc(
+		`<ListBucketResult><Name>bucket</Name><Prefix>a%2Fb</Prefix><Delimiter>%2F</Delimiter>`+
+			`<EncodingType>url</EncodingType><IsTruncated>true</IsTruncated>`+
+			`<NextMarker>a%2Fb%01c</NextMarker>`+
+			`<Contents><Key>a%2Fb+%01c</Key></Contents>`+
+			`<CommonPrefixes><Prefix>a%2Fb%25%2F</Prefix></CommonPrefixes></ListBucketResult>`,
+		`<ListBucketResult><Name>bucket</Name><EncodingType>url</EncodingType>`+
+			`<IsTruncated>false</IsTruncated>`+
+			`<Contents><Key>a%2Fb%02d</Key></Contents></ListBucketResult>`,
+	)
+
+	input := &s3.ListObjectsInput{Bucket: aws.String("bucket"), Prefix: aws.String("a/b")}
+	keys := []string{}
+	err := svc.ListObjectsPages(input, func(p *s3.ListObjectsOutput, last bool) bool {
+		assert.Nil(t, p.EncodingType)
+		for _, o := range p.Contents {
+			keys = append(keys, *o.Key)
+		}
+		if !last {
+			assert.Equal(t, "a/b", *p.Prefix)
+			assert.Equal(t, "/", *p.Delimiter)
+			assert.Equal(t, "a/b\x01c", *p.NextMarker)
+			assert.Equal(t, "a/b%/", *p.CommonPrefixes[0].Prefix)
+		}
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a/b \x01c", "a/b\x02d"}, keys)
+	assert.Nil(t, input.EncodingType)
+	assert.Len(t, *queries, 2)
+	assert.Contains(t, (*queries)[0], "encoding-type=url")
+	assert.Contains(t, (*queries)[1], "encoding-type=url")
+	assert.Contains(t, (*queries)[1], "marker=a%2Fb%01c")
+}
+
+func TestListObjectVersionsURLEncoding(t *testing.T) {
+	svc, _ := listingSvc(
+		`<ListVersionsResult><EncodingType>url</EncodingType><NextKeyMarker>k%01</NextKeyMarker>` +
+			`<Version><Key>k%01</Key></Version><DeleteMarker><Key>k%02</Key></DeleteMarker></ListVersionsResult>`,
+	)
+
+	out, err := svc.ListObjectVersions(&s3.ListObjectVersionsInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "k\x01", *out.NextKeyMarker)
+	assert.Equal(t, "k\x01", *out.Versions[0].Key)
+	assert.Equal(t, "k\x02", *out.DeleteMarkers[0].Key)
+}
+
+func TestListMultipartUploadsURLEncoding(t *testing.T) {
+	svc, _ := listingSvc(
+		`<ListMultipartUploadsResult><EncodingType>url</EncodingType>` +
+			`<Upload><Key>a%20b</Key></Upload></ListMultipartUploadsResult>`,
+	)
+
+	out, err := svc.ListMultipartUploads(&s3.ListMultipartUploadsInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, "a b", *out.Uploads[0].Key)
+}
+
+func TestListObjectsNotURLEncoded(t *testing.T) {
+	svc, queries := listingSvc(
+		`<ListBucketResult><Contents><Key>a%2Fb</Key></Contents></ListBucketResult>`,
+	)
+
+	// keys are left as returned when the caller chose another encoding type
+	out, err := svc.ListObjects(&s3.ListObjectsInput{
+		Bucket:       aws.String("bucket"),
+		EncodingType: aws.String("none"),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a%2Fb", *out.Contents[0].Key)
+	assert.Contains(t, (*queries)[0], "encoding-type=none")
+}